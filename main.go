@@ -20,6 +20,7 @@ package main
 
 import (
 	"os"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	cli "github.com/urfave/cli/v2"
@@ -27,6 +28,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/harvester/seeder/pkg/controllers"
+	"github.com/harvester/seeder/pkg/render"
 )
 
 var (
@@ -76,6 +78,27 @@ func main() {
 			Destination: &s.EnableLeaderElection,
 			Usage:       "enable leader election",
 		},
+		&cli.IntFlag{
+			Name:        "bmc-max-concurrent-jobs",
+			EnvVars:     []string{"BMC_MAX_CONCURRENT_JOBS"},
+			Value:       5,
+			Destination: &s.BMCMaxConcurrentJobs,
+			Usage:       "maximum number of power action jobs running at once against BMCs in the same subnet, 0 disables the limit",
+		},
+		&cli.DurationFlag{
+			Name:        "bmc-job-spacing",
+			EnvVars:     []string{"BMC_JOB_SPACING"},
+			Value:       2 * time.Second,
+			Destination: &s.BMCJobSpacing,
+			Usage:       "minimum interval between power action jobs submitted to BMCs in the same subnet",
+		},
+		&cli.IntFlag{
+			Name:        "bmc-subnet-prefix-length",
+			EnvVars:     []string{"BMC_SUBNET_PREFIX_LENGTH"},
+			Value:       24,
+			Destination: &s.BMCSubnetPrefixLength,
+			Usage:       "prefix length used to group IPv4 BMC addresses into subnets for job scheduling",
+		},
 		&cli.BoolFlag{
 			Name:        "debug",
 			EnvVars:     []string{"DEBUG"},
@@ -85,6 +108,38 @@ func main() {
 		},
 	}
 
+	var renderOpts render.Options
+	app.Commands = []*cli.Command{
+		{
+			Name:  "render",
+			Usage: "render the tinkerbell objects, ipxe script and harvester config seeder generates for Cluster, Inventory and AddressPool manifests, without a kubernetes cluster",
+			Flags: []cli.Flag{
+				&cli.StringSliceFlag{
+					Name:     "file",
+					Aliases:  []string{"f"},
+					Required: true,
+					Usage:    "yaml file containing Cluster, Inventory, AddressPool and optional seeder-config ConfigMap objects, can be repeated",
+				},
+				&cli.StringFlag{
+					Name:        "seeder-address",
+					Value:       render.DefaultSeederAddress,
+					Destination: &renderOpts.SeederAddress,
+					Usage:       "placeholder address for the seeder endpoint service",
+				},
+				&cli.StringFlag{
+					Name:        "tink-stack-address",
+					Value:       render.DefaultTinkStackAddress,
+					Destination: &renderOpts.TinkStackAddress,
+					Usage:       "placeholder address for the tink-stack service",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				renderOpts.Files = c.StringSlice("file")
+				return render.Run(os.Stdout, renderOpts)
+			},
+		},
+	}
+
 	app.Action = func(c *cli.Context) error {
 		ctx := ctrl.SetupSignalHandler()
 		return s.Start(ctx)
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -15,6 +16,26 @@ const (
 	AddressPoolFinalizer = "finalizer.addresspool.harvesterhci.io"
 )
 
+const (
+	// KindReserved marks the reserved addresses of the pool in the address allocation
+	KindReserved string = "reserved"
+	// KindExternal marks addresses in use in the external ipam provider, which are not allocated by seeder
+	KindExternal string = "external"
+)
+
+// IPAMProviderType is the provider which the addresses of a pool are reserved with
+// +kubebuilder:validation:Enum=inCluster;netbox
+type IPAMProviderType string
+
+const (
+	IPAMProviderInCluster IPAMProviderType = "inCluster"
+	IPAMProviderNetBox    IPAMProviderType = "netbox"
+)
+
+const (
+	NetBoxTokenKey = "token"
+)
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:printcolumn:name="AddressPoolStatus",type="string",JSONPath=`.status.status`
@@ -36,6 +57,24 @@ type AddressSpec struct {
 	Netmask           string   `json:"netmask,omitempty"`
 	Gateway           string   `json:"gateway"`
 	ReservedAddresses []string `json:"reservedAddresses,omitempty"`
+	// IPAM configures the provider which the allocated addresses are reserved with. Addresses are only tracked in
+	// the pool status when not specified
+	IPAM *IPAMSpec `json:"ipam,omitempty"`
+}
+
+type IPAMSpec struct {
+	// +kubebuilder:default=inCluster
+	Provider IPAMProviderType `json:"provider,omitempty"`
+	NetBox   *NetBoxIPAMSpec  `json:"netbox,omitempty"`
+}
+
+// NetBoxIPAMSpec reserves the allocated addresses as ip addresses in netbox, or a server implementing the netbox
+// ipam rest api
+type NetBoxIPAMSpec struct {
+	URL string `json:"url"`
+	// TokenSecretRef references a secret with the api token in the token key, and defaults to the pool namespace
+	TokenSecretRef        corev1.SecretReference `json:"tokenSecretRef"`
+	InsecureSkipTLSVerify bool                   `json:"insecureSkipTLSVerify,omitempty"`
 }
 
 type AddressStatus struct {
@@ -45,6 +84,19 @@ type AddressStatus struct {
 	AvailableAddresses int                                `json:"availableAddresses"`
 	AddressAllocation  map[string]ObjectReferenceWithKind `json:"addressAllocation"`
 	Netmask            string                             `json:"netmask"`
+	// ExternalIDs maps allocated addresses to the id of their reservation in the external ipam provider
+	ExternalIDs map[string]string `json:"externalIDs,omitempty"`
+	// IPAMSync reports the last reconcile of the address allocation with the external ipam provider
+	IPAMSync *IPAMSyncStatus `json:"ipamSync,omitempty"`
+}
+
+type IPAMSyncStatus struct {
+	// LastSynced is the time of the last successful sync
+	LastSynced string `json:"lastSynced,omitempty"`
+	// Conflicts lists the allocated addresses which are used by others in the provider
+	Conflicts []string `json:"conflicts,omitempty"`
+	// Error reports why the last sync failed
+	Error string `json:"error,omitempty"`
 }
 
 type ObjectReferenceWithKind struct {
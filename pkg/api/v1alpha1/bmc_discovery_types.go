@@ -0,0 +1,113 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type BMCDiscoveryStatusType string
+
+type DiscoveredMachineStatus string
+
+const (
+	BMCDiscoveryScanComplete BMCDiscoveryStatusType = "scanComplete"
+	BMCDiscoveryScanError    BMCDiscoveryStatusType = "scanError"
+	// BMCDiscoveryScanInProgress is set while the addresses of a scan are probed in batches
+	BMCDiscoveryScanInProgress BMCDiscoveryStatusType = "scanInProgress"
+)
+
+const (
+	DiscoveredMachineInventoryCreated    DiscoveredMachineStatus = "inventoryCreated"
+	DiscoveredMachineDuplicate           DiscoveredMachineStatus = "duplicateInventory"
+	DiscoveredMachineAuthenticationError DiscoveredMachineStatus = "authenticationFailed"
+	DiscoveredMachineError               DiscoveredMachineStatus = "error"
+)
+
+const (
+	BMCDiscoveryLabelKey = "metal.harvesterhci.io/bmc-discovery"
+)
+
+// BMCDiscoverySpec defines the networks to scan for redfish endpoints, and the defaults
+// applied to inventory objects generated for discovered machines
+type BMCDiscoverySpec struct {
+	// +kubebuilder:validation:MinItems=1
+	CIDRs []string `json:"cidrs"`
+	// Credentials are secrets containing a username and password. Each secret is tried in order
+	// against a discovered endpoint, and the first one to authenticate is used by the generated inventory
+	// +kubebuilder:validation:MinItems=1
+	Credentials []corev1.SecretReference `json:"credentials"`
+	// +kubebuilder:default=443
+	RedfishPort int `json:"redfishPort,omitempty"`
+	// BMCPort is the port set on the baseboard connection of generated inventories
+	// +kubebuilder:default=623
+	BMCPort int `json:"bmcPort,omitempty"`
+	// +kubebuilder:default="/dev/sda"
+	PrimaryDisk string `json:"primaryDisk,omitempty"`
+	Events      Events `json:"events,omitempty"`
+	// +kubebuilder:default:="24h"
+	// +kubebuilder:validation:Format:=duration
+	ScanInterval string `json:"scanInterval,omitempty"`
+	// +kubebuilder:default:="5s"
+	// +kubebuilder:validation:Format:=duration
+	ProbeTimeout string `json:"probeTimeout,omitempty"`
+	// VerifyTLS verifies the certificates of discovered bmcs when authenticating with the credentials, and on
+	// the baseboard connection of generated inventories. Bmcs commonly use self signed certificates, so
+	// certificates are not verified by default. Probing for the redfish service root sends no credentials,
+	// and never verifies certificates
+	VerifyTLS bool `json:"verifyTLS,omitempty"`
+}
+
+type DiscoveredMachine struct {
+	Address   string                  `json:"address"`
+	Status    DiscoveredMachineStatus `json:"status"`
+	Inventory ObjectReference         `json:"inventory,omitempty"`
+	Message   string                  `json:"message,omitempty"`
+}
+
+// BMCDiscoveryScanProgress tracks a scan which is split into batches of addresses across reconciles
+type BMCDiscoveryScanProgress struct {
+	// ObservedGeneration is the generation of the spec being scanned. The scan restarts if the spec changes
+	ObservedGeneration int64 `json:"observedGeneration"`
+	ScannedAddresses   int   `json:"scannedAddresses"`
+	TotalAddresses     int   `json:"totalAddresses"`
+	// DiscoveredMachines are the machines discovered by the scan so far
+	DiscoveredMachines []DiscoveredMachine `json:"discoveredMachines,omitempty"`
+}
+
+type BMCDiscoveryStatus struct {
+	Status             BMCDiscoveryStatusType `json:"status,omitempty"`
+	Message            string                 `json:"message,omitempty"`
+	LastScanTime       string                 `json:"lastScanTime,omitempty"`
+	DiscoveredMachines []DiscoveredMachine    `json:"discoveredMachines,omitempty"`
+	// ScanProgress is set while a scan is in progress. DiscoveredMachines holds the results of the last
+	// complete scan until the scan in progress completes
+	ScanProgress *BMCDiscoveryScanProgress `json:"scanProgress,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Status",type="string",JSONPath=`.status.status`
+//+kubebuilder:printcolumn:name="LastScanTime",type="string",JSONPath=`.status.lastScanTime`
+//+kubebuilder:printcolumn:name="Message",type="string",JSONPath=`.status.message`
+
+// BMCDiscovery scans networks for redfish endpoints and generates Inventory objects for discovered machines
+type BMCDiscovery struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BMCDiscoverySpec   `json:"spec,omitempty"`
+	Status BMCDiscoveryStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// BMCDiscoveryList contains a list of BMCDiscovery
+type BMCDiscoveryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BMCDiscovery `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BMCDiscovery{}, &BMCDiscoveryList{})
+}
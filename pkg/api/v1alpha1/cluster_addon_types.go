@@ -0,0 +1,64 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterAddonSpec defines the manifests and harvester settings applied to clusters referencing the addon. Manifests
+// are applied before settings, so settings can refer to objects created by the manifests
+type ClusterAddonSpec struct {
+	// ManifestConfigMaps are configmaps in the addon namespace containing kubernetes manifests. The configmaps are
+	// applied in order, and the keys of each configmap in sorted order
+	ManifestConfigMaps []string `json:"manifestConfigMaps,omitempty"`
+	// Settings are harvester settings applied in order
+	Settings []HarvesterSetting `json:"settings,omitempty"`
+}
+
+// HarvesterSetting is the value of a harvester setting
+type HarvesterSetting struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type ClusterAddonPhase string
+
+const (
+	ClusterAddonPending ClusterAddonPhase = "pending"
+	ClusterAddonApplied ClusterAddonPhase = "applied"
+	ClusterAddonFailed  ClusterAddonPhase = "failed"
+)
+
+// ClusterAddonStatus reports the result of applying an addon to the cluster
+type ClusterAddonStatus struct {
+	Name    string            `json:"name"`
+	Phase   ClusterAddonPhase `json:"phase"`
+	Message string            `json:"message,omitempty"`
+	// ObservedGeneration is the generation of the addon last applied
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastDriftCorrected is the last time objects or settings changed in the cluster were re-applied
+	LastDriftCorrected string `json:"lastDriftCorrected,omitempty"`
+	LastUpdated        string `json:"lastUpdated,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterAddon is the Schema for the ClusterAddon API
+type ClusterAddon struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterAddonSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterAddonList contains a list of ClusterAddon
+type ClusterAddonList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterAddon `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterAddon{}, &ClusterAddonList{})
+}
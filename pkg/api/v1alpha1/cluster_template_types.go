@@ -0,0 +1,49 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ClusterTemplateFieldsAnnotation records the cluster fields defaulted from the cluster template when the
+	// cluster was created
+	ClusterTemplateFieldsAnnotation = "metal.harvesterhci.io/cluster-template-fields"
+	// ClusterTemplateGenerationAnnotation records the generation of the cluster template the cluster was
+	// defaulted from
+	ClusterTemplateGenerationAnnotation = "metal.harvesterhci.io/cluster-template-generation"
+)
+
+// ClusterTemplateSpec holds the settings shared by clusters referencing the template. Settings which are not
+// specified on a cluster default to those of the template when the cluster is created
+type ClusterTemplateSpec struct {
+	HarvesterVersion string `json:"version,omitempty"`
+	ImageURL         string `json:"imageURL,omitempty"`
+	// VIPAddressPoolReference is the address pool the cluster vip is allocated from
+	VIPAddressPoolReference *ObjectReference `json:"vipAddressPoolReference,omitempty"`
+	ClusterConfig           ClusterConfig    `json:"clusterConfig,omitempty"`
+	// Addons are the names of ClusterAddons applied to clusters created from the template
+	Addons []string `json:"addons,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterTemplate is the Schema for the ClusterTemplate API
+type ClusterTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterTemplateSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterTemplateList contains a list of ClusterTemplate
+type ClusterTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterTemplate{}, &ClusterTemplateList{})
+}
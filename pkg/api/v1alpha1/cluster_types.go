@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -25,6 +26,17 @@ const (
 	DefaultLocalClusterName      = "local"
 	DefaultLocalClusterNamespace = "harvester-system"
 	DefaultLocalClusterAddress   = "10.53.0.1"
+	VirtualMediaURLAnnotation    = "metal.harvesterhci.io/virtual-media-url"
+	InstallCompletedAnnotation   = "metal.harvesterhci.io/install-completed"
+	ForceNodeRemovalAnnotation   = "metal.harvesterhci.io/force-removal"
+	RancherTokenKey              = "token"
+)
+
+type ProvisioningMode string
+
+const (
+	ProvisioningModePXE          ProvisioningMode = "pxe"
+	ProvisioningModeVirtualMedia ProvisioningMode = "virtualMedia"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -34,9 +46,113 @@ const (
 type ClusterSpec struct {
 	HarvesterVersion string       `json:"version"`
 	ImageURL         string       `json:"imageURL,omitempty"`
+	Artifacts        ArtifactSpec `json:"artifacts,omitempty"`
 	Nodes            []NodeConfig `json:"nodes"`
 	VIPConfig        `json:"vipConfig"`
 	ClusterConfig    `json:"clusterConfig,omitempty"`
+	// SparePool selects free inventory which can replace nodes with critical hardware health
+	SparePool *SparePoolSpec `json:"sparePool,omitempty"`
+	// TopologySpreadConstraints spread cluster nodes across the failure domains of the inventory location
+	TopologySpreadConstraints []TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// NodeMetadata maps inventory attributes to labels and annotations on the harvester nodes. A default mapping
+	// is used if not specified
+	NodeMetadata []NodeMetadataMapping `json:"nodeMetadata,omitempty"`
+	// ClusterTemplateName is a cluster template in the same namespace, which provides the defaults of settings
+	// not specified on the cluster when it is created
+	ClusterTemplateName string `json:"clusterTemplateName,omitempty"`
+	// Addons are the names of ClusterAddons in the same namespace, which are applied in order once the cluster is
+	// running
+	Addons []string `json:"addons,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=bmcAddress;inventoryName;inventoryNamespace;manufacturer;model;serialNumber;biosVersion;bmcFirmwareVersion;gpus;pciDevices;location
+type NodeMetadataSource string
+
+const (
+	NodeMetadataBMCAddress         NodeMetadataSource = "bmcAddress"
+	NodeMetadataInventoryName      NodeMetadataSource = "inventoryName"
+	NodeMetadataInventoryNamespace NodeMetadataSource = "inventoryNamespace"
+	NodeMetadataManufacturer       NodeMetadataSource = "manufacturer"
+	NodeMetadataModel              NodeMetadataSource = "model"
+	NodeMetadataSerialNumber       NodeMetadataSource = "serialNumber"
+	NodeMetadataBIOSVersion        NodeMetadataSource = "biosVersion"
+	NodeMetadataBMCFirmwareVersion NodeMetadataSource = "bmcFirmwareVersion"
+	NodeMetadataGPUs               NodeMetadataSource = "gpus"
+	NodeMetadataPCIDevices         NodeMetadataSource = "pciDevices"
+	NodeMetadataLocation           NodeMetadataSource = "location"
+)
+
+// +kubebuilder:validation:Enum=label;annotation
+type NodeMetadataTarget string
+
+const (
+	NodeMetadataLabel      NodeMetadataTarget = "label"
+	NodeMetadataAnnotation NodeMetadataTarget = "annotation"
+)
+
+// NodeMetadataMapping publishes an inventory attribute as a label or annotation on the harvester node
+type NodeMetadataMapping struct {
+	Source NodeMetadataSource `json:"source"`
+	// Key of the label or annotation, which defaults to metal.harvesterhci.io/ followed by the source
+	Key string `json:"key,omitempty"`
+	// Target is the type of metadata. Label values are truncated and invalid characters replaced
+	// +kubebuilder:default:=annotation
+	Target NodeMetadataTarget `json:"target,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=datacenter;room;rack;powerDomain
+type TopologyKey string
+
+const (
+	TopologyKeyDatacenter  TopologyKey = "datacenter"
+	TopologyKeyRoom        TopologyKey = "room"
+	TopologyKeyRack        TopologyKey = "rack"
+	TopologyKeyPowerDomain TopologyKey = "powerDomain"
+)
+
+// +kubebuilder:validation:Enum=management;all
+type TopologySpreadScope string
+
+const (
+	// TopologySpreadScopeManagement applies a constraint to the first three nodes of the cluster, which Harvester
+	// promotes to management nodes
+	TopologySpreadScopeManagement TopologySpreadScope = "management"
+	TopologySpreadScopeAll        TopologySpreadScope = "all"
+)
+
+// +kubebuilder:validation:Enum=DoNotSchedule;ScheduleAnyway
+type UnsatisfiableConstraintAction string
+
+const (
+	DoNotSchedule  UnsatisfiableConstraintAction = "DoNotSchedule"
+	ScheduleAnyway UnsatisfiableConstraintAction = "ScheduleAnyway"
+)
+
+// TopologySpreadConstraint limits the number of cluster nodes placed in the same failure domain
+type TopologySpreadConstraint struct {
+	TopologyKey TopologyKey `json:"topologyKey"`
+	// +kubebuilder:default:=management
+	Scope TopologySpreadScope `json:"scope,omitempty"`
+	// MaxNodesPerDomain is the number of nodes in scope which can share a failure domain
+	// +kubebuilder:default:=1
+	// +kubebuilder:validation:Minimum=1
+	MaxNodesPerDomain int `json:"maxNodesPerDomain,omitempty"`
+	// WhenUnsatisfiable DoNotSchedule rejects nodes and spares which violate the constraint, while ScheduleAnyway
+	// only prefers spares which satisfy it. Violations are reported in the cluster status in both cases
+	// +kubebuilder:default:=DoNotSchedule
+	WhenUnsatisfiable UnsatisfiableConstraintAction `json:"whenUnsatisfiable,omitempty"`
+}
+
+// SparePoolSpec defines the inventory used to replace failed nodes. A replacement keeps the address, hostname and
+// role of the failed node, and the spare is installed in join mode
+type SparePoolSpec struct {
+	// Selector matches free inventory which can be used as a spare
+	Selector metav1.LabelSelector `json:"selector"`
+	// AutoReplace replaces nodes whose inventory reports critical hardware health with a spare from the pool.
+	// Nodes are replaced one at a time, and needs events to be enabled on the inventory. The controller updates
+	// the inventoryReference of the failed node in spec.nodes to the spare, so tools applying the cluster spec,
+	// such as gitops, need to ignore changes to spec.nodes or keep AutoReplace disabled
+	AutoReplace bool `json:"autoReplace,omitempty"`
 }
 
 type VIPConfig struct {
@@ -56,6 +172,146 @@ type ClusterConfig struct {
 	VlanID          int  `json:"vlanID,omitempty"`
 	StreamImageMode bool `json:"streamImageMode,omitempty"`
 	WipeDisks       bool `json:"wipeDisks,omitempty"`
+	// ProvisioningMode controls how nodes boot the harvester installer. pxe requires nodes to be on the same
+	// L2 segment as smee, while virtualMedia mounts an ISO via the BMC and needs no DHCP
+	// +kubebuilder:validation:Enum=pxe;virtualMedia
+	// +kubebuilder:default=pxe
+	ProvisioningMode ProvisioningMode `json:"provisioningMode,omitempty"`
+	VirtualMedia     VirtualMediaSpec `json:"virtualMedia,omitempty"`
+	// MaxInstallingNodes limits the number of nodes installing at once, to avoid saturating the image server
+	// and uplink. Remaining nodes are rebooted into the installer as installations complete. 0 disables the limit
+	// +kubebuilder:validation:Minimum=0
+	MaxInstallingNodes int `json:"maxInstallingNodes,omitempty"`
+	// JoinBatchSize limits the number of join nodes rebooted into the installer at once. Join nodes are held until
+	// the create node API answers, and each batch waits for the previous batch to complete installation.
+	// 0 admits all join nodes at once
+	// +kubebuilder:validation:Minimum=0
+	JoinBatchSize int `json:"joinBatchSize,omitempty"`
+	// Decommission sanitizes inventory removed from the cluster, or freed when the cluster is deleted,
+	// before it is returned to the pool
+	Decommission DecommissionSpec `json:"decommission,omitempty"`
+	// RancherRegistration imports the cluster into rancher once it is running
+	RancherRegistration *RancherRegistrationSpec `json:"rancherRegistration,omitempty"`
+	// NTPServers are the ntp servers used by the nodes
+	NTPServers []string `json:"ntpServers,omitempty"`
+	// Proxy is used by the nodes and the cluster to reach external networks
+	Proxy *ProxyConfig `json:"proxy,omitempty"`
+	// RegistryMirrors configure containerd to pull images of upstream registries from mirrors
+	RegistryMirrors []RegistryMirror `json:"registryMirrors,omitempty"`
+	// Sysctls are kernel parameters set on the nodes
+	Sysctls map[string]string `json:"sysctls,omitempty"`
+	// KernelArgs are appended to the kernel command line of the installed nodes
+	KernelArgs []string `json:"kernelArgs,omitempty"`
+	// WriteFiles are written to the nodes when harvester is installed
+	WriteFiles []WriteFile `json:"writeFiles,omitempty"`
+	// AfterInstallCommands are run in a chroot of the installed os, after seeder configures the boot loader
+	AfterInstallCommands []string `json:"afterInstallCommands,omitempty"`
+}
+
+// ProxyConfig defines the http proxy of the nodes and the cluster. NoProxy should include the node and cluster
+// networks, so traffic inside the cluster is not sent to the proxy
+type ProxyConfig struct {
+	HTTPProxy  string   `json:"httpProxy,omitempty"`
+	HTTPSProxy string   `json:"httpsProxy,omitempty"`
+	NoProxy    []string `json:"noProxy,omitempty"`
+}
+
+// RegistryMirror defines the mirror endpoints containerd pulls images of an upstream registry from
+type RegistryMirror struct {
+	// Registry is the upstream registry, such as docker.io
+	Registry string `json:"registry"`
+	// Endpoints are the urls of the mirror, tried in order
+	Endpoints []string `json:"endpoints"`
+	// CredentialsSecretRef is a secret with the username and password keys used to authenticate with the mirror
+	CredentialsSecretRef *corev1.SecretReference `json:"credentialsSecretRef,omitempty"`
+	// InsecureSkipTLSVerify skips verification of the mirror certificate
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+}
+
+// WriteFile is a file written to the nodes when harvester is installed
+type WriteFile struct {
+	// Path is the absolute path of the file
+	Path    string `json:"path"`
+	Content string `json:"content"`
+	// Owner of the file, in the user:group format
+	Owner string `json:"owner,omitempty"`
+	// Permissions of the file in octal notation, such as 0644
+	Permissions string `json:"permissions,omitempty"`
+	// Encoding of the content
+	// +kubebuilder:validation:Enum=b64;base64;gz;gzip;gz+base64;gzip+base64;gz+b64;gzip+b64
+	Encoding string `json:"encoding,omitempty"`
+}
+
+// RancherRegistrationSpec defines the rancher server the cluster is imported into. The cluster is created in rancher
+// using the api token, and the registration manifest url is applied to the cluster-registration-url harvester setting
+type RancherRegistrationSpec struct {
+	// URL of the rancher server
+	URL string `json:"url"`
+	// TokenSecretRef is a secret with a rancher api token in the token key
+	TokenSecretRef corev1.SecretReference `json:"tokenSecretRef"`
+	// ClusterName of the imported cluster in rancher, which defaults to the cluster namespace and name
+	ClusterName string `json:"clusterName,omitempty"`
+	// InsecureSkipTLSVerify skips verification of the rancher server certificate
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+}
+
+type DecommissionMode string
+
+const (
+	DecommissionModeNone     DecommissionMode = "none"
+	DecommissionModeWorkflow DecommissionMode = "workflow"
+	DecommissionModeRedfish  DecommissionMode = "redfish"
+)
+
+// DecommissionSpec defines how inventory is sanitized before it is returned to the pool. Inventory which is
+// sanitized successfully has the sanitized condition, and inventory which fails to be sanitized is quarantined
+type DecommissionSpec struct {
+	// Mode workflow pxe boots the tinkerbell hook os and runs a workflow wiping all disks, redfish requests a
+	// secure erase of all drives via the BMC, and none returns inventory to the pool without wiping disks
+	// +kubebuilder:validation:Enum=none;workflow;redfish
+	// +kubebuilder:default=none
+	Mode DecommissionMode `json:"mode,omitempty"`
+	// ResetBIOS resets bios settings to their defaults via redfish once disks have been wiped
+	ResetBIOS bool `json:"resetBIOS,omitempty"`
+	// Timeout after which inventory which has not been sanitized is quarantined
+	// +kubebuilder:default:="2h"
+	// +kubebuilder:validation:Format:=duration
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// VirtualMediaSpec defines the ISO mounted via the BMC when using virtualMedia provisioning mode.
+// The ISO needs to boot the installer with harvester.install.config_url set to .ConfigURL, which serves
+// the per node harvester config from the seeder endpoint
+type VirtualMediaSpec struct {
+	// ISOURL is an explicit url or a go template, which is rendered per node with .ISOURL, .ConfigURL, .Name,
+	// .Namespace, .Address, .Netmask, .Gateway, .MACAddress, .VlanID, .Version and .Arch.
+	// Defaults to the ISO artifact of the cluster
+	ISOURL string `json:"isoURL,omitempty"`
+}
+
+// ArtifactSpec allows overriding the locations of the harvester release artifacts.
+// Each url can be an explicit url or a go template, which is rendered with .ImageURL, .Version and .Arch.
+// Artifacts which are not specified default to the harvester release layout under ImageURL
+type ArtifactSpec struct {
+	Kernel   Artifact         `json:"kernel,omitempty"`
+	Initrd   Artifact         `json:"initrd,omitempty"`
+	RootFS   Artifact         `json:"rootfs,omitempty"`
+	ISO      Artifact         `json:"iso,omitempty"`
+	RawImage RawImageArtifact `json:"rawImage,omitempty"`
+}
+
+type Artifact struct {
+	URL string `json:"url,omitempty"`
+}
+
+type RawImageArtifact struct {
+	URL string `json:"url,omitempty"`
+	// Checksum of the raw image in the format <algorithm>:<hex digest>, for example sha256:abcd...
+	// Seeder does not verify the image. The checksum is passed to the stream harvester action as IMG_CHECKSUM,
+	// and the image is only verified if the action image, set with the stream-harvester-image key of the
+	// seeder-config configmap, checks it
+	// +kubebuilder:validation:Pattern=`^(sha256|sha512):[a-fA-F0-9]+$`
+	Checksum string `json:"checksum,omitempty"`
 }
 
 type NodeConfig struct {
@@ -74,6 +330,68 @@ type ClusterStatus struct {
 	ClusterToken   string                `json:"token,omitempty"`
 	Status         ClusterWorkflowStatus `json:"status,omitempty"`
 	ClusterAddress string                `json:"clusterAddress,omitempty"`
+	// CreateNodeReady is set once the create node API answers, and join nodes can be admitted
+	CreateNodeReady bool `json:"createNodeReady,omitempty"`
+	// NodeRemovals reports the progress of nodes being removed from the cluster
+	NodeRemovals []NodeRemovalStatus `json:"nodeRemovals,omitempty"`
+	// NodeReplacements tracks spare inventory replacing failed nodes, until the spare is allocated the identity
+	// of the failed node
+	NodeReplacements []NodeReplacementStatus `json:"nodeReplacements,omitempty"`
+	// TopologyViolations reports the nodes which do not satisfy the topology spread constraints
+	TopologyViolations []string `json:"topologyViolations,omitempty"`
+	// TemplateDrift lists the fields defaulted from the cluster template whose value no longer matches the
+	// template. Template changes are not applied to existing clusters
+	TemplateDrift []string `json:"templateDrift,omitempty"`
+	// RancherImport reports the progress of importing the cluster into rancher
+	RancherImport *RancherImportStatus `json:"rancherImport,omitempty"`
+	// Addons reports the result of applying each cluster addon
+	Addons []ClusterAddonStatus `json:"addons,omitempty"`
+}
+
+type RancherImportPhase string
+
+const (
+	RancherImportRegistering RancherImportPhase = "registering"
+	RancherImportActive      RancherImportPhase = "active"
+	RancherImportFailed      RancherImportPhase = "failed"
+)
+
+// RancherImportStatus tracks the import of the cluster into rancher
+type RancherImportStatus struct {
+	// ClusterID of the imported cluster in rancher
+	ClusterID   string             `json:"clusterID,omitempty"`
+	Phase       RancherImportPhase `json:"phase"`
+	Message     string             `json:"message,omitempty"`
+	LastUpdated string             `json:"lastUpdated,omitempty"`
+}
+
+type NodeRemovalPhase string
+
+const (
+	NodeRemovalDraining         NodeRemovalPhase = "draining"
+	NodeRemovalEvictingReplicas NodeRemovalPhase = "evictingReplicas"
+	NodeRemovalDeletingNode     NodeRemovalPhase = "deletingNode"
+	NodeRemovalDecommissioning  NodeRemovalPhase = "decommissioning"
+	NodeRemovalPoweringOff      NodeRemovalPhase = "poweringOff"
+)
+
+// NodeRemovalStatus tracks an inventory removed from the cluster. Nodes are cordoned and drained, longhorn replicas
+// are evicted and the kubernetes node is deleted, and the machine is decommissioned before it is powered off and
+// the inventory is freed
+type NodeRemovalStatus struct {
+	InventoryReference ObjectReference  `json:"inventoryReference"`
+	NodeName           string           `json:"nodeName,omitempty"`
+	Phase              NodeRemovalPhase `json:"phase"`
+	Message            string           `json:"message,omitempty"`
+	LastUpdated        string           `json:"lastUpdated,omitempty"`
+}
+
+// NodeReplacementStatus records the identity carried over from a failed inventory to the spare replacing it
+type NodeReplacementStatus struct {
+	FailedInventory ObjectReference `json:"failedInventory"`
+	SpareInventory  ObjectReference `json:"spareInventory"`
+	Address         string          `json:"address"`
+	Hostname        string          `json:"hostname"`
 }
 
 type ClusterWorkflowStatus string
@@ -90,6 +408,8 @@ const (
 //+kubebuilder:printcolumn:name="ClusterStatus",type="string",JSONPath=`.status.status`
 //+kubebuilder:printcolumn:name="ClusterToken",type="string",JSONPath=`.status.token`
 //+kubebuilder:printcolumn:name="ClusterAddress",type="string",JSONPath=`.status.clusterAddress`
+//+kubebuilder:printcolumn:name="Template",type="string",JSONPath=`.spec.clusterTemplateName`,priority=1
+//+kubebuilder:printcolumn:name="Rancher",type="string",JSONPath=`.status.rancherImport.phase`,priority=1
 
 // Cluster is the Schema for the clusters API
 type Cluster struct {
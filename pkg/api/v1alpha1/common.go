@@ -22,14 +22,26 @@ var (
 	ClusterGroupVersionKind           = schema.GroupVersionKind{Group: GroupVersion.Group, Version: GroupVersion.Version, Kind: "Cluster"}
 	InventoryTemplateGroupVersionKind = schema.GroupVersionKind{Group: GroupVersion.Group, Version: GroupVersion.Version, Kind: "InventoryTemplate"}
 	NestedClusterGroupVersionKind     = schema.GroupVersionKind{Group: GroupVersion.Group, Version: GroupVersion.Version, Kind: "NestedCluster"}
+	SeederClusterGroupVersionKind     = schema.GroupVersionKind{Group: GroupVersion.Group, Version: GroupVersion.Version, Kind: "SeederCluster"}
 )
 
 const (
 	NodePowerActionShutdown              = "shutdown"
 	NodePowerActionPowerOn               = "poweron"
 	NodePowerActionReboot                = "reboot"
+	NodePowerActionSoftShutdown          = "softshutdown"
+	NodePowerActionPowerCycle            = "powercycle"
+	NodePowerActionWarmReset             = "warmreset"
+	NodePowerActionBootDisk              = "bootdisk"
+	NodePowerActionBootBIOS              = "bootbios"
+	NodePowerActionBootCDROM             = "bootcdrom"
+	NodePowerActionPersistentBootDisk    = "persistentbootdisk"
+	NodePowerActionPersistentBootPXE     = "persistentbootpxe"
+	NodePowerActionVirtualMediaBoot      = "virtualmediaboot"
 	NodeJobComplete                      = "complete"
 	NodeJobFailed                        = "failed"
+	NodeJobPending                       = "pending"
+	NodeJobUnknown                       = "unknown"
 	DefaultHarvesterProvisioningTemplate = "default-harvester-template"
 	DefaultTinkStackService              = "tink-stack"
 	SeederConfig                         = "seeder-config"
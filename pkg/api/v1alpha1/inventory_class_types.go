@@ -0,0 +1,71 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// InventoryClassSpec describes a hardware profile, and the namespaces allowed to allocate inventory of the class
+type InventoryClassSpec struct {
+	Description string `json:"description,omitempty"`
+	// Requirements are checked against the hardware discovered via redfish on inventory of the class
+	Requirements HardwareRequirements `json:"requirements,omitempty"`
+	// AllowedNamespaces can allocate inventory of the class to clusters. All namespaces are allowed if not specified
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
+	// Quotas limit the number of inventories of the class allocated to clusters in a namespace
+	Quotas []NamespaceQuota `json:"quotas,omitempty"`
+	// DefaultQuota applies to namespaces without a quota, and allocation is not limited if not specified
+	// +kubebuilder:validation:Minimum=0
+	DefaultQuota *int `json:"defaultQuota,omitempty"`
+}
+
+type NamespaceQuota struct {
+	Namespace string `json:"namespace"`
+	// +kubebuilder:validation:Minimum=0
+	Limit int `json:"limit"`
+}
+
+type InventoryClassStatus struct {
+	// Inventories is the number of inventories of the class
+	Inventories int `json:"inventories"`
+	// Available is the number of ready inventories of the class which are not allocated or quarantined
+	Available int `json:"available"`
+	// Usage is the number of inventories of the class allocated to clusters in each namespace
+	Usage []NamespaceUsage `json:"usage,omitempty"`
+	// NonConforming lists inventories of the class whose discovered hardware does not meet the requirements
+	NonConforming []ObjectReference `json:"nonConforming,omitempty"`
+}
+
+type NamespaceUsage struct {
+	Namespace string `json:"namespace"`
+	Allocated int    `json:"allocated"`
+	// Limit is the quota of the namespace, and is not set if allocation is not limited
+	Limit *int `json:"limit,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:scope=Cluster
+//+kubebuilder:printcolumn:name="Inventories",type="integer",JSONPath=`.status.inventories`
+//+kubebuilder:printcolumn:name="Available",type="integer",JSONPath=`.status.available`
+
+// InventoryClass groups inventories with the same hardware profile, and limits which namespaces can allocate them
+type InventoryClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InventoryClassSpec   `json:"spec,omitempty"`
+	Status InventoryClassStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// InventoryClassList contains a list of InventoryClass
+type InventoryClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InventoryClass `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&InventoryClass{}, &InventoryClassList{})
+}
@@ -31,6 +31,12 @@ type TaskWorkflowStatus string
 
 type ConditionType condition.Cond
 
+type DesiredPowerState string
+
+type PowerActionRequester string
+
+type QuarantineSource string
+
 const (
 	KindCluster   string = "cluster"
 	KindInventory string = "inventory"
@@ -40,12 +46,46 @@ const (
 	InventoryFinalizer       = "finalizer.inventory.metal.harvesterhci.io"
 	LocalInventoryAnnotation = "metal.harvesterhci.io/local-inventory"
 	LocalInventoryNodeName   = "metal.harvesterhci.io/local-node-name"
+	// ReleaseQuarantineAnnotation releases quarantined inventory back to the pool
+	ReleaseQuarantineAnnotation = "metal.harvesterhci.io/release-quarantine"
+	// DecommissionWorkflowLabel identifies tinkerbell templates and workflows used to wipe inventory
+	DecommissionWorkflowLabel = "decommission.metal.harvesterhci.io"
+	// RetryAcceptanceAnnotation clears the acceptance results of an inventory, and runs the acceptance checks again
+	RetryAcceptanceAnnotation = "metal.harvesterhci.io/retry-acceptance"
+	// RediscoverHardwareAnnotation discovers the hardware of an inventory again via redfish, and is removed once
+	// the discovered hardware has been updated
+	RediscoverHardwareAnnotation = "metal.harvesterhci.io/rediscover-hardware"
+	// BurnInWorkflowLabel identifies tinkerbell hardware, templates and workflows used to burn in inventory
+	BurnInWorkflowLabel = "burnin.metal.harvesterhci.io"
 )
 
 const (
 	InventoryReady InventoryWorkflowStatus = "inventoryNodeReady"
 )
 
+const (
+	DesiredPowerStateOn        DesiredPowerState = "on"
+	DesiredPowerStateOff       DesiredPowerState = "off"
+	DesiredPowerStateUnmanaged DesiredPowerState = "unmanaged"
+	DesiredPowerStateAuto      DesiredPowerState = "auto"
+)
+
+const (
+	PowerActionRequesterUser       PowerActionRequester = "user"
+	PowerActionRequesterController PowerActionRequester = "controller"
+)
+
+const (
+	QuarantineSourceUser           QuarantineSource = "user"
+	QuarantineSourceDecommission   QuarantineSource = "decommission"
+	QuarantineSourceAcceptance     QuarantineSource = "acceptance"
+	QuarantineSourceHardwareHealth QuarantineSource = "hardwareHealth"
+)
+
+const (
+	DefaultPowerActionHistoryLimit = 10
+)
+
 const (
 	BMCObjectCreated            condition.Cond = "bmcObjectCreated"
 	BMCJobSubmitted             condition.Cond = "bmcJobSubmitted"
@@ -60,18 +100,129 @@ const (
 	TinkHardwareCreated         condition.Cond = "tinkHardwareCreated"
 	TinkTemplateCreated         condition.Cond = "tinkTemplateCreated"
 	ClusterCleanupSubmitted     condition.Cond = "clusterCleanupSubmitted"
+	HardwareDiscovered          condition.Cond = "hardwareDiscovered"
+	HardwareMismatch            condition.Cond = "hardwareMismatch"
+	PowerStateDrift             condition.Cond = "powerStateDrift"
+	HarvesterInstalling         condition.Cond = "harvesterInstalling"
+	HarvesterJoinAdmitted       condition.Cond = "harvesterJoinAdmitted"
+	HardwareHealthCritical      condition.Cond = "hardwareHealthCritical"
+	Decommissioning             condition.Cond = "decommissioning"
+	SecureEraseRequested        condition.Cond = "secureEraseRequested"
+	Sanitized                   condition.Cond = "sanitized"
+	Quarantined                 condition.Cond = "quarantined"
+	HardwareAccepted            condition.Cond = "hardwareAccepted"
+	AcceptanceFailed            condition.Cond = "acceptanceFailed"
+	BurnInRunning               condition.Cond = "burnInRunning"
 )
 
 // InventorySpec defines the desired state of Inventory
 type InventorySpec struct {
-	PrimaryDisk                   string            `json:"primaryDisk"`
-	ManagementInterfaceMacAddress string            `json:"managementInterfaceMacAddress"`
-	BaseboardManagementSpec       rufio.MachineSpec `json:"baseboardSpec"`
-	Events                        `json:"events"`
-	PowerActionRequested          string `json:"powerActionRequested,omitempty"`
-	// +kubebuilder:default=amd64
+	PrimaryDisk string `json:"primaryDisk"`
+	// ManagementInterfaceMacAddress is optional, and is discovered via redfish if not specified
+	ManagementInterfaceMacAddress string `json:"managementInterfaceMacAddress,omitempty"`
+	// ManagementInterfaceSelector is used to pick the management interface from the interfaces discovered via redfish
+	ManagementInterfaceSelector *InterfaceSelector `json:"managementInterfaceSelector,omitempty"`
+	BaseboardManagementSpec     rufio.MachineSpec  `json:"baseboardSpec"`
+	Events                      `json:"events"`
+	// PowerActionRequested submits a one off power or boot operation for the machine.
+	// softshutdown requests a graceful shutdown, and falls back to a hard shutdown after softShutdownTimeout.
+	// persistentbootdisk and persistentbootpxe change the boot device for all subsequent boots
+	// +kubebuilder:validation:Enum=shutdown;poweron;reboot;softshutdown;powercycle;warmreset;bootdisk;bootbios;bootcdrom;persistentbootdisk;persistentbootpxe
+	PowerActionRequested string `json:"powerActionRequested,omitempty"`
+	// +kubebuilder:default:="5m"
+	// +kubebuilder:validation:Format:=duration
+	SoftShutdownTimeout string `json:"softShutdownTimeout,omitempty"`
+	// DesiredPowerState is continuously enforced against the machine power state reported by the BMC.
+	// auto keeps inventory allocated to a cluster powered on, and free inventory powered off
+	// +kubebuilder:validation:Enum=on;off;unmanaged;auto
+	// +kubebuilder:default=unmanaged
+	DesiredPowerState DesiredPowerState `json:"desiredPowerState,omitempty"`
+	// PowerActionHistoryLimit is the number of power action records retained in status
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=1
+	PowerActionHistoryLimit int `json:"powerActionHistoryLimit,omitempty"`
+	// Arch is optional, and is discovered via redfish if not specified. Hardware is not generated until the arch is known
 	// +kubebuilder:validation:Enum=amd64;arm64
 	Arch string `json:"arch,omitempty"`
+	// Acceptance checks the machine before it can be allocated to a cluster
+	Acceptance *AcceptanceSpec `json:"acceptance,omitempty"`
+	// Maintenance quarantines the inventory, and prevents it from being allocated to a cluster
+	Maintenance *MaintenanceSpec `json:"maintenance,omitempty"`
+	// InventoryClassName limits allocation of the inventory to the namespaces and quotas of the class
+	InventoryClassName string `json:"inventoryClassName,omitempty"`
+	// Location of the machine. Fields which are not specified are filled from the redfish chassis location
+	Location Location `json:"location,omitempty"`
+}
+
+// Location describes where a machine is installed, and is used to spread cluster nodes across failure domains
+type Location struct {
+	Datacenter string `json:"datacenter,omitempty"`
+	Room       string `json:"room,omitempty"`
+	Rack       string `json:"rack,omitempty"`
+	// RackPosition is the lowest rack unit occupied by the machine
+	RackPosition int `json:"rackPosition,omitempty"`
+	// PowerDomain groups machines sharing a power feed, and is not reported by redfish
+	PowerDomain string `json:"powerDomain,omitempty"`
+}
+
+// MaintenanceSpec defines why the inventory is quarantined, and for how long
+type MaintenanceSpec struct {
+	Reason string `json:"reason"`
+	// Expiry releases the inventory from maintenance once passed
+	// +kubebuilder:validation:Format:=date-time
+	Expiry string `json:"expiry,omitempty"`
+	// RequestedBy is set by the mutating webhook to the user who requested maintenance
+	RequestedBy string `json:"requestedBy,omitempty"`
+}
+
+// AcceptanceSpec defines the checks a machine must pass before the inventory can be allocated to a cluster
+type AcceptanceSpec struct {
+	// MinimumRequirements are checked against the hardware discovered via redfish
+	MinimumRequirements HardwareRequirements `json:"minimumRequirements,omitempty"`
+	// BurnIn pxe boots the machine into the tinkerbell hook os, and runs a validation workflow
+	BurnIn *BurnInSpec `json:"burnIn,omitempty"`
+}
+
+// HardwareRequirements defaults to the minimum hardware requirements of harvester
+type HardwareRequirements struct {
+	// +kubebuilder:default=8
+	CPUCores int `json:"cpuCores,omitempty"`
+	// +kubebuilder:default=32
+	MemoryGiB int `json:"memoryGiB,omitempty"`
+	// DiskGiB is the minimum capacity of the largest disk
+	// +kubebuilder:default=250
+	DiskGiB int `json:"diskGiB,omitempty"`
+	// LinkSpeedMbps is the minimum link speed of the management interface, and is not checked if not specified
+	LinkSpeedMbps int `json:"linkSpeedMbps,omitempty"`
+}
+
+// BurnInSpec configures the validation workflow run on the machine
+type BurnInSpec struct {
+	// AddressPoolReference is the pool used to address the machine while the validation workflow runs
+	AddressPoolReference ObjectReference `json:"addressPoolReference"`
+	// Nameservers are served to the hook os via dhcp, and are needed to install the test tools
+	Nameservers []string `json:"nameservers,omitempty"`
+	// MemoryTestMiB is the amount of memory tested by memtester
+	// +kubebuilder:default=1024
+	MemoryTestMiB int `json:"memoryTestMiB,omitempty"`
+	// DiskTestSeconds is the runtime of the read only fio test run against each disk
+	// +kubebuilder:default=300
+	DiskTestSeconds int `json:"diskTestSeconds,omitempty"`
+	// +kubebuilder:default:="4h"
+	// +kubebuilder:validation:Format:=duration
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// InterfaceSelector matches the first discovered interface which meets all the specified criteria
+type InterfaceSelector struct {
+	// Name of the redfish ethernet interface
+	Name string `json:"name,omitempty"`
+	// LinkUp only matches interfaces with an active link
+	LinkUp bool `json:"linkUp,omitempty"`
+	// VlanID only matches interfaces configured with the vlan
+	VlanID int `json:"vlanID,omitempty"`
+	// PXEEnabled only matches interfaces with PXE boot enabled
+	PXEEnabled bool `json:"pxeEnabled,omitempty"`
 }
 
 type BMCSecretReference struct {
@@ -96,6 +247,69 @@ type InventoryStatus struct {
 	Cluster           ObjectReference    `json:"ownerCluster,omitempty"`
 	PowerAction       PowerActionDetails `json:"powerAction,omitempty"`
 	MachinePowerState rufio.PowerState   `json:"machinePowerState,omitempty"`
+	Hardware          DiscoveredHardware `json:"discoveredHardware,omitempty"`
+	// PowerActionHistory contains the most recent power actions performed on the machine, oldest first
+	PowerActionHistory []PowerActionRecord `json:"powerActionHistory,omitempty"`
+	// Hostname overrides the default node hostname of <name>-<namespace>, and is set when the inventory
+	// replaces a failed node in a cluster
+	Hostname string `json:"hostname,omitempty"`
+	// AcceptanceResults contains the result of each acceptance check run against the machine
+	AcceptanceResults []AcceptanceResult `json:"acceptanceResults,omitempty"`
+	// Quarantine describes who quarantined the inventory and why, and is set along with the quarantined condition
+	Quarantine *QuarantineStatus `json:"quarantine,omitempty"`
+}
+
+type QuarantineStatus struct {
+	// Source is user for maintenance requested on the inventory, or the automated check which quarantined it
+	Source      QuarantineSource `json:"source"`
+	Reason      string           `json:"reason"`
+	RequestedBy string           `json:"requestedBy,omitempty"`
+	Since       string           `json:"since,omitempty"`
+	Expiry      string           `json:"expiry,omitempty"`
+}
+
+type AcceptanceResult struct {
+	Check   string `json:"check"`
+	Passed  bool   `json:"passed"`
+	Message string `json:"message,omitempty"`
+}
+
+// DiscoveredHardware contains the hardware details reported by redfish
+type DiscoveredHardware struct {
+	Arch                          string                `json:"arch,omitempty"`
+	ManagementInterfaceMacAddress string                `json:"managementInterfaceMacAddress,omitempty"`
+	Interfaces                    []DiscoveredInterface `json:"interfaces,omitempty"`
+	CPUCores                      int                   `json:"cpuCores,omitempty"`
+	MemoryGiB                     int                   `json:"memoryGiB,omitempty"`
+	Disks                         []DiscoveredDisk      `json:"disks,omitempty"`
+	Location                      Location              `json:"location,omitempty"`
+	BIOSVersion                   string                `json:"biosVersion,omitempty"`
+	BMCFirmwareVersion            string                `json:"bmcFirmwareVersion,omitempty"`
+	// GPUs lists the model of each gpu reported as a processor
+	GPUs       []string              `json:"gpus,omitempty"`
+	PCIDevices []DiscoveredPCIDevice `json:"pciDevices,omitempty"`
+}
+
+type DiscoveredPCIDevice struct {
+	Name            string `json:"name"`
+	Manufacturer    string `json:"manufacturer,omitempty"`
+	Model           string `json:"model,omitempty"`
+	FirmwareVersion string `json:"firmwareVersion,omitempty"`
+}
+
+type DiscoveredDisk struct {
+	Name        string `json:"name"`
+	CapacityGiB int    `json:"capacityGiB"`
+}
+
+type DiscoveredInterface struct {
+	Name       string `json:"name"`
+	MACAddress string `json:"macAddress"`
+	Enabled    bool   `json:"enabled,omitempty"`
+	LinkUp     bool   `json:"linkUp,omitempty"`
+	VlanID     int    `json:"vlanID,omitempty"`
+	PXEEnabled bool   `json:"pxeEnabled,omitempty"`
+	SpeedMbps  int    `json:"speedMbps,omitempty"`
 }
 
 type Conditions struct {
@@ -119,6 +333,23 @@ type PowerActionDetails struct {
 	LastActionStatus    string `json:"actionStatus,omitempty"`
 	LastActionRequested string `json:"lastActionRequested,omitempty"`
 	LastJobName         string `json:"lastJobName,omitempty"`
+	// EnforcementJobName is the job submitted to restore the desired power state
+	EnforcementJobName string `json:"enforcementJobName,omitempty"`
+	// LastEnforcementTime is the completion time of the last job submitted to restore the desired power state
+	LastEnforcementTime string `json:"lastEnforcementTime,omitempty"`
+}
+
+// PowerActionRecord is an audit record of a power action job submitted for the inventory
+type PowerActionRecord struct {
+	Action    string               `json:"action"`
+	Requester PowerActionRequester `json:"requester"`
+	Reason    string               `json:"reason,omitempty"`
+	JobName   string               `json:"jobName,omitempty"`
+	Outcome   string               `json:"outcome,omitempty"`
+	// Message contains the error reported by the BMC when the job fails
+	Message        string `json:"message,omitempty"`
+	SubmittedTime  string `json:"submittedTime,omitempty"`
+	CompletionTime string `json:"completionTime,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -126,6 +357,10 @@ type PowerActionDetails struct {
 //+kubebuilder:printcolumn:name="InventoryStatus",type="string",JSONPath=`.status.status`
 //+kubebuilder:printcolumn:name="GeneratedPassword",type="string",JSONPath=`.status.generatedPassword`
 //+kubebuilder:printcolumn:name="AllocatedNodeAddress",type="string",JSONPath=`.status.pxeBootConfig.address`
+//+kubebuilder:printcolumn:name="PowerState",type="string",JSONPath=`.status.machinePowerState`
+//+kubebuilder:printcolumn:name="Quarantine",type="string",JSONPath=`.status.quarantine.source`
+//+kubebuilder:printcolumn:name="Class",type="string",JSONPath=`.spec.inventoryClassName`
+//+kubebuilder:printcolumn:name="Rack",type="string",JSONPath=`.spec.location.rack`,priority=1
 
 // Inventory is the Schema for the inventories API
 type Inventory struct {
@@ -0,0 +1,62 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// kinds of objects which can be granted access to objects in another namespace, and which can be referenced
+const (
+	ReferenceKindCluster      = "Cluster"
+	ReferenceKindInventory    = "Inventory"
+	ReferenceKindBMCDiscovery = "BMCDiscovery"
+	ReferenceKindAddressPool  = "AddressPool"
+	ReferenceKindSecret       = "Secret"
+)
+
+// ReferenceGrantSpec allows objects in other namespaces to reference objects in the namespace of the grant.
+// References to objects in the same namespace are always allowed
+type ReferenceGrantSpec struct {
+	// From lists the kinds and namespaces of objects which can reference objects in the namespace of the grant
+	// +kubebuilder:validation:MinItems=1
+	From []ReferenceGrantFrom `json:"from"`
+	// To lists the kinds of objects in the namespace of the grant which can be referenced
+	// +kubebuilder:validation:MinItems=1
+	To []ReferenceGrantTo `json:"to"`
+}
+
+type ReferenceGrantFrom struct {
+	// +kubebuilder:validation:Enum=Cluster;Inventory;BMCDiscovery;AddressPool
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+}
+
+type ReferenceGrantTo struct {
+	// +kubebuilder:validation:Enum=AddressPool;Inventory;Secret
+	Kind string `json:"kind"`
+	// Name limits the grant to a single object, and all objects of the kind can be referenced if not specified
+	Name string `json:"name,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ReferenceGrant allows clusters, inventory, bmc discoveries and address pools in other namespaces to reference
+// address pools, inventory and secrets in the namespace of the grant
+type ReferenceGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ReferenceGrantSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ReferenceGrantList contains a list of ReferenceGrant
+type ReferenceGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReferenceGrant `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ReferenceGrant{}, &ReferenceGrantList{})
+}
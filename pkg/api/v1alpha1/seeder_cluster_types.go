@@ -0,0 +1,69 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	SeederClusterFinalizer = "metal.harvesterhci.io/seedercluster"
+	// CAPIClusterNameLabel and CAPIPausedAnnotation are set by cluster api on infrastructure objects
+	CAPIClusterNameLabel = "cluster.x-k8s.io/cluster-name"
+	CAPIPausedAnnotation = "cluster.x-k8s.io/paused"
+	CAPIGroup            = "cluster.x-k8s.io"
+	// DefaultControlPlaneEndpointPort is the kubernetes api port of harvester clusters
+	DefaultControlPlaneEndpointPort = 6443
+)
+
+// SeederClusterSpec is the cluster api infrastructure cluster. The settings are used to generate the seeder Cluster
+// the machines of the cluster api cluster are provisioned into
+type SeederClusterSpec struct {
+	// ControlPlaneEndpoint is set to the cluster vip once it is allocated
+	ControlPlaneEndpoint APIEndpoint `json:"controlPlaneEndpoint,omitempty"`
+	HarvesterVersion     string      `json:"version"`
+	ImageURL             string      `json:"imageURL,omitempty"`
+	VIPConfig            `json:"vipConfig"`
+	ClusterConfig        `json:"clusterConfig,omitempty"`
+}
+
+// APIEndpoint is the endpoint of the cluster api server
+type APIEndpoint struct {
+	Host string `json:"host,omitempty"`
+	Port int32  `json:"port,omitempty"`
+}
+
+type SeederClusterStatus struct {
+	// Ready is set once the cluster vip is allocated and the control plane endpoint is known
+	Ready bool `json:"ready"`
+	// ClusterStatus is the status of the generated seeder cluster
+	ClusterStatus  ClusterWorkflowStatus `json:"clusterStatus,omitempty"`
+	FailureReason  string                `json:"failureReason,omitempty"`
+	FailureMessage string                `json:"failureMessage,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:metadata:labels="cluster.x-k8s.io/v1beta1=v1alpha1"
+//+kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=`.status.ready`
+//+kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=`.spec.controlPlaneEndpoint.host`
+
+// SeederCluster is the Schema for the SeederCluster API
+type SeederCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SeederClusterSpec   `json:"spec,omitempty"`
+	Status SeederClusterStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// SeederClusterList contains a list of SeederCluster
+type SeederClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SeederCluster `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SeederCluster{}, &SeederClusterList{})
+}
@@ -0,0 +1,103 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	SeederMachineFinalizer = "metal.harvesterhci.io/seedermachine"
+	// ProviderIDPrefix matches the provider id rke2 sets on harvester nodes, which is followed by the node hostname
+	ProviderIDPrefix = "rke2://"
+)
+
+// SeederMachineSpec is the cluster api infrastructure machine. A free inventory matching the selector is claimed
+// and added to the seeder cluster of the SeederCluster
+type SeederMachineSpec struct {
+	// ProviderID is set once an inventory is claimed
+	ProviderID *string `json:"providerID,omitempty"`
+	// InventorySelector matches the inventory which can be claimed by the machine
+	InventorySelector metav1.LabelSelector `json:"inventorySelector,omitempty"`
+	// AddressPoolReference is the address pool the node address is allocated from
+	AddressPoolReference ObjectReference `json:"addressPoolReference"`
+}
+
+// MachineAddressType follows the address types of cluster api machines
+type MachineAddressType string
+
+const (
+	MachineHostName   MachineAddressType = "Hostname"
+	MachineInternalIP MachineAddressType = "InternalIP"
+)
+
+type MachineAddress struct {
+	Type    MachineAddressType `json:"type"`
+	Address string             `json:"address"`
+}
+
+type SeederMachineStatus struct {
+	// Ready is set once harvester has been installed on the claimed inventory
+	Ready     bool             `json:"ready"`
+	Addresses []MachineAddress `json:"addresses,omitempty"`
+	// InventoryReference is the inventory claimed by the machine
+	InventoryReference *ObjectReference `json:"inventoryReference,omitempty"`
+	FailureReason      string           `json:"failureReason,omitempty"`
+	FailureMessage     string           `json:"failureMessage,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:metadata:labels="cluster.x-k8s.io/v1beta1=v1alpha1"
+//+kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=`.status.ready`
+//+kubebuilder:printcolumn:name="ProviderID",type="string",JSONPath=`.spec.providerID`
+//+kubebuilder:printcolumn:name="Inventory",type="string",JSONPath=`.status.inventoryReference.name`
+
+// SeederMachine is the Schema for the SeederMachine API
+type SeederMachine struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SeederMachineSpec   `json:"spec,omitempty"`
+	Status SeederMachineStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// SeederMachineList contains a list of SeederMachine
+type SeederMachineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SeederMachine `json:"items"`
+}
+
+// SeederMachineTemplateSpec is used by cluster api to create SeederMachines for machine deployments and control planes
+type SeederMachineTemplateSpec struct {
+	Template SeederMachineTemplateResource `json:"template"`
+}
+
+type SeederMachineTemplateResource struct {
+	Spec SeederMachineSpec `json:"spec"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:metadata:labels="cluster.x-k8s.io/v1beta1=v1alpha1"
+
+// SeederMachineTemplate is the Schema for the SeederMachineTemplate API
+type SeederMachineTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec SeederMachineTemplateSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// SeederMachineTemplateList contains a list of SeederMachineTemplate
+type SeederMachineTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SeederMachineTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SeederMachine{}, &SeederMachineList{}, &SeederMachineTemplate{}, &SeederMachineTemplateList{})
+}
@@ -25,6 +25,57 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIEndpoint) DeepCopyInto(out *APIEndpoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIEndpoint.
+func (in *APIEndpoint) DeepCopy() *APIEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(APIEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AcceptanceResult) DeepCopyInto(out *AcceptanceResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AcceptanceResult.
+func (in *AcceptanceResult) DeepCopy() *AcceptanceResult {
+	if in == nil {
+		return nil
+	}
+	out := new(AcceptanceResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AcceptanceSpec) DeepCopyInto(out *AcceptanceSpec) {
+	*out = *in
+	out.MinimumRequirements = in.MinimumRequirements
+	if in.BurnIn != nil {
+		in, out := &in.BurnIn, &out.BurnIn
+		*out = new(BurnInSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AcceptanceSpec.
+func (in *AcceptanceSpec) DeepCopy() *AcceptanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AcceptanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddressPool) DeepCopyInto(out *AddressPool) {
 	*out = *in
@@ -92,6 +143,11 @@ func (in *AddressSpec) DeepCopyInto(out *AddressSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.IPAM != nil {
+		in, out := &in.IPAM, &out.IPAM
+		*out = new(IPAMSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddressSpec.
@@ -114,6 +170,18 @@ func (in *AddressStatus) DeepCopyInto(out *AddressStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.ExternalIDs != nil {
+		in, out := &in.ExternalIDs, &out.ExternalIDs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.IPAMSync != nil {
+		in, out := &in.IPAMSync, &out.IPAMSync
+		*out = new(IPAMSyncStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddressStatus.
@@ -127,41 +195,61 @@ func (in *AddressStatus) DeepCopy() *AddressStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BMCSecretReference) DeepCopyInto(out *BMCSecretReference) {
+func (in *Artifact) DeepCopyInto(out *Artifact) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BMCSecretReference.
-func (in *BMCSecretReference) DeepCopy() *BMCSecretReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Artifact.
+func (in *Artifact) DeepCopy() *Artifact {
 	if in == nil {
 		return nil
 	}
-	out := new(BMCSecretReference)
+	out := new(Artifact)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Cluster) DeepCopyInto(out *Cluster) {
+func (in *ArtifactSpec) DeepCopyInto(out *ArtifactSpec) {
+	*out = *in
+	out.Kernel = in.Kernel
+	out.Initrd = in.Initrd
+	out.RootFS = in.RootFS
+	out.ISO = in.ISO
+	out.RawImage = in.RawImage
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactSpec.
+func (in *ArtifactSpec) DeepCopy() *ArtifactSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BMCDiscovery) DeepCopyInto(out *BMCDiscovery) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cluster.
-func (in *Cluster) DeepCopy() *Cluster {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BMCDiscovery.
+func (in *BMCDiscovery) DeepCopy() *BMCDiscovery {
 	if in == nil {
 		return nil
 	}
-	out := new(Cluster)
+	out := new(BMCDiscovery)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Cluster) DeepCopyObject() runtime.Object {
+func (in *BMCDiscovery) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -169,173 +257,1595 @@ func (in *Cluster) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
+func (in *BMCDiscoveryList) DeepCopyInto(out *BMCDiscoveryList) {
 	*out = *in
-	if in.SSHKeys != nil {
-		in, out := &in.SSHKeys, &out.SSHKeys
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BMCDiscovery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BMCDiscoveryList.
+func (in *BMCDiscoveryList) DeepCopy() *BMCDiscoveryList {
+	if in == nil {
+		return nil
+	}
+	out := new(BMCDiscoveryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BMCDiscoveryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BMCDiscoveryScanProgress) DeepCopyInto(out *BMCDiscoveryScanProgress) {
+	*out = *in
+	if in.DiscoveredMachines != nil {
+		in, out := &in.DiscoveredMachines, &out.DiscoveredMachines
+		*out = make([]DiscoveredMachine, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BMCDiscoveryScanProgress.
+func (in *BMCDiscoveryScanProgress) DeepCopy() *BMCDiscoveryScanProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(BMCDiscoveryScanProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BMCDiscoverySpec) DeepCopyInto(out *BMCDiscoverySpec) {
+	*out = *in
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = make([]v1.SecretReference, len(*in))
+		copy(*out, *in)
+	}
+	out.Events = in.Events
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BMCDiscoverySpec.
+func (in *BMCDiscoverySpec) DeepCopy() *BMCDiscoverySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BMCDiscoverySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BMCDiscoveryStatus) DeepCopyInto(out *BMCDiscoveryStatus) {
+	*out = *in
+	if in.DiscoveredMachines != nil {
+		in, out := &in.DiscoveredMachines, &out.DiscoveredMachines
+		*out = make([]DiscoveredMachine, len(*in))
+		copy(*out, *in)
+	}
+	if in.ScanProgress != nil {
+		in, out := &in.ScanProgress, &out.ScanProgress
+		*out = new(BMCDiscoveryScanProgress)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BMCDiscoveryStatus.
+func (in *BMCDiscoveryStatus) DeepCopy() *BMCDiscoveryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BMCDiscoveryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BMCSecretReference) DeepCopyInto(out *BMCSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BMCSecretReference.
+func (in *BMCSecretReference) DeepCopy() *BMCSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(BMCSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BurnInSpec) DeepCopyInto(out *BurnInSpec) {
+	*out = *in
+	out.AddressPoolReference = in.AddressPoolReference
 	if in.Nameservers != nil {
 		in, out := &in.Nameservers, &out.Nameservers
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.BondOptions != nil {
-		in, out := &in.BondOptions, &out.BondOptions
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BurnInSpec.
+func (in *BurnInSpec) DeepCopy() *BurnInSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(BurnInSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterConfig.
-func (in *ClusterConfig) DeepCopy() *ClusterConfig {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Cluster) DeepCopyInto(out *Cluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cluster.
+func (in *Cluster) DeepCopy() *Cluster {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterConfig)
+	out := new(Cluster)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Cluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterList) DeepCopyInto(out *ClusterList) {
+func (in *ClusterAddon) DeepCopyInto(out *ClusterAddon) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAddon.
+func (in *ClusterAddon) DeepCopy() *ClusterAddon {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAddon)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAddon) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAddonList) DeepCopyInto(out *ClusterAddonList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Cluster, len(*in))
+		*out = make([]ClusterAddon, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterList.
-func (in *ClusterList) DeepCopy() *ClusterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAddonList.
+func (in *ClusterAddonList) DeepCopy() *ClusterAddonList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterList)
+	out := new(ClusterAddonList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterList) DeepCopyObject() runtime.Object {
+func (in *ClusterAddonList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
-	return nil
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAddonSpec) DeepCopyInto(out *ClusterAddonSpec) {
+	*out = *in
+	if in.ManifestConfigMaps != nil {
+		in, out := &in.ManifestConfigMaps, &out.ManifestConfigMaps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = make([]HarvesterSetting, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAddonSpec.
+func (in *ClusterAddonSpec) DeepCopy() *ClusterAddonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAddonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAddonStatus) DeepCopyInto(out *ClusterAddonStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAddonStatus.
+func (in *ClusterAddonStatus) DeepCopy() *ClusterAddonStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAddonStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
+	*out = *in
+	if in.SSHKeys != nil {
+		in, out := &in.SSHKeys, &out.SSHKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BondOptions != nil {
+		in, out := &in.BondOptions, &out.BondOptions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.VirtualMedia = in.VirtualMedia
+	out.Decommission = in.Decommission
+	if in.RancherRegistration != nil {
+		in, out := &in.RancherRegistration, &out.RancherRegistration
+		*out = new(RancherRegistrationSpec)
+		**out = **in
+	}
+	if in.NTPServers != nil {
+		in, out := &in.NTPServers, &out.NTPServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RegistryMirrors != nil {
+		in, out := &in.RegistryMirrors, &out.RegistryMirrors
+		*out = make([]RegistryMirror, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sysctls != nil {
+		in, out := &in.Sysctls, &out.Sysctls
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.KernelArgs != nil {
+		in, out := &in.KernelArgs, &out.KernelArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WriteFiles != nil {
+		in, out := &in.WriteFiles, &out.WriteFiles
+		*out = make([]WriteFile, len(*in))
+		copy(*out, *in)
+	}
+	if in.AfterInstallCommands != nil {
+		in, out := &in.AfterInstallCommands, &out.AfterInstallCommands
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterConfig.
+func (in *ClusterConfig) DeepCopy() *ClusterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterList) DeepCopyInto(out *ClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Cluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterList.
+func (in *ClusterList) DeepCopy() *ClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
+	*out = *in
+	out.Artifacts = in.Artifacts
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]NodeConfig, len(*in))
+		copy(*out, *in)
+	}
+	out.VIPConfig = in.VIPConfig
+	in.ClusterConfig.DeepCopyInto(&out.ClusterConfig)
+	if in.SparePool != nil {
+		in, out := &in.SparePool, &out.SparePool
+		*out = new(SparePoolSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]TopologySpreadConstraint, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeMetadata != nil {
+		in, out := &in.NodeMetadata, &out.NodeMetadata
+		*out = make([]NodeMetadataMapping, len(*in))
+		copy(*out, *in)
+	}
+	if in.Addons != nil {
+		in, out := &in.Addons, &out.Addons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSpec.
+func (in *ClusterSpec) DeepCopy() *ClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
+	*out = *in
+	if in.NodeRemovals != nil {
+		in, out := &in.NodeRemovals, &out.NodeRemovals
+		*out = make([]NodeRemovalStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeReplacements != nil {
+		in, out := &in.NodeReplacements, &out.NodeReplacements
+		*out = make([]NodeReplacementStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.TopologyViolations != nil {
+		in, out := &in.TopologyViolations, &out.TopologyViolations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TemplateDrift != nil {
+		in, out := &in.TemplateDrift, &out.TemplateDrift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RancherImport != nil {
+		in, out := &in.RancherImport, &out.RancherImport
+		*out = new(RancherImportStatus)
+		**out = **in
+	}
+	if in.Addons != nil {
+		in, out := &in.Addons, &out.Addons
+		*out = make([]ClusterAddonStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
+func (in *ClusterStatus) DeepCopy() *ClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplate) DeepCopyInto(out *ClusterTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplate.
+func (in *ClusterTemplate) DeepCopy() *ClusterTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateList) DeepCopyInto(out *ClusterTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateList.
+func (in *ClusterTemplateList) DeepCopy() *ClusterTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTemplateSpec) DeepCopyInto(out *ClusterTemplateSpec) {
+	*out = *in
+	if in.VIPAddressPoolReference != nil {
+		in, out := &in.VIPAddressPoolReference, &out.VIPAddressPoolReference
+		*out = new(ObjectReference)
+		**out = **in
+	}
+	in.ClusterConfig.DeepCopyInto(&out.ClusterConfig)
+	if in.Addons != nil {
+		in, out := &in.Addons, &out.Addons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTemplateSpec.
+func (in *ClusterTemplateSpec) DeepCopy() *ClusterTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Conditions) DeepCopyInto(out *Conditions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Conditions.
+func (in *Conditions) DeepCopy() *Conditions {
+	if in == nil {
+		return nil
+	}
+	out := new(Conditions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DecommissionSpec) DeepCopyInto(out *DecommissionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DecommissionSpec.
+func (in *DecommissionSpec) DeepCopy() *DecommissionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DecommissionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscoveredDisk) DeepCopyInto(out *DiscoveredDisk) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredDisk.
+func (in *DiscoveredDisk) DeepCopy() *DiscoveredDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscoveredDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscoveredHardware) DeepCopyInto(out *DiscoveredHardware) {
+	*out = *in
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]DiscoveredInterface, len(*in))
+		copy(*out, *in)
+	}
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]DiscoveredDisk, len(*in))
+		copy(*out, *in)
+	}
+	out.Location = in.Location
+	if in.GPUs != nil {
+		in, out := &in.GPUs, &out.GPUs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PCIDevices != nil {
+		in, out := &in.PCIDevices, &out.PCIDevices
+		*out = make([]DiscoveredPCIDevice, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredHardware.
+func (in *DiscoveredHardware) DeepCopy() *DiscoveredHardware {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscoveredHardware)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscoveredInterface) DeepCopyInto(out *DiscoveredInterface) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredInterface.
+func (in *DiscoveredInterface) DeepCopy() *DiscoveredInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscoveredInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscoveredMachine) DeepCopyInto(out *DiscoveredMachine) {
+	*out = *in
+	out.Inventory = in.Inventory
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredMachine.
+func (in *DiscoveredMachine) DeepCopy() *DiscoveredMachine {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscoveredMachine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscoveredPCIDevice) DeepCopyInto(out *DiscoveredPCIDevice) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredPCIDevice.
+func (in *DiscoveredPCIDevice) DeepCopy() *DiscoveredPCIDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscoveredPCIDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskConfig) DeepCopyInto(out *DiskConfig) {
+	*out = *in
+	out.Size = in.Size.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskConfig.
+func (in *DiskConfig) DeepCopy() *DiskConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Events) DeepCopyInto(out *Events) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Events.
+func (in *Events) DeepCopy() *Events {
+	if in == nil {
+		return nil
+	}
+	out := new(Events)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HardwareRequirements) DeepCopyInto(out *HardwareRequirements) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HardwareRequirements.
+func (in *HardwareRequirements) DeepCopy() *HardwareRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(HardwareRequirements)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HarvesterSetting) DeepCopyInto(out *HarvesterSetting) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HarvesterSetting.
+func (in *HarvesterSetting) DeepCopy() *HarvesterSetting {
+	if in == nil {
+		return nil
+	}
+	out := new(HarvesterSetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMSpec) DeepCopyInto(out *IPAMSpec) {
+	*out = *in
+	if in.NetBox != nil {
+		in, out := &in.NetBox, &out.NetBox
+		*out = new(NetBoxIPAMSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMSpec.
+func (in *IPAMSpec) DeepCopy() *IPAMSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMSyncStatus) DeepCopyInto(out *IPAMSyncStatus) {
+	*out = *in
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMSyncStatus.
+func (in *IPAMSyncStatus) DeepCopy() *IPAMSyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMSyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceSelector) DeepCopyInto(out *InterfaceSelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSelector.
+func (in *InterfaceSelector) DeepCopy() *InterfaceSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Inventory) DeepCopyInto(out *Inventory) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Inventory.
+func (in *Inventory) DeepCopy() *Inventory {
+	if in == nil {
+		return nil
+	}
+	out := new(Inventory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Inventory) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryClass) DeepCopyInto(out *InventoryClass) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryClass.
+func (in *InventoryClass) DeepCopy() *InventoryClass {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InventoryClass) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryClassList) DeepCopyInto(out *InventoryClassList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InventoryClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryClassList.
+func (in *InventoryClassList) DeepCopy() *InventoryClassList {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryClassList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InventoryClassList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryClassSpec) DeepCopyInto(out *InventoryClassSpec) {
+	*out = *in
+	out.Requirements = in.Requirements
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Quotas != nil {
+		in, out := &in.Quotas, &out.Quotas
+		*out = make([]NamespaceQuota, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultQuota != nil {
+		in, out := &in.DefaultQuota, &out.DefaultQuota
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryClassSpec.
+func (in *InventoryClassSpec) DeepCopy() *InventoryClassSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryClassSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryClassStatus) DeepCopyInto(out *InventoryClassStatus) {
+	*out = *in
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = make([]NamespaceUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NonConforming != nil {
+		in, out := &in.NonConforming, &out.NonConforming
+		*out = make([]ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryClassStatus.
+func (in *InventoryClassStatus) DeepCopy() *InventoryClassStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryClassStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryList) DeepCopyInto(out *InventoryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Inventory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryList.
+func (in *InventoryList) DeepCopy() *InventoryList {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InventoryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventorySpec) DeepCopyInto(out *InventorySpec) {
+	*out = *in
+	if in.ManagementInterfaceSelector != nil {
+		in, out := &in.ManagementInterfaceSelector, &out.ManagementInterfaceSelector
+		*out = new(InterfaceSelector)
+		**out = **in
+	}
+	in.BaseboardManagementSpec.DeepCopyInto(&out.BaseboardManagementSpec)
+	out.Events = in.Events
+	if in.Acceptance != nil {
+		in, out := &in.Acceptance, &out.Acceptance
+		*out = new(AcceptanceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(MaintenanceSpec)
+		**out = **in
+	}
+	out.Location = in.Location
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventorySpec.
+func (in *InventorySpec) DeepCopy() *InventorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InventorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryStatus) DeepCopyInto(out *InventoryStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Conditions, len(*in))
+		copy(*out, *in)
+	}
+	in.PXEBootInterface.DeepCopyInto(&out.PXEBootInterface)
+	out.Cluster = in.Cluster
+	out.PowerAction = in.PowerAction
+	in.Hardware.DeepCopyInto(&out.Hardware)
+	if in.PowerActionHistory != nil {
+		in, out := &in.PowerActionHistory, &out.PowerActionHistory
+		*out = make([]PowerActionRecord, len(*in))
+		copy(*out, *in)
+	}
+	if in.AcceptanceResults != nil {
+		in, out := &in.AcceptanceResults, &out.AcceptanceResults
+		*out = make([]AcceptanceResult, len(*in))
+		copy(*out, *in)
+	}
+	if in.Quarantine != nil {
+		in, out := &in.Quarantine, &out.Quarantine
+		*out = new(QuarantineStatus)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryStatus.
+func (in *InventoryStatus) DeepCopy() *InventoryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryTemplate) DeepCopyInto(out *InventoryTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryTemplate.
+func (in *InventoryTemplate) DeepCopy() *InventoryTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InventoryTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryTemplateConfig) DeepCopyInto(out *InventoryTemplateConfig) {
+	*out = *in
+	in.InventoryTemplateSpec.DeepCopyInto(&out.InventoryTemplateSpec)
+	out.AddressPoolReference = in.AddressPoolReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryTemplateConfig.
+func (in *InventoryTemplateConfig) DeepCopy() *InventoryTemplateConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryTemplateConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryTemplateList) DeepCopyInto(out *InventoryTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InventoryTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryTemplateList.
+func (in *InventoryTemplateList) DeepCopy() *InventoryTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InventoryTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryTemplateSpec) DeepCopyInto(out *InventoryTemplateSpec) {
+	*out = *in
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+	in.VMSpec.DeepCopyInto(&out.VMSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryTemplateSpec.
+func (in *InventoryTemplateSpec) DeepCopy() *InventoryTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryTemplateStatus) DeepCopyInto(out *InventoryTemplateStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryTemplateStatus.
+func (in *InventoryTemplateStatus) DeepCopy() *InventoryTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Location) DeepCopyInto(out *Location) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Location.
+func (in *Location) DeepCopy() *Location {
+	if in == nil {
+		return nil
+	}
+	out := new(Location)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineAddress) DeepCopyInto(out *MachineAddress) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineAddress.
+func (in *MachineAddress) DeepCopy() *MachineAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceSpec) DeepCopyInto(out *MaintenanceSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceSpec.
+func (in *MaintenanceSpec) DeepCopy() *MaintenanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceQuota) DeepCopyInto(out *NamespaceQuota) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceQuota.
+func (in *NamespaceQuota) DeepCopy() *NamespaceQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceUsage) DeepCopyInto(out *NamespaceUsage) {
+	*out = *in
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceUsage.
+func (in *NamespaceUsage) DeepCopy() *NamespaceUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NestedCluster) DeepCopyInto(out *NestedCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NestedCluster.
+func (in *NestedCluster) DeepCopy() *NestedCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(NestedCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NestedCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NestedClusterList) DeepCopyInto(out *NestedClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NestedCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NestedClusterList.
+func (in *NestedClusterList) DeepCopy() *NestedClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(NestedClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NestedClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NestedClusterSpec) DeepCopyInto(out *NestedClusterSpec) {
+	*out = *in
+	if in.InventoryTemplateConfig != nil {
+		in, out := &in.InventoryTemplateConfig, &out.InventoryTemplateConfig
+		*out = make([]InventoryTemplateConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.VIPConfig = in.VIPConfig
+	in.ClusterConfig.DeepCopyInto(&out.ClusterConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NestedClusterSpec.
+func (in *NestedClusterSpec) DeepCopy() *NestedClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NestedClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetBoxIPAMSpec) DeepCopyInto(out *NetBoxIPAMSpec) {
+	*out = *in
+	out.TokenSecretRef = in.TokenSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetBoxIPAMSpec.
+func (in *NetBoxIPAMSpec) DeepCopy() *NetBoxIPAMSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetBoxIPAMSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkConfig.
+func (in *NetworkConfig) DeepCopy() *NetworkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeConfig) DeepCopyInto(out *NodeConfig) {
+	*out = *in
+	out.InventoryReference = in.InventoryReference
+	out.AddressPoolReference = in.AddressPoolReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeConfig.
+func (in *NodeConfig) DeepCopy() *NodeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeMetadataMapping) DeepCopyInto(out *NodeMetadataMapping) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeMetadataMapping.
+func (in *NodeMetadataMapping) DeepCopy() *NodeMetadataMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeMetadataMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeRemovalStatus) DeepCopyInto(out *NodeRemovalStatus) {
+	*out = *in
+	out.InventoryReference = in.InventoryReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeRemovalStatus.
+func (in *NodeRemovalStatus) DeepCopy() *NodeRemovalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeRemovalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeReplacementStatus) DeepCopyInto(out *NodeReplacementStatus) {
+	*out = *in
+	out.FailedInventory = in.FailedInventory
+	out.SpareInventory = in.SpareInventory
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReplacementStatus.
+func (in *NodeReplacementStatus) DeepCopy() *NodeReplacementStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeReplacementStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectReference) DeepCopyInto(out *ObjectReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectReference.
+func (in *ObjectReference) DeepCopy() *ObjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectReferenceWithKind) DeepCopyInto(out *ObjectReferenceWithKind) {
+	*out = *in
+	out.ObjectReference = in.ObjectReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectReferenceWithKind.
+func (in *ObjectReferenceWithKind) DeepCopy() *ObjectReferenceWithKind {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectReferenceWithKind)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PXEBootInterface) DeepCopyInto(out *PXEBootInterface) {
+	*out = *in
+	if in.NameServers != nil {
+		in, out := &in.NameServers, &out.NameServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PXEBootInterface.
+func (in *PXEBootInterface) DeepCopy() *PXEBootInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(PXEBootInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PowerActionDetails) DeepCopyInto(out *PowerActionDetails) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerActionDetails.
+func (in *PowerActionDetails) DeepCopy() *PowerActionDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(PowerActionDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PowerActionRecord) DeepCopyInto(out *PowerActionRecord) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerActionRecord.
+func (in *PowerActionRecord) DeepCopy() *PowerActionRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(PowerActionRecord)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
+func (in *ProxyConfig) DeepCopyInto(out *ProxyConfig) {
 	*out = *in
-	if in.Nodes != nil {
-		in, out := &in.Nodes, &out.Nodes
-		*out = make([]NodeConfig, len(*in))
+	if in.NoProxy != nil {
+		in, out := &in.NoProxy, &out.NoProxy
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	out.VIPConfig = in.VIPConfig
-	in.ClusterConfig.DeepCopyInto(&out.ClusterConfig)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSpec.
-func (in *ClusterSpec) DeepCopy() *ClusterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyConfig.
+func (in *ProxyConfig) DeepCopy() *ProxyConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterSpec)
+	out := new(ProxyConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
+func (in *QuarantineStatus) DeepCopyInto(out *QuarantineStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
-func (in *ClusterStatus) DeepCopy() *ClusterStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuarantineStatus.
+func (in *QuarantineStatus) DeepCopy() *QuarantineStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterStatus)
+	out := new(QuarantineStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Conditions) DeepCopyInto(out *Conditions) {
+func (in *RancherImportStatus) DeepCopyInto(out *RancherImportStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Conditions.
-func (in *Conditions) DeepCopy() *Conditions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RancherImportStatus.
+func (in *RancherImportStatus) DeepCopy() *RancherImportStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Conditions)
+	out := new(RancherImportStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DiskConfig) DeepCopyInto(out *DiskConfig) {
+func (in *RancherRegistrationSpec) DeepCopyInto(out *RancherRegistrationSpec) {
 	*out = *in
-	out.Size = in.Size.DeepCopy()
+	out.TokenSecretRef = in.TokenSecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskConfig.
-func (in *DiskConfig) DeepCopy() *DiskConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RancherRegistrationSpec.
+func (in *RancherRegistrationSpec) DeepCopy() *RancherRegistrationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DiskConfig)
+	out := new(RancherRegistrationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Events) DeepCopyInto(out *Events) {
+func (in *RawImageArtifact) DeepCopyInto(out *RawImageArtifact) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Events.
-func (in *Events) DeepCopy() *Events {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RawImageArtifact.
+func (in *RawImageArtifact) DeepCopy() *RawImageArtifact {
 	if in == nil {
 		return nil
 	}
-	out := new(Events)
+	out := new(RawImageArtifact)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Inventory) DeepCopyInto(out *Inventory) {
+func (in *ReferenceGrant) DeepCopyInto(out *ReferenceGrant) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Inventory.
-func (in *Inventory) DeepCopy() *Inventory {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrant.
+func (in *ReferenceGrant) DeepCopy() *ReferenceGrant {
 	if in == nil {
 		return nil
 	}
-	out := new(Inventory)
+	out := new(ReferenceGrant)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Inventory) DeepCopyObject() runtime.Object {
+func (in *ReferenceGrant) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -343,31 +1853,46 @@ func (in *Inventory) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InventoryList) DeepCopyInto(out *InventoryList) {
+func (in *ReferenceGrantFrom) DeepCopyInto(out *ReferenceGrantFrom) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrantFrom.
+func (in *ReferenceGrantFrom) DeepCopy() *ReferenceGrantFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(ReferenceGrantFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReferenceGrantList) DeepCopyInto(out *ReferenceGrantList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Inventory, len(*in))
+		*out = make([]ReferenceGrant, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryList.
-func (in *InventoryList) DeepCopy() *InventoryList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrantList.
+func (in *ReferenceGrantList) DeepCopy() *ReferenceGrantList {
 	if in == nil {
 		return nil
 	}
-	out := new(InventoryList)
+	out := new(ReferenceGrantList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *InventoryList) DeepCopyObject() runtime.Object {
+func (in *ReferenceGrantList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -375,47 +1900,72 @@ func (in *InventoryList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InventorySpec) DeepCopyInto(out *InventorySpec) {
+func (in *ReferenceGrantSpec) DeepCopyInto(out *ReferenceGrantSpec) {
 	*out = *in
-	in.BaseboardManagementSpec.DeepCopyInto(&out.BaseboardManagementSpec)
-	out.Events = in.Events
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]ReferenceGrantFrom, len(*in))
+		copy(*out, *in)
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]ReferenceGrantTo, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventorySpec.
-func (in *InventorySpec) DeepCopy() *InventorySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrantSpec.
+func (in *ReferenceGrantSpec) DeepCopy() *ReferenceGrantSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(InventorySpec)
+	out := new(ReferenceGrantSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InventoryStatus) DeepCopyInto(out *InventoryStatus) {
+func (in *ReferenceGrantTo) DeepCopyInto(out *ReferenceGrantTo) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]Conditions, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReferenceGrantTo.
+func (in *ReferenceGrantTo) DeepCopy() *ReferenceGrantTo {
+	if in == nil {
+		return nil
+	}
+	out := new(ReferenceGrantTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryMirror) DeepCopyInto(out *RegistryMirror) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	in.PXEBootInterface.DeepCopyInto(&out.PXEBootInterface)
-	out.Cluster = in.Cluster
-	out.PowerAction = in.PowerAction
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryStatus.
-func (in *InventoryStatus) DeepCopy() *InventoryStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryMirror.
+func (in *RegistryMirror) DeepCopy() *RegistryMirror {
 	if in == nil {
 		return nil
 	}
-	out := new(InventoryStatus)
+	out := new(RegistryMirror)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InventoryTemplate) DeepCopyInto(out *InventoryTemplate) {
+func (in *SeederCluster) DeepCopyInto(out *SeederCluster) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -423,18 +1973,18 @@ func (in *InventoryTemplate) DeepCopyInto(out *InventoryTemplate) {
 	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryTemplate.
-func (in *InventoryTemplate) DeepCopy() *InventoryTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeederCluster.
+func (in *SeederCluster) DeepCopy() *SeederCluster {
 	if in == nil {
 		return nil
 	}
-	out := new(InventoryTemplate)
+	out := new(SeederCluster)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *InventoryTemplate) DeepCopyObject() runtime.Object {
+func (in *SeederCluster) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -442,48 +1992,31 @@ func (in *InventoryTemplate) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InventoryTemplateConfig) DeepCopyInto(out *InventoryTemplateConfig) {
-	*out = *in
-	in.InventoryTemplateSpec.DeepCopyInto(&out.InventoryTemplateSpec)
-	out.AddressPoolReference = in.AddressPoolReference
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryTemplateConfig.
-func (in *InventoryTemplateConfig) DeepCopy() *InventoryTemplateConfig {
-	if in == nil {
-		return nil
-	}
-	out := new(InventoryTemplateConfig)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InventoryTemplateList) DeepCopyInto(out *InventoryTemplateList) {
+func (in *SeederClusterList) DeepCopyInto(out *SeederClusterList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]InventoryTemplate, len(*in))
+		*out = make([]SeederCluster, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryTemplateList.
-func (in *InventoryTemplateList) DeepCopy() *InventoryTemplateList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeederClusterList.
+func (in *SeederClusterList) DeepCopy() *SeederClusterList {
 	if in == nil {
 		return nil
 	}
-	out := new(InventoryTemplateList)
+	out := new(SeederClusterList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *InventoryTemplateList) DeepCopyObject() runtime.Object {
+func (in *SeederClusterList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -491,62 +2024,59 @@ func (in *InventoryTemplateList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InventoryTemplateSpec) DeepCopyInto(out *InventoryTemplateSpec) {
+func (in *SeederClusterSpec) DeepCopyInto(out *SeederClusterSpec) {
 	*out = *in
-	if in.Credentials != nil {
-		in, out := &in.Credentials, &out.Credentials
-		*out = new(v1.SecretReference)
-		**out = **in
-	}
-	in.VMSpec.DeepCopyInto(&out.VMSpec)
+	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
+	out.VIPConfig = in.VIPConfig
+	in.ClusterConfig.DeepCopyInto(&out.ClusterConfig)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryTemplateSpec.
-func (in *InventoryTemplateSpec) DeepCopy() *InventoryTemplateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeederClusterSpec.
+func (in *SeederClusterSpec) DeepCopy() *SeederClusterSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(InventoryTemplateSpec)
+	out := new(SeederClusterSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InventoryTemplateStatus) DeepCopyInto(out *InventoryTemplateStatus) {
+func (in *SeederClusterStatus) DeepCopyInto(out *SeederClusterStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryTemplateStatus.
-func (in *InventoryTemplateStatus) DeepCopy() *InventoryTemplateStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeederClusterStatus.
+func (in *SeederClusterStatus) DeepCopy() *SeederClusterStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(InventoryTemplateStatus)
+	out := new(SeederClusterStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NestedCluster) DeepCopyInto(out *NestedCluster) {
+func (in *SeederMachine) DeepCopyInto(out *SeederMachine) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NestedCluster.
-func (in *NestedCluster) DeepCopy() *NestedCluster {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeederMachine.
+func (in *SeederMachine) DeepCopy() *SeederMachine {
 	if in == nil {
 		return nil
 	}
-	out := new(NestedCluster)
+	out := new(SeederMachine)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NestedCluster) DeepCopyObject() runtime.Object {
+func (in *SeederMachine) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -554,31 +2084,31 @@ func (in *NestedCluster) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NestedClusterList) DeepCopyInto(out *NestedClusterList) {
+func (in *SeederMachineList) DeepCopyInto(out *SeederMachineList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NestedCluster, len(*in))
+		*out = make([]SeederMachine, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NestedClusterList.
-func (in *NestedClusterList) DeepCopy() *NestedClusterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeederMachineList.
+func (in *SeederMachineList) DeepCopy() *SeederMachineList {
 	if in == nil {
 		return nil
 	}
-	out := new(NestedClusterList)
+	out := new(SeederMachineList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NestedClusterList) DeepCopyObject() runtime.Object {
+func (in *SeederMachineList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -586,123 +2116,169 @@ func (in *NestedClusterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NestedClusterSpec) DeepCopyInto(out *NestedClusterSpec) {
+func (in *SeederMachineSpec) DeepCopyInto(out *SeederMachineSpec) {
 	*out = *in
-	if in.InventoryTemplateConfig != nil {
-		in, out := &in.InventoryTemplateConfig, &out.InventoryTemplateConfig
-		*out = make([]InventoryTemplateConfig, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.ProviderID != nil {
+		in, out := &in.ProviderID, &out.ProviderID
+		*out = new(string)
+		**out = **in
 	}
-	out.VIPConfig = in.VIPConfig
-	in.ClusterConfig.DeepCopyInto(&out.ClusterConfig)
+	in.InventorySelector.DeepCopyInto(&out.InventorySelector)
+	out.AddressPoolReference = in.AddressPoolReference
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NestedClusterSpec.
-func (in *NestedClusterSpec) DeepCopy() *NestedClusterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeederMachineSpec.
+func (in *SeederMachineSpec) DeepCopy() *SeederMachineSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NestedClusterSpec)
+	out := new(SeederMachineSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
+func (in *SeederMachineStatus) DeepCopyInto(out *SeederMachineStatus) {
 	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]MachineAddress, len(*in))
+		copy(*out, *in)
+	}
+	if in.InventoryReference != nil {
+		in, out := &in.InventoryReference, &out.InventoryReference
+		*out = new(ObjectReference)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkConfig.
-func (in *NetworkConfig) DeepCopy() *NetworkConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeederMachineStatus.
+func (in *SeederMachineStatus) DeepCopy() *SeederMachineStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkConfig)
+	out := new(SeederMachineStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeConfig) DeepCopyInto(out *NodeConfig) {
+func (in *SeederMachineTemplate) DeepCopyInto(out *SeederMachineTemplate) {
 	*out = *in
-	out.InventoryReference = in.InventoryReference
-	out.AddressPoolReference = in.AddressPoolReference
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeConfig.
-func (in *NodeConfig) DeepCopy() *NodeConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeederMachineTemplate.
+func (in *SeederMachineTemplate) DeepCopy() *SeederMachineTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeConfig)
+	out := new(SeederMachineTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SeederMachineTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectReference) DeepCopyInto(out *ObjectReference) {
+func (in *SeederMachineTemplateList) DeepCopyInto(out *SeederMachineTemplateList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SeederMachineTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectReference.
-func (in *ObjectReference) DeepCopy() *ObjectReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeederMachineTemplateList.
+func (in *SeederMachineTemplateList) DeepCopy() *SeederMachineTemplateList {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectReference)
+	out := new(SeederMachineTemplateList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SeederMachineTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObjectReferenceWithKind) DeepCopyInto(out *ObjectReferenceWithKind) {
+func (in *SeederMachineTemplateResource) DeepCopyInto(out *SeederMachineTemplateResource) {
 	*out = *in
-	out.ObjectReference = in.ObjectReference
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectReferenceWithKind.
-func (in *ObjectReferenceWithKind) DeepCopy() *ObjectReferenceWithKind {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeederMachineTemplateResource.
+func (in *SeederMachineTemplateResource) DeepCopy() *SeederMachineTemplateResource {
 	if in == nil {
 		return nil
 	}
-	out := new(ObjectReferenceWithKind)
+	out := new(SeederMachineTemplateResource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PXEBootInterface) DeepCopyInto(out *PXEBootInterface) {
+func (in *SeederMachineTemplateSpec) DeepCopyInto(out *SeederMachineTemplateSpec) {
 	*out = *in
-	if in.NameServers != nil {
-		in, out := &in.NameServers, &out.NameServers
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeederMachineTemplateSpec.
+func (in *SeederMachineTemplateSpec) DeepCopy() *SeederMachineTemplateSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(SeederMachineTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PXEBootInterface.
-func (in *PXEBootInterface) DeepCopy() *PXEBootInterface {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SparePoolSpec) DeepCopyInto(out *SparePoolSpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SparePoolSpec.
+func (in *SparePoolSpec) DeepCopy() *SparePoolSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PXEBootInterface)
+	out := new(SparePoolSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PowerActionDetails) DeepCopyInto(out *PowerActionDetails) {
+func (in *TopologySpreadConstraint) DeepCopyInto(out *TopologySpreadConstraint) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerActionDetails.
-func (in *PowerActionDetails) DeepCopy() *PowerActionDetails {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologySpreadConstraint.
+func (in *TopologySpreadConstraint) DeepCopy() *TopologySpreadConstraint {
 	if in == nil {
 		return nil
 	}
-	out := new(PowerActionDetails)
+	out := new(TopologySpreadConstraint)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -750,3 +2326,33 @@ func (in *VMSpec) DeepCopy() *VMSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMediaSpec) DeepCopyInto(out *VirtualMediaSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMediaSpec.
+func (in *VirtualMediaSpec) DeepCopy() *VirtualMediaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMediaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WriteFile) DeepCopyInto(out *WriteFile) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WriteFile.
+func (in *WriteFile) DeepCopy() *WriteFile {
+	if in == nil {
+		return nil
+	}
+	out := new(WriteFile)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
+	"time"
 
 	"github.com/go-logr/logr"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -17,6 +19,11 @@ import (
 	"github.com/harvester/seeder/pkg/util"
 )
 
+const (
+	ipamSyncInterval  = 5 * time.Minute
+	ipamRetryInterval = 30 * time.Second
+)
+
 // InventoryReconciler reconciles a Inventory object
 type AddressPoolReconciler struct {
 	client.Client
@@ -58,6 +65,7 @@ func (r *AddressPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	reconcileList := []addressPoolReconciler{
 		r.reconcilePoolCapacity,
+		r.syncIPAM,
 	}
 
 	deletionReconcileList := []addressPoolReconciler{
@@ -67,6 +75,10 @@ func (r *AddressPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	if pool.DeletionTimestamp.IsZero() {
 		for _, reconciler := range reconcileList {
 			if err := reconciler(ctx, pool); err != nil {
+				if requeueAfter, ok := util.IsDeferred(err); ok {
+					r.Info("addresspool reconcile deferred", "name", pool.Name, "reason", err.Error())
+					return ctrl.Result{RequeueAfter: requeueAfter}, nil
+				}
 				return ctrl.Result{}, err
 			}
 		}
@@ -78,6 +90,10 @@ func (r *AddressPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 	}
 
+	// allocations are periodically reconciled with the external ipam provider to correct drift
+	if pool.DeletionTimestamp.IsZero() && util.IsExternalIPAM(pool) {
+		return ctrl.Result{RequeueAfter: ipamSyncInterval}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -107,7 +123,7 @@ func (r *AddressPoolReconciler) reconcilePoolCapacity(ctx context.Context, poolO
 		for _, reservedAddress := range pool.Spec.ReservedAddresses {
 			if _, ok := pool.Status.AddressAllocation[reservedAddress]; !ok {
 				pool.Status.AddressAllocation[reservedAddress] = seederv1alpha1.ObjectReferenceWithKind{
-					Kind: "reserved",
+					Kind: seederv1alpha1.KindReserved,
 					ObjectReference: seederv1alpha1.ObjectReference{
 						Name:      "reservedAddress",
 						Namespace: "reserved",
@@ -136,6 +152,62 @@ func (r *AddressPoolReconciler) reconcilePoolCapacity(ctx context.Context, poolO
 	return nil
 }
 
+// syncIPAM reconciles the address allocation with the external ipam provider once the pool status has been
+// generated. Pools are synced when the allocation has changed since the last sync, or the sync interval has passed.
+// Sync errors are reported in the pool status and retried
+func (r *AddressPoolReconciler) syncIPAM(ctx context.Context, poolObj *seederv1alpha1.AddressPool) error {
+	if !util.IsExternalIPAM(poolObj) || poolObj.Status.Status == "" || !ipamSyncDue(poolObj) {
+		return nil
+	}
+
+	pool := poolObj.DeepCopy()
+	provider, err := util.NewIPAMProvider(ctx, r.Client, pool)
+	if err == nil {
+		err = provider.Sync(ctx, pool)
+	}
+	if err != nil {
+		pool = poolObj.DeepCopy()
+		if pool.Status.IPAMSync == nil {
+			pool.Status.IPAMSync = &seederv1alpha1.IPAMSyncStatus{}
+		}
+		pool.Status.IPAMSync.Error = err.Error()
+		if !reflect.DeepEqual(pool.Status, poolObj.Status) {
+			if updateErr := r.Status().Update(ctx, pool); updateErr != nil {
+				return updateErr
+			}
+		}
+		return &util.DeferredError{Reason: fmt.Sprintf("error syncing address pool %s with ipam provider: %v", pool.Name, err), RequeueAfter: ipamRetryInterval}
+	}
+	return r.Status().Update(ctx, pool)
+}
+
+// ipamSyncDue returns true if the last sync failed or is older than the sync interval, or if addresses have been
+// allocated or released since
+func ipamSyncDue(pool *seederv1alpha1.AddressPool) bool {
+	sync := pool.Status.IPAMSync
+	if sync == nil || sync.Error != "" {
+		return true
+	}
+	lastSynced, err := time.Parse(time.RFC3339, sync.LastSynced)
+	if err != nil || time.Since(lastSynced) >= ipamSyncInterval {
+		return true
+	}
+	for address, owner := range pool.Status.AddressAllocation {
+		if (owner.Kind != seederv1alpha1.KindCluster && owner.Kind != seederv1alpha1.KindInventory) || slices.Contains(sync.Conflicts, address) {
+			continue
+		}
+		if _, ok := pool.Status.ExternalIDs[address]; !ok {
+			return true
+		}
+	}
+	for address := range pool.Status.ExternalIDs {
+		if _, ok := pool.Status.AddressAllocation[address]; !ok {
+			return true
+		}
+	}
+	return false
+}
+
 // deleteAddressPool will ensure that none of the IP's is in use before removing finalizer
 func (r *AddressPoolReconciler) deleteAddressPool(ctx context.Context, poolObj *seederv1alpha1.AddressPool) error {
 	pool := poolObj.DeepCopy()
@@ -161,6 +233,19 @@ func (r *AddressPoolReconciler) deleteAddressPool(ctx context.Context, poolObj *
 		if addressInUse {
 			return fmt.Errorf("one of the address in addresspool %s is still in use, requeuing", pool.Name)
 		}
+
+		// remove the reservations left in the external ipam provider
+		if util.IsExternalIPAM(pool) && len(pool.Status.ExternalIDs) != 0 {
+			provider, err := util.NewIPAMProvider(ctx, r.Client, pool)
+			if err != nil {
+				return err
+			}
+			for address := range pool.Status.ExternalIDs {
+				if err := provider.Release(ctx, pool, address); err != nil {
+					return err
+				}
+			}
+		}
 		controllerutil.RemoveFinalizer(pool, seederv1alpha1.AddressPoolFinalizer)
 		return r.Update(ctx, pool)
 	}
@@ -5,6 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -53,3 +54,58 @@ var _ = Describe("AddressPool controller tests", func() {
 		}).ShouldNot(HaveOccurred())
 	})
 })
+
+var _ = Describe("AddressPool with external ipam provider", func() {
+	var a *seederv1alpha1.AddressPool
+
+	BeforeEach(func() {
+		a = &seederv1alpha1.AddressPool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "netbox-sample",
+				Namespace: "default",
+			},
+			Spec: seederv1alpha1.AddressSpec{
+				CIDR:    "192.168.2.1/29",
+				Gateway: "192.168.2.7",
+				IPAM: &seederv1alpha1.IPAMSpec{
+					Provider: seederv1alpha1.IPAMProviderNetBox,
+					NetBox: &seederv1alpha1.NetBoxIPAMSpec{
+						URL: "https://netbox.example.com",
+						TokenSecretRef: corev1.SecretReference{
+							Name: "missing-netbox-token",
+						},
+					},
+				},
+			},
+		}
+
+		Eventually(func() error {
+			return k8sClient.Create(ctx, a)
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+	})
+
+	It("reports sync errors in the pool status", func() {
+		Eventually(func() error {
+			obj := &seederv1alpha1.AddressPool{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Namespace: a.Namespace, Name: a.Name}, obj)
+			if err != nil {
+				return err
+			}
+
+			if obj.Status.Status != seederv1alpha1.PoolReady {
+				return fmt.Errorf("waiting for pool to be ready. current status is %s", obj.Status.Status)
+			}
+			if obj.Status.IPAMSync == nil || obj.Status.IPAMSync.Error == "" {
+				return fmt.Errorf("waiting for ipam sync error to be reported")
+			}
+			return nil
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Eventually(func() error {
+			err := k8sClient.Delete(ctx, a)
+			return err
+		}).ShouldNot(HaveOccurred())
+	})
+})
@@ -0,0 +1,350 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	rufio "github.com/tinkerbell/rufio/api/v1alpha1"
+	"golang.org/x/sync/errgroup"
+	"inet.af/netaddr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/events"
+	"github.com/harvester/seeder/pkg/util"
+)
+
+const (
+	defaultRedfishPort        = 443
+	maxDiscoveryAddresses     = 4096
+	defaultDiscoveryWorkers   = 32
+	defaultDiscoveryRetryTime = 5 * time.Minute
+	discoveryBatchSize        = 128
+	discoveryBatchInterval    = time.Second
+)
+
+// BMCDiscoveryReconciler scans networks for redfish endpoints and creates inventory objects
+type BMCDiscoveryReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	logr.Logger
+}
+
+type bmcCredential struct {
+	ref      corev1.SecretReference
+	username string
+	password string
+}
+
+func (r *BMCDiscoveryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	r.Info("Reconciling bmc discovery objects", req.Name, req.Namespace)
+	d := &seederv1alpha1.BMCDiscovery{}
+	err := r.Get(ctx, req.NamespacedName, d)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		r.Error(err, "unable to fetch bmcdiscovery object")
+		return ctrl.Result{}, err
+	}
+
+	if !d.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	interval, err := time.ParseDuration(d.Spec.ScanInterval)
+	if err != nil {
+		return ctrl.Result{}, r.updateStatus(ctx, d, seederv1alpha1.BMCDiscoveryScanError, fmt.Sprintf("error parsing scan interval: %v", err), d.Status.DiscoveredMachines, nil)
+	}
+
+	// if next scan time is after current time then requeue, unless a scan is in progress
+	if d.Status.LastScanTime != "" && d.Status.ScanProgress == nil {
+		lastScan, err := time.Parse(time.RFC3339, d.Status.LastScanTime)
+		if err == nil && lastScan.Add(interval).After(time.Now()) {
+			return ctrl.Result{RequeueAfter: time.Until(lastScan.Add(interval))}, nil
+		}
+	}
+
+	progress, err := r.scan(ctx, d)
+	if err != nil {
+		r.Error(err, "error scanning networks", "bmcdiscovery", req.NamespacedName)
+		if statusErr := r.updateStatus(ctx, d, seederv1alpha1.BMCDiscoveryScanError, err.Error(), d.Status.DiscoveredMachines, nil); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+		return ctrl.Result{RequeueAfter: defaultDiscoveryRetryTime}, nil
+	}
+
+	if progress.ScannedAddresses < progress.TotalAddresses {
+		msg := fmt.Sprintf("scanned %d of %d addresses", progress.ScannedAddresses, progress.TotalAddresses)
+		if err := r.updateStatus(ctx, d, seederv1alpha1.BMCDiscoveryScanInProgress, msg, d.Status.DiscoveredMachines, progress); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: discoveryBatchInterval}, nil
+	}
+
+	if err := r.updateStatus(ctx, d, seederv1alpha1.BMCDiscoveryScanComplete, "", progress.DiscoveredMachines, nil); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+// scan probes the next batch of addresses in the cidrs, and generates an inventory for each redfish endpoint
+// which accepts one of the credentials. Batches keep a reconcile from blocking on probes of large networks, and
+// the returned progress is recorded in the status to continue the scan on the next reconcile
+func (r *BMCDiscoveryReconciler) scan(ctx context.Context, d *seederv1alpha1.BMCDiscovery) (*seederv1alpha1.BMCDiscoveryScanProgress, error) {
+	probeTimeout, err := time.ParseDuration(d.Spec.ProbeTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing probe timeout: %v", err)
+	}
+
+	addresses, err := discoveryAddresses(d.Spec.CIDRs)
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := r.fetchCredentials(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	port := d.Spec.RedfishPort
+	if port == 0 {
+		port = defaultRedfishPort
+	}
+
+	// the scan restarts if the spec changed since the scan in progress started
+	progress := &seederv1alpha1.BMCDiscoveryScanProgress{
+		ObservedGeneration: d.Generation,
+		TotalAddresses:     len(addresses),
+	}
+	if p := d.Status.ScanProgress; p != nil && p.ObservedGeneration == d.Generation && p.TotalAddresses == len(addresses) {
+		progress = p.DeepCopy()
+	}
+	batch := addresses[progress.ScannedAddresses:min(progress.ScannedAddresses+discoveryBatchSize, len(addresses))]
+
+	// results are stored by index to keep the discovered machines in address order
+	found := make([]bool, len(batch))
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(defaultDiscoveryWorkers)
+	for n, address := range batch {
+		eg.Go(func() error {
+			found[n] = events.ProbeRedfish(egCtx, redfishEndpoint(address, port), probeTimeout)
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// inventories are only listed once per batch, and created inventories are added to the list to
+	// find duplicates in the rest of the batch
+	var items []seederv1alpha1.Inventory
+	var listed bool
+	for n, address := range batch {
+		if !found[n] {
+			continue
+		}
+		if !listed {
+			items, err = util.ListInventory(ctx, r.Client)
+			if err != nil {
+				return nil, fmt.Errorf("error listing inventory: %w", err)
+			}
+			listed = true
+		}
+		machine, i := r.createInventory(ctx, d, address, port, creds, items)
+		if i != nil {
+			items = append(items, *i)
+		}
+		progress.DiscoveredMachines = append(progress.DiscoveredMachines, machine)
+	}
+	progress.ScannedAddresses += len(batch)
+	return progress, nil
+}
+
+// createInventory generates an inventory for a redfish endpoint, and creates it unless one of the
+// inventories already uses the same baseboard host and port. The created inventory is returned
+func (r *BMCDiscoveryReconciler) createInventory(ctx context.Context, d *seederv1alpha1.BMCDiscovery, address string, port int, creds []bmcCredential, items []seederv1alpha1.Inventory) (seederv1alpha1.DiscoveredMachine, *seederv1alpha1.Inventory) {
+	machine := seederv1alpha1.DiscoveredMachine{
+		Address: address,
+	}
+
+	var ef *events.EventFetcher
+	var cred bmcCredential
+	for _, c := range creds {
+		var err error
+		ef, err = events.NewEventFetcherWithTLS(ctx, c.username, c.password, redfishEndpoint(address, port), d.Spec.VerifyTLS)
+		if err == nil {
+			cred = c
+			break
+		}
+	}
+	if ef == nil {
+		machine.Status = seederv1alpha1.DiscoveredMachineAuthenticationError
+		machine.Message = "unable to authenticate with any of the credentials"
+		return machine, nil
+	}
+	defer ef.Close()
+
+	labels, _, err := ef.GetConfig()
+	if err != nil {
+		machine.Status = seederv1alpha1.DiscoveredMachineError
+		machine.Message = fmt.Sprintf("error querying chassis information: %v", err)
+		return machine, nil
+	}
+
+	info, err := ef.GetSystemInfo()
+	if err != nil {
+		machine.Status = seederv1alpha1.DiscoveredMachineError
+		machine.Message = fmt.Sprintf("error querying system information: %v", err)
+		return machine, nil
+	}
+
+	i := generateDiscoveredInventory(d, address, port, cred.ref, labels, info)
+	machine.Inventory = seederv1alpha1.ObjectReference{Name: i.Name, Namespace: i.Namespace}
+
+	if v := util.FindInventoryWithSameBMC(items, i); v != nil {
+		machine.Status = seederv1alpha1.DiscoveredMachineDuplicate
+		machine.Inventory = seederv1alpha1.ObjectReference{Name: v.Name, Namespace: v.Namespace}
+		machine.Message = "inventory exists with same baseboard host and port"
+		return machine, nil
+	}
+
+	if err := r.Create(ctx, i); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			machine.Status = seederv1alpha1.DiscoveredMachineDuplicate
+			machine.Message = "inventory with the same name already exists"
+			return machine, nil
+		}
+		machine.Status = seederv1alpha1.DiscoveredMachineError
+		machine.Message = fmt.Sprintf("error creating inventory: %v", err)
+		return machine, nil
+	}
+
+	machine.Status = seederv1alpha1.DiscoveredMachineInventoryCreated
+	return machine, i
+}
+
+// generateDiscoveredInventory generates an inventory object for a discovered machine
+func generateDiscoveredInventory(d *seederv1alpha1.BMCDiscovery, address string, port int, secretRef corev1.SecretReference, labels map[string]string, info *events.SystemInfo) *seederv1alpha1.Inventory {
+	i := &seederv1alpha1.Inventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", d.Name, strings.NewReplacer(".", "-", ":", "-").Replace(address)),
+			Namespace: d.Namespace,
+			Labels: map[string]string{
+				seederv1alpha1.BMCDiscoveryLabelKey: d.Name,
+			},
+		},
+		Spec: seederv1alpha1.InventorySpec{
+			PrimaryDisk:                   d.Spec.PrimaryDisk,
+			ManagementInterfaceMacAddress: info.ManagementMACAddress(),
+			Arch:                          info.Arch,
+			BaseboardManagementSpec: rufio.MachineSpec{
+				Connection: rufio.Connection{
+					Host:          address,
+					Port:          d.Spec.BMCPort,
+					InsecureTLS:   !d.Spec.VerifyTLS,
+					AuthSecretRef: secretRef,
+				},
+			},
+			Events: d.Spec.Events,
+		},
+	}
+
+	// labels reported by redfish are only copied if they are valid label values
+	for k, v := range labels {
+		if len(validation.IsValidLabelValue(v)) == 0 {
+			i.Labels[k] = v
+		}
+	}
+
+	if port != defaultRedfishPort {
+		i.Labels[seederv1alpha1.OverrideRedfishPortLabel] = strconv.Itoa(port)
+	}
+
+	return i
+}
+
+// fetchCredentials looks up the username and password from the credential secrets
+func (r *BMCDiscoveryReconciler) fetchCredentials(ctx context.Context, d *seederv1alpha1.BMCDiscovery) ([]bmcCredential, error) {
+	var creds []bmcCredential
+	for _, ref := range d.Spec.Credentials {
+		if ref.Namespace == "" {
+			ref.Namespace = d.Namespace
+		}
+		s, err := util.GetGrantedSecret(ctx, r.Client, seederv1alpha1.ReferenceKindBMCDiscovery, d.Namespace, ref)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching credential secret %s/%s: %v", ref.Namespace, ref.Name, err)
+		}
+
+		username, ok := s.Data["username"]
+		if !ok {
+			return nil, fmt.Errorf("secret %s has no key username", s.Name)
+		}
+		password, ok := s.Data["password"]
+		if !ok {
+			return nil, fmt.Errorf("secret %s has no key password", s.Name)
+		}
+		creds = append(creds, bmcCredential{ref: ref, username: string(username), password: string(password)})
+	}
+	return creds, nil
+}
+
+// updateStatus updates the BMCDiscovery status if needed
+func (r *BMCDiscoveryReconciler) updateStatus(ctx context.Context, d *seederv1alpha1.BMCDiscovery, status seederv1alpha1.BMCDiscoveryStatusType, msg string, machines []seederv1alpha1.DiscoveredMachine, progress *seederv1alpha1.BMCDiscoveryScanProgress) error {
+	dObj := d.DeepCopy()
+	dObj.Status.Status = status
+	dObj.Status.Message = msg
+	dObj.Status.DiscoveredMachines = machines
+	dObj.Status.ScanProgress = progress
+	// scan time is only recorded on successful scans, errors are retried after defaultDiscoveryRetryTime
+	if status == seederv1alpha1.BMCDiscoveryScanComplete {
+		dObj.Status.LastScanTime = time.Now().Format(time.RFC3339)
+	}
+	if !reflect.DeepEqual(dObj.Status, d.Status) {
+		return r.Status().Update(ctx, dObj)
+	}
+	return nil
+}
+
+// discoveryAddresses returns all addresses in the cidrs
+func discoveryAddresses(cidrs []string) ([]string, error) {
+	var addresses []string
+	for _, cidr := range cidrs {
+		prefix, err := netaddr.ParseIPPrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing cidr %s: %v", cidr, err)
+		}
+		ipRange := prefix.Range()
+		for ip := ipRange.From(); ipRange.Contains(ip); ip = ip.Next() {
+			addresses = append(addresses, ip.String())
+			if len(addresses) > maxDiscoveryAddresses {
+				return nil, fmt.Errorf("cidrs contain more than %d addresses", maxDiscoveryAddresses)
+			}
+		}
+	}
+	return addresses, nil
+}
+
+func redfishEndpoint(address string, port int) string {
+	return fmt.Sprintf("https://%s", net.JoinHostPort(address, strconv.Itoa(port)))
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *BMCDiscoveryReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&seederv1alpha1.BMCDiscovery{}).
+		Named("bmcdiscovery").
+		Complete(r)
+}
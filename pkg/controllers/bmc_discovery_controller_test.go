@@ -0,0 +1,191 @@
+package controllers
+
+import (
+	"fmt"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+var _ = Describe("BMC discovery controller tests", func() {
+	var d, duplicate *seederv1alpha1.BMCDiscovery
+	var creds *corev1.Secret
+	var ns *corev1.Namespace
+	BeforeEach(func() {
+		ns = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "discovery-test",
+			},
+		}
+
+		creds = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "discovery",
+				Namespace: "discovery-test",
+			},
+			StringData: map[string]string{
+				"username": "root",
+				"password": "calvin",
+			},
+		}
+
+		d = &seederv1alpha1.BMCDiscovery{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "discovery",
+				Namespace: "discovery-test",
+			},
+			Spec: seederv1alpha1.BMCDiscoverySpec{
+				Credentials: []corev1.SecretReference{
+					{
+						Name:      "discovery",
+						Namespace: "discovery-test",
+					},
+				},
+				BMCPort:      624,
+				ScanInterval: "1h",
+				ProbeTimeout: "5s",
+			},
+		}
+
+		duplicate = &seederv1alpha1.BMCDiscovery{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "duplicate",
+				Namespace: "discovery-test",
+			},
+			Spec: d.Spec,
+		}
+
+		Eventually(func() error {
+			return k8sClient.Create(ctx, ns)
+		}, "60s", "5s").ShouldNot(HaveOccurred())
+
+		Eventually(func() error {
+			return k8sClient.Create(ctx, creds)
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+	})
+
+	It("check inventory is created for discovered redfish endpoint", func() {
+		port, err := strconv.Atoi(redfishPort)
+		Expect(err).ToNot(HaveOccurred())
+
+		Eventually(func() error {
+			d.Spec.CIDRs = []string{fmt.Sprintf("%s/32", redfishAddress)}
+			d.Spec.RedfishPort = port
+			return k8sClient.Create(ctx, d)
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+
+		Eventually(func() error {
+			dObj := &seederv1alpha1.BMCDiscovery{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: d.Namespace, Name: d.Name}, dObj); err != nil {
+				return err
+			}
+
+			if dObj.Status.Status != seederv1alpha1.BMCDiscoveryScanComplete {
+				return fmt.Errorf("waiting for scan to complete. Current status %v", dObj.Status)
+			}
+
+			if len(dObj.Status.DiscoveredMachines) != 1 {
+				return fmt.Errorf("expected to find 1 discovered machine, got %v", dObj.Status.DiscoveredMachines)
+			}
+
+			if dObj.Status.DiscoveredMachines[0].Status != seederv1alpha1.DiscoveredMachineInventoryCreated {
+				return fmt.Errorf("expected inventory to be created, got %v", dObj.Status.DiscoveredMachines[0])
+			}
+			return nil
+		}, "120s", "5s").ShouldNot(HaveOccurred())
+
+		Eventually(func() error {
+			iList := &seederv1alpha1.InventoryList{}
+			if err := k8sClient.List(ctx, iList, client.InNamespace(d.Namespace), client.MatchingLabels{seederv1alpha1.BMCDiscoveryLabelKey: d.Name}); err != nil {
+				return err
+			}
+
+			if len(iList.Items) != 1 {
+				return fmt.Errorf("expected to find 1 inventory, got %d", len(iList.Items))
+			}
+
+			i := iList.Items[0]
+			if i.Spec.ManagementInterfaceMacAddress != "ec:f4:bb:f0:46:54" {
+				return fmt.Errorf("expected management mac address to be discovered, got %s", i.Spec.ManagementInterfaceMacAddress)
+			}
+
+			if i.Spec.Arch != "amd64" {
+				return fmt.Errorf("expected arch to be discovered, got %s", i.Spec.Arch)
+			}
+
+			if _, ok := i.Labels["manufacturer"]; !ok {
+				return fmt.Errorf("expected manufacturer label to be populated")
+			}
+
+			if i.Labels[seederv1alpha1.OverrideRedfishPortLabel] != redfishPort {
+				return fmt.Errorf("expected redfish port label to be populated")
+			}
+
+			if !i.Spec.BaseboardManagementSpec.Connection.InsecureTLS {
+				return fmt.Errorf("expected tls verification to be disabled when verifyTLS is not set")
+			}
+			return nil
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+
+		Eventually(func() error {
+			duplicate.Spec.CIDRs = d.Spec.CIDRs
+			duplicate.Spec.RedfishPort = d.Spec.RedfishPort
+			return k8sClient.Create(ctx, duplicate)
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+
+		Eventually(func() error {
+			dObj := &seederv1alpha1.BMCDiscovery{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: duplicate.Namespace, Name: duplicate.Name}, dObj); err != nil {
+				return err
+			}
+
+			if len(dObj.Status.DiscoveredMachines) != 1 {
+				return fmt.Errorf("expected to find 1 discovered machine, got %v", dObj.Status.DiscoveredMachines)
+			}
+
+			if dObj.Status.DiscoveredMachines[0].Status != seederv1alpha1.DiscoveredMachineDuplicate {
+				return fmt.Errorf("expected machine to be identified as duplicate, got %v", dObj.Status.DiscoveredMachines[0])
+			}
+			return nil
+		}, "120s", "5s").ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Eventually(func() error {
+			return k8sClient.DeleteAllOf(ctx, &seederv1alpha1.Inventory{}, client.InNamespace(ns.Name))
+		}).ShouldNot(HaveOccurred())
+
+		Eventually(func() error {
+			iList := &seederv1alpha1.InventoryList{}
+			if err := k8sClient.List(ctx, iList, client.InNamespace(ns.Name)); err != nil {
+				return err
+			}
+			if len(iList.Items) != 0 {
+				return fmt.Errorf("waiting for inventory objects to be cleaned up")
+			}
+			return nil
+		}, "60s", "5s").ShouldNot(HaveOccurred())
+
+		for _, obj := range []client.Object{d, duplicate, creds} {
+			Eventually(func() error {
+				err := k8sClient.Delete(ctx, obj)
+				if apierrors.IsNotFound(err) {
+					return nil
+				}
+				return err
+			}).ShouldNot(HaveOccurred())
+		}
+
+		Eventually(func() error {
+			return k8sClient.Delete(ctx, ns)
+		}).ShouldNot(HaveOccurred())
+	})
+})
@@ -0,0 +1,226 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/util"
+)
+
+const (
+	clusterAddonResyncInterval = 5 * time.Minute
+	clusterAddonRetryInterval  = 30 * time.Second
+)
+
+// ClusterAddonReconciler applies the addons referenced by a Cluster once it is running. Addons are re-applied
+// periodically, which corrects objects and settings changed in the cluster
+type ClusterAddonReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	logr.Logger
+}
+
+//+kubebuilder:rbac:groups=metal.harvesterhci.io,resources=clusteraddons,verbs=get;list;watch
+
+func (r *ClusterAddonReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	cObj := &seederv1alpha1.Cluster{}
+	err := r.Get(ctx, req.NamespacedName, cObj)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		r.Error(err, "unable to fetch cluster object")
+		return ctrl.Result{}, err
+	}
+
+	c := cObj.DeepCopy()
+	// ignore the local cluster and clusters being deleted
+	if c.Name == seederv1alpha1.DefaultLocalClusterName && c.Namespace == seederv1alpha1.DefaultLocalClusterNamespace {
+		return ctrl.Result{}, nil
+	}
+	if !c.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	statuses, err := r.applyAddons(ctx, c)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !reflect.DeepEqual(c.Status.Addons, statuses) {
+		c.Status.Addons = statuses
+		if err := r.Status().Update(ctx, c); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if len(statuses) == 0 || c.Status.Status != seederv1alpha1.ClusterRunning {
+		return ctrl.Result{}, nil
+	}
+	for _, s := range statuses {
+		if s.Phase != seederv1alpha1.ClusterAddonApplied {
+			return ctrl.Result{RequeueAfter: clusterAddonRetryInterval}, nil
+		}
+	}
+	return ctrl.Result{RequeueAfter: clusterAddonResyncInterval}, nil
+}
+
+// applyAddons applies the cluster addons in order, and returns their status. Addons following one which failed to
+// apply are not applied, and all addons are pending until the cluster is running
+func (r *ClusterAddonReconciler) applyAddons(ctx context.Context, c *seederv1alpha1.Cluster) ([]seederv1alpha1.ClusterAddonStatus, error) {
+	if len(c.Spec.Addons) == 0 {
+		return nil, nil
+	}
+
+	var dc dynamic.Interface
+	var mapper meta.RESTMapper
+	var blockedBy string
+	if c.Status.Status != seederv1alpha1.ClusterRunning {
+		blockedBy = "waiting for cluster to be running"
+	} else {
+		restConfig, err := genRestConfig(c)
+		if err != nil {
+			return nil, err
+		}
+		dc, err = dynamic.NewForConfig(restConfig)
+		if err != nil {
+			return nil, fmt.Errorf("error generating dynamic client for cluster %s: %w", c.Name, err)
+		}
+		discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+		if err != nil {
+			return nil, fmt.Errorf("error generating discovery client for cluster %s: %w", c.Name, err)
+		}
+		mapper = restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+	}
+
+	statuses := make([]seederv1alpha1.ClusterAddonStatus, 0, len(c.Spec.Addons))
+	for _, name := range c.Spec.Addons {
+		existing := findClusterAddonStatus(c.Status.Addons, name)
+		status := seederv1alpha1.ClusterAddonStatus{
+			Name:               name,
+			ObservedGeneration: existing.ObservedGeneration,
+			LastDriftCorrected: existing.LastDriftCorrected,
+		}
+
+		if blockedBy != "" {
+			status.Phase = seederv1alpha1.ClusterAddonPending
+			status.Message = blockedBy
+		} else if generation, changed, err := r.applyAddon(ctx, c, name, dc, mapper); err != nil {
+			status.Phase = seederv1alpha1.ClusterAddonFailed
+			status.Message = err.Error()
+			blockedBy = fmt.Sprintf("waiting for addon %s to be applied", name)
+		} else {
+			status.Phase = seederv1alpha1.ClusterAddonApplied
+			// changes to an addon which was already applied at the same generation were made in the cluster
+			if changed != 0 && existing.Phase == seederv1alpha1.ClusterAddonApplied && existing.ObservedGeneration == generation {
+				r.Info("corrected drift of cluster addon", "cluster", c.Name, "addon", name, "changed", changed)
+				status.LastDriftCorrected = time.Now().UTC().Format(time.RFC3339)
+			}
+			status.ObservedGeneration = generation
+		}
+
+		status.LastUpdated = existing.LastUpdated
+		if status != existing {
+			status.LastUpdated = time.Now().UTC().Format(time.RFC3339)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// applyAddon applies the addon to the cluster, and returns the addon generation and the number of objects and
+// settings changed
+func (r *ClusterAddonReconciler) applyAddon(ctx context.Context, c *seederv1alpha1.Cluster, name string, dc dynamic.Interface, mapper meta.RESTMapper) (int64, int, error) {
+	addon := &seederv1alpha1.ClusterAddon{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: c.Namespace, Name: name}, addon); err != nil {
+		return 0, 0, fmt.Errorf("error fetching cluster addon: %w", err)
+	}
+
+	manifests, err := util.ClusterAddonManifests(ctx, r.Client, addon)
+	if err != nil {
+		return addon.Generation, 0, err
+	}
+
+	changed, err := util.ApplyClusterAddon(ctx, dc, mapper, manifests, addon.Spec.Settings)
+	return addon.Generation, changed, err
+}
+
+func findClusterAddonStatus(statuses []seederv1alpha1.ClusterAddonStatus, name string) seederv1alpha1.ClusterAddonStatus {
+	for _, s := range statuses {
+		if s.Name == name {
+			return s
+		}
+	}
+	return seederv1alpha1.ClusterAddonStatus{}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ClusterAddonReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// enqueueClustersUsingAddons maps addons to the clusters in their namespace referencing them
+	enqueueClustersUsingAddons := func(ctx context.Context, namespace string, addons []string) []reconcile.Request {
+		clusterList := &seederv1alpha1.ClusterList{}
+		if err := r.List(ctx, clusterList, client.InNamespace(namespace)); err != nil {
+			r.Error(err, "error listing clusters")
+			return nil
+		}
+		var reconRequest []reconcile.Request
+		for _, c := range clusterList.Items {
+			for _, name := range addons {
+				if slices.Contains(c.Spec.Addons, name) {
+					reconRequest = append(reconRequest, reconcile.Request{
+						NamespacedName: types.NamespacedName{
+							Namespace: c.Namespace,
+							Name:      c.Name,
+						},
+					})
+					break
+				}
+			}
+		}
+		return reconRequest
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&seederv1alpha1.Cluster{}).
+		Watches(&seederv1alpha1.ClusterAddon{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+			return enqueueClustersUsingAddons(ctx, a.GetNamespace(), []string{a.GetName()})
+		})).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+			addonList := &seederv1alpha1.ClusterAddonList{}
+			if err := r.List(ctx, addonList, client.InNamespace(a.GetNamespace())); err != nil {
+				r.Error(err, "error listing cluster addons")
+				return nil
+			}
+			var addons []string
+			for _, v := range addonList.Items {
+				if slices.Contains(v.Spec.ManifestConfigMaps, a.GetName()) {
+					addons = append(addons, v.Name)
+				}
+			}
+			if len(addons) == 0 {
+				return nil
+			}
+			return enqueueClustersUsingAddons(ctx, a.GetNamespace(), addons)
+		})).
+		Named("clusteraddons").
+		Complete(r)
+}
@@ -0,0 +1,126 @@
+package controllers
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+var _ = Describe("ClusterAddon Controller", func() {
+	var c *seederv1alpha1.Cluster
+	var a *seederv1alpha1.AddressPool
+	var ntp, proxy *seederv1alpha1.ClusterAddon
+
+	BeforeEach(func() {
+		a = &seederv1alpha1.AddressPool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cluster-addon-address-pool",
+				Namespace: "default",
+			},
+			Spec: seederv1alpha1.AddressSpec{
+				CIDR:    "192.168.3.1/29",
+				Gateway: "192.168.3.7",
+			},
+		}
+
+		ntp = &seederv1alpha1.ClusterAddon{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ntp",
+				Namespace: "default",
+			},
+			Spec: seederv1alpha1.ClusterAddonSpec{
+				Settings: []seederv1alpha1.HarvesterSetting{
+					{
+						Name:  "ntp-servers",
+						Value: `{"ntpServers":["0.suse.pool.ntp.org"]}`,
+					},
+				},
+			},
+		}
+
+		proxy = &seederv1alpha1.ClusterAddon{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "proxy",
+				Namespace: "default",
+			},
+			Spec: seederv1alpha1.ClusterAddonSpec{
+				Settings: []seederv1alpha1.HarvesterSetting{
+					{
+						Name:  "http-proxy",
+						Value: `{"httpProxy":"http://proxy.example.com:3128"}`,
+					},
+				},
+			},
+		}
+
+		c = &seederv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cluster-addon-test",
+				Namespace: "default",
+			},
+			Spec: seederv1alpha1.ClusterSpec{
+				HarvesterVersion: "v1.3.0",
+				ImageURL:         "localhost:5000/v1.3.0",
+				VIPConfig: seederv1alpha1.VIPConfig{
+					AddressPoolReference: seederv1alpha1.ObjectReference{
+						Name:      a.Name,
+						Namespace: a.Namespace,
+					},
+				},
+				Addons: []string{ntp.Name, proxy.Name},
+			},
+		}
+
+		for _, obj := range []client.Object{a, ntp, proxy, c} {
+			Eventually(func() error {
+				return k8sClient.Create(ctx, obj)
+			}, "30s", "5s").ShouldNot(HaveOccurred())
+		}
+	})
+
+	It("reports addons as pending in order until the cluster is running", func() {
+		Eventually(func() error {
+			obj := &seederv1alpha1.Cluster{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: c.Namespace, Name: c.Name}, obj); err != nil {
+				return err
+			}
+			if len(obj.Status.Addons) != 2 {
+				return fmt.Errorf("waiting for addon status to be reported")
+			}
+			for idx, name := range c.Spec.Addons {
+				s := obj.Status.Addons[idx]
+				if s.Name != name || s.Phase != seederv1alpha1.ClusterAddonPending {
+					return fmt.Errorf("expected addon %s to be pending, got %s %s", name, s.Name, s.Phase)
+				}
+			}
+			return nil
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Eventually(func() error {
+			return k8sClient.Delete(ctx, c)
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+
+		Eventually(func() error {
+			err := k8sClient.Get(ctx, types.NamespacedName{Namespace: c.Namespace, Name: c.Name}, &seederv1alpha1.Cluster{})
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("waiting for cluster to be deleted: %v", err)
+		}, "120s", "5s").ShouldNot(HaveOccurred())
+
+		for _, obj := range []client.Object{ntp, proxy, a} {
+			Eventually(func() error {
+				return k8sClient.Delete(ctx, obj)
+			}, "30s", "5s").ShouldNot(HaveOccurred())
+		}
+	})
+})
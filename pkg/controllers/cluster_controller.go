@@ -19,6 +19,8 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -31,6 +33,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
 	typedCore "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -57,6 +60,11 @@ type ClusterReconciler struct {
 const (
 	DefaultDeletionReconcileInterval = 30 * time.Second
 	DefaultShutdownRetriggerInterval = 600 // seconds
+	createNodeProbeInterval          = 30 * time.Second
+	nodeRemovalInterval              = 15 * time.Second
+	decommissionInterval             = 30 * time.Second
+	defaultDecommissionTimeout       = 2 * time.Hour
+	rancherImportInterval            = 30 * time.Second
 )
 
 type clusterReconciler func(context.Context, *seederv1alpha1.Cluster) error
@@ -95,12 +103,30 @@ func (r *ClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return ctrl.Result{}, nil
 	}
 
+	// join node admission waits on the cluster api, and does not block the reconcilers which follow it. The
+	// cluster is requeued to check the api again
+	var requeueAfter time.Duration
+	admitJoinNodes := func(ctx context.Context, c *seederv1alpha1.Cluster) error {
+		err := r.admitJoinNodes(ctx, c)
+		if deferred, ok := util.IsDeferred(err); ok {
+			r.Info("join node admission deferred", "cluster", c.Name, "reason", err.Error())
+			requeueAfter = deferred
+			return nil
+		}
+		return err
+	}
+
 	reconcileList := []clusterReconciler{
 		r.generateClusterConfig,
 		r.patchNodesAndPools,
 		r.createTinkerbellHardware,
 		r.reconcileNodes,
+		admitJoinNodes,
 		r.markClusterReady,
+		r.replaceFailedNodes,
+		r.reportTopologySpread,
+		r.reportTemplateDrift,
+		r.importToRancher,
 	}
 	deletionReconcileList := []clusterReconciler{
 		r.cleanupClusterDeps,
@@ -113,6 +139,13 @@ func (r *ClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		}
 		for _, reconciler := range reconcileList {
 			if err := reconciler(ctx, c); err != nil {
+				if deferred, ok := util.IsDeferred(err); ok {
+					r.Info("cluster reconcile deferred", "cluster", c.Name, "reason", err.Error())
+					if requeueAfter > 0 && requeueAfter < deferred {
+						deferred = requeueAfter
+					}
+					return ctrl.Result{RequeueAfter: deferred}, nil
+				}
 				return ctrl.Result{}, err
 			}
 		}
@@ -128,7 +161,7 @@ func (r *ClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		}
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 // generateClusterConfig will generate the clusterConfig
@@ -155,19 +188,22 @@ func (r *ClusterReconciler) generateClusterConfig(ctx context.Context, cObj *see
 				}
 			}
 			if !addressFound {
-				vip, err := util.AllocateAddress(vipPool.Status.DeepCopy(), c.Spec.StaticAddress)
+				provider, err := util.NewIPAMProvider(ctx, r.Client, vipPool)
 				if err != nil {
 					return err
 				}
-				c.Status.ClusterAddress = vip
-				// update address allocation
-				vipPool.Status.AddressAllocation[vip] = seederv1alpha1.ObjectReferenceWithKind{
+				vip, err := provider.Allocate(ctx, vipPool, seederv1alpha1.ObjectReferenceWithKind{
 					Kind: seederv1alpha1.KindCluster,
 					ObjectReference: seederv1alpha1.ObjectReference{
 						Name:      c.Name,
 						Namespace: c.Namespace,
 					},
+				}, c.Spec.StaticAddress)
+				if err != nil {
+					return err
 				}
+				c.Status.ClusterAddress = vip
+				// update address allocation
 				if err := r.lockedAddressPoolUpdate(ctx, vipPool); err != nil {
 					return fmt.Errorf("error updating address pool with cluster vip: %v", err)
 				}
@@ -206,7 +242,23 @@ func (r *ClusterReconciler) patchNodesAndPools(ctx context.Context, cObj *seeder
 				return fmt.Errorf("waiting for inventory %s in namespace %s to be ready", i.Name, i.Namespace)
 			}
 
+			// inventory placed in maintenance after allocation remains part of the cluster
+			if !util.ConditionExists(i, seederv1alpha1.InventoryAllocatedToCluster) {
+				if util.ConditionExists(i, seederv1alpha1.Quarantined) {
+					return fmt.Errorf("inventory %s in namespace %s is quarantined: %s", i.Name, i.Namespace, seederv1alpha1.Quarantined.GetMessage(i))
+				}
+
+				if !util.InventoryAccepted(i) {
+					return fmt.Errorf("waiting for inventory %s in namespace %s to pass acceptance", i.Name, i.Namespace)
+				}
+			}
+
+			// spare inventory replacing a failed node keeps its hostname, and always joins the existing cluster
+			replacement := findNodeReplacement(c, i)
 			if util.ConditionExists(i, seederv1alpha1.InventoryAllocatedToCluster) {
+				if replacement != nil {
+					removeNodeReplacement(c, i)
+				}
 				continue
 			}
 
@@ -223,18 +275,21 @@ func (r *ClusterReconciler) patchNodesAndPools(ctx context.Context, cObj *seeder
 				if pool.Status.Status != seederv1alpha1.PoolReady {
 					return fmt.Errorf("waiting for address pool %s to be ready", pool.Name)
 				}
-				nodeAddress, err = util.AllocateAddress(pool.Status.DeepCopy(), nc.StaticAddress)
+				provider, err := util.NewIPAMProvider(ctx, r.Client, pool)
 				if err != nil {
 					return err
 				}
-
-				pool.Status.AddressAllocation[nodeAddress] = seederv1alpha1.ObjectReferenceWithKind{
+				nodeAddress, err = provider.Allocate(ctx, pool, seederv1alpha1.ObjectReferenceWithKind{
 					ObjectReference: seederv1alpha1.ObjectReference{
 						Namespace: i.Namespace,
 						Name:      i.Name,
 					},
 					Kind: seederv1alpha1.KindInventory,
+				}, nc.StaticAddress)
+				if err != nil {
+					return err
 				}
+
 				err = r.lockedAddressPoolUpdate(ctx, pool)
 				if err != nil {
 					return fmt.Errorf("error updating address pool after allocation: %v", err)
@@ -252,8 +307,13 @@ func (r *ClusterReconciler) patchNodesAndPools(ctx context.Context, cObj *seeder
 			util.CreateOrUpdateCondition(i, seederv1alpha1.InventoryAllocatedToCluster,
 				fmt.Sprintf("node assigned to cluster %s", c.Name))
 			util.RemoveCondition(i, seederv1alpha1.InventoryFreed)
+			util.RemoveCondition(i, seederv1alpha1.Sanitized)
 
-			if n == 0 {
+			if replacement != nil {
+				i.Status.Hostname = replacement.Hostname
+			}
+
+			if n == 0 && replacement == nil {
 				util.CreateOrUpdateCondition(i, seederv1alpha1.HarvesterCreateNode, "Create Mode")
 			} else {
 				util.CreateOrUpdateCondition(i, seederv1alpha1.HarvesterJoinNode, "Join Mode")
@@ -264,6 +324,10 @@ func (r *ClusterReconciler) patchNodesAndPools(ctx context.Context, cObj *seeder
 				return err
 			}
 
+			if replacement != nil {
+				removeNodeReplacement(c, i)
+			}
+
 		}
 
 		c.Status.Status = seederv1alpha1.ClusterNodesPatched
@@ -295,6 +359,11 @@ func (r *ClusterReconciler) createTinkerbellHardware(ctx context.Context, cObj *
 			return fmt.Errorf("error fetching svc %s in ns %s: %v", seederv1alpha1.DefaultSeederDeploymentService, seederv1alpha1.DefaultLocalClusterNamespace, err)
 		}
 
+		registryAuth, err := util.GetRegistryCredentials(ctx, r.Client, c)
+		if err != nil {
+			return err
+		}
+
 		for _, i := range c.Spec.Nodes {
 			inventory := &seederv1alpha1.Inventory{}
 			err := r.Get(ctx, types.NamespacedName{Namespace: i.InventoryReference.Namespace, Name: i.InventoryReference.Name}, inventory)
@@ -312,7 +381,7 @@ func (r *ClusterReconciler) createTinkerbellHardware(ctx context.Context, cObj *
 
 			// tinkStack Service exposes Hegel endpoint
 			// seederDeploymentService exposes the api endpoint to update hardware objects
-			hw, err := tink.GenerateHWRequest(inventory, c, seederDeploymentService, tinkStackService)
+			hw, err := tink.GenerateHWRequest(inventory, c, seederDeploymentService, tinkStackService, registryAuth)
 			if err != nil {
 				return err
 			}
@@ -359,6 +428,14 @@ func (r *ClusterReconciler) reconcileNodes(ctx context.Context, cObj *seederv1al
 			}
 		}
 
+		if err := r.recordNodeReplacements(ctx, c, removedNodes); err != nil {
+			return err
+		}
+
+		// removed nodes are decommissioned at the same time, and are only powered off and freed once all of them
+		// are sanitized
+		var decommissioning []string
+		var sanitized []seederv1alpha1.Inventory
 		for _, i := range removedNodes {
 			iObj := &seederv1alpha1.Inventory{}
 			err := r.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, iObj)
@@ -376,14 +453,61 @@ func (r *ClusterReconciler) reconcileNodes(ctx context.Context, cObj *seederv1al
 				continue
 			}
 
-			// free up address
+			// remove node from the target cluster before the machine is powered off
+			if err := r.removeNodeFromCluster(ctx, c, iObj); err != nil {
+				return err
+			}
+
+			// sanitize the machine before it is returned to the pool
+			done, err := r.decommissionInventory(ctx, c, iObj)
+			if err != nil {
+				return err
+			}
+			if !done {
+				if err := r.updateNodeRemovalStatus(ctx, c, iObj, "", seederv1alpha1.NodeRemovalDecommissioning, seederv1alpha1.Decommissioning.GetMessage(iObj)); err != nil {
+					return err
+				}
+				decommissioning = append(decommissioning, i.Name)
+				continue
+			}
+			sanitized = append(sanitized, i)
+		}
+
+		if len(decommissioning) != 0 {
+			return &util.DeferredError{Reason: fmt.Sprintf("waiting for inventory %s to be decommissioned", strings.Join(decommissioning, ", ")), RequeueAfter: decommissionInterval}
+		}
+
+		for _, i := range sanitized {
+			iObj := &seederv1alpha1.Inventory{}
+			if err := r.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, iObj); err != nil {
+				return err
+			}
+
+			if err := r.updateNodeRemovalStatus(ctx, c, iObj, "", seederv1alpha1.NodeRemovalPoweringOff, ""); err != nil {
+				return err
+			}
+
+			// free up address, or hand it over to the spare replacing the node
 			a, err := util.FindIPInAddressPools(ctx, r.Client, i.Name, i.Namespace, i.Status.Address)
 			if err != nil {
 				return err
 			}
 
 			if a != nil {
-				delete(a.Status.AddressAllocation, i.Status.Address)
+				if replacement := findNodeReplacementForFailed(c, &i); replacement != nil && replacement.Address == i.Status.Address {
+					a.Status.AddressAllocation[i.Status.Address] = seederv1alpha1.ObjectReferenceWithKind{
+						ObjectReference: replacement.SpareInventory,
+						Kind:            seederv1alpha1.KindInventory,
+					}
+				} else {
+					provider, err := util.NewIPAMProvider(ctx, r.Client, a)
+					if err != nil {
+						return err
+					}
+					if err := provider.Release(ctx, a, i.Status.Address); err != nil {
+						return fmt.Errorf("error releasing address of inventory %s: %w", i.Name, err)
+					}
+				}
 				if err := r.Status().Update(ctx, a); err != nil {
 					return err
 				}
@@ -396,6 +520,13 @@ func (r *ClusterReconciler) reconcileNodes(ctx context.Context, cObj *seederv1al
 			if !ok {
 				return fmt.Errorf("waiting for inventory %s to be shutdown", i.Name)
 			}
+
+			if removeNodeRemovalStatus(c, iObj) {
+				if err := r.Status().Update(ctx, c); err != nil {
+					return fmt.Errorf("error updating node removal status for inventory %s: %w", i.Name, err)
+				}
+			}
+
 			// fetch and clear last job request
 			err = r.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, iObj)
 			if err != nil {
@@ -411,12 +542,15 @@ func (r *ClusterReconciler) reconcileNodes(ctx context.Context, cObj *seederv1al
 			iObj.Status.Cluster = seederv1alpha1.ObjectReference{}
 			iObj.Status.GeneratedPassword = ""
 			iObj.Status.PowerAction.LastJobName = ""
+			iObj.Status.Hostname = ""
 			util.RemoveCondition(iObj, seederv1alpha1.InventoryAllocatedToCluster)
 			util.RemoveCondition(iObj, seederv1alpha1.TinkHardwareCreated)
 			util.RemoveCondition(iObj, seederv1alpha1.HarvesterJoinNode)
 			util.RemoveCondition(iObj, seederv1alpha1.TinkWorkflowCreated)
 			util.RemoveCondition(iObj, seederv1alpha1.TinkTemplateCreated)
 			util.RemoveCondition(iObj, seederv1alpha1.ClusterCleanupSubmitted)
+			util.RemoveCondition(iObj, seederv1alpha1.HarvesterInstalling)
+			util.RemoveCondition(iObj, seederv1alpha1.HarvesterJoinAdmitted)
 			util.CreateOrUpdateCondition(iObj, seederv1alpha1.InventoryFreed, "")
 			if err := r.Status().Update(ctx, iObj); err != nil {
 				return err
@@ -495,10 +629,673 @@ func (r *ClusterReconciler) reconcileNodes(ctx context.Context, cObj *seederv1al
 	return nil
 }
 
+// removeNodeFromCluster cordons and drains the node in the target cluster, waits for longhorn replicas to be rebuilt
+// on other nodes and deletes the node object. Progress is reported in the cluster status, and the removal is deferred
+// until each phase is complete. Nodes which are not part of a running cluster, or inventory with the force removal
+// annotation, skip straight to decommission
+func (r *ClusterReconciler) removeNodeFromCluster(ctx context.Context, c *seederv1alpha1.Cluster, i *seederv1alpha1.Inventory) error {
+	_, force := i.Annotations[seederv1alpha1.ForceNodeRemovalAnnotation]
+	if c.Status.Status != seederv1alpha1.ClusterRunning || force {
+		return nil
+	}
+
+	restConfig, err := genRestConfig(c)
+	if err != nil {
+		return fmt.Errorf("error generating rest config for cluster %s: %w", c.Name, err)
+	}
+	coreClient, err := typedCore.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("error generating client for cluster %s: %w", c.Name, err)
+	}
+
+	node, err := util.FindNodeByAddress(ctx, coreClient, i.Status.Address, util.NodeHostname(i))
+	if err != nil {
+		return err
+	}
+
+	// node is no longer part of the cluster
+	if node == nil {
+		return nil
+	}
+
+	if err := r.updateNodeRemovalStatus(ctx, c, i, node.Name, seederv1alpha1.NodeRemovalDraining, ""); err != nil {
+		return err
+	}
+	if err := util.CordonNode(ctx, coreClient, node); err != nil {
+		return fmt.Errorf("error cordoning node %s: %w", node.Name, err)
+	}
+
+	pods, err := util.DrainNode(ctx, coreClient, node.Name)
+	if err != nil {
+		return err
+	}
+	if pods != 0 {
+		msg := fmt.Sprintf("waiting for %d pods to be evicted", pods)
+		if err := r.updateNodeRemovalStatus(ctx, c, i, node.Name, seederv1alpha1.NodeRemovalDraining, msg); err != nil {
+			return err
+		}
+		return &util.DeferredError{Reason: fmt.Sprintf("node %s: %s", node.Name, msg), RequeueAfter: nodeRemovalInterval}
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("error generating dynamic client for cluster %s: %w", c.Name, err)
+	}
+	replicas, stuck, err := util.EvictLonghornReplicas(ctx, dynamicClient, node.Name)
+	if err != nil {
+		return err
+	}
+	// volumes without a healthy replica on another node are not waited on, and are reported in the status
+	var stuckMsg string
+	if len(stuck) != 0 {
+		stuckMsg = fmt.Sprintf("volumes %s have no healthy replica on another node and are not migrated", strings.Join(stuck, ", "))
+	}
+	if replicas != 0 {
+		msg := fmt.Sprintf("waiting for %d volume replicas to be rebuilt on other nodes", replicas)
+		if stuckMsg != "" {
+			msg = fmt.Sprintf("%s, %s", msg, stuckMsg)
+		}
+		if err := r.updateNodeRemovalStatus(ctx, c, i, node.Name, seederv1alpha1.NodeRemovalEvictingReplicas, msg); err != nil {
+			return err
+		}
+		return &util.DeferredError{Reason: fmt.Sprintf("node %s: %s", node.Name, msg), RequeueAfter: nodeRemovalInterval}
+	}
+
+	if err := r.updateNodeRemovalStatus(ctx, c, i, node.Name, seederv1alpha1.NodeRemovalDeletingNode, stuckMsg); err != nil {
+		return err
+	}
+	if err := coreClient.Nodes().Delete(ctx, node.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting node %s: %w", node.Name, err)
+	}
+	return nil
+}
+
+// updateNodeRemovalStatus records the removal phase of an inventory in the cluster status
+func (r *ClusterReconciler) updateNodeRemovalStatus(ctx context.Context, c *seederv1alpha1.Cluster, i *seederv1alpha1.Inventory, nodeName string, phase seederv1alpha1.NodeRemovalPhase, msg string) error {
+	status := seederv1alpha1.NodeRemovalStatus{
+		InventoryReference: seederv1alpha1.ObjectReference{
+			Name:      i.Name,
+			Namespace: i.Namespace,
+		},
+		NodeName: nodeName,
+		Phase:    phase,
+		Message:  msg,
+	}
+
+	index := -1
+	for idx, v := range c.Status.NodeRemovals {
+		if v.InventoryReference == status.InventoryReference {
+			index = idx
+			break
+		}
+	}
+
+	if index == -1 {
+		status.LastUpdated = time.Now().UTC().Format(time.RFC3339)
+		c.Status.NodeRemovals = append(c.Status.NodeRemovals, status)
+		return r.Status().Update(ctx, c)
+	}
+
+	existing := c.Status.NodeRemovals[index]
+	if nodeName == "" {
+		status.NodeName = existing.NodeName
+	}
+	status.LastUpdated = existing.LastUpdated
+	if existing == status {
+		return nil
+	}
+	status.LastUpdated = time.Now().UTC().Format(time.RFC3339)
+	c.Status.NodeRemovals[index] = status
+	return r.Status().Update(ctx, c)
+}
+
+// removeNodeRemovalStatus removes the removal status of an inventory once it has been freed
+func removeNodeRemovalStatus(c *seederv1alpha1.Cluster, i *seederv1alpha1.Inventory) bool {
+	for idx, v := range c.Status.NodeRemovals {
+		if v.InventoryReference.Name == i.Name && v.InventoryReference.Namespace == i.Namespace {
+			c.Status.NodeRemovals = append(c.Status.NodeRemovals[:idx], c.Status.NodeRemovals[idx+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// recordNodeReplacements pairs inventory removed from the cluster with inventory added in its place from the same
+// address pool. The spare takes over the address and hostname of the removed node once it has been removed
+func (r *ClusterReconciler) recordNodeReplacements(ctx context.Context, c *seederv1alpha1.Cluster, removedNodes []seederv1alpha1.Inventory) error {
+	var updated bool
+	for idx := range removedNodes {
+		failed := &removedNodes[idx]
+		if failed.Status.Address == "" || findNodeReplacementForFailed(c, failed) != nil {
+			continue
+		}
+
+		pool, err := util.FindIPInAddressPools(ctx, r.Client, failed.Name, failed.Namespace, failed.Status.Address)
+		if err != nil {
+			return err
+		}
+		if pool == nil {
+			continue
+		}
+
+		for _, n := range c.Spec.Nodes {
+			if n.AddressPoolReference.Name != pool.Name || n.AddressPoolReference.Namespace != pool.Namespace {
+				continue
+			}
+			if n.StaticAddress != "" && n.StaticAddress != failed.Status.Address {
+				continue
+			}
+
+			spare := &seederv1alpha1.Inventory{}
+			if err := r.Get(ctx, types.NamespacedName{Namespace: n.InventoryReference.Namespace, Name: n.InventoryReference.Name}, spare); err != nil {
+				return err
+			}
+			if util.ConditionExists(spare, seederv1alpha1.InventoryAllocatedToCluster) || findNodeReplacement(c, spare) != nil {
+				continue
+			}
+
+			r.Info("replacing node with spare inventory", "cluster", c.Name, "failed", failed.Name, "spare", spare.Name, "address", failed.Status.Address)
+			c.Status.NodeReplacements = append(c.Status.NodeReplacements, seederv1alpha1.NodeReplacementStatus{
+				FailedInventory: seederv1alpha1.ObjectReference{
+					Name:      failed.Name,
+					Namespace: failed.Namespace,
+				},
+				SpareInventory: seederv1alpha1.ObjectReference{
+					Name:      spare.Name,
+					Namespace: spare.Namespace,
+				},
+				Address:  failed.Status.Address,
+				Hostname: util.NodeHostname(failed),
+			})
+			updated = true
+			break
+		}
+	}
+
+	if updated {
+		return r.Status().Update(ctx, c)
+	}
+	return nil
+}
+
+// findNodeReplacement returns the replacement record where the inventory is the spare
+func findNodeReplacement(c *seederv1alpha1.Cluster, i *seederv1alpha1.Inventory) *seederv1alpha1.NodeReplacementStatus {
+	for idx, v := range c.Status.NodeReplacements {
+		if v.SpareInventory.Name == i.Name && v.SpareInventory.Namespace == i.Namespace {
+			return &c.Status.NodeReplacements[idx]
+		}
+	}
+	return nil
+}
+
+// findNodeReplacementForFailed returns the replacement record where the inventory is the failed node
+func findNodeReplacementForFailed(c *seederv1alpha1.Cluster, i *seederv1alpha1.Inventory) *seederv1alpha1.NodeReplacementStatus {
+	for idx, v := range c.Status.NodeReplacements {
+		if v.FailedInventory.Name == i.Name && v.FailedInventory.Namespace == i.Namespace {
+			return &c.Status.NodeReplacements[idx]
+		}
+	}
+	return nil
+}
+
+// removeNodeReplacement removes the replacement record once the spare has been allocated to the cluster
+func removeNodeReplacement(c *seederv1alpha1.Cluster, i *seederv1alpha1.Inventory) {
+	for idx, v := range c.Status.NodeReplacements {
+		if v.SpareInventory.Name == i.Name && v.SpareInventory.Namespace == i.Namespace {
+			c.Status.NodeReplacements = append(c.Status.NodeReplacements[:idx], c.Status.NodeReplacements[idx+1:]...)
+			return
+		}
+	}
+}
+
+// replaceFailedNodes swaps the inventory of a node reporting critical hardware health for a spare from the spare
+// pool. Nodes are replaced one at a time, and reconcileNodes carries out the replacement
+func (r *ClusterReconciler) replaceFailedNodes(ctx context.Context, cObj *seederv1alpha1.Cluster) error {
+	c := cObj.DeepCopy()
+	if c.Spec.SparePool == nil || !c.Spec.SparePool.AutoReplace || c.Status.Status != seederv1alpha1.ClusterRunning {
+		return nil
+	}
+
+	// wait for in progress replacements and removals to complete
+	if len(c.Status.NodeReplacements) != 0 || len(c.Status.NodeRemovals) != 0 {
+		return nil
+	}
+
+	for idx, n := range c.Spec.Nodes {
+		i := &seederv1alpha1.Inventory{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: n.InventoryReference.Namespace, Name: n.InventoryReference.Name}, i); err != nil {
+			return err
+		}
+
+		if i.Status.Cluster.Name != c.Name || i.Status.Cluster.Namespace != c.Namespace || !util.ConditionExists(i, seederv1alpha1.HardwareHealthCritical) || util.ConditionExists(i, seederv1alpha1.Quarantined) {
+			continue
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(&c.Spec.SparePool.Selector)
+		if err != nil {
+			return fmt.Errorf("error parsing spare pool selector for cluster %s: %w", c.Name, err)
+		}
+
+		nodes, err := util.ClusterNodeInventory(ctx, r.Client, c)
+		if err != nil {
+			return err
+		}
+
+		// prefer spares satisfying all topology spread constraints, and fall back to those only satisfying
+		// the DoNotSchedule constraints
+		spare, err := util.FindSpareInventory(ctx, r.Client, selector, c.Namespace, spareSatisfiesTopology(c, nodes, i))
+		if err != nil {
+			return err
+		}
+
+		if spare == nil {
+			spare, err = util.FindSpareInventory(ctx, r.Client, selector, c.Namespace, spareSatisfiesTopology(c, nodes, i, seederv1alpha1.DoNotSchedule))
+			if err != nil {
+				return err
+			}
+		}
+
+		if spare == nil {
+			r.Info("no spare inventory available to replace node with critical hardware health", "cluster", c.Name, "inventory", i.Name)
+			return nil
+		}
+
+		r.Info("replacing node with critical hardware health", "cluster", c.Name, "inventory", i.Name, "spare", spare.Name)
+		c.Spec.Nodes[idx].InventoryReference = seederv1alpha1.ObjectReference{
+			Name:      spare.Name,
+			Namespace: spare.Namespace,
+		}
+		return r.Update(ctx, c)
+	}
+	return nil
+}
+
+// reportTopologySpread reports the nodes which violate the topology spread constraints in the cluster status
+func (r *ClusterReconciler) reportTopologySpread(ctx context.Context, cObj *seederv1alpha1.Cluster) error {
+	c := cObj.DeepCopy()
+	nodes, err := util.ClusterNodeInventory(ctx, r.Client, c)
+	if err != nil {
+		return err
+	}
+
+	var violations []string
+	for _, v := range util.TopologySpreadViolations(c.Spec.TopologySpreadConstraints, nodes) {
+		violations = append(violations, v.String())
+	}
+
+	if slices.Equal(c.Status.TopologyViolations, violations) {
+		return nil
+	}
+	c.Status.TopologyViolations = violations
+	return r.Status().Update(ctx, c)
+}
+
+// reportTemplateDrift reports the fields defaulted from the cluster template which no longer match the template.
+// Template changes are never applied to the cluster
+func (r *ClusterReconciler) reportTemplateDrift(ctx context.Context, cObj *seederv1alpha1.Cluster) error {
+	c := cObj.DeepCopy()
+	var drift []string
+	if c.Spec.ClusterTemplateName != "" {
+		t, err := util.GetClusterTemplate(ctx, r.Client, c)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		if err == nil {
+			drift = util.ClusterTemplateDrift(c, t)
+		}
+	}
+
+	if slices.Equal(c.Status.TemplateDrift, drift) {
+		return nil
+	}
+	c.Status.TemplateDrift = drift
+	return r.Status().Update(ctx, c)
+}
+
+// importToRancher imports running clusters into rancher. The cluster is created in rancher using the api token, and
+// the registration manifest url is applied to the cluster-registration-url setting, which deploys the rancher agent
+func (r *ClusterReconciler) importToRancher(ctx context.Context, cObj *seederv1alpha1.Cluster) error {
+	c := cObj.DeepCopy()
+	reg := c.Spec.ClusterConfig.RancherRegistration
+	if reg == nil || c.Status.Status != seederv1alpha1.ClusterRunning {
+		return nil
+	}
+
+	var clusterID string
+	if c.Status.RancherImport != nil {
+		if c.Status.RancherImport.Phase == seederv1alpha1.RancherImportActive {
+			return nil
+		}
+		clusterID = c.Status.RancherImport.ClusterID
+	}
+
+	clusterID, active, err := r.registerWithRancher(ctx, c, reg, clusterID)
+	if err != nil {
+		if err := r.updateRancherImportStatus(ctx, c, clusterID, seederv1alpha1.RancherImportFailed, err.Error()); err != nil {
+			return err
+		}
+		return &util.DeferredError{Reason: fmt.Sprintf("rancher import failed: %v", err), RequeueAfter: rancherImportInterval}
+	}
+
+	if active {
+		return r.updateRancherImportStatus(ctx, c, clusterID, seederv1alpha1.RancherImportActive, "")
+	}
+
+	msg := "waiting for rancher agent to connect"
+	if err := r.updateRancherImportStatus(ctx, c, clusterID, seederv1alpha1.RancherImportRegistering, msg); err != nil {
+		return err
+	}
+	return &util.DeferredError{Reason: msg, RequeueAfter: rancherImportInterval}
+}
+
+// registerWithRancher ensures the cluster exists in rancher and the registration manifest has been applied to the
+// target cluster, and returns the rancher cluster id and if the cluster is active in rancher
+func (r *ClusterReconciler) registerWithRancher(ctx context.Context, c *seederv1alpha1.Cluster, reg *seederv1alpha1.RancherRegistrationSpec, clusterID string) (string, bool, error) {
+	s, err := util.GetGrantedSecret(ctx, r.Client, seederv1alpha1.ReferenceKindCluster, c.Namespace, reg.TokenSecretRef)
+	if err != nil {
+		return clusterID, false, fmt.Errorf("error fetching rancher token secret: %w", err)
+	}
+	token, ok := s.Data[seederv1alpha1.RancherTokenKey]
+	if !ok || len(token) == 0 {
+		return clusterID, false, fmt.Errorf("rancher token secret %s/%s has no %s key", s.Namespace, s.Name, seederv1alpha1.RancherTokenKey)
+	}
+
+	rc := util.NewRancherClient(reg.URL, string(token), reg.InsecureSkipTLSVerify)
+	if clusterID == "" {
+		name := reg.ClusterName
+		if name == "" {
+			name = fmt.Sprintf("%s-%s", c.Namespace, c.Name)
+		}
+		clusterID, err = rc.EnsureImportedCluster(ctx, name)
+		if err != nil {
+			return clusterID, false, err
+		}
+	}
+
+	active, err := rc.ClusterActive(ctx, clusterID)
+	if err != nil || active {
+		return clusterID, active, err
+	}
+
+	manifestURL, err := rc.RegistrationManifestURL(ctx, clusterID)
+	if err != nil {
+		return clusterID, false, err
+	}
+	// rancher generates the manifest asynchronously after the registration token is created
+	if manifestURL == "" {
+		return clusterID, false, nil
+	}
+
+	restConfig, err := genRestConfig(c)
+	if err != nil {
+		return clusterID, false, err
+	}
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return clusterID, false, fmt.Errorf("error generating dynamic client for cluster %s: %w", c.Name, err)
+	}
+	if _, err := util.UpdateHarvesterSetting(ctx, dynamicClient, util.ClusterRegistrationURLSetting, manifestURL); err != nil {
+		return clusterID, false, err
+	}
+	return clusterID, false, nil
+}
+
+func (r *ClusterReconciler) updateRancherImportStatus(ctx context.Context, c *seederv1alpha1.Cluster, clusterID string, phase seederv1alpha1.RancherImportPhase, msg string) error {
+	status := seederv1alpha1.RancherImportStatus{
+		ClusterID: clusterID,
+		Phase:     phase,
+		Message:   msg,
+	}
+	if existing := c.Status.RancherImport; existing != nil {
+		status.LastUpdated = existing.LastUpdated
+		if *existing == status {
+			return nil
+		}
+	}
+	status.LastUpdated = time.Now().UTC().Format(time.RFC3339)
+	c.Status.RancherImport = &status
+	return r.Status().Update(ctx, c)
+}
+
+// spareSatisfiesTopology returns a filter accepting spares which do not introduce violations of the topology spread
+// constraints with the actions when replacing the failed node
+func spareSatisfiesTopology(c *seederv1alpha1.Cluster, nodes []*seederv1alpha1.Inventory, failed *seederv1alpha1.Inventory, actions ...seederv1alpha1.UnsatisfiableConstraintAction) func(*seederv1alpha1.Inventory) bool {
+	return func(spare *seederv1alpha1.Inventory) bool {
+		candidate := make([]*seederv1alpha1.Inventory, len(nodes))
+		for idx, v := range nodes {
+			candidate[idx] = v
+			if v.Name == failed.Name && v.Namespace == failed.Namespace {
+				candidate[idx] = spare
+			}
+		}
+		return len(util.NewTopologyViolations(c.Spec.TopologySpreadConstraints, nodes, candidate, actions...)) == 0
+	}
+}
+
+// decommissionInventory sanitizes an inventory before it is returned to the pool, and returns true once the inventory
+// has been sanitized or quarantined. Inventory is quarantined if the decommission fails or does not complete in time
+func (r *ClusterReconciler) decommissionInventory(ctx context.Context, c *seederv1alpha1.Cluster, i *seederv1alpha1.Inventory) (bool, error) {
+	mode := c.Spec.Decommission.Mode
+	if mode == "" || mode == seederv1alpha1.DecommissionModeNone {
+		return true, nil
+	}
+
+	if util.ConditionExists(i, seederv1alpha1.Sanitized) || util.ConditionExists(i, seederv1alpha1.Quarantined) {
+		return true, nil
+	}
+
+	// condition is set once, and the last updated time is used to track the decommission timeout
+	if !util.ConditionExists(i, seederv1alpha1.Decommissioning) {
+		r.Info("decommissioning inventory", "inventory", i.Name, "mode", mode)
+		util.CreateOrUpdateCondition(i, seederv1alpha1.Decommissioning, fmt.Sprintf("%s decommission in progress", mode))
+		return false, r.Status().Update(ctx, i)
+	}
+
+	timeout, err := time.ParseDuration(c.Spec.Decommission.Timeout)
+	if err != nil {
+		timeout = defaultDecommissionTimeout
+	}
+	started, err := time.Parse(time.RFC3339, seederv1alpha1.Decommissioning.GetLastUpdated(i))
+	if err == nil && time.Since(started) > timeout {
+		return true, r.quarantineInventory(ctx, i, fmt.Sprintf("decommission did not complete within %s", timeout))
+	}
+
+	switch mode {
+	case seederv1alpha1.DecommissionModeWorkflow:
+		state, err := r.runDecommissionWorkflow(ctx, c, i)
+		if err != nil {
+			return false, err
+		}
+		switch state {
+		case tinkv1alpha1.WorkflowStateSuccess:
+		case tinkv1alpha1.WorkflowStateFailed, tinkv1alpha1.WorkflowStateTimeout:
+			return true, r.quarantineInventory(ctx, i, fmt.Sprintf("workflow %s %s", tink.DecommissionName(i), state))
+		default:
+			return false, nil
+		}
+	case seederv1alpha1.DecommissionModeRedfish:
+		ef, err := newEventFetcher(ctx, r.Client, i)
+		if err != nil {
+			return false, fmt.Errorf("error connecting to redfish endpoint of inventory %s: %w", i.Name, err)
+		}
+		// the erase is requested once, and the drives are polled until the erase operations have completed
+		if !util.ConditionExists(i, seederv1alpha1.SecureEraseRequested) {
+			drives, err := ef.SecureEraseDrives()
+			ef.Close()
+			if err != nil {
+				return true, r.quarantineInventory(ctx, i, err.Error())
+			}
+			r.Info("requested secure erase of drives", "inventory", i.Name, "drives", drives)
+			util.CreateOrUpdateCondition(i, seederv1alpha1.SecureEraseRequested, fmt.Sprintf("secure erase requested on %d drives", drives))
+			return false, r.Status().Update(ctx, i)
+		}
+		pending, err := ef.SecureEraseProgress()
+		ef.Close()
+		if err != nil {
+			return true, r.quarantineInventory(ctx, i, err.Error())
+		}
+		if pending != 0 {
+			r.Info("waiting for secure erase of drives", "inventory", i.Name, "drives", pending)
+			return false, nil
+		}
+	}
+
+	if c.Spec.Decommission.ResetBIOS {
+		ef, err := newEventFetcher(ctx, r.Client, i)
+		if err != nil {
+			return false, fmt.Errorf("error connecting to redfish endpoint of inventory %s: %w", i.Name, err)
+		}
+		err = ef.ResetBIOS()
+		ef.Close()
+		if err != nil {
+			return true, r.quarantineInventory(ctx, i, err.Error())
+		}
+	}
+
+	if err := r.cleanupDecommissionWorkflow(ctx, i); err != nil {
+		return false, err
+	}
+	util.RemoveCondition(i, seederv1alpha1.Decommissioning)
+	util.RemoveCondition(i, seederv1alpha1.SecureEraseRequested)
+	util.CreateOrUpdateCondition(i, seederv1alpha1.Sanitized, fmt.Sprintf("sanitized by %s decommission", mode))
+	return true, r.Status().Update(ctx, i)
+}
+
+// runDecommissionWorkflow creates a tinkerbell workflow wiping the disks of the inventory, and pxe boots the machine
+// into the hook os to run it. The state of the workflow is returned
+func (r *ClusterReconciler) runDecommissionWorkflow(ctx context.Context, c *seederv1alpha1.Cluster, i *seederv1alpha1.Inventory) (tinkv1alpha1.WorkflowState, error) {
+	workflow := &tinkv1alpha1.Workflow{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: tink.DecommissionName(i)}, workflow)
+	if err == nil {
+		return workflow.Status.State, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return "", err
+	}
+
+	// wait for in flight power actions before rebooting the machine
+	if util.ConditionExists(i, seederv1alpha1.BMCJobSubmitted) {
+		return "", nil
+	}
+
+	hw := &tinkv1alpha1.Hardware{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, hw); err != nil {
+		return "", fmt.Errorf("error fetching hardware for inventory %s: %w", i.Name, err)
+	}
+	tink.PrepareHardwareForDecommission(hw)
+	if err := r.Update(ctx, hw); err != nil {
+		return "", fmt.Errorf("error preparing hardware %s for decommission: %w", hw.Name, err)
+	}
+
+	seederConfig := &corev1.ConfigMap{}
+	err = r.Get(ctx, types.NamespacedName{Name: seederv1alpha1.SeederConfig, Namespace: c.Namespace}, seederConfig)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return "", fmt.Errorf("error fetching configmap %s in ns %s: %v", seederv1alpha1.SeederConfig, c.Namespace, err)
+	}
+
+	template, err := tink.GenerateDecommissionTemplate(seederConfig, i)
+	if err != nil {
+		return "", err
+	}
+	if err := controllerutil.SetOwnerReference(c, template, r.Scheme); err != nil {
+		return "", err
+	}
+	if err := r.Create(ctx, template); err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", fmt.Errorf("error creating decommission template for inventory %s: %w", i.Name, err)
+	}
+
+	workflow = tink.GenerateDecommissionWorkflow(i)
+	if err := controllerutil.SetOwnerReference(c, workflow, r.Scheme); err != nil {
+		return "", err
+	}
+	if err := r.Create(ctx, workflow); err != nil {
+		return "", fmt.Errorf("error creating decommission workflow for inventory %s: %w", i.Name, err)
+	}
+
+	return "", r.triggerDecommissionReboot(ctx, i)
+}
+
+// triggerDecommissionReboot requests a pxe boot of the machine into the hook os
+func (r *ClusterReconciler) triggerDecommissionReboot(ctx context.Context, i *seederv1alpha1.Inventory) error {
+	iObj := i.DeepCopy()
+	i.Status.PowerAction.LastJobName = ""
+	if err := r.Status().Patch(ctx, i, client.MergeFrom(iObj)); err != nil {
+		return fmt.Errorf("error patching inventory status while triggering decommission reboot: %w", err)
+	}
+
+	if err := r.Get(ctx, types.NamespacedName{Name: iObj.Name, Namespace: iObj.Namespace}, i); err != nil {
+		return fmt.Errorf("error fetching inventory %s: %w", iObj.Name, err)
+	}
+	iObj = i.DeepCopy()
+	i.Spec.PowerActionRequested = seederv1alpha1.NodePowerActionReboot
+	return r.Patch(ctx, i, client.MergeFrom(iObj))
+}
+
+// cleanupDecommissionWorkflow removes the decommission workflow and template of the inventory
+func (r *ClusterReconciler) cleanupDecommissionWorkflow(ctx context.Context, i *seederv1alpha1.Inventory) error {
+	objs := []client.Object{&tinkv1alpha1.Workflow{}, &tinkv1alpha1.Template{}}
+	for _, obj := range objs {
+		obj.SetName(tink.DecommissionName(i))
+		obj.SetNamespace(i.Namespace)
+		if err := r.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error deleting decommission objects for inventory %s: %w", i.Name, err)
+		}
+	}
+	return nil
+}
+
+// quarantineInventory marks an inventory which could not be sanitized as quarantined. Quarantined inventory is not
+// allocated to clusters until it is released with the release quarantine annotation
+func (r *ClusterReconciler) quarantineInventory(ctx context.Context, i *seederv1alpha1.Inventory, msg string) error {
+	r.Info("quarantining inventory after failed decommission", "inventory", i.Name, "reason", msg)
+	if err := r.cleanupDecommissionWorkflow(ctx, i); err != nil {
+		return err
+	}
+	util.RemoveCondition(i, seederv1alpha1.Decommissioning)
+	util.RemoveCondition(i, seederv1alpha1.SecureEraseRequested)
+	reason := fmt.Sprintf("decommission failed: %s", msg)
+	// failed hardware, such as a node replaced by a spare, is likely to be the cause of the failed decommission
+	if util.ConditionExists(i, seederv1alpha1.HardwareHealthCritical) {
+		reason = fmt.Sprintf("%s, redfish reports critical system health", reason)
+	}
+	util.QuarantineInventory(i, seederv1alpha1.QuarantineSourceDecommission, reason, "", "")
+	return r.Status().Update(ctx, i)
+}
+
 // cleanupClusterDeps will trigger cleanup of nodes and associated infra
 func (r *ClusterReconciler) cleanupClusterDeps(ctx context.Context, cObj *seederv1alpha1.Cluster) error {
 	r.Info("cleaning up cluster components", "cluster", cObj.Name)
 	c := cObj.DeepCopy()
+	// nodes are decommissioned in parallel, and none are powered off and freed until all of them are sanitized
+	var decommissioning []string
+	for _, nc := range c.Spec.Nodes {
+		i := &seederv1alpha1.Inventory{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: nc.InventoryReference.Namespace,
+			Name: nc.InventoryReference.Name}, i)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		if i.Status.Cluster.Name != c.Name || i.Status.Cluster.Namespace != c.Namespace {
+			continue
+		}
+
+		done, err := r.decommissionInventory(ctx, c, i)
+		if err != nil {
+			return err
+		}
+		if !done {
+			decommissioning = append(decommissioning, i.Name)
+		}
+	}
+
+	if len(decommissioning) != 0 {
+		return fmt.Errorf("waiting for inventory %s to be decommissioned", strings.Join(decommissioning, ", "))
+	}
+
 	// clean up nodes
 	for _, nc := range c.Spec.Nodes {
 		var poolmissing, inventorymissing bool
@@ -558,10 +1355,13 @@ func (r *ClusterReconciler) cleanupClusterDeps(ctx context.Context, cObj *seeder
 			iObj.Status.Cluster = seederv1alpha1.ObjectReference{}
 			iObj.Status.GeneratedPassword = ""
 			iObj.Status.PowerAction.LastJobName = ""
+			iObj.Status.Hostname = ""
 			util.RemoveCondition(iObj, seederv1alpha1.InventoryAllocatedToCluster)
 			util.RemoveCondition(iObj, seederv1alpha1.HarvesterJoinNode)
 			util.RemoveCondition(iObj, seederv1alpha1.HarvesterCreateNode)
 			util.RemoveCondition(iObj, seederv1alpha1.ClusterCleanupSubmitted)
+			util.RemoveCondition(iObj, seederv1alpha1.HarvesterInstalling)
+			util.RemoveCondition(iObj, seederv1alpha1.HarvesterJoinAdmitted)
 			util.CreateOrUpdateCondition(i, seederv1alpha1.InventoryFreed, "")
 			err = r.Status().Update(ctx, iObj)
 
@@ -571,7 +1371,13 @@ func (r *ClusterReconciler) cleanupClusterDeps(ctx context.Context, cObj *seeder
 		}
 
 		if !poolmissing {
-			delete(pool.Status.AddressAllocation, i.Status.Address)
+			provider, err := util.NewIPAMProvider(ctx, r.Client, pool)
+			if err != nil {
+				return err
+			}
+			if err := provider.Release(ctx, pool, i.Status.Address); err != nil {
+				return fmt.Errorf("error releasing address of inventory %s: %w", i.Name, err)
+			}
 			if err := r.lockedAddressPoolUpdate(ctx, pool); err != nil {
 				return err
 			}
@@ -592,7 +1398,13 @@ func (r *ClusterReconciler) cleanupClusterDeps(ctx context.Context, cObj *seeder
 		}
 
 		if !poolNotFound {
-			delete(pool.Status.AddressAllocation, c.Status.ClusterAddress)
+			provider, err := util.NewIPAMProvider(ctx, r.Client, pool)
+			if err != nil {
+				return err
+			}
+			if err := provider.Release(ctx, pool, c.Status.ClusterAddress); err != nil {
+				return fmt.Errorf("error releasing cluster address: %w", err)
+			}
 			if err := r.lockedAddressPoolUpdate(ctx, pool); err != nil {
 				return err
 			}
@@ -607,6 +1419,129 @@ func (r *ClusterReconciler) cleanupClusterDeps(ctx context.Context, cObj *seeder
 	return nil
 }
 
+// admitJoinNodes holds join nodes until the create node API answers, to avoid join nodes timing out against
+// a cluster which is not yet up. Clusters without a create node, such as clusters whose create node has been
+// replaced, probe the cluster VIP instead. Join nodes are then admitted in batches of JoinBatchSize, and each batch waits
+// for the previous batch to complete installation. Admitted nodes are rebooted by the inventory controller
+func (r *ClusterReconciler) admitJoinNodes(ctx context.Context, cObj *seederv1alpha1.Cluster) error {
+	c := cObj.DeepCopy()
+	if c.Status.Status != seederv1alpha1.ClusterTinkHardwareSubmitted && c.Status.Status != seederv1alpha1.ClusterRunning {
+		return nil
+	}
+
+	var createNode *seederv1alpha1.Inventory
+	var pending, admitted []*seederv1alpha1.Inventory
+	for _, n := range c.Spec.Nodes {
+		i := &seederv1alpha1.Inventory{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: n.InventoryReference.Namespace, Name: n.InventoryReference.Name}, i); err != nil {
+			return err
+		}
+
+		if i.Status.Cluster.Name != c.Name || i.Status.Cluster.Namespace != c.Namespace {
+			continue
+		}
+
+		switch {
+		case util.ConditionExists(i, seederv1alpha1.HarvesterCreateNode):
+			createNode = i
+		case !util.ConditionExists(i, seederv1alpha1.HarvesterJoinNode):
+			continue
+		case util.ConditionExists(i, seederv1alpha1.HarvesterJoinAdmitted):
+			admitted = append(admitted, i)
+		case i.Status.PowerAction.LastJobName != "":
+			// node was provisioned before join nodes were admitted by the cluster controller
+			continue
+		default:
+			pending = append(pending, i)
+		}
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	// the api of the create node, or of the cluster VIP when there is no create node
+	apiName, apiAddress := "vip", c.Status.ClusterAddress
+	if createNode != nil {
+		apiName, apiAddress = fmt.Sprintf("create node %s", createNode.Name), createNode.Status.Address
+	}
+
+	if !c.Status.CreateNodeReady {
+		if apiAddress == "" {
+			return &util.DeferredError{
+				Reason:       fmt.Sprintf("waiting for create node to be allocated to cluster %s", c.Name),
+				RequeueAfter: createNodeProbeInterval,
+			}
+		}
+
+		if err := probeClusterAPI(c, apiAddress); err != nil {
+			return &util.DeferredError{
+				Reason:       fmt.Sprintf("waiting for %s api to be available: %v", apiName, err),
+				RequeueAfter: createNodeProbeInterval,
+			}
+		}
+
+		r.Info("cluster api is available, admitting join nodes", "cluster", c.Name, "api", apiName)
+		c.Status.CreateNodeReady = true
+		return r.Status().Update(ctx, c)
+	}
+
+	// wait for previous batch to be rebooted and complete installation
+	for _, i := range admitted {
+		if i.Status.PowerAction.LastJobName == "" {
+			return nil
+		}
+		if !util.ConditionExists(i, seederv1alpha1.HarvesterInstalling) {
+			continue
+		}
+		completed, err := r.installCompleted(ctx, i)
+		if err != nil {
+			return err
+		}
+		if !completed {
+			return nil
+		}
+	}
+
+	batchSize := c.Spec.JoinBatchSize
+	if batchSize <= 0 || batchSize > len(pending) {
+		batchSize = len(pending)
+	}
+
+	for _, i := range pending[:batchSize] {
+		util.CreateOrUpdateCondition(i, seederv1alpha1.HarvesterJoinAdmitted, fmt.Sprintf("%s is available", apiName))
+		if err := r.Status().Update(ctx, i); err != nil {
+			return fmt.Errorf("error admitting join node %s: %w", i.Name, err)
+		}
+	}
+	return nil
+}
+
+// installCompleted returns true if the installer has reported completion on the hardware object of the inventory
+func (r *ClusterReconciler) installCompleted(ctx context.Context, i *seederv1alpha1.Inventory) (bool, error) {
+	return inventoryInstallCompleted(ctx, r.Client, i)
+}
+
+// inventoryInstallCompleted checks if the hardware of the inventory has been marked as installed
+func inventoryInstallCompleted(ctx context.Context, c client.Client, i *seederv1alpha1.Inventory) (bool, error) {
+	hw := &tinkv1alpha1.Hardware{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, hw); err != nil {
+		return false, fmt.Errorf("error fetching hardware for inventory %s: %w", i.Name, err)
+	}
+	_, ok := hw.Annotations[seederv1alpha1.InstallCompletedAnnotation]
+	return ok, nil
+}
+
+// probeClusterAPI checks if the cluster api is available at the address by generating a kubeconfig from its bootstrap endpoint
+func probeClusterAPI(c *seederv1alpha1.Cluster, address string) error {
+	port, ok := c.Labels[seederv1alpha1.OverrideAPIPortLabel]
+	if !ok {
+		port = seederv1alpha1.DefaultAPIPort
+	}
+	_, err := util.GenerateKubeConfig(address, port, seederv1alpha1.DefaultAPIPrefix, c.Status.ClusterToken)
+	return err
+}
+
 // markClusterReady will use the cluster endpoint and token to try and generate a kubeconfig for target cluster
 // and will mark cluster running when the kubeconfig can be generated
 func (r *ClusterReconciler) markClusterReady(ctx context.Context, cObj *seederv1alpha1.Cluster) error {
@@ -652,11 +1587,55 @@ func (r *ClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
 				}
 			}
 			return reconRequest
+		})).
+		// inventory health changes trigger replacement of failed nodes
+		Watches(&seederv1alpha1.Inventory{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+			i, ok := a.(*seederv1alpha1.Inventory)
+			if !ok || i.Status.Cluster.Name == "" {
+				return nil
+			}
+			return []reconcile.Request{
+				{
+					NamespacedName: types.NamespacedName{
+						Namespace: i.Status.Cluster.Namespace,
+						Name:      i.Status.Cluster.Name,
+					},
+				},
+			}
+		})).
+		// template changes are reported as drift on the clusters using the template
+		Watches(&seederv1alpha1.ClusterTemplate{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+			clusterList := &seederv1alpha1.ClusterList{}
+			if err := r.List(ctx, clusterList, client.InNamespace(a.GetNamespace())); err != nil {
+				r.Error(err, "error listing clusters for cluster template", "template", a.GetName())
+				return nil
+			}
+			var reconRequest []reconcile.Request
+			for _, c := range clusterList.Items {
+				if c.Spec.ClusterTemplateName == a.GetName() {
+					reconRequest = append(reconRequest, reconcile.Request{
+						NamespacedName: types.NamespacedName{
+							Namespace: c.Namespace,
+							Name:      c.Name,
+						},
+					})
+				}
+			}
+			return reconRequest
 		})).Named("cluster").
 		Complete(r)
 }
 
 func genCoreTypedClient(ctx context.Context, c *seederv1alpha1.Cluster) (*typedCore.CoreV1Client, error) {
+	restConfig, err := genRestConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	return typedCore.NewForConfig(restConfig)
+}
+
+// genRestConfig generates a rest config for the target cluster
+func genRestConfig(c *seederv1alpha1.Cluster) (*rest.Config, error) {
 	port, ok := c.Labels[seederv1alpha1.OverrideAPIPortLabel]
 	if !ok {
 		port = seederv1alpha1.DefaultAPIPort
@@ -692,7 +1671,7 @@ func genCoreTypedClient(ctx context.Context, c *seederv1alpha1.Cluster) (*typedC
 		}
 	}
 
-	return typedCore.NewForConfig(restConfig)
+	return restConfig, nil
 }
 
 func createOrUpdateInventoryConditions(ctx context.Context, inventory *seederv1alpha1.Inventory, cond condition.Cond, msg string, client client.Client) error {
@@ -586,6 +586,20 @@ var _ = Describe("add inventory to cluster tests", func() {
 			fmt.Println(iObj.Status.Conditions)
 			return fmt.Errorf("waiting for inventory to be allocated to cluster")
 		}, "30s", "5s").ShouldNot(HaveOccurred())
+
+		// join node is held until the create node api is available
+		Consistently(func() error {
+			iObj := &seederv1alpha1.Inventory{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Namespace: i2.Namespace, Name: i2.Name}, iObj)
+			if err != nil {
+				return err
+			}
+
+			if util.ConditionExists(iObj, seederv1alpha1.HarvesterJoinAdmitted) || iObj.Status.PowerAction.LastJobName != "" {
+				return fmt.Errorf("expected join node to not be rebooted before create node api is available")
+			}
+			return nil
+		}, "15s", "5s").ShouldNot(HaveOccurred())
 	})
 
 	AfterEach(func() {
@@ -10,7 +10,6 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 	typedCore "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -18,6 +17,7 @@ import (
 
 	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
 	"github.com/harvester/seeder/pkg/events"
+	"github.com/harvester/seeder/pkg/util"
 )
 
 type ClusterEventReconciler struct {
@@ -66,92 +66,85 @@ func (r *ClusterEventReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	return ctrl.Result{RequeueAfter: 15 * time.Minute}, nil
 }
 
+// updateNodes publishes inventory metadata as labels and annotations on the harvester nodes, and records redfish
+// health events on nodes whose inventory has event collection enabled. Nodes are associated with inventory using the
+// address allocated to the inventory by the cluster
 func (r *ClusterEventReconciler) updateNodes(ctx context.Context, c *seederv1alpha1.Cluster) error {
 	typedClient, err := genCoreTypedClient(ctx, c)
 	if err != nil {
 		return err
 	}
 
-	inventoryList, err := r.identifyInventory(ctx, c)
+	inventoryList, err := util.ClusterNodeInventory(ctx, r.Client, c)
 	if err != nil {
 		return err
 	}
 
-	if len(inventoryList) == 0 {
-		// no nodes have event collection enabled. nothing to do
-		return nil
-	}
-
 	nodeList, err := typedClient.Nodes().List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return err
 	}
 
-	// associate k8s node with inventory using the address allocated to inventory by cluster
-	// this should make it easy to uniquely identify nodes in the cluster
 	for _, i := range inventoryList {
 		node := findNodeByIP(nodeList.Items, i.Status.Address)
-		if node != nil {
-			s := &corev1.Secret{}
-			err := r.Get(ctx, types.NamespacedName{Namespace: i.Spec.BaseboardManagementSpec.Connection.AuthSecretRef.Namespace,
-				Name: i.Spec.BaseboardManagementSpec.Connection.AuthSecretRef.Name}, s)
-			if err != nil {
-				return err
-			}
-			username := s.Data["username"]
-			password := s.Data["password"]
-			bmcendpoint := fmt.Sprintf("https://%s", i.Spec.BaseboardManagementSpec.Connection.Host)
-			if port, ok := i.Labels[seederv1alpha1.OverrideRedfishPortLabel]; ok {
-				bmcendpoint = fmt.Sprintf("https://%s:%s", i.Spec.BaseboardManagementSpec.Connection.Host, port)
-			}
-			e, err := events.NewEventFetcher(ctx, string(username), string(password), bmcendpoint)
-			if err != nil {
-				return err
-			}
-			defer e.Close()
-			labels, status, err := e.GetConfig()
+		if node == nil {
+			continue
+		}
+
+		var status []string
+		if i.Spec.Enabled {
+			var labels map[string]string
+			labels, status, err = r.fetchRedfishConfig(ctx, i)
 			if err != nil {
 				return err
 			}
-
-			if node.Labels == nil {
-				node.Labels = make(map[string]string)
+			// labels queried via redfish are used as the source of the manufacturer, model and serial number
+			if i.Labels == nil {
+				i.Labels = make(map[string]string)
 			}
 			for k, v := range labels {
-				node.Labels[k] = v
+				i.Labels[k] = v
 			}
+		}
 
-			updatedNode, err := typedClient.Nodes().Update(ctx, node, metav1.UpdateOptions{})
+		labels, annotations := util.NodeMetadata(i, c.Spec.NodeMetadata)
+		if util.SyncNodeMetadata(node, labels, annotations) {
+			node, err = typedClient.Nodes().Update(ctx, node, metav1.UpdateOptions{})
 			if err != nil {
 				return err
 			}
+		}
 
-			recorder := remoteEventRecorder(typedClient, r.Scheme)
-			for _, v := range status {
-				update := "Warning"
-				recorder.Event(updatedNode, update, seederv1alpha1.EventLoggerName, v)
-			}
+		if len(status) == 0 {
+			continue
+		}
+		recorder := remoteEventRecorder(typedClient, r.Scheme)
+		for _, v := range status {
+			update := "Warning"
+			recorder.Event(node, update, seederv1alpha1.EventLoggerName, v)
 		}
 	}
 	return nil
 }
 
-func (r *ClusterEventReconciler) identifyInventory(ctx context.Context, c *seederv1alpha1.Cluster) ([]*seederv1alpha1.Inventory, error) {
-	var retNodes []*seederv1alpha1.Inventory
-	// identify nodes for which event collection is enabled
-	for _, v := range c.Spec.Nodes {
-		nodeObj := &seederv1alpha1.Inventory{}
-		err := r.Get(ctx, types.NamespacedName{Namespace: v.InventoryReference.Namespace, Name: v.InventoryReference.Name}, nodeObj)
-		if err != nil {
-			return nil, err
-		}
-		if nodeObj.Spec.Enabled {
-			retNodes = append(retNodes, nodeObj)
-		}
+// fetchRedfishConfig queries the labels and health of the inventory via redfish
+func (r *ClusterEventReconciler) fetchRedfishConfig(ctx context.Context, i *seederv1alpha1.Inventory) (map[string]string, []string, error) {
+	s, err := util.GetGrantedSecret(ctx, r.Client, seederv1alpha1.ReferenceKindInventory, i.Namespace, i.Spec.BaseboardManagementSpec.Connection.AuthSecretRef)
+	if err != nil {
+		return nil, nil, err
 	}
-
-	return retNodes, nil
-
+	username := s.Data["username"]
+	password := s.Data["password"]
+	bmcendpoint := fmt.Sprintf("https://%s", i.Spec.BaseboardManagementSpec.Connection.Host)
+	if port, ok := i.Labels[seederv1alpha1.OverrideRedfishPortLabel]; ok {
+		bmcendpoint = fmt.Sprintf("https://%s:%s", i.Spec.BaseboardManagementSpec.Connection.Host, port)
+	}
+	e, err := events.NewEventFetcher(ctx, string(username), string(password), bmcendpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer e.Close()
+	return e.GetConfig()
 }
 
 func findNodeByIP(nodeList []corev1.Node, address string) *corev1.Node {
@@ -0,0 +1,136 @@
+package controllers
+
+import (
+	"context"
+	"reflect"
+	"sort"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/util"
+)
+
+// InventoryClassReconciler reports the inventories of a class, and their allocation to clusters in each namespace
+type InventoryClassReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	logr.Logger
+}
+
+func (r *InventoryClassReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	r.Info("Reconciling inventory class", "name", req.Name)
+	class := &seederv1alpha1.InventoryClass{}
+	err := r.Get(ctx, req.NamespacedName, class)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		r.Error(err, "unable to fetch inventory class object")
+		return ctrl.Result{}, err
+	}
+
+	if !class.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	return ctrl.Result{}, r.updateStatus(ctx, class)
+}
+
+func (r *InventoryClassReconciler) updateStatus(ctx context.Context, class *seederv1alpha1.InventoryClass) error {
+	items, err := util.ListInventory(ctx, r.Client)
+	if err != nil {
+		return err
+	}
+
+	clusterList := &seederv1alpha1.ClusterList{}
+	if err := r.List(ctx, clusterList); err != nil {
+		return err
+	}
+
+	status := seederv1alpha1.InventoryClassStatus{}
+	members := make(map[types.NamespacedName]bool)
+	for _, i := range items {
+		if i.Spec.InventoryClassName != class.Name {
+			continue
+		}
+		members[types.NamespacedName{Namespace: i.Namespace, Name: i.Name}] = true
+		status.Inventories++
+
+		if i.Status.Status == seederv1alpha1.InventoryReady && i.Status.Cluster.Name == "" && i.DeletionTimestamp.IsZero() &&
+			!util.ConditionExists(&i, seederv1alpha1.Quarantined) && util.InventoryAccepted(&i) {
+			status.Available++
+		}
+
+		if util.ConditionExists(&i, seederv1alpha1.HardwareDiscovered) && !util.AcceptancePassed(util.CheckHardwareRequirements(&i, class.Spec.Requirements)) {
+			status.NonConforming = append(status.NonConforming, seederv1alpha1.ObjectReference{Name: i.Name, Namespace: i.Namespace})
+		}
+	}
+
+	allocated := make(map[string]int)
+	for _, q := range class.Spec.Quotas {
+		allocated[q.Namespace] = 0
+	}
+	for _, c := range clusterList.Items {
+		for _, n := range c.Spec.Nodes {
+			if members[types.NamespacedName{Namespace: n.InventoryReference.Namespace, Name: n.InventoryReference.Name}] {
+				allocated[c.Namespace]++
+			}
+		}
+	}
+
+	for namespace, count := range allocated {
+		usage := seederv1alpha1.NamespaceUsage{
+			Namespace: namespace,
+			Allocated: count,
+		}
+		if limit, ok := util.InventoryClassQuota(class, namespace); ok {
+			usage.Limit = &limit
+		}
+		status.Usage = append(status.Usage, usage)
+	}
+	sort.Slice(status.Usage, func(i, j int) bool {
+		return status.Usage[i].Namespace < status.Usage[j].Namespace
+	})
+
+	if reflect.DeepEqual(class.Status, status) {
+		return nil
+	}
+
+	classObj := class.DeepCopy()
+	classObj.Status = status
+	return r.Status().Update(ctx, classObj)
+}
+
+// enqueueAllClasses reconciles every inventory class, as changes to inventory or clusters can move usage between
+// classes and namespaces
+func (r *InventoryClassReconciler) enqueueAllClasses(ctx context.Context, _ client.Object) []reconcile.Request {
+	classList := &seederv1alpha1.InventoryClassList{}
+	if err := r.List(ctx, classList); err != nil {
+		r.Error(err, "unable to list inventory classes")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, v := range classList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: v.Name}})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *InventoryClassReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&seederv1alpha1.InventoryClass{}).
+		Watches(&seederv1alpha1.Inventory{}, handler.EnqueueRequestsFromMapFunc(r.enqueueAllClasses)).
+		Watches(&seederv1alpha1.Cluster{}, handler.EnqueueRequestsFromMapFunc(r.enqueueAllClasses)).
+		Named("inventoryclass").
+		Complete(r)
+}
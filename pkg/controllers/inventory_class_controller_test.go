@@ -0,0 +1,95 @@
+package controllers
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	rufio "github.com/tinkerbell/rufio/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+var _ = Describe("Inventory class status", func() {
+	var class *seederv1alpha1.InventoryClass
+	var i *seederv1alpha1.Inventory
+
+	BeforeEach(func() {
+		class = &seederv1alpha1.InventoryClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "class-test",
+			},
+			Spec: seederv1alpha1.InventoryClassSpec{
+				Quotas: []seederv1alpha1.NamespaceQuota{
+					{
+						Namespace: "default",
+						Limit:     2,
+					},
+				},
+			},
+		}
+
+		i = &seederv1alpha1.Inventory{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "class-member",
+				Namespace: "default",
+			},
+			Spec: seederv1alpha1.InventorySpec{
+				PrimaryDisk:                   "/dev/sda",
+				ManagementInterfaceMacAddress: "xx:xx:xx:xx:xx",
+				InventoryClassName:            class.Name,
+				BaseboardManagementSpec: rufio.MachineSpec{
+					Connection: rufio.Connection{
+						Host:        "localhost",
+						Port:        623,
+						InsecureTLS: true,
+						AuthSecretRef: corev1.SecretReference{
+							Name:      "class-member",
+							Namespace: "default",
+						},
+					},
+				},
+			},
+		}
+
+		Eventually(func() error {
+			if err := k8sClient.Create(ctx, class); err != nil {
+				return err
+			}
+			return k8sClient.Create(ctx, i)
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+	})
+
+	It("reports inventories and namespace usage of the class", func() {
+		Eventually(func() error {
+			classObj := &seederv1alpha1.InventoryClass{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Name: class.Name}, classObj); err != nil {
+				return err
+			}
+			if classObj.Status.Inventories != 1 {
+				return fmt.Errorf("expected 1 inventory in class, found %d", classObj.Status.Inventories)
+			}
+			if len(classObj.Status.Usage) != 1 {
+				return fmt.Errorf("expected usage for the quota namespace, found %v", classObj.Status.Usage)
+			}
+			usage := classObj.Status.Usage[0]
+			if usage.Namespace != "default" || usage.Allocated != 0 || usage.Limit == nil || *usage.Limit != 2 {
+				return fmt.Errorf("unexpected usage %v", usage)
+			}
+			return nil
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Eventually(func() error {
+			return k8sClient.Delete(ctx, i)
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+
+		Eventually(func() error {
+			return k8sClient.Delete(ctx, class)
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+	})
+})
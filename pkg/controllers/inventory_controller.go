@@ -20,14 +20,20 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
+	"github.com/stmcginnis/gofish/redfish"
 	rufio "github.com/tinkerbell/rufio/api/v1alpha1"
+	tinkv1alpha1 "github.com/tinkerbell/tink/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -35,6 +41,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/tink"
 	"github.com/harvester/seeder/pkg/util"
 )
 
@@ -43,10 +50,19 @@ type InventoryReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
 	logr.Logger
+	record.EventRecorder
+	Scheduler *util.Scheduler
 }
 
 type inventoryReconciler func(context.Context, *seederv1alpha1.Inventory) error
 
+const (
+	powerActionRecordGracePeriod = time.Minute
+	defaultSoftShutdownTimeout   = 5 * time.Minute
+	defaultBurnInTimeout         = 4 * time.Hour
+	burnInInterval               = time.Minute
+)
+
 //+kubebuilder:rbac:groups=metal.harvesterhci.io,resources=inventories,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=metal.harvesterhci.io,resources=inventories/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=metal.harvesterhci.io,resources=inventories/finalizers,verbs=update
@@ -84,13 +100,19 @@ func (r *InventoryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		r.checkAndMarkNodeReady,
 		r.handleBaseboardDeletion,
 		r.reconcileBMCJob,
+		r.reconcileInstallation,
+		r.reconcilePowerActionHistory,
 		r.housekeepingBMCJob,
 		r.hasMachineSpecChanged,
 		r.reconcileMachinePowerState,
+		r.enforceDesiredPowerState,
+		r.releaseQuarantine,
+		r.reconcileMaintenance,
+		r.retryAcceptance,
 	}
 	// if inventory object has LocalInventoryAnnotation then skip reconcile as this will be handled by the local_cluster_controller
 	if _, ok := inventoryObj.Annotations[seederv1alpha1.LocalInventoryAnnotation]; !ok {
-		reconcileList = append(reconcileList, r.triggerReboot, r.inventoryFreed)
+		reconcileList = append(reconcileList, r.triggerReboot, r.inventoryFreed, r.reconcileAcceptance)
 	}
 
 	deletionReconcileList := []inventoryReconciler{
@@ -99,6 +121,11 @@ func (r *InventoryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	if inventoryObj.DeletionTimestamp.IsZero() {
 		for _, reconciler := range reconcileList {
 			if err := reconciler(ctx, inventoryObj); err != nil {
+				// jobs deferred by the scheduler are retried once capacity is expected to be available
+				if requeueAfter, ok := util.IsDeferred(err); ok {
+					r.Info("operation deferred", "inventory", inventoryObj.Name, "reason", err.Error())
+					return ctrl.Result{RequeueAfter: requeueAfter}, nil
+				}
 				return ctrl.Result{}, err
 			}
 		}
@@ -110,7 +137,13 @@ func (r *InventoryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		}
 	}
 
-	return ctrl.Result{}, nil
+	// drift is checked again once the power state enforcement cooldown has passed
+	requeueAfter := util.PowerEnforcementCooldownRemaining(inventoryObj, time.Now())
+	// maintenance is released once it expires
+	if remaining := util.MaintenanceRemaining(inventoryObj, time.Now()); remaining > 0 && (requeueAfter == 0 || remaining < requeueAfter) {
+		requeueAfter = remaining
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 // manageBMCObject checks if an associated BaseboardManagement Object exists else creates one
@@ -122,7 +155,14 @@ func (r *InventoryReconciler) manageBaseboardObject(ctx context.Context, iObj *s
 	if util.ConditionExists(i, seederv1alpha1.BMCObjectCreated) {
 		return nil
 	}
-	err := util.CheckSecretExists(ctx, r.Client, r.Logger, i.Spec.BaseboardManagementSpec.Connection.AuthSecretRef)
+	secretRef := i.Spec.BaseboardManagementSpec.Connection.AuthSecretRef
+	err := util.CheckReferenceGranted(ctx, r.Client, seederv1alpha1.ReferenceKindInventory, i.Namespace, seederv1alpha1.ReferenceKindSecret,
+		types.NamespacedName{Namespace: secretRef.Namespace, Name: secretRef.Name})
+	if err != nil {
+		return err
+	}
+
+	err = util.CheckSecretExists(ctx, r.Client, r.Logger, secretRef)
 	if err != nil {
 		return err
 	}
@@ -232,6 +272,13 @@ func (r *InventoryReconciler) handleInventoryDeletion(ctx context.Context, iObj
 			}
 		}
 
+		// burn in objects are owned by the inventory, but the address allocated for the burn in needs to be released
+		if util.ConditionExists(i, seederv1alpha1.BurnInRunning) {
+			if err := r.releaseBurnInAddress(ctx, i); err != nil {
+				return err
+			}
+		}
+
 		if controllerutil.ContainsFinalizer(i, seederv1alpha1.InventoryFinalizer) {
 			controllerutil.RemoveFinalizer(i, seederv1alpha1.InventoryFinalizer)
 			return r.Update(ctx, i)
@@ -253,6 +300,41 @@ func (r *InventoryReconciler) SetupWithManager(mgr ctrl.Manager) error {
 				},
 			},
 			}
+		})).
+		Watches(&rufio.Job{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+			name, ok := a.GetLabels()[util.InventoryJobLabel]
+			if !ok {
+				return nil
+			}
+			return []reconcile.Request{{
+				NamespacedName: types.NamespacedName{
+					Namespace: a.GetNamespace(),
+					Name:      name,
+				},
+			},
+			}
+		})).
+		Watches(&tinkv1alpha1.Hardware{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+			return []reconcile.Request{{
+				NamespacedName: types.NamespacedName{
+					Namespace: a.GetNamespace(),
+					Name:      a.GetName(),
+				},
+			},
+			}
+		})).
+		Watches(&tinkv1alpha1.Workflow{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+			name, ok := a.GetLabels()[seederv1alpha1.BurnInWorkflowLabel]
+			if !ok {
+				return nil
+			}
+			return []reconcile.Request{{
+				NamespacedName: types.NamespacedName{
+					Namespace: a.GetNamespace(),
+					Name:      name,
+				},
+			},
+			}
 		})).Named("inventory").
 		Complete(r)
 }
@@ -264,17 +346,77 @@ func (r *InventoryReconciler) triggerReboot(ctx context.Context, iObj *seederv1a
 	i := iObj.DeepCopy()
 	// TODO: Change it back to check seederv1alpha1.TinkWorkflowCreated exists since this will be a valid condition after move to
 	// workflow based processing
-	if i.Status.Status == seederv1alpha1.InventoryReady && util.ConditionExists(i, seederv1alpha1.TinkHardwareCreated) && util.ConditionExists(i, seederv1alpha1.InventoryAllocatedToCluster) && !util.ConditionExists(i, seederv1alpha1.BMCJobSubmitted) && i.Status.PowerAction.LastJobName == "" && !util.ConditionExists(i, seederv1alpha1.ClusterCleanupSubmitted) {
-		// submit BMC task
-		j := util.GenerateJob(i.Name, i.Namespace, "reboot")
+	if i.Status.Status == seederv1alpha1.InventoryReady && util.ConditionExists(i, seederv1alpha1.TinkHardwareCreated) && util.ConditionExists(i, seederv1alpha1.InventoryAllocatedToCluster) && !util.ConditionExists(i, seederv1alpha1.BMCJobSubmitted) && i.Status.PowerAction.LastJobName == "" && !util.ConditionExists(i, seederv1alpha1.ClusterCleanupSubmitted) && !util.ConditionExists(i, seederv1alpha1.Decommissioning) {
+		// join nodes are held by the cluster controller until the create node api is available
+		if util.ConditionExists(i, seederv1alpha1.HarvesterJoinNode) && !util.ConditionExists(i, seederv1alpha1.HarvesterJoinAdmitted) {
+			return nil
+		}
+
+		hw := &tinkv1alpha1.Hardware{}
+		if err := r.Get(ctx, types.NamespacedName{Name: i.Name, Namespace: i.Namespace}, hw); err != nil {
+			return fmt.Errorf("error fetching hardware for inventory %s: %v", i.Name, err)
+		}
+
+		c := &seederv1alpha1.Cluster{}
+		if err := r.Get(ctx, types.NamespacedName{Name: i.Status.Cluster.Name, Namespace: i.Status.Cluster.Namespace}, c); err != nil {
+			return fmt.Errorf("error fetching cluster for inventory %s: %v", i.Name, err)
+		}
+
+		// limit the number of nodes installing at once in the cluster
+		if r.Scheduler != nil {
+			if err := r.Scheduler.AdmitInstall(ctx, c, i); err != nil {
+				return err
+			}
+		}
+
+		// submit BMC task, hardware generated for virtual media provisioning mode contains the iso to be mounted
+		action := seederv1alpha1.NodePowerActionReboot
+		j := util.GenerateJob(i.Name, i.Namespace, action)
+		if isoURL, ok := hw.Annotations[seederv1alpha1.VirtualMediaURLAnnotation]; ok {
+			action = seederv1alpha1.NodePowerActionVirtualMediaBoot
+			j = util.GenerateVirtualMediaJob(i.Name, i.Namespace, isoURL)
+		}
 		if err := r.jobWrapper(ctx, i, j); err != nil {
 			return err
 		}
+		// the installation slot is only held once the job is created, as jobs may be deferred by the scheduler
+		if r.Scheduler != nil {
+			r.Scheduler.ReserveInstall(c, i)
+		}
 		util.CreateOrUpdateCondition(i, seederv1alpha1.BMCJobSubmitted, "BMCJob Submitted")
+		util.CreateOrUpdateCondition(i, seederv1alpha1.HarvesterInstalling, "")
 		i.Status.PowerAction.LastJobName = j.Name
+		util.RecordPowerAction(i, j, action, seederv1alpha1.PowerActionRequesterController, "provisioning")
+		return r.Status().Update(ctx, i)
+	}
+
+	return nil
+}
+
+// reconcileInstallation removes the HarvesterInstalling condition once the installer has reported completion
+// on the hardware object, releasing the installation slot for the next node in the cluster
+func (r *InventoryReconciler) reconcileInstallation(ctx context.Context, iObj *seederv1alpha1.Inventory) error {
+	if !util.ConditionExists(iObj, seederv1alpha1.HarvesterInstalling) {
+		return nil
+	}
+
+	i := iObj.DeepCopy()
+	hw := &tinkv1alpha1.Hardware{}
+	err := r.Get(ctx, types.NamespacedName{Name: i.Name, Namespace: i.Namespace}, hw)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("error fetching hardware for inventory %s: %v", i.Name, err)
+	}
+
+	if apierrors.IsNotFound(err) || !util.ConditionExists(i, seederv1alpha1.InventoryAllocatedToCluster) {
+		util.RemoveCondition(i, seederv1alpha1.HarvesterInstalling)
 		return r.Status().Update(ctx, i)
 	}
 
+	if _, ok := hw.Annotations[seederv1alpha1.InstallCompletedAnnotation]; ok {
+		r.Event(i, "Normal", "InstallationCompleted", fmt.Sprintf("harvester installation completed on hardware %s", hw.Name))
+		util.RemoveCondition(i, seederv1alpha1.HarvesterInstalling)
+		return r.Status().Update(ctx, i)
+	}
 	return nil
 }
 
@@ -307,6 +449,12 @@ func (r *InventoryReconciler) reconcileBMCJob(ctx context.Context, iObj *seederv
 			completed = true
 		}
 
+		// graceful shutdown did not power off the machine, fallback to a hard shutdown
+		if i.Status.PowerAction.LastActionRequested == seederv1alpha1.NodePowerActionSoftShutdown && !j.HasCondition(rufio.JobCompleted, rufio.ConditionTrue) &&
+			(j.HasCondition(rufio.JobFailed, rufio.ConditionTrue) || time.Since(j.CreationTimestamp.Time) > softShutdownTimeout(i)) {
+			return r.fallbackToHardShutdown(ctx, i, j)
+		}
+
 		// job has completed, BMCJobSubmitted condition can be removed to avoid
 		// further reconciles by reconcileBMCJob handler
 		if completed {
@@ -319,6 +467,69 @@ func (r *InventoryReconciler) reconcileBMCJob(ctx context.Context, iObj *seederv
 	return nil
 }
 
+// fallbackToHardShutdown replaces a soft shutdown job which has not powered off the machine with a hard shutdown job
+func (r *InventoryReconciler) fallbackToHardShutdown(ctx context.Context, i *seederv1alpha1.Inventory, softJob *rufio.Job) error {
+	job := util.GenerateJob(i.Name, i.Namespace, seederv1alpha1.NodePowerActionShutdown)
+	if err := r.jobWrapper(ctx, i, job); err != nil {
+		return fmt.Errorf("error creating hard shutdown job: %w", err)
+	}
+
+	msg := fmt.Sprintf("soft shutdown job %s did not power off machine within %s", softJob.Name, softShutdownTimeout(i))
+	r.Event(i, "Warning", "SoftShutdownFallback", msg)
+	r.Info("falling back to hard shutdown", "inventory", i.Name, "jobName", job.Name)
+	i.Status.PowerAction.LastActionStatus = ""
+	i.Status.PowerAction.LastActionRequested = seederv1alpha1.NodePowerActionShutdown
+	i.Status.PowerAction.LastJobName = job.Name
+	util.RecordPowerAction(i, job, seederv1alpha1.NodePowerActionShutdown, seederv1alpha1.PowerActionRequesterController, msg)
+	util.RemoveCondition(i, seederv1alpha1.BMCJobError)
+	util.RemoveCondition(i, seederv1alpha1.BMCJobComplete)
+	return r.Status().Update(ctx, i)
+}
+
+// softShutdownTimeout returns the time allowed for a graceful shutdown before falling back to a hard shutdown
+func softShutdownTimeout(i *seederv1alpha1.Inventory) time.Duration {
+	timeout, err := time.ParseDuration(i.Spec.SoftShutdownTimeout)
+	if err != nil || timeout <= 0 {
+		return defaultSoftShutdownTimeout
+	}
+	return timeout
+}
+
+// setPersistentBootDevice changes the boot device for all subsequent boots via redfish, as rufio only supports
+// one time boot devices
+func (r *InventoryReconciler) setPersistentBootDevice(ctx context.Context, i *seederv1alpha1.Inventory) error {
+	target := redfish.HddBootSourceOverrideTarget
+	if i.Spec.PowerActionRequested == seederv1alpha1.NodePowerActionPersistentBootPXE {
+		target = redfish.PxeBootSourceOverrideTarget
+	}
+
+	err := func() error {
+		rc, err := newEventFetcher(ctx, r.Client, i)
+		if err != nil {
+			return err
+		}
+		defer rc.Close()
+		return rc.SetPersistentBootDevice(target)
+	}()
+
+	util.RecordPowerAction(i, nil, i.Spec.PowerActionRequested, seederv1alpha1.PowerActionRequesterUser, "powerActionRequested")
+	record := &i.Status.PowerActionHistory[len(i.Status.PowerActionHistory)-1]
+	record.CompletionTime = time.Now().UTC().Format(time.RFC3339)
+	i.Status.PowerAction.LastActionRequested = i.Spec.PowerActionRequested
+	if err != nil {
+		r.Error(err, "error setting persistent boot device", "inventory", i.Name)
+		record.Outcome = seederv1alpha1.NodeJobFailed
+		record.Message = err.Error()
+		i.Status.PowerAction.LastActionStatus = seederv1alpha1.NodeJobFailed
+		util.CreateOrUpdateCondition(i, seederv1alpha1.BMCJobError, err.Error())
+	} else {
+		record.Outcome = seederv1alpha1.NodeJobComplete
+		i.Status.PowerAction.LastActionStatus = seederv1alpha1.NodeJobComplete
+		util.RemoveCondition(i, seederv1alpha1.BMCJobError)
+	}
+	return r.Status().Update(ctx, i)
+}
+
 func (r *InventoryReconciler) inventoryFreed(ctx context.Context, iObj *seederv1alpha1.Inventory) error {
 	i := iObj.DeepCopy()
 	if util.ConditionExists(i, seederv1alpha1.InventoryFreed) {
@@ -326,6 +537,7 @@ func (r *InventoryReconciler) inventoryFreed(ctx context.Context, iObj *seederv1
 		if err := r.jobWrapper(ctx, i, j); err != nil {
 			return err
 		}
+		util.RecordPowerAction(i, j, seederv1alpha1.NodePowerActionShutdown, seederv1alpha1.PowerActionRequesterController, "inventory freed")
 
 		// trigger status update
 		util.RemoveCondition(i, seederv1alpha1.InventoryFreed)
@@ -334,11 +546,45 @@ func (r *InventoryReconciler) inventoryFreed(ctx context.Context, iObj *seederv1
 	return nil
 }
 
+// reconcilePowerActionHistory records the outcome of pending power actions before completed jobs are cleaned up
+func (r *InventoryReconciler) reconcilePowerActionHistory(ctx context.Context, iObj *seederv1alpha1.Inventory) error {
+	i := iObj.DeepCopy()
+	var updated bool
+	for _, record := range iObj.Status.PowerActionHistory {
+		if record.Outcome != seederv1alpha1.NodeJobPending {
+			continue
+		}
+
+		j := &rufio.Job{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: record.JobName}, j)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+			// newly created jobs may not yet be present in the cache
+			submitted, err := time.Parse(time.RFC3339, record.SubmittedTime)
+			if err == nil && time.Since(submitted) < powerActionRecordGracePeriod {
+				continue
+			}
+			j = nil
+		}
+
+		if util.UpdatePowerActionRecord(i, record.JobName, j) {
+			updated = true
+		}
+	}
+
+	if updated {
+		return r.Status().Update(ctx, i)
+	}
+	return nil
+}
+
 func (r *InventoryReconciler) housekeepingBMCJob(ctx context.Context, iObj *seederv1alpha1.Inventory) error {
 	i := iObj.DeepCopy()
 	if !util.ConditionExists(i, seederv1alpha1.InventoryAllocatedToCluster) && !util.ConditionExists(i, seederv1alpha1.InventoryFreed) {
 		bmcjoblist := &rufio.JobList{}
-		l, err := labels.Parse(fmt.Sprintf("inventory.metal.harvesterhci.io=%s", i.Name))
+		l, err := labels.Parse(fmt.Sprintf("%s=%s", util.InventoryJobLabel, i.Name))
 		if err != nil {
 			return err
 		}
@@ -371,23 +617,36 @@ func (r *InventoryReconciler) housekeepingBMCJob(ctx context.Context, iObj *seed
 
 func (r *InventoryReconciler) triggerPowerAction(ctx context.Context, iObj *seederv1alpha1.Inventory) error {
 	i := iObj.DeepCopy()
+	if i.Status.Status == seederv1alpha1.InventoryReady && !util.ConditionExists(i, seederv1alpha1.BMCJobSubmitted) && util.IsPersistentBootAction(i.Spec.PowerActionRequested) && i.Status.PowerAction.LastJobName == "" {
+		// persistent boot device changes are applied once, and do not generate a job
+		if i.Status.PowerAction.LastActionRequested == i.Spec.PowerActionRequested && i.Status.PowerAction.LastActionStatus != "" {
+			return nil
+		}
+		return r.setPersistentBootDevice(ctx, i)
+	}
+
 	if i.Status.Status == seederv1alpha1.InventoryReady && !util.ConditionExists(i, seederv1alpha1.BMCJobSubmitted) && i.Spec.PowerActionRequested != "" && i.Status.PowerAction.LastJobName == "" {
 		// if job name is not present then create one
 		job := util.GenerateJob(i.Name, i.Namespace, i.Spec.PowerActionRequested)
 		if job == nil {
 			return fmt.Errorf("unsupported action, can not generate job for inventory %s", i.Name)
 		}
-		err := controllerutil.SetOwnerReference(i, job, r.Scheme)
-		if err != nil {
-			return fmt.Errorf("error setting owner reference on job %s: %v", job.Name, err)
-		}
 		r.Info("creating job", job.Name, job.Namespace)
-		err = r.Create(ctx, job)
-		if err != nil {
-			return fmt.Errorf("error creating power action job: %v", err)
+		if err := r.jobWrapper(ctx, i, job); err != nil {
+			return fmt.Errorf("error creating power action job: %w", err)
 		}
 		i.Status.PowerAction.LastActionStatus = ""
+		i.Status.PowerAction.LastActionRequested = i.Spec.PowerActionRequested
 		i.Status.PowerAction.LastJobName = job.Name
+		// cluster controller requests a shutdown during cluster cleanup
+		if util.ConditionExists(i, seederv1alpha1.ClusterCleanupSubmitted) {
+			util.RecordPowerAction(i, job, i.Spec.PowerActionRequested, seederv1alpha1.PowerActionRequesterController, "cluster cleanup")
+		} else if util.ConditionExists(i, seederv1alpha1.Decommissioning) {
+			// cluster controller reboots the machine into the hook os to wipe disks
+			util.RecordPowerAction(i, job, i.Spec.PowerActionRequested, seederv1alpha1.PowerActionRequesterController, "decommission")
+		} else {
+			util.RecordPowerAction(i, job, i.Spec.PowerActionRequested, seederv1alpha1.PowerActionRequesterUser, "powerActionRequested")
+		}
 		util.CreateOrUpdateCondition(i, seederv1alpha1.BMCJobSubmitted, "BMCJob Submitted")
 		util.RemoveCondition(i, seederv1alpha1.BMCJobError)
 		util.RemoveCondition(i, seederv1alpha1.BMCJobComplete)
@@ -400,6 +659,60 @@ func (r *InventoryReconciler) triggerPowerAction(ctx context.Context, iObj *seed
 	return nil
 }
 
+// releaseQuarantine returns quarantined inventory to the pool once an operator has added the release quarantine
+// annotation. Maintenance requested on the inventory is removed, and acceptance checks which quarantined the inventory
+// are run again
+func (r *InventoryReconciler) releaseQuarantine(ctx context.Context, iObj *seederv1alpha1.Inventory) error {
+	if _, ok := iObj.Annotations[seederv1alpha1.ReleaseQuarantineAnnotation]; !ok {
+		return nil
+	}
+
+	i := iObj.DeepCopy()
+	if util.ConditionExists(i, seederv1alpha1.Quarantined) {
+		r.Info("releasing inventory from quarantine", "inventory", i.Name)
+		if util.QuarantineSourceIs(i, seederv1alpha1.QuarantineSourceAcceptance) {
+			util.RemoveCondition(i, seederv1alpha1.AcceptanceFailed)
+			i.Status.AcceptanceResults = nil
+		}
+		util.ReleaseQuarantine(i)
+		if err := r.Status().Update(ctx, i); err != nil {
+			return err
+		}
+	}
+
+	delete(i.Annotations, seederv1alpha1.ReleaseQuarantineAnnotation)
+	i.Spec.Maintenance = nil
+	return r.Update(ctx, i)
+}
+
+// reconcileMaintenance quarantines inventory with maintenance requested, and releases it once maintenance is removed
+// or has expired
+func (r *InventoryReconciler) reconcileMaintenance(ctx context.Context, iObj *seederv1alpha1.Inventory) error {
+	i := iObj.DeepCopy()
+	active := util.MaintenanceActive(i, time.Now())
+	switch {
+	case active && !util.ConditionExists(i, seederv1alpha1.Quarantined):
+		m := i.Spec.Maintenance
+		r.Info("placing inventory in maintenance", "inventory", i.Name, "reason", m.Reason, "requestedBy", m.RequestedBy)
+		util.QuarantineInventory(i, seederv1alpha1.QuarantineSourceUser, m.Reason, m.RequestedBy, m.Expiry)
+	case active && util.QuarantineSourceIs(i, seederv1alpha1.QuarantineSourceUser):
+		// maintenance details can be changed while the inventory is in maintenance
+		m := i.Spec.Maintenance
+		q := i.Status.Quarantine
+		if q.Reason == m.Reason && q.RequestedBy == m.RequestedBy && q.Expiry == m.Expiry {
+			return nil
+		}
+		q.Reason, q.RequestedBy, q.Expiry = m.Reason, m.RequestedBy, m.Expiry
+		util.SetErrorCondition(i, seederv1alpha1.Quarantined, m.Reason)
+	case !active && util.QuarantineSourceIs(i, seederv1alpha1.QuarantineSourceUser):
+		r.Info("releasing inventory from maintenance", "inventory", i.Name)
+		util.ReleaseQuarantine(i)
+	default:
+		return nil
+	}
+	return r.Status().Update(ctx, i)
+}
+
 func (r *InventoryReconciler) hasMachineSpecChanged(ctx context.Context, iObj *seederv1alpha1.Inventory) error {
 	i := iObj.DeepCopy()
 	existingObj := &rufio.Machine{}
@@ -449,9 +762,11 @@ func (r *InventoryReconciler) jobWrapper(ctx context.Context, i *seederv1alpha1.
 		if err := controllerutil.SetOwnerReference(i, j, r.Scheme); err != nil {
 			return err
 		}
-		if err := r.Create(ctx, j); err != nil {
-			return err
+		// jobs are submitted via the fleet wide scheduler to limit concurrent jobs per bmc subnet
+		if r.Scheduler == nil {
+			return r.Create(ctx, j)
 		}
+		return r.Scheduler.SubmitJob(ctx, i, j)
 	}
 	return nil
 }
@@ -476,3 +791,385 @@ func (r *InventoryReconciler) reconcileMachinePowerState(ctx context.Context, iO
 	}
 	return nil
 }
+
+// enforceDesiredPowerState will submit a power action job when the machine power state drifts from the desired power state
+func (r *InventoryReconciler) enforceDesiredPowerState(ctx context.Context, iObj *seederv1alpha1.Inventory) error {
+	i := iObj.DeepCopy()
+	if i.Status.Status != seederv1alpha1.InventoryReady {
+		return nil
+	}
+
+	desiredPowerState := util.DesiredMachinePowerState(i)
+	if desiredPowerState == "" {
+		if util.ConditionExists(i, seederv1alpha1.PowerStateDrift) {
+			util.RemoveCondition(i, seederv1alpha1.PowerStateDrift)
+			return r.Status().Update(ctx, i)
+		}
+		return nil
+	}
+
+	// other power actions are in progress, and the machine is expected to change power state
+	if util.ConditionExists(i, seederv1alpha1.BMCJobSubmitted) || util.ConditionExists(i, seederv1alpha1.ClusterCleanupSubmitted) || util.ConditionExists(i, seederv1alpha1.InventoryFreed) || util.ConditionExists(i, seederv1alpha1.BurnInRunning) {
+		return nil
+	}
+
+	// quarantined inventory may be under maintenance, and power state is left to the operator
+	if util.ConditionExists(i, seederv1alpha1.Quarantined) {
+		return nil
+	}
+
+	// provisioning has not yet started, and triggerReboot will power on the machine
+	if util.ConditionExists(i, seederv1alpha1.InventoryAllocatedToCluster) && i.Status.PowerAction.LastJobName == "" {
+		return nil
+	}
+
+	if i.Status.PowerAction.EnforcementJobName != "" {
+		j := &rufio.Job{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Status.PowerAction.EnforcementJobName}, j)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		if err == nil && !j.HasCondition(rufio.JobCompleted, rufio.ConditionTrue) && !j.HasCondition(rufio.JobFailed, rufio.ConditionTrue) {
+			return fmt.Errorf("power state enforcement job %s not yet completed, requeuing", j.Name)
+		}
+
+		if j.HasCondition(rufio.JobFailed, rufio.ConditionTrue) {
+			r.Event(i, "Warning", "PowerStateEnforcementFailed", fmt.Sprintf("job %s to restore desired power state %s failed", j.Name, i.Spec.DesiredPowerState))
+		}
+		completionTime := time.Now().UTC()
+		if j.Status.CompletionTime != nil {
+			completionTime = j.Status.CompletionTime.UTC()
+		}
+		i.Status.PowerAction.EnforcementJobName = ""
+		i.Status.PowerAction.LastEnforcementTime = completionTime.Format(time.RFC3339)
+		return r.Status().Update(ctx, i)
+	}
+
+	b := &rufio.Machine{}
+	err := r.Get(ctx, types.NamespacedName{Name: i.Name, Namespace: i.Namespace}, b)
+	if err != nil {
+		return err
+	}
+
+	// power state is not yet known
+	if b.Status.Power != rufio.On && b.Status.Power != rufio.Off {
+		return nil
+	}
+
+	if b.Status.Power == desiredPowerState {
+		if util.ConditionExists(i, seederv1alpha1.PowerStateDrift) {
+			util.RemoveCondition(i, seederv1alpha1.PowerStateDrift)
+			return r.Status().Update(ctx, i)
+		}
+		return nil
+	}
+
+	// the machine power state may not have been refreshed since the last enforcement job completed, and drift is
+	// checked again once the cooldown has passed
+	if util.PowerEnforcementCooldownRemaining(i, time.Now()) > 0 {
+		return nil
+	}
+
+	powerAction := seederv1alpha1.NodePowerActionPowerOn
+	if desiredPowerState == rufio.Off {
+		powerAction = seederv1alpha1.NodePowerActionShutdown
+	}
+
+	msg := fmt.Sprintf("machine power state %s does not match desired power state %s", b.Status.Power, desiredPowerState)
+	r.Event(i, "Warning", "PowerStateDrift", msg)
+
+	job := util.GenerateJob(i.Name, i.Namespace, powerAction)
+	if err := r.jobWrapper(ctx, i, job); err != nil {
+		return fmt.Errorf("error creating power state enforcement job: %w", err)
+	}
+
+	r.Info("enforcing desired power state", "inventory", i.Name, "jobName", job.Name)
+	i.Status.PowerAction.EnforcementJobName = job.Name
+	util.RecordPowerAction(i, job, powerAction, seederv1alpha1.PowerActionRequesterController, msg)
+	util.SetErrorCondition(i, seederv1alpha1.PowerStateDrift, msg)
+	return r.Status().Update(ctx, i)
+}
+
+// reconcileAcceptance checks the hardware discovered via redfish against the minimum requirements, and runs the burn in
+// workflow on the machine. Inventory is only allocated to a cluster once it has passed acceptance
+func (r *InventoryReconciler) reconcileAcceptance(ctx context.Context, iObj *seederv1alpha1.Inventory) error {
+	i := iObj.DeepCopy()
+	if i.Spec.Acceptance == nil || i.Status.Status != seederv1alpha1.InventoryReady {
+		return nil
+	}
+
+	if util.ConditionExists(i, seederv1alpha1.HardwareAccepted) || util.ConditionExists(i, seederv1alpha1.AcceptanceFailed) {
+		return nil
+	}
+
+	// a running burn in is completed, but new checks are not started on quarantined inventory
+	if util.ConditionExists(i, seederv1alpha1.Quarantined) && !util.ConditionExists(i, seederv1alpha1.BurnInRunning) {
+		return nil
+	}
+
+	// inventory already in use is checked once it is returned to the pool
+	if util.ConditionExists(i, seederv1alpha1.InventoryAllocatedToCluster) {
+		return nil
+	}
+
+	// hardware is discovered by the inventory event controller
+	if !util.ConditionExists(i, seederv1alpha1.HardwareDiscovered) {
+		return nil
+	}
+
+	if util.ConditionExists(i, seederv1alpha1.BurnInRunning) {
+		return r.checkBurnIn(ctx, i)
+	}
+
+	results := util.CheckHardwareRequirements(i, i.Spec.Acceptance.MinimumRequirements)
+	if !util.AcceptancePassed(results) || i.Spec.Acceptance.BurnIn == nil {
+		return r.completeAcceptance(ctx, i, results)
+	}
+	return r.startBurnIn(ctx, i, results)
+}
+
+// startBurnIn allocates an address from the burn in address pool, creates the tinkerbell objects needed to run the
+// burn in workflow, and pxe boots the machine into the hook os
+func (r *InventoryReconciler) startBurnIn(ctx context.Context, i *seederv1alpha1.Inventory, results []seederv1alpha1.AcceptanceResult) error {
+	// wait for in flight power actions before rebooting the machine
+	if util.ConditionExists(i, seederv1alpha1.BMCJobSubmitted) {
+		return nil
+	}
+
+	if err := r.allocateBurnInAddress(ctx, i); err != nil {
+		return err
+	}
+
+	hw, err := tink.GenerateBurnInHardware(i)
+	if err != nil {
+		return err
+	}
+
+	seederConfig := &corev1.ConfigMap{}
+	err = r.Get(ctx, types.NamespacedName{Name: seederv1alpha1.SeederConfig, Namespace: i.Namespace}, seederConfig)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("error fetching configmap %s in ns %s: %v", seederv1alpha1.SeederConfig, i.Namespace, err)
+	}
+
+	template, err := tink.GenerateBurnInTemplate(seederConfig, i)
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range []client.Object{hw, template, tink.GenerateBurnInWorkflow(i)} {
+		if err := controllerutil.SetOwnerReference(i, obj, r.Scheme); err != nil {
+			return err
+		}
+		if err := r.Create(ctx, obj); err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("error creating burn in objects for inventory %s: %w", i.Name, err)
+		}
+	}
+
+	// the machine is rebooted into the hook os by the controller, and the power action requested by the user in
+	// the spec is left untouched
+	action := seederv1alpha1.NodePowerActionReboot
+	j := util.GenerateJob(i.Name, i.Namespace, action)
+	if err := r.jobWrapper(ctx, i, j); err != nil {
+		return err
+	}
+
+	r.Info("starting burn in", "inventory", i.Name, "jobName", j.Name)
+	i.Status.AcceptanceResults = results
+	i.Status.PowerAction.LastActionStatus = ""
+	i.Status.PowerAction.LastActionRequested = action
+	i.Status.PowerAction.LastJobName = j.Name
+	util.RecordPowerAction(i, j, action, seederv1alpha1.PowerActionRequesterController, "burn in")
+	util.CreateOrUpdateCondition(i, seederv1alpha1.BMCJobSubmitted, "BMCJob Submitted")
+	util.RemoveCondition(i, seederv1alpha1.BMCJobError)
+	util.RemoveCondition(i, seederv1alpha1.BMCJobComplete)
+	util.CreateOrUpdateCondition(i, seederv1alpha1.BurnInRunning, "burn in workflow started")
+	return r.Status().Update(ctx, i)
+}
+
+// checkBurnIn completes acceptance once the burn in workflow has finished, or has not finished within the timeout
+func (r *InventoryReconciler) checkBurnIn(ctx context.Context, i *seederv1alpha1.Inventory) error {
+	results := i.Status.AcceptanceResults
+	workflow := &tinkv1alpha1.Workflow{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: tink.BurnInName(i)}, workflow)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		results = append(results, seederv1alpha1.AcceptanceResult{
+			Check:   "burn-in",
+			Message: fmt.Sprintf("workflow %s not found", tink.BurnInName(i)),
+		})
+		return r.completeAcceptance(ctx, i, results)
+	}
+
+	switch workflow.Status.State {
+	case tinkv1alpha1.WorkflowStateSuccess, tinkv1alpha1.WorkflowStateFailed, tinkv1alpha1.WorkflowStateTimeout:
+		return r.completeAcceptance(ctx, i, append(results, tink.BurnInResults(workflow)...))
+	}
+
+	timeout, err := time.ParseDuration(i.Spec.Acceptance.BurnIn.Timeout)
+	if err != nil {
+		timeout = defaultBurnInTimeout
+	}
+	started, err := time.Parse(time.RFC3339, seederv1alpha1.BurnInRunning.GetLastUpdated(i))
+	if err == nil && time.Since(started) > timeout {
+		results = append(results, seederv1alpha1.AcceptanceResult{
+			Check:   "burn-in",
+			Message: fmt.Sprintf("workflow did not complete within %s", timeout),
+		})
+		return r.completeAcceptance(ctx, i, results)
+	}
+
+	return &util.DeferredError{Reason: fmt.Sprintf("waiting for burn in of inventory %s", i.Name), RequeueAfter: burnInInterval}
+}
+
+// completeAcceptance records the acceptance results, and marks the inventory as accepted if all checks have passed
+func (r *InventoryReconciler) completeAcceptance(ctx context.Context, i *seederv1alpha1.Inventory, results []seederv1alpha1.AcceptanceResult) error {
+	if util.ConditionExists(i, seederv1alpha1.BurnInRunning) {
+		if util.ConditionExists(i, seederv1alpha1.BMCJobSubmitted) {
+			return &util.DeferredError{Reason: fmt.Sprintf("waiting for burn in reboot job of inventory %s", i.Name), RequeueAfter: burnInInterval}
+		}
+		if err := r.cleanupBurnIn(ctx, i); err != nil {
+			return err
+		}
+		util.RemoveCondition(i, seederv1alpha1.BurnInRunning)
+		// the burn in reboot job is forgotten once completed, as provisioning and power actions are only
+		// submitted when no job is tracked on the inventory
+		i.Status.PowerAction.LastJobName = ""
+		i.Status.PowerAction.LastActionRequested = ""
+	}
+
+	i.Status.AcceptanceResults = results
+	if util.AcceptancePassed(results) {
+		r.Info("inventory passed acceptance", "inventory", i.Name)
+		util.CreateOrUpdateCondition(i, seederv1alpha1.HardwareAccepted, "hardware passed acceptance")
+	} else {
+		var failed []string
+		for _, v := range results {
+			if !v.Passed {
+				failed = append(failed, v.Check)
+			}
+		}
+		msg := fmt.Sprintf("hardware failed acceptance checks: %s", strings.Join(failed, ", "))
+		r.Event(i, "Warning", "AcceptanceFailed", msg)
+		util.SetErrorCondition(i, seederv1alpha1.AcceptanceFailed, msg)
+		util.QuarantineInventory(i, seederv1alpha1.QuarantineSourceAcceptance, msg, "", "")
+	}
+	return r.Status().Update(ctx, i)
+}
+
+// allocateBurnInAddress allocates an address from the burn in address pool to the inventory
+func (r *InventoryReconciler) allocateBurnInAddress(ctx context.Context, i *seederv1alpha1.Inventory) error {
+	ref := i.Spec.Acceptance.BurnIn.AddressPoolReference
+	pool := &seederv1alpha1.AddressPool{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, pool); err != nil {
+		return fmt.Errorf("error fetching burn in address pool %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+
+	var address string
+	for k, v := range pool.Status.AddressAllocation {
+		if v.Name == i.Name && v.Namespace == i.Namespace && v.Kind == seederv1alpha1.KindInventory {
+			address = k
+		}
+	}
+
+	if address == "" {
+		if pool.Status.Status != seederv1alpha1.PoolReady {
+			return fmt.Errorf("waiting for address pool %s to be ready", pool.Name)
+		}
+		provider, err := util.NewIPAMProvider(ctx, r.Client, pool)
+		if err != nil {
+			return err
+		}
+		address, err = provider.Allocate(ctx, pool, seederv1alpha1.ObjectReferenceWithKind{
+			ObjectReference: seederv1alpha1.ObjectReference{
+				Namespace: i.Namespace,
+				Name:      i.Name,
+			},
+			Kind: seederv1alpha1.KindInventory,
+		}, "")
+		if err != nil {
+			return err
+		}
+		if err := r.Status().Update(ctx, pool); err != nil {
+			return fmt.Errorf("error updating address pool after allocation: %v", err)
+		}
+	}
+
+	i.Status.Address = address
+	i.Status.Gateway = pool.Spec.Gateway
+	i.Status.Netmask = pool.Status.Netmask
+	return nil
+}
+
+// releaseBurnInAddress returns the address allocated for the burn in to the address pool
+func (r *InventoryReconciler) releaseBurnInAddress(ctx context.Context, i *seederv1alpha1.Inventory) error {
+	if i.Status.Address == "" {
+		return nil
+	}
+
+	pool, err := util.FindIPInAddressPools(ctx, r.Client, i.Name, i.Namespace, i.Status.Address)
+	if err != nil {
+		return err
+	}
+	if pool != nil {
+		provider, err := util.NewIPAMProvider(ctx, r.Client, pool)
+		if err != nil {
+			return err
+		}
+		if err := provider.Release(ctx, pool, i.Status.Address); err != nil {
+			return fmt.Errorf("error releasing burn in address from pool %s: %w", pool.Name, err)
+		}
+		if err := r.Status().Update(ctx, pool); err != nil {
+			return fmt.Errorf("error releasing burn in address from pool %s: %w", pool.Name, err)
+		}
+	}
+
+	i.Status.Address = ""
+	i.Status.Gateway = ""
+	i.Status.Netmask = ""
+	return nil
+}
+
+// cleanupBurnIn removes the burn in workflow, template and hardware, and releases the burn in address
+func (r *InventoryReconciler) cleanupBurnIn(ctx context.Context, i *seederv1alpha1.Inventory) error {
+	objs := []client.Object{&tinkv1alpha1.Workflow{}, &tinkv1alpha1.Template{}, &tinkv1alpha1.Hardware{}}
+	for _, obj := range objs {
+		obj.SetName(tink.BurnInName(i))
+		obj.SetNamespace(i.Namespace)
+		if err := r.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error deleting burn in objects for inventory %s: %w", i.Name, err)
+		}
+	}
+	return r.releaseBurnInAddress(ctx, i)
+}
+
+// retryAcceptance clears the acceptance results once an operator has added the retry acceptance annotation
+func (r *InventoryReconciler) retryAcceptance(ctx context.Context, iObj *seederv1alpha1.Inventory) error {
+	if _, ok := iObj.Annotations[seederv1alpha1.RetryAcceptanceAnnotation]; !ok {
+		return nil
+	}
+
+	// a running burn in is completed before acceptance can be retried
+	if util.ConditionExists(iObj, seederv1alpha1.BurnInRunning) {
+		return nil
+	}
+
+	i := iObj.DeepCopy()
+	if util.ConditionExists(i, seederv1alpha1.HardwareAccepted) || util.ConditionExists(i, seederv1alpha1.AcceptanceFailed) {
+		r.Info("retrying acceptance", "inventory", i.Name)
+		util.RemoveCondition(i, seederv1alpha1.HardwareAccepted)
+		util.RemoveCondition(i, seederv1alpha1.AcceptanceFailed)
+		i.Status.AcceptanceResults = nil
+		if util.QuarantineSourceIs(i, seederv1alpha1.QuarantineSourceAcceptance) {
+			util.ReleaseQuarantine(i)
+		}
+		if err := r.Status().Update(ctx, i); err != nil {
+			return err
+		}
+	}
+
+	delete(i.Annotations, seederv1alpha1.RetryAcceptanceAnnotation)
+	return r.Update(ctx, i)
+}
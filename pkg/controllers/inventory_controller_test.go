@@ -11,8 +11,10 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/util"
 )
 
 var _ = Describe("Inventory controller and baseboard tests", func() {
@@ -492,3 +494,380 @@ var _ = Describe("reconcile out of band machine power operation", func() {
 		}).ShouldNot(HaveOccurred())
 	})
 })
+
+var _ = Describe("enforce desired machine power state", func() {
+	var i *seederv1alpha1.Inventory
+	var creds *corev1.Secret
+
+	BeforeEach(func() {
+		i = &seederv1alpha1.Inventory{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "desired-power-state",
+				Namespace: "default",
+			},
+			Spec: seederv1alpha1.InventorySpec{
+				PrimaryDisk:                   "/dev/sda",
+				ManagementInterfaceMacAddress: "xx:xx:xx:xx:xx",
+				DesiredPowerState:             seederv1alpha1.DesiredPowerStateAuto,
+				BaseboardManagementSpec: rufio.MachineSpec{
+					Connection: rufio.Connection{
+						Host:        "localhost",
+						Port:        623,
+						InsecureTLS: true,
+						AuthSecretRef: corev1.SecretReference{
+							Name:      "desired-power-state",
+							Namespace: "default",
+						},
+					},
+				},
+			},
+		}
+
+		creds = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "desired-power-state",
+				Namespace: "default",
+			},
+			StringData: map[string]string{
+				"username": "admin",
+				"password": "password",
+			},
+		}
+
+		Eventually(func() error {
+			err := k8sClient.Create(ctx, creds)
+			if err != nil {
+				return err
+			}
+			err = k8sClient.Create(ctx, i)
+			return err
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+	})
+
+	It("power off free inventory after unexpected power on", func() {
+		By("check inventory reconcile", func() {
+			Eventually(func() error {
+				iObj := &seederv1alpha1.Inventory{}
+				err := k8sClient.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, iObj)
+				if err != nil {
+					return err
+				}
+
+				if iObj.Status.Status != seederv1alpha1.InventoryReady {
+					return fmt.Errorf("waiting for baseboard object to be created. Current status %v", iObj)
+				}
+				return nil
+			}, "30s", "5s").ShouldNot(HaveOccurred())
+		})
+
+		By("power on machine out of band", func() {
+			Eventually(func() error {
+				b := &rufio.Machine{}
+				err := k8sClient.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, b)
+				if err != nil {
+					return err
+				}
+				b.Status.Power = rufio.On
+				return k8sClient.Status().Update(ctx, b)
+			}).ShouldNot(HaveOccurred())
+		})
+
+		By("check machine is powered off", func() {
+			Eventually(func() error {
+				b := &rufio.Machine{}
+				err := k8sClient.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, b)
+				if err != nil {
+					return err
+				}
+
+				if b.Status.Power != rufio.Off {
+					return fmt.Errorf("waiting for machine to be powered off. Current state %v", b.Status.Power)
+				}
+				return nil
+			}, "60s", "5s").ShouldNot(HaveOccurred())
+		})
+
+		By("check power state drift is cleared", func() {
+			Eventually(func() error {
+				iObj := &seederv1alpha1.Inventory{}
+				err := k8sClient.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, iObj)
+				if err != nil {
+					return err
+				}
+
+				if util.ConditionExists(iObj, seederv1alpha1.PowerStateDrift) {
+					return fmt.Errorf("waiting for power state drift condition to be removed")
+				}
+
+				if iObj.Status.PowerAction.EnforcementJobName != "" {
+					return fmt.Errorf("waiting for enforcement job to be cleared")
+				}
+				return nil
+			}, "60s", "5s").ShouldNot(HaveOccurred())
+		})
+
+		By("check power action history is recorded", func() {
+			Eventually(func() error {
+				iObj := &seederv1alpha1.Inventory{}
+				err := k8sClient.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, iObj)
+				if err != nil {
+					return err
+				}
+
+				if len(iObj.Status.PowerActionHistory) == 0 {
+					return fmt.Errorf("waiting for power action history to be recorded")
+				}
+
+				record := iObj.Status.PowerActionHistory[len(iObj.Status.PowerActionHistory)-1]
+				if record.Requester != seederv1alpha1.PowerActionRequesterController || record.Action != seederv1alpha1.NodePowerActionShutdown {
+					return fmt.Errorf("expected shutdown requested by controller, got %v", record)
+				}
+
+				if record.Outcome != seederv1alpha1.NodeJobComplete {
+					return fmt.Errorf("waiting for power action outcome to be recorded, got %v", record)
+				}
+				return nil
+			}, "60s", "5s").ShouldNot(HaveOccurred())
+		})
+	})
+
+	AfterEach(func() {
+		Eventually(func() error {
+			return k8sClient.Delete(ctx, creds)
+
+		}).ShouldNot(HaveOccurred())
+
+		Eventually(func() error {
+			return k8sClient.Delete(ctx, i)
+
+		}).ShouldNot(HaveOccurred())
+
+		Eventually(func() error {
+			// wait until finalizers have cleaned up objects
+			err := k8sClient.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, i)
+			if err != nil {
+				// object is missing
+				if apierrors.IsNotFound(err) {
+					return nil
+				}
+			}
+			return fmt.Errorf("waiting for inventory object to be not found")
+		}).ShouldNot(HaveOccurred())
+	})
+})
+
+var _ = Describe("provision inventory after burn in", Ordered, func() {
+	var i *seederv1alpha1.Inventory
+	var c *seederv1alpha1.Cluster
+	var burnInPool, clusterPool *seederv1alpha1.AddressPool
+	var creds *corev1.Secret
+
+	BeforeAll(func() {
+		burnInPool = &seederv1alpha1.AddressPool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "burn-in-test-burnin",
+				Namespace: "default",
+			},
+			Spec: seederv1alpha1.AddressSpec{
+				CIDR:    "192.168.2.1/29",
+				Gateway: "192.168.2.7",
+			},
+		}
+
+		clusterPool = &seederv1alpha1.AddressPool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "burn-in-test",
+				Namespace: "default",
+			},
+			Spec: seederv1alpha1.AddressSpec{
+				CIDR:    "192.168.3.1/29",
+				Gateway: "192.168.3.7",
+			},
+		}
+
+		i = &seederv1alpha1.Inventory{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "burn-in-test",
+				Namespace: "default",
+			},
+			Spec: seederv1alpha1.InventorySpec{
+				PrimaryDisk:                   "/dev/sda",
+				ManagementInterfaceMacAddress: "xx:xx:xx:xx:xx",
+				Arch:                          "amd64",
+				BaseboardManagementSpec: rufio.MachineSpec{
+					Connection: rufio.Connection{
+						Host:        "localhost",
+						Port:        623,
+						InsecureTLS: true,
+						AuthSecretRef: corev1.SecretReference{
+							Name:      "burn-in-test",
+							Namespace: "default",
+						},
+					},
+				},
+				Acceptance: &seederv1alpha1.AcceptanceSpec{
+					MinimumRequirements: seederv1alpha1.HardwareRequirements{
+						CPUCores:  1,
+						MemoryGiB: 1,
+						DiskGiB:   1,
+					},
+					BurnIn: &seederv1alpha1.BurnInSpec{
+						AddressPoolReference: seederv1alpha1.ObjectReference{
+							Name:      "burn-in-test-burnin",
+							Namespace: "default",
+						},
+					},
+				},
+			},
+		}
+
+		creds = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "burn-in-test",
+				Namespace: "default",
+			},
+			StringData: map[string]string{
+				"username": "admin",
+				"password": "password",
+			},
+		}
+
+		c = &seederv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "burn-in-test",
+				Namespace: "default",
+			},
+			Spec: seederv1alpha1.ClusterSpec{
+				HarvesterVersion: "harvester_1_0_2",
+				Nodes: []seederv1alpha1.NodeConfig{
+					{
+						InventoryReference: seederv1alpha1.ObjectReference{
+							Name:      "burn-in-test",
+							Namespace: "default",
+						},
+						AddressPoolReference: seederv1alpha1.ObjectReference{
+							Name:      "burn-in-test",
+							Namespace: "default",
+						},
+					},
+				},
+				VIPConfig: seederv1alpha1.VIPConfig{
+					AddressPoolReference: seederv1alpha1.ObjectReference{
+						Name:      "burn-in-test",
+						Namespace: "default",
+					},
+				},
+				ClusterConfig: seederv1alpha1.ClusterConfig{
+					SSHKeys: []string{
+						"abc",
+					},
+					ConfigURL: "file:///testdata/config.yaml",
+				},
+			},
+		}
+
+		for _, obj := range []client.Object{burnInPool, clusterPool, creds, i} {
+			Eventually(func() error {
+				return k8sClient.Create(ctx, obj)
+			}, "30s", "5s").ShouldNot(HaveOccurred())
+		}
+	})
+
+	It("accept inventory after burn in", func() {
+		By("mark hardware as discovered", func() {
+			Eventually(func() error {
+				iObj := &seederv1alpha1.Inventory{}
+				err := k8sClient.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, iObj)
+				if err != nil {
+					return err
+				}
+
+				if iObj.Status.Status != seederv1alpha1.InventoryReady {
+					return fmt.Errorf("waiting for inventory to be ready. Current status %v", iObj.Status.Status)
+				}
+
+				iObj.Status.Hardware = seederv1alpha1.DiscoveredHardware{
+					CPUCores:  4,
+					MemoryGiB: 16,
+					Disks: []seederv1alpha1.DiscoveredDisk{
+						{
+							Name:        "sda",
+							CapacityGiB: 100,
+						},
+					},
+				}
+				util.CreateOrUpdateCondition(iObj, seederv1alpha1.HardwareDiscovered, "hardware discovered via redfish")
+				return k8sClient.Status().Update(ctx, iObj)
+			}, "30s", "5s").ShouldNot(HaveOccurred())
+		})
+
+		By("check inventory is accepted", func() {
+			Eventually(func() error {
+				iObj := &seederv1alpha1.Inventory{}
+				err := k8sClient.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, iObj)
+				if err != nil {
+					return err
+				}
+
+				if !util.ConditionExists(iObj, seederv1alpha1.HardwareAccepted) {
+					return fmt.Errorf("waiting for inventory to be accepted. Current conditions %v", iObj.Status.Conditions)
+				}
+
+				if iObj.Status.PowerAction.LastJobName != "" {
+					return fmt.Errorf("expected burn in job %s to be cleared", iObj.Status.PowerAction.LastJobName)
+				}
+				return nil
+			}, "120s", "5s").ShouldNot(HaveOccurred())
+		})
+	})
+
+	It("provision accepted inventory", func() {
+		Eventually(func() error {
+			return k8sClient.Create(ctx, c)
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+
+		Eventually(func() error {
+			iObj := &seederv1alpha1.Inventory{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, iObj)
+			if err != nil {
+				return err
+			}
+
+			var jobName string
+			for _, v := range iObj.Status.PowerActionHistory {
+				if v.Reason == "provisioning" {
+					jobName = v.JobName
+				}
+			}
+			if jobName == "" {
+				return fmt.Errorf("waiting for provisioning reboot to be recorded. Current history %v", iObj.Status.PowerActionHistory)
+			}
+
+			return k8sClient.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: jobName}, &rufio.Job{})
+		}, "120s", "5s").ShouldNot(HaveOccurred())
+	})
+
+	AfterAll(func() {
+		Eventually(func() error {
+			err := k8sClient.Delete(ctx, c)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+			err = k8sClient.Get(ctx, types.NamespacedName{Namespace: c.Namespace, Name: c.Name}, &seederv1alpha1.Cluster{})
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("waiting for cluster to be deleted")
+		}, "180s", "5s").ShouldNot(HaveOccurred())
+
+		for _, obj := range []client.Object{i, creds, clusterPool, burnInPool} {
+			Eventually(func() error {
+				err := k8sClient.Delete(ctx, obj)
+				if err != nil && !apierrors.IsNotFound(err) {
+					return err
+				}
+				return nil
+			}, "30s", "5s").ShouldNot(HaveOccurred())
+		}
+	})
+})
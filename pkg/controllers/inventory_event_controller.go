@@ -3,10 +3,11 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
-	corev1 "k8s.io/api/core/v1"
+	"github.com/stmcginnis/gofish/common"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -17,6 +18,7 @@ import (
 
 	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
 	"github.com/harvester/seeder/pkg/events"
+	"github.com/harvester/seeder/pkg/util"
 )
 
 // InventoryEventReconciler reconciles events for an inventory object
@@ -56,8 +58,11 @@ func (r *InventoryEventReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, err
 	}
 
-	// if Event lookup is disabled, ignore the objects
-	if !i.Spec.Enabled {
+	// hardware is discovered again when requested with the rediscover hardware annotation
+	_, rediscover := i.Annotations[seederv1alpha1.RediscoverHardwareAnnotation]
+
+	// if Event lookup is disabled and hardware has been discovered, ignore the objects
+	if !i.Spec.Enabled && util.ConditionExists(i, seederv1alpha1.HardwareDiscovered) && !rediscover {
 		return ctrl.Result{}, nil
 	}
 
@@ -66,6 +71,16 @@ func (r *InventoryEventReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, fmt.Errorf("waiting for inventory %s in namespace %s to be ready", i.Name, i.Namespace)
 	}
 
+	// hardware discovery is needed to identify the management interface, and runs once even if events are disabled
+	if !util.ConditionExists(i, seederv1alpha1.HardwareDiscovered) || rediscover {
+		if err := r.discoverHardware(ctx, i); err != nil {
+			return ctrl.Result{}, err
+		}
+		if !i.Spec.Enabled {
+			return ctrl.Result{}, nil
+		}
+	}
+
 	// if next check time is after current time then requeue
 	timeStamp, ok := i.Annotations[NextCheckTime]
 	if ok {
@@ -104,29 +119,7 @@ func (r *InventoryEventReconciler) getInventoryInfo(ctx context.Context, i *seed
 	if err != nil {
 		return err
 	}
-	// fetch bmc secret first
-	s := &corev1.Secret{}
-	err = r.Get(ctx, types.NamespacedName{Namespace: i.Spec.BaseboardManagementSpec.Connection.AuthSecretRef.Namespace,
-		Name: i.Spec.BaseboardManagementSpec.Connection.AuthSecretRef.Name}, s)
-
-	if err != nil {
-		return err
-	}
-
-	username, ok := s.Data["username"]
-	if !ok {
-		return fmt.Errorf("secret %s has no key username", s.Name)
-	}
-	password, ok := s.Data["password"]
-	if !ok {
-		return fmt.Errorf("secret %s has no key password", s.Name)
-	}
-
-	bmcendpoint := fmt.Sprintf("https://%s", i.Spec.BaseboardManagementSpec.Connection.Host)
-	if port, ok := i.Labels[seederv1alpha1.OverrideRedfishPortLabel]; ok {
-		bmcendpoint = fmt.Sprintf("https://%s:%s", i.Spec.BaseboardManagementSpec.Connection.Host, port)
-	}
-	rc, err := events.NewEventFetcher(ctx, string(username), string(password), bmcendpoint)
+	rc, err := newEventFetcher(ctx, r.Client, i)
 	if err != nil {
 		return err
 	}
@@ -167,7 +160,176 @@ func (r *InventoryEventReconciler) getInventoryInfo(ctx context.Context, i *seed
 	for _, v := range status {
 		r.Event(i, "Normal", "RedfishStatusEvent", fmt.Sprintf("current inventory status: %s", v))
 	}
-	return nil
+
+	health, err := rc.GetSystemHealth()
+	if err != nil {
+		return err
+	}
+
+	if health == common.CriticalHealth && !util.ConditionExists(i, seederv1alpha1.HardwareHealthCritical) {
+		r.Event(i, "Warning", "HardwareHealthCritical", "redfish reports critical system health")
+	}
+
+	// critical hardware health is used to trigger replacement of nodes with a spare, and quarantines free inventory
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		obj := &seederv1alpha1.Inventory{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, obj)
+		if err != nil {
+			return err
+		}
+
+		critical := util.ConditionExists(obj, seederv1alpha1.HardwareHealthCritical)
+		quarantine := health == common.CriticalHealth && obj.Status.Cluster.Name == "" && !util.ConditionExists(obj, seederv1alpha1.Quarantined)
+		switch {
+		case health == common.CriticalHealth && !critical:
+			util.SetErrorCondition(obj, seederv1alpha1.HardwareHealthCritical, "redfish reports critical system health")
+		case health != common.CriticalHealth && critical:
+			util.RemoveCondition(obj, seederv1alpha1.HardwareHealthCritical)
+		case quarantine:
+		default:
+			return nil
+		}
+
+		if quarantine {
+			util.QuarantineInventory(obj, seederv1alpha1.QuarantineSourceHardwareHealth, "redfish reports critical system health", "", "")
+		}
+		return r.Status().Update(ctx, obj)
+	})
+}
+
+// discoverHardware will leverage Redfish to query the interfaces and arch of the inventory, and check them against
+// the values specified on the inventory
+func (r *InventoryEventReconciler) discoverHardware(ctx context.Context, i *seederv1alpha1.Inventory) error {
+	rc, err := newEventFetcher(ctx, r.Client, i)
+	if err != nil {
+		return err
+	}
+
+	defer rc.Close()
+	info, err := rc.GetSystemInfo()
+	if err != nil {
+		return err
+	}
+
+	hardware := seederv1alpha1.DiscoveredHardware{
+		Arch:                          info.Arch,
+		ManagementInterfaceMacAddress: info.SelectInterface(i.Spec.ManagementInterfaceSelector),
+		CPUCores:                      info.CPUCores,
+		MemoryGiB:                     info.MemoryGiB,
+		Location:                      info.Location,
+		BIOSVersion:                   info.BIOSVersion,
+		BMCFirmwareVersion:            info.BMCFirmwareVersion,
+		GPUs:                          info.GPUs,
+		PCIDevices:                    info.PCIDevices,
+	}
+	for _, v := range info.Disks {
+		hardware.Disks = append(hardware.Disks, seederv1alpha1.DiscoveredDisk{
+			Name:        v.Name,
+			CapacityGiB: v.CapacityGiB,
+		})
+	}
+	for _, v := range info.Interfaces {
+		hardware.Interfaces = append(hardware.Interfaces, seederv1alpha1.DiscoveredInterface{
+			Name:       v.Name,
+			MACAddress: v.MACAddress,
+			Enabled:    v.Enabled,
+			LinkUp:     v.LinkUp,
+			VlanID:     v.VlanID,
+			PXEEnabled: v.PXEEnabled,
+			SpeedMbps:  v.SpeedMbps,
+		})
+	}
+
+	mismatches := hardwareMismatches(i, hardware)
+	for _, v := range mismatches {
+		r.Event(i, "Warning", "HardwareMismatch", v)
+	}
+
+	if i.Spec.ManagementInterfaceMacAddress == "" && hardware.ManagementInterfaceMacAddress == "" {
+		r.Event(i, "Warning", "HardwareDiscovery", "no discovered interface matches the management interface selector")
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		obj := &seederv1alpha1.Inventory{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, obj)
+		if err != nil {
+			return err
+		}
+
+		obj.Status.Hardware = hardware
+		if len(mismatches) != 0 {
+			util.SetErrorCondition(obj, seederv1alpha1.HardwareMismatch, strings.Join(mismatches, ", "))
+		} else {
+			util.RemoveCondition(obj, seederv1alpha1.HardwareMismatch)
+		}
+		util.CreateOrUpdateCondition(obj, seederv1alpha1.HardwareDiscovered, "hardware discovered via redfish")
+		return r.Status().Update(ctx, obj)
+	})
+	if err != nil {
+		return err
+	}
+
+	// the request to discover the hardware again has been completed
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		obj := &seederv1alpha1.Inventory{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, obj)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := obj.Annotations[seederv1alpha1.RediscoverHardwareAnnotation]; !ok {
+			return nil
+		}
+		delete(obj.Annotations, seederv1alpha1.RediscoverHardwareAnnotation)
+		return r.Update(ctx, obj)
+	})
+}
+
+// hardwareMismatches checks the management interface mac address and arch specified on the inventory
+// against the discovered hardware
+func hardwareMismatches(i *seederv1alpha1.Inventory, hardware seederv1alpha1.DiscoveredHardware) []string {
+	var mismatches []string
+	if i.Spec.ManagementInterfaceMacAddress != "" && len(hardware.Interfaces) != 0 {
+		var found bool
+		for _, v := range hardware.Interfaces {
+			if strings.EqualFold(v.MACAddress, i.Spec.ManagementInterfaceMacAddress) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			mismatches = append(mismatches, fmt.Sprintf("management interface mac address %s not found in discovered interfaces", i.Spec.ManagementInterfaceMacAddress))
+		}
+	}
+
+	if i.Spec.Arch != "" && hardware.Arch != "" && i.Spec.Arch != hardware.Arch {
+		mismatches = append(mismatches, fmt.Sprintf("arch %s does not match discovered arch %s", i.Spec.Arch, hardware.Arch))
+	}
+	return mismatches
+}
+
+// newEventFetcher uses the bmc secret to create a redfish client for the inventory
+func newEventFetcher(ctx context.Context, c client.Client, i *seederv1alpha1.Inventory) (*events.EventFetcher, error) {
+	// fetch bmc secret first
+	s, err := util.GetGrantedSecret(ctx, c, seederv1alpha1.ReferenceKindInventory, i.Namespace, i.Spec.BaseboardManagementSpec.Connection.AuthSecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	username, ok := s.Data["username"]
+	if !ok {
+		return nil, fmt.Errorf("secret %s has no key username", s.Name)
+	}
+	password, ok := s.Data["password"]
+	if !ok {
+		return nil, fmt.Errorf("secret %s has no key password", s.Name)
+	}
+
+	bmcendpoint := fmt.Sprintf("https://%s", i.Spec.BaseboardManagementSpec.Connection.Host)
+	if port, ok := i.Labels[seederv1alpha1.OverrideRedfishPortLabel]; ok {
+		bmcendpoint = fmt.Sprintf("https://%s:%s", i.Spec.BaseboardManagementSpec.Connection.Host, port)
+	}
+	return events.NewEventFetcher(ctx, string(username), string(password), bmcendpoint)
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -13,6 +13,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/util"
 )
 
 var _ = Describe("Inventory event controller tests", func() {
@@ -117,6 +118,61 @@ var _ = Describe("Inventory event controller tests", func() {
 			return nil
 		}, "120s", "5s").ShouldNot(HaveOccurred())
 
+		Eventually(func() error {
+			iObj := &seederv1alpha1.Inventory{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, iObj)
+			if err != nil {
+				return err
+			}
+
+			if !util.ConditionExists(iObj, seederv1alpha1.HardwareDiscovered) {
+				return fmt.Errorf("waiting for hardware to be discovered")
+			}
+
+			if iObj.Status.Hardware.Arch != "amd64" {
+				return fmt.Errorf("expected arch to be discovered, got %s", iObj.Status.Hardware.Arch)
+			}
+
+			if iObj.Status.Hardware.ManagementInterfaceMacAddress != "ec:f4:bb:f0:46:54" {
+				return fmt.Errorf("expected management mac address to be discovered, got %s", iObj.Status.Hardware.ManagementInterfaceMacAddress)
+			}
+
+			// mac address specified on the inventory does not exist on the redfish mock
+			if !util.ConditionExists(iObj, seederv1alpha1.HardwareMismatch) {
+				return fmt.Errorf("expected hardware mismatch condition to be set")
+			}
+			return nil
+		}, "120s", "5s").ShouldNot(HaveOccurred())
+
+		// hardware is only discovered again when requested
+		Eventually(func() error {
+			iObj := &seederv1alpha1.Inventory{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, iObj)
+			if err != nil {
+				return err
+			}
+
+			iObj.Annotations[seederv1alpha1.RediscoverHardwareAnnotation] = "true"
+			return k8sClient.Update(ctx, iObj)
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+
+		Eventually(func() error {
+			iObj := &seederv1alpha1.Inventory{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, iObj)
+			if err != nil {
+				return err
+			}
+
+			if _, ok := iObj.Annotations[seederv1alpha1.RediscoverHardwareAnnotation]; ok {
+				return fmt.Errorf("waiting for hardware to be discovered again")
+			}
+
+			if iObj.Status.Hardware.Arch != "amd64" {
+				return fmt.Errorf("expected arch to be discovered, got %s", iObj.Status.Hardware.Arch)
+			}
+			return nil
+		}, "120s", "5s").ShouldNot(HaveOccurred())
+
 		Eventually(func() error {
 			el := &corev1.EventList{}
 			err := k8sClient.List(ctx, el, &client.ListOptions{Namespace: i.Namespace})
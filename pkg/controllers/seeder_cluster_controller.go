@@ -0,0 +1,161 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/util"
+)
+
+// SeederClusterReconciler reconciles the cluster api infrastructure cluster, by generating a seeder Cluster and
+// publishing its vip as the control plane endpoint
+type SeederClusterReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	logr.Logger
+}
+
+//+kubebuilder:rbac:groups=metal.harvesterhci.io,resources=seederclusters,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=metal.harvesterhci.io,resources=seederclusters/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=metal.harvesterhci.io,resources=seederclusters/finalizers,verbs=update
+
+func (r *SeederClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	sc := &seederv1alpha1.SeederCluster{}
+	err := r.Get(ctx, req.NamespacedName, sc)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		r.Error(err, "unable to fetch seedercluster object")
+		return ctrl.Result{}, err
+	}
+
+	if util.IsCAPIPaused(sc) {
+		r.Info("skipping paused seedercluster", "name", sc.Name, "namespace", sc.Namespace)
+		return ctrl.Result{}, nil
+	}
+
+	if !sc.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.cleanupSeederCluster(ctx, sc)
+	}
+
+	if !controllerutil.ContainsFinalizer(sc, seederv1alpha1.SeederClusterFinalizer) {
+		controllerutil.AddFinalizer(sc, seederv1alpha1.SeederClusterFinalizer)
+		return ctrl.Result{}, r.Update(ctx, sc)
+	}
+
+	return ctrl.Result{}, r.ensureClusterExists(ctx, sc)
+}
+
+// ensureClusterExists creates the seeder cluster, and keeps its settings in sync with the SeederCluster. The nodes
+// of the seeder cluster are managed by the SeederMachine controller
+func (r *SeederClusterReconciler) ensureClusterExists(ctx context.Context, sc *seederv1alpha1.SeederCluster) error {
+	desired := util.GenerateClusterFromSeederCluster(sc)
+	clusterObj := &seederv1alpha1.Cluster{}
+	err := r.Get(ctx, client.ObjectKeyFromObject(desired), clusterObj)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error fetching cluster object for seeder cluster %s/%s: %w", sc.Namespace, sc.Name, err)
+		}
+		if err := r.Create(ctx, desired); err != nil {
+			return fmt.Errorf("error creating cluster object for seeder cluster %s/%s: %w", sc.Namespace, sc.Name, err)
+		}
+		clusterObj = desired
+	}
+
+	if !metav1.IsControlledBy(clusterObj, sc) {
+		return r.updateFailure(ctx, sc, "ClusterExists", fmt.Sprintf("cluster %s/%s is not owned by the seeder cluster", clusterObj.Namespace, clusterObj.Name))
+	}
+
+	updated := clusterObj.DeepCopy()
+	updated.Spec.HarvesterVersion = desired.Spec.HarvesterVersion
+	updated.Spec.ImageURL = desired.Spec.ImageURL
+	updated.Spec.VIPConfig = desired.Spec.VIPConfig
+	updated.Spec.ClusterConfig = desired.Spec.ClusterConfig
+	if !reflect.DeepEqual(updated.Spec, clusterObj.Spec) {
+		if err := r.Update(ctx, updated); err != nil {
+			return fmt.Errorf("error updating cluster object for seeder cluster %s/%s: %w", sc.Namespace, sc.Name, err)
+		}
+	}
+
+	// the control plane endpoint is published once the vip has been allocated
+	if clusterObj.Status.ClusterAddress != "" && sc.Spec.ControlPlaneEndpoint.Host != clusterObj.Status.ClusterAddress {
+		sc.Spec.ControlPlaneEndpoint = seederv1alpha1.APIEndpoint{
+			Host: clusterObj.Status.ClusterAddress,
+			Port: seederv1alpha1.DefaultControlPlaneEndpointPort,
+		}
+		if err := r.Update(ctx, sc); err != nil {
+			return fmt.Errorf("error updating control plane endpoint for seeder cluster %s/%s: %w", sc.Namespace, sc.Name, err)
+		}
+	}
+
+	status := seederv1alpha1.SeederClusterStatus{
+		Ready:         sc.Spec.ControlPlaneEndpoint.Host != "",
+		ClusterStatus: clusterObj.Status.Status,
+	}
+	if reflect.DeepEqual(sc.Status, status) {
+		return nil
+	}
+	sc.Status = status
+	return r.Status().Update(ctx, sc)
+}
+
+func (r *SeederClusterReconciler) updateFailure(ctx context.Context, sc *seederv1alpha1.SeederCluster, reason, msg string) error {
+	if sc.Status.FailureReason == reason && sc.Status.FailureMessage == msg {
+		return nil
+	}
+	sc.Status.Ready = false
+	sc.Status.FailureReason = reason
+	sc.Status.FailureMessage = msg
+	return r.Status().Update(ctx, sc)
+}
+
+// cleanupSeederCluster deletes the seeder cluster and waits for it to be removed, which powers off and frees the
+// inventory of the cluster, before the finalizer is removed
+func (r *SeederClusterReconciler) cleanupSeederCluster(ctx context.Context, sc *seederv1alpha1.SeederCluster) error {
+	clusterObj := &seederv1alpha1.Cluster{}
+	err := r.Get(ctx, client.ObjectKey{Namespace: sc.Namespace, Name: sc.Name}, clusterObj)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			if controllerutil.RemoveFinalizer(sc, seederv1alpha1.SeederClusterFinalizer) {
+				return r.Update(ctx, sc)
+			}
+			return nil
+		}
+		return fmt.Errorf("error fetching cluster object during deletion %s/%s: %w", sc.Namespace, sc.Name, err)
+	}
+
+	if !metav1.IsControlledBy(clusterObj, sc) {
+		if controllerutil.RemoveFinalizer(sc, seederv1alpha1.SeederClusterFinalizer) {
+			return r.Update(ctx, sc)
+		}
+		return nil
+	}
+
+	if clusterObj.DeletionTimestamp.IsZero() {
+		if err := r.Delete(ctx, clusterObj); err != nil {
+			return fmt.Errorf("error deleting cluster object %s/%s: %w", clusterObj.Namespace, clusterObj.Name, err)
+		}
+	}
+
+	return fmt.Errorf("waiting for cluster object %s/%s to be deleted before removing finalizer", clusterObj.Namespace, clusterObj.Name)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SeederClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&seederv1alpha1.SeederCluster{}).
+		Owns(&seederv1alpha1.Cluster{}).
+		Named("seederclusters").
+		Complete(r)
+}
@@ -0,0 +1,113 @@
+package controllers
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+var _ = Describe("SeederCluster Controller", func() {
+	var sc *seederv1alpha1.SeederCluster
+	var a *seederv1alpha1.AddressPool
+
+	BeforeEach(func() {
+		a = &seederv1alpha1.AddressPool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "seeder-cluster-address-pool",
+				Namespace: "default",
+			},
+			Spec: seederv1alpha1.AddressSpec{
+				CIDR:    "192.168.2.1/29",
+				Gateway: "192.168.2.7",
+			},
+		}
+
+		sc = &seederv1alpha1.SeederCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "seeder-cluster-test",
+				Namespace: "default",
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: "cluster.x-k8s.io/v1beta1",
+						Kind:       "Cluster",
+						Name:       "capi-cluster",
+						UID:        "capi-cluster-uid",
+					},
+				},
+			},
+			Spec: seederv1alpha1.SeederClusterSpec{
+				HarvesterVersion: "v1.3.0",
+				ImageURL:         "localhost:5000/v1.3.0",
+				VIPConfig: seederv1alpha1.VIPConfig{
+					AddressPoolReference: seederv1alpha1.ObjectReference{
+						Name:      a.Name,
+						Namespace: a.Namespace,
+					},
+				},
+			},
+		}
+
+		Eventually(func() error {
+			return k8sClient.Create(ctx, a)
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+
+		Eventually(func() error {
+			return k8sClient.Create(ctx, sc)
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+	})
+
+	It("generates a seeder cluster and publishes the control plane endpoint", func() {
+		By("checking the cluster is owned by the seeder cluster", func() {
+			Eventually(func() error {
+				c := &seederv1alpha1.Cluster{}
+				if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: sc.Namespace, Name: sc.Name}, c); err != nil {
+					return err
+				}
+				if !metav1.IsControlledBy(c, sc) {
+					return fmt.Errorf("expected cluster to be owned by the seeder cluster")
+				}
+				return nil
+			}, "30s", "5s").ShouldNot(HaveOccurred())
+		})
+
+		By("checking the seeder cluster is ready", func() {
+			Eventually(func() error {
+				obj := &seederv1alpha1.SeederCluster{}
+				if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: sc.Namespace, Name: sc.Name}, obj); err != nil {
+					return err
+				}
+				if !obj.Status.Ready {
+					return fmt.Errorf("waiting for seeder cluster to be ready")
+				}
+				if obj.Spec.ControlPlaneEndpoint.Port != seederv1alpha1.DefaultControlPlaneEndpointPort || obj.Spec.ControlPlaneEndpoint.Host == "" {
+					return fmt.Errorf("unexpected control plane endpoint %v", obj.Spec.ControlPlaneEndpoint)
+				}
+				return nil
+			}, "60s", "5s").ShouldNot(HaveOccurred())
+		})
+	})
+
+	AfterEach(func() {
+		Eventually(func() error {
+			return k8sClient.Delete(ctx, sc)
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+
+		Eventually(func() error {
+			err := k8sClient.Get(ctx, types.NamespacedName{Namespace: sc.Namespace, Name: sc.Name}, &seederv1alpha1.SeederCluster{})
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("waiting for seeder cluster to be deleted: %v", err)
+		}, "120s", "5s").ShouldNot(HaveOccurred())
+
+		Eventually(func() error {
+			return k8sClient.Delete(ctx, a)
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+	})
+})
@@ -0,0 +1,303 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+	tinkv1alpha1 "github.com/tinkerbell/tink/api/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/util"
+)
+
+const (
+	seederMachineInterval = 30 * time.Second
+)
+
+// SeederMachineReconciler reconciles the cluster api infrastructure machine. Machines claim a free inventory and add
+// it as a node of the seeder cluster, which allocates the node address, and provisions and powers on the inventory
+type SeederMachineReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	logr.Logger
+}
+
+type seederMachineReconciler func(context.Context, *seederv1alpha1.SeederMachine, *seederv1alpha1.SeederCluster) error
+
+//+kubebuilder:rbac:groups=metal.harvesterhci.io,resources=seedermachines,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=metal.harvesterhci.io,resources=seedermachines/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=metal.harvesterhci.io,resources=seedermachines/finalizers,verbs=update
+
+func (r *SeederMachineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	mObj := &seederv1alpha1.SeederMachine{}
+	err := r.Get(ctx, req.NamespacedName, mObj)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		r.Error(err, "unable to fetch seedermachine object")
+		return ctrl.Result{}, err
+	}
+
+	m := mObj.DeepCopy()
+	if util.IsCAPIPaused(m) {
+		r.Info("skipping paused seedermachine", "name", m.Name, "namespace", m.Namespace)
+		return ctrl.Result{}, nil
+	}
+
+	if !m.DeletionTimestamp.IsZero() {
+		// the seeder cluster may already be gone, and the inventory is released without it
+		sc, err := util.FindSeederCluster(ctx, r.Client, m)
+		if err != nil {
+			r.Info("unable to find seedercluster of deleted seedermachine", "name", m.Name, "namespace", m.Namespace, "error", err.Error())
+		}
+		if err := r.releaseInventory(ctx, m, sc); err != nil {
+			if requeueAfter, ok := util.IsDeferred(err); ok {
+				return ctrl.Result{RequeueAfter: requeueAfter}, nil
+			}
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	sc, err := util.FindSeederCluster(ctx, r.Client, m)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !controllerutil.ContainsFinalizer(m, seederv1alpha1.SeederMachineFinalizer) {
+		controllerutil.AddFinalizer(m, seederv1alpha1.SeederMachineFinalizer)
+		return ctrl.Result{}, r.Update(ctx, m)
+	}
+
+	// machines wait for the infrastructure cluster, and its vip, to be ready
+	if sc == nil || !sc.Status.Ready {
+		r.Info("waiting for seedercluster to be ready", "name", m.Name, "namespace", m.Namespace)
+		return ctrl.Result{RequeueAfter: seederMachineInterval}, nil
+	}
+
+	reconcileList := []seederMachineReconciler{
+		r.claimInventory,
+		r.addClusterNode,
+		r.updateMachineStatus,
+	}
+	for _, reconciler := range reconcileList {
+		if err := reconciler(ctx, m, sc); err != nil {
+			if requeueAfter, ok := util.IsDeferred(err); ok {
+				r.Info("seedermachine reconcile deferred", "name", m.Name, "reason", err.Error())
+				return ctrl.Result{RequeueAfter: requeueAfter}, nil
+			}
+			return ctrl.Result{}, err
+		}
+	}
+	return ctrl.Result{}, nil
+}
+
+// claimInventory records a free inventory matching the selector in the machine status. Inventory claimed by other
+// machines which has not yet been added to their cluster is skipped
+func (r *SeederMachineReconciler) claimInventory(ctx context.Context, m *seederv1alpha1.SeederMachine, _ *seederv1alpha1.SeederCluster) error {
+	if m.Status.InventoryReference != nil {
+		return nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&m.Spec.InventorySelector)
+	if err != nil {
+		return fmt.Errorf("error parsing inventory selector of seeder machine %s/%s: %w", m.Namespace, m.Name, err)
+	}
+
+	machineList := &seederv1alpha1.SeederMachineList{}
+	if err := r.List(ctx, machineList); err != nil {
+		return fmt.Errorf("error listing seeder machines: %w", err)
+	}
+
+	i, err := util.FindSpareInventory(ctx, r.Client, selector, m.Namespace, func(i *seederv1alpha1.Inventory) bool {
+		return util.InventoryClaimedBySeederMachine(machineList.Items, i) == ""
+	})
+	if err != nil {
+		return err
+	}
+	if i == nil {
+		return &util.DeferredError{Reason: fmt.Sprintf("no free inventory for seeder machine %s", m.Name), RequeueAfter: seederMachineInterval}
+	}
+
+	m.Status.InventoryReference = &seederv1alpha1.ObjectReference{
+		Name:      i.Name,
+		Namespace: i.Namespace,
+	}
+	return r.Status().Update(ctx, m)
+}
+
+// addClusterNode adds the claimed inventory as a node of the seeder cluster. The cluster controller allocates the
+// node address from the address pool, generates the tinkerbell hardware and workflow, and powers on the inventory
+func (r *SeederMachineReconciler) addClusterNode(ctx context.Context, m *seederv1alpha1.SeederMachine, sc *seederv1alpha1.SeederCluster) error {
+	c := &seederv1alpha1.Cluster{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: sc.Namespace, Name: sc.Name}, c); err != nil {
+		return fmt.Errorf("error fetching cluster for seeder machine %s/%s: %w", m.Namespace, m.Name, err)
+	}
+
+	if clusterHasNode(c, *m.Status.InventoryReference) {
+		return nil
+	}
+
+	c.Spec.Nodes = append(c.Spec.Nodes, seederv1alpha1.NodeConfig{
+		InventoryReference:   *m.Status.InventoryReference,
+		AddressPoolReference: m.Spec.AddressPoolReference,
+	})
+	return r.Update(ctx, c)
+}
+
+// updateMachineStatus sets the provider id once the node address has been allocated, and marks the machine ready
+// once harvester has been installed on the inventory
+func (r *SeederMachineReconciler) updateMachineStatus(ctx context.Context, m *seederv1alpha1.SeederMachine, _ *seederv1alpha1.SeederCluster) error {
+	i := &seederv1alpha1.Inventory{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: m.Status.InventoryReference.Namespace, Name: m.Status.InventoryReference.Name}, i)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			m.Status.Ready = false
+			m.Status.FailureReason = "InventoryNotFound"
+			m.Status.FailureMessage = fmt.Sprintf("inventory %s/%s claimed by the machine no longer exists", m.Status.InventoryReference.Namespace, m.Status.InventoryReference.Name)
+			return r.Status().Update(ctx, m)
+		}
+		return fmt.Errorf("error fetching inventory for seeder machine %s/%s: %w", m.Namespace, m.Name, err)
+	}
+
+	if i.Status.Address == "" {
+		return nil
+	}
+
+	providerID := util.SeederMachineProviderID(i)
+	if m.Spec.ProviderID == nil || *m.Spec.ProviderID != providerID {
+		m.Spec.ProviderID = &providerID
+		if err := r.Update(ctx, m); err != nil {
+			return err
+		}
+	}
+
+	ready, err := inventoryInstallCompleted(ctx, r.Client, i)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	status := m.Status.DeepCopy()
+	status.Ready = ready
+	status.Addresses = []seederv1alpha1.MachineAddress{
+		{
+			Type:    seederv1alpha1.MachineInternalIP,
+			Address: i.Status.Address,
+		},
+		{
+			Type:    seederv1alpha1.MachineHostName,
+			Address: util.NodeHostname(i),
+		},
+	}
+	if reflect.DeepEqual(*status, m.Status) {
+		return nil
+	}
+	m.Status = *status
+	return r.Status().Update(ctx, m)
+}
+
+// releaseInventory removes the claimed inventory from the seeder cluster, and waits for the cluster controller to
+// remove the node and free the inventory before the finalizer is removed. The cluster the inventory is allocated to
+// is used when the seeder cluster has already been deleted
+func (r *SeederMachineReconciler) releaseInventory(ctx context.Context, m *seederv1alpha1.SeederMachine, sc *seederv1alpha1.SeederCluster) error {
+	if ref := m.Status.InventoryReference; ref != nil {
+		i := &seederv1alpha1.Inventory{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, i)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error fetching inventory for seeder machine %s/%s: %w", m.Namespace, m.Name, err)
+		}
+		allocated := err == nil && i.Status.Cluster.Name != ""
+
+		// the cluster is found from the inventory allocation when the seeder cluster no longer exists
+		var clusterKey types.NamespacedName
+		switch {
+		case sc != nil:
+			clusterKey = types.NamespacedName{Namespace: sc.Namespace, Name: sc.Name}
+		case allocated:
+			clusterKey = types.NamespacedName{Namespace: i.Status.Cluster.Namespace, Name: i.Status.Cluster.Name}
+		}
+
+		if clusterKey.Name != "" {
+			c := &seederv1alpha1.Cluster{}
+			err := r.Get(ctx, clusterKey, c)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("error fetching cluster for seeder machine %s/%s: %w", m.Namespace, m.Name, err)
+			}
+			if err == nil && clusterHasNode(c, *ref) {
+				var nodes []seederv1alpha1.NodeConfig
+				for _, n := range c.Spec.Nodes {
+					if n.InventoryReference != *ref {
+						nodes = append(nodes, n)
+					}
+				}
+				c.Spec.Nodes = nodes
+				if err := r.Update(ctx, c); err != nil {
+					return fmt.Errorf("error removing node from cluster %s/%s: %w", c.Namespace, c.Name, err)
+				}
+			}
+		}
+
+		if allocated {
+			return &util.DeferredError{Reason: fmt.Sprintf("waiting for inventory %s to be freed", i.Name), RequeueAfter: seederMachineInterval}
+		}
+	}
+
+	if controllerutil.RemoveFinalizer(m, seederv1alpha1.SeederMachineFinalizer) {
+		return r.Update(ctx, m)
+	}
+	return nil
+}
+
+func clusterHasNode(c *seederv1alpha1.Cluster, ref seederv1alpha1.ObjectReference) bool {
+	for _, n := range c.Spec.Nodes {
+		if n.InventoryReference == ref {
+			return true
+		}
+	}
+	return false
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SeederMachineReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// inventory allocation and hardware install completion update the machine claiming the inventory
+	enqueueClaimingMachine := handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+		machineList := &seederv1alpha1.SeederMachineList{}
+		if err := r.List(ctx, machineList); err != nil {
+			r.Error(err, "error listing seeder machines")
+			return nil
+		}
+		var reconRequest []reconcile.Request
+		for _, m := range machineList.Items {
+			ref := m.Status.InventoryReference
+			if ref != nil && ref.Name == a.GetName() && ref.Namespace == a.GetNamespace() {
+				reconRequest = append(reconRequest, reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Namespace: m.Namespace,
+						Name:      m.Name,
+					},
+				})
+			}
+		}
+		return reconRequest
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&seederv1alpha1.SeederMachine{}).
+		Watches(&seederv1alpha1.Inventory{}, enqueueClaimingMachine).
+		Watches(&tinkv1alpha1.Hardware{}, enqueueClaimingMachine).
+		Named("seedermachines").
+		Complete(r)
+}
@@ -0,0 +1,386 @@
+package controllers
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	rufio "github.com/tinkerbell/rufio/api/v1alpha1"
+	tinkv1alpha1 "github.com/tinkerbell/tink/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/util"
+)
+
+var _ = Describe("SeederMachine Controller", Ordered, func() {
+	var sc *seederv1alpha1.SeederCluster
+	var a *seederv1alpha1.AddressPool
+	var creds *corev1.Secret
+	var inventories []*seederv1alpha1.Inventory
+	var claimed, m, replacement *seederv1alpha1.SeederMachine
+
+	const capiCluster = "seeder-machine-capi"
+
+	newInventory := func(name string) *seederv1alpha1.Inventory {
+		return &seederv1alpha1.Inventory{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels: map[string]string{
+					"seeder-machine-test": "true",
+				},
+			},
+			Spec: seederv1alpha1.InventorySpec{
+				PrimaryDisk:                   "/dev/sda",
+				ManagementInterfaceMacAddress: "xx:xx:xx:xx:xx",
+				Arch:                          "amd64",
+				BaseboardManagementSpec: rufio.MachineSpec{
+					Connection: rufio.Connection{
+						Host:        "localhost",
+						Port:        623,
+						InsecureTLS: true,
+						AuthSecretRef: corev1.SecretReference{
+							Name:      "seeder-machine-test",
+							Namespace: "default",
+						},
+					},
+				},
+			},
+		}
+	}
+
+	newMachine := func(name, clusterName string) *seederv1alpha1.SeederMachine {
+		return &seederv1alpha1.SeederMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels: map[string]string{
+					seederv1alpha1.CAPIClusterNameLabel: clusterName,
+				},
+			},
+			Spec: seederv1alpha1.SeederMachineSpec{
+				InventorySelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"seeder-machine-test": "true",
+					},
+				},
+				AddressPoolReference: seederv1alpha1.ObjectReference{
+					Name:      "seeder-machine-test",
+					Namespace: "default",
+				},
+			},
+		}
+	}
+
+	inventoryRef := func(i *seederv1alpha1.Inventory) seederv1alpha1.ObjectReference {
+		return seederv1alpha1.ObjectReference{Name: i.Name, Namespace: i.Namespace}
+	}
+
+	checkClaimed := func(machine *seederv1alpha1.SeederMachine, i *seederv1alpha1.Inventory) {
+		Eventually(func() error {
+			obj := &seederv1alpha1.SeederMachine{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: machine.Namespace, Name: machine.Name}, obj); err != nil {
+				return err
+			}
+			if obj.Status.InventoryReference == nil || *obj.Status.InventoryReference != inventoryRef(i) {
+				return fmt.Errorf("expected machine to claim inventory %s, got %v", i.Name, obj.Status.InventoryReference)
+			}
+
+			c := &seederv1alpha1.Cluster{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: sc.Namespace, Name: sc.Name}, c); err != nil {
+				return err
+			}
+			if !clusterHasNode(c, inventoryRef(i)) {
+				return fmt.Errorf("waiting for inventory %s to be added to cluster", i.Name)
+			}
+			return nil
+		}, "60s", "5s").ShouldNot(HaveOccurred())
+	}
+
+	checkReleased := func(machine *seederv1alpha1.SeederMachine, i *seederv1alpha1.Inventory) {
+		Eventually(func() error {
+			c := &seederv1alpha1.Cluster{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: sc.Namespace, Name: sc.Name}, c); err != nil {
+				return err
+			}
+			if clusterHasNode(c, inventoryRef(i)) {
+				return fmt.Errorf("waiting for inventory %s to be removed from cluster", i.Name)
+			}
+
+			iObj := &seederv1alpha1.Inventory{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, iObj); err != nil {
+				return err
+			}
+			if iObj.Status.Cluster.Name != "" {
+				return fmt.Errorf("waiting for inventory %s to be freed", i.Name)
+			}
+
+			err := k8sClient.Get(ctx, types.NamespacedName{Namespace: machine.Namespace, Name: machine.Name}, &seederv1alpha1.SeederMachine{})
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("waiting for finalizer to be removed from seeder machine %s: %v", machine.Name, err)
+		}, "180s", "5s").ShouldNot(HaveOccurred())
+	}
+
+	BeforeAll(func() {
+		a = &seederv1alpha1.AddressPool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "seeder-machine-test",
+				Namespace: "default",
+			},
+			Spec: seederv1alpha1.AddressSpec{
+				CIDR:    "192.168.2.1/29",
+				Gateway: "192.168.2.7",
+			},
+		}
+
+		creds = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "seeder-machine-test",
+				Namespace: "default",
+			},
+			StringData: map[string]string{
+				"username": "admin",
+				"password": "password",
+			},
+		}
+
+		inventories = []*seederv1alpha1.Inventory{
+			newInventory("seeder-machine-test-1"),
+			newInventory("seeder-machine-test-2"),
+		}
+
+		sc = &seederv1alpha1.SeederCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "seeder-machine-test",
+				Namespace: "default",
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: "cluster.x-k8s.io/v1beta1",
+						Kind:       "Cluster",
+						Name:       capiCluster,
+						UID:        "seeder-machine-capi-uid",
+					},
+				},
+			},
+			Spec: seederv1alpha1.SeederClusterSpec{
+				HarvesterVersion: "v1.3.0",
+				ImageURL:         "localhost:5000/v1.3.0",
+				VIPConfig: seederv1alpha1.VIPConfig{
+					AddressPoolReference: seederv1alpha1.ObjectReference{
+						Name:      a.Name,
+						Namespace: a.Namespace,
+					},
+				},
+			},
+		}
+
+		// machine of another cluster api cluster which has claimed the first inventory, but not yet added it
+		// to its cluster
+		claimed = newMachine("seeder-machine-test-claimed", "seeder-machine-other-capi")
+		m = newMachine("seeder-machine-test", capiCluster)
+		replacement = newMachine("seeder-machine-test-replacement", capiCluster)
+
+		for _, obj := range []client.Object{a, creds, inventories[0], inventories[1], sc, claimed} {
+			Eventually(func() error {
+				return k8sClient.Create(ctx, obj)
+			}, "30s", "5s").ShouldNot(HaveOccurred())
+		}
+
+		Eventually(func() error {
+			obj := &seederv1alpha1.SeederMachine{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: claimed.Namespace, Name: claimed.Name}, obj); err != nil {
+				return err
+			}
+			ref := inventoryRef(inventories[0])
+			obj.Status.InventoryReference = &ref
+			return k8sClient.Status().Update(ctx, obj)
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+
+		Eventually(func() error {
+			obj := &seederv1alpha1.SeederCluster{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: sc.Namespace, Name: sc.Name}, obj); err != nil {
+				return err
+			}
+			if !obj.Status.Ready {
+				return fmt.Errorf("waiting for seeder cluster to be ready")
+			}
+			return nil
+		}, "60s", "5s").ShouldNot(HaveOccurred())
+	})
+
+	It("claims a free inventory and adds it to the cluster", func() {
+		Eventually(func() error {
+			return k8sClient.Create(ctx, m)
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+
+		// inventory claimed by another machine is skipped
+		checkClaimed(m, inventories[1])
+	})
+
+	It("sets the provider id and marks the machine ready", func() {
+		By("checking the provider id is set", func() {
+			Eventually(func() error {
+				iObj := &seederv1alpha1.Inventory{}
+				if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: inventories[1].Namespace, Name: inventories[1].Name}, iObj); err != nil {
+					return err
+				}
+				if iObj.Status.Address == "" {
+					return fmt.Errorf("waiting for address to be allocated to inventory %s", iObj.Name)
+				}
+
+				obj := &seederv1alpha1.SeederMachine{}
+				if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: m.Namespace, Name: m.Name}, obj); err != nil {
+					return err
+				}
+				if obj.Spec.ProviderID == nil || *obj.Spec.ProviderID != util.SeederMachineProviderID(iObj) {
+					return fmt.Errorf("expected provider id %s, got %v", util.SeederMachineProviderID(iObj), obj.Spec.ProviderID)
+				}
+				if obj.Status.Ready {
+					return fmt.Errorf("expected machine to not be ready before installation has completed")
+				}
+				return nil
+			}, "60s", "5s").ShouldNot(HaveOccurred())
+		})
+
+		By("completing the installation", func() {
+			Eventually(func() error {
+				hw := &tinkv1alpha1.Hardware{}
+				if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: inventories[1].Namespace, Name: inventories[1].Name}, hw); err != nil {
+					return err
+				}
+				if hw.Annotations == nil {
+					hw.Annotations = make(map[string]string)
+				}
+				hw.Annotations[seederv1alpha1.InstallCompletedAnnotation] = "true"
+				return k8sClient.Update(ctx, hw)
+			}, "60s", "5s").ShouldNot(HaveOccurred())
+		})
+
+		By("checking the machine is ready", func() {
+			Eventually(func() error {
+				obj := &seederv1alpha1.SeederMachine{}
+				if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: m.Namespace, Name: m.Name}, obj); err != nil {
+					return err
+				}
+				if !obj.Status.Ready {
+					return fmt.Errorf("waiting for machine to be ready")
+				}
+				if len(obj.Status.Addresses) == 0 {
+					return fmt.Errorf("expected machine addresses to be reported")
+				}
+				return nil
+			}, "60s", "5s").ShouldNot(HaveOccurred())
+		})
+	})
+
+	It("removes the node from the cluster when the machine is deleted", func() {
+		Eventually(func() error {
+			return k8sClient.Delete(ctx, m)
+		}, "30s", "5s").ShouldNot(HaveOccurred())
+
+		checkReleased(m, inventories[1])
+	})
+
+	It("removes the node from the cluster when the seeder cluster has been deleted", func() {
+		By("releasing the inventory claimed by the other machine", func() {
+			Eventually(func() error {
+				return k8sClient.Delete(ctx, claimed)
+			}, "30s", "5s").ShouldNot(HaveOccurred())
+
+			Eventually(func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{Namespace: claimed.Namespace, Name: claimed.Name}, &seederv1alpha1.SeederMachine{})
+				if apierrors.IsNotFound(err) {
+					return nil
+				}
+				return fmt.Errorf("waiting for seeder machine %s to be deleted: %v", claimed.Name, err)
+			}, "60s", "5s").ShouldNot(HaveOccurred())
+		})
+
+		By("claiming the released inventory", func() {
+			Eventually(func() error {
+				return k8sClient.Create(ctx, replacement)
+			}, "30s", "5s").ShouldNot(HaveOccurred())
+
+			checkClaimed(replacement, inventories[0])
+
+			Eventually(func() error {
+				iObj := &seederv1alpha1.Inventory{}
+				if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: inventories[0].Namespace, Name: inventories[0].Name}, iObj); err != nil {
+					return err
+				}
+				if iObj.Status.Cluster.Name != sc.Name {
+					return fmt.Errorf("waiting for inventory %s to be allocated to cluster", iObj.Name)
+				}
+				return nil
+			}, "60s", "5s").ShouldNot(HaveOccurred())
+		})
+
+		By("deleting the seeder cluster without the cluster", func() {
+			// the cluster is left behind when it is not controlled by the seeder cluster
+			Eventually(func() error {
+				c := &seederv1alpha1.Cluster{}
+				if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: sc.Namespace, Name: sc.Name}, c); err != nil {
+					return err
+				}
+				c.OwnerReferences = nil
+				return k8sClient.Update(ctx, c)
+			}, "30s", "5s").ShouldNot(HaveOccurred())
+
+			Eventually(func() error {
+				return k8sClient.Delete(ctx, sc)
+			}, "30s", "5s").ShouldNot(HaveOccurred())
+
+			Eventually(func() error {
+				err := k8sClient.Get(ctx, types.NamespacedName{Namespace: sc.Namespace, Name: sc.Name}, &seederv1alpha1.SeederCluster{})
+				if apierrors.IsNotFound(err) {
+					return nil
+				}
+				return fmt.Errorf("waiting for seeder cluster to be deleted: %v", err)
+			}, "60s", "5s").ShouldNot(HaveOccurred())
+		})
+
+		By("deleting the machine", func() {
+			Eventually(func() error {
+				return k8sClient.Delete(ctx, replacement)
+			}, "30s", "5s").ShouldNot(HaveOccurred())
+
+			checkReleased(replacement, inventories[0])
+		})
+	})
+
+	AfterAll(func() {
+		Eventually(func() error {
+			c := &seederv1alpha1.Cluster{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Namespace: sc.Namespace, Name: sc.Name}, c)
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if c.DeletionTimestamp.IsZero() {
+				if err := k8sClient.Delete(ctx, c); err != nil {
+					return err
+				}
+			}
+			return fmt.Errorf("waiting for cluster to be deleted")
+		}, "120s", "5s").ShouldNot(HaveOccurred())
+
+		for _, obj := range []client.Object{inventories[0], inventories[1], creds, a} {
+			Eventually(func() error {
+				err := k8sClient.Delete(ctx, obj)
+				if err != nil && !apierrors.IsNotFound(err) {
+					return err
+				}
+				return nil
+			}, "30s", "5s").ShouldNot(HaveOccurred())
+		}
+	})
+})
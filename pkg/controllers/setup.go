@@ -49,6 +49,9 @@ type Server struct {
 	LeaderElectionNamespace string
 	EmbeddedMode            bool
 	Debug                   bool
+	BMCMaxConcurrentJobs    int
+	BMCJobSpacing           time.Duration
+	BMCSubnetPrefixLength   int
 	logger                  logr.Logger
 }
 
@@ -103,9 +106,11 @@ func (s *Server) Start(ctx context.Context) error {
 			ShutdownRetriggerInterval: DefaultShutdownRetriggerInterval,
 		},
 		&InventoryReconciler{
-			Client: mgr.GetClient(),
-			Scheme: mgr.GetScheme(),
-			Logger: s.logger.WithName("inventory-controller"),
+			Client:        mgr.GetClient(),
+			Scheme:        mgr.GetScheme(),
+			Logger:        s.logger.WithName("inventory-controller"),
+			EventRecorder: mgr.GetEventRecorderFor("seeder"),
+			Scheduler:     util.NewScheduler(mgr.GetClient(), s.BMCMaxConcurrentJobs, s.BMCJobSpacing, s.BMCSubnetPrefixLength),
 		},
 		&ClusterEventReconciler{
 			Client:        mgr.GetClient(),
@@ -166,6 +171,31 @@ func (s *Server) Start(ctx context.Context) error {
 			Scheme: mgr.GetScheme(),
 			Logger: s.logger.WithName("nested-cluster-controller"),
 		},
+		&BMCDiscoveryReconciler{
+			Client: mgr.GetClient(),
+			Scheme: mgr.GetScheme(),
+			Logger: s.logger.WithName("bmc-discovery-controller"),
+		},
+		&InventoryClassReconciler{
+			Client: mgr.GetClient(),
+			Scheme: mgr.GetScheme(),
+			Logger: s.logger.WithName("inventory-class-controller"),
+		},
+		&SeederClusterReconciler{
+			Client: mgr.GetClient(),
+			Scheme: mgr.GetScheme(),
+			Logger: s.logger.WithName("seeder-cluster-controller"),
+		},
+		&SeederMachineReconciler{
+			Client: mgr.GetClient(),
+			Scheme: mgr.GetScheme(),
+			Logger: s.logger.WithName("seeder-machine-controller"),
+		},
+		&ClusterAddonReconciler{
+			Client: mgr.GetClient(),
+			Scheme: mgr.GetScheme(),
+			Logger: s.logger.WithName("cluster-addon-controller"),
+		},
 	}
 
 	var embedModeControllers = []controller{
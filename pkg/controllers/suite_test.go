@@ -51,6 +51,7 @@ import (
 	"github.com/harvester/seeder/pkg/crd"
 	"github.com/harvester/seeder/pkg/endpoint"
 	"github.com/harvester/seeder/pkg/mock"
+	"github.com/harvester/seeder/pkg/util"
 	nadv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	storagev1 "k8s.io/api/storage/v1"
 )
@@ -175,9 +176,11 @@ var _ = BeforeSuite(func() {
 	Expect(err).NotTo(HaveOccurred())
 
 	err = (&InventoryReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-		Logger: ctrlruntimelog.Log.WithName("controller.inventory"),
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		Logger:        ctrlruntimelog.Log.WithName("controller.inventory"),
+		EventRecorder: mgr.GetEventRecorderFor("seeder"),
+		Scheduler:     util.NewScheduler(mgr.GetClient(), 5, 0, util.DefaultBMCSubnetPrefixLength),
 	}).SetupWithManager(mgr)
 	Expect(err).NotTo(HaveOccurred())
 
@@ -277,6 +280,41 @@ var _ = BeforeSuite(func() {
 	}).SetupWithManager(mgr)
 	Expect(err).NotTo(HaveOccurred())
 
+	err = (&BMCDiscoveryReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+		Logger: ctrlruntimelog.Log.WithName("controller.bmc-discovery-reconciler"),
+	}).SetupWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = (&InventoryClassReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+		Logger: ctrlruntimelog.Log.WithName("controller.inventory-class-reconciler"),
+	}).SetupWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = (&SeederClusterReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+		Logger: ctrlruntimelog.Log.WithName("controller.seeder-cluster-reconciler"),
+	}).SetupWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = (&SeederMachineReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+		Logger: ctrlruntimelog.Log.WithName("controller.seeder-machine-reconciler"),
+	}).SetupWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = (&ClusterAddonReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+		Logger: ctrlruntimelog.Log.WithName("controller.cluster-addon-reconciler"),
+	}).SetupWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
 	endpointServer := endpoint.NewServer(ctx, mgr.GetClient(), ctrlruntimelog.Log.WithName("endpoint-server"))
 	go func() {
 		defer GinkgoRecover()
@@ -3,6 +3,7 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	tinkv1alpha1 "github.com/tinkerbell/tink/api/v1alpha1"
@@ -47,22 +48,34 @@ func (r *WorkflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 
 	r.Info(fmt.Sprintf("current workflow status: %v", wObj.Status), req.Name, req.Namespace)
 
-	hw := &tinkv1alpha1.Hardware{}
-	err = r.Get(ctx, req.NamespacedName, hw)
-	if err != nil {
-		// for now we return error and requeue workflow
-		// this includes case when hardware object no longer exists
-		// may need to fine tune to handle hardware deletion
-		return ctrl.Result{}, err
-	}
+	// decommission and burn in workflows are tracked by the cluster and inventory controllers, and do not install harvester
+	_, decommission := wObj.Labels[seederv1alpha1.DecommissionWorkflowLabel]
+	_, burnIn := wObj.Labels[seederv1alpha1.BurnInWorkflowLabel]
+	if !decommission && !burnIn {
+		hw := &tinkv1alpha1.Hardware{}
+		err = r.Get(ctx, req.NamespacedName, hw)
+		if err != nil {
+			// for now we return error and requeue workflow
+			// this includes case when hardware object no longer exists
+			// may need to fine tune to handle hardware deletion
+			return ctrl.Result{}, err
+		}
 
-	// enable/disable IPXE/Workflow based on workflow status
-	if wObj.Status.State == tinkv1alpha1.WorkflowStateSuccess && (*hw.Spec.Interfaces[0].Netboot.AllowWorkflow || *hw.Spec.Interfaces[0].Netboot.AllowPXE) {
+		// enable/disable IPXE/Workflow based on workflow status, and mark installation as complete
+		_, installCompleted := hw.Annotations[seederv1alpha1.InstallCompletedAnnotation]
+		if wObj.Status.State == tinkv1alpha1.WorkflowStateSuccess && (*hw.Spec.Interfaces[0].Netboot.AllowWorkflow || *hw.Spec.Interfaces[0].Netboot.AllowPXE || !installCompleted) {
 
-		hw.Spec.Interfaces[0].Netboot.AllowWorkflow = &[]bool{false}[0]
-		hw.Spec.Interfaces[0].Netboot.AllowPXE = &[]bool{false}[0]
+			hw.Spec.Interfaces[0].Netboot.AllowWorkflow = &[]bool{false}[0]
+			hw.Spec.Interfaces[0].Netboot.AllowPXE = &[]bool{false}[0]
+			if hw.Annotations == nil {
+				hw.Annotations = make(map[string]string)
+			}
+			if !installCompleted {
+				hw.Annotations[seederv1alpha1.InstallCompletedAnnotation] = time.Now().UTC().Format(time.RFC3339)
+			}
 
-		return ctrl.Result{}, r.Update(ctx, hw)
+			return ctrl.Result{}, r.Update(ctx, hw)
+		}
 	}
 
 	cluster, err := r.getOwnerCluster(ctx, wObj)
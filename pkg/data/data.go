@@ -7,10 +7,18 @@
 // chart/seeder-crd/templates/bmc.tinkerbell.org_machines.yaml
 // chart/seeder-crd/templates/bmc.tinkerbell.org_tasks.yaml
 // chart/seeder-crd/templates/metal.harvesterhci.io_addresspools.yaml
+// chart/seeder-crd/templates/metal.harvesterhci.io_bmcdiscoveries.yaml
+// chart/seeder-crd/templates/metal.harvesterhci.io_clusteraddons.yaml
 // chart/seeder-crd/templates/metal.harvesterhci.io_clusters.yaml
+// chart/seeder-crd/templates/metal.harvesterhci.io_clustertemplates.yaml
 // chart/seeder-crd/templates/metal.harvesterhci.io_inventories.yaml
+// chart/seeder-crd/templates/metal.harvesterhci.io_inventoryclasses.yaml
 // chart/seeder-crd/templates/metal.harvesterhci.io_inventorytemplates.yaml
 // chart/seeder-crd/templates/metal.harvesterhci.io_nestedclusters.yaml
+// chart/seeder-crd/templates/metal.harvesterhci.io_referencegrants.yaml
+// chart/seeder-crd/templates/metal.harvesterhci.io_seederclusters.yaml
+// chart/seeder-crd/templates/metal.harvesterhci.io_seedermachines.yaml
+// chart/seeder-crd/templates/metal.harvesterhci.io_seedermachinetemplates.yaml
 // chart/seeder-crd/templates/tinkerbell.org_hardware.yaml
 package data
 
@@ -88,7 +96,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _chartSeederCrdTemplatesBmcTinkerbellOrg_baseboardmanagementsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x4d\x6f\xe3\x36\x13\xbe\xfb\x57\x0c\xf2\x1e\xf6\xf2\xda\x41\xba\x40\x51\xf8\xb6\x4d\x5b\x34\x68\xb6\x08\x36\xe9\x5e\x8a\x1e\x46\xd2\xd8\x62\x23\x92\xda\xe1\xd0\x69\xba\xd8\xff\x5e\x0c\x29\xd9\xb2\x23\x69\xbd\xfd\xe0\x8d\xe4\x70\x66\x9e\xf9\x96\x96\xcb\xe5\x02\x5b\xf3\x9e\x38\x18\xef\xd6\x80\xad\xa1\x3f\x84\x9c\xee\xc2\xea\xf1\x9b\xb0\x32\xfe\x72\x77\xb5\x78\x34\xae\x5a\xc3\x75\x0c\xe2\xed\x3b\x0a\x3e\x72\x49\xdf\xd1\xc6\x38\x23\xc6\xbb\x85\x25\xc1\x0a\x05\xd7\x0b\x00\x74\xce\x0b\xea\x71\xd0\x2d\xc0\xc7\x4f\x0b\x80\x92\x29\x9d\x3d\x18\x4b\x41\xd0\xb6\x6b\x70\xb1\x69\x16\x00\x0e\x2d\xad\xa1\xc0\x40\x85\x47\xae\x2c\x3a\xdc\x92\x25\x27\x61\x55\xd8\x72\x25\xc6\x3d\x12\x17\xd4\x34\x2b\xcf\xdb\x45\x68\xa9\x54\xb6\x5b\xf6\xb1\x5d\xc3\x08\x45\xe6\xd8\xc9\x2e\x51\x68\xeb\xd9\xf4\x7b\x80\x25\x1c\xe8\xd3\x51\x86\xf6\x6d\x2f\xff\xed\x5e\x7e\xba\x6d\x4c\x90\x9f\xa6\x28\x6e\x4d\xc8\x54\x6d\x13\x19\x9b\x71\x14\x89\x20\xd4\x9e\xe5\xe7\x83\x5e\xaa\x47\x61\xf3\x95\x71\xdb\xd8\x20\x8f\xbe\x5e\x00\x84\xd2\xb7\xb4\x86\xf4\xb8\xc5\x92\xaa\x05\xc0\x2e\xfb\xab\x63\xb6\xec\x6c\xb8\xbb\xc2\xa6\xad\xf1\xaa\x93\x10\xca\x9a\x2c\xf6\xf2\x00\x7c\x4b\xee\xcd\xdd\xcd\xfb\xd7\xf7\x27\x17\x00\x15\x85\x92\x4d\x2b\x29\x06\x46\x80\x82\x09\x20\x35\x41\x7e\x09\x1b\xcf\x69\x3b\x09\xb7\x5f\x6f\xee\x6e\x06\xfb\x96\x7d\x4b\x2c\x03\x67\xe4\x35\x08\xc0\xa3\xf3\x13\xbd\x5e\xa9\xf2\x99\x0e\x2a\x8d\x3d\xca\x4a\x75\xc6\xa0\xaa\x43\x0c\x7e\x03\x52\x9b\x00\x4c\x2d\x53\x20\x97\xa3\xf1\x84\xb5\xdf\x00\x3a\xf0\xc5\xef\x54\xca\x0a\xee\x89\x95\x8d\xfa\x29\x36\x15\x94\xde\xed\x88\x05\x98\x4a\xbf\x75\xe6\xcf\x3d\xef\x00\xe2\x93\xd0\x06\x85\x3a\xe7\x1f\x96\x71\x42\xec\xb0\x81\x1d\x36\x91\xfe\x0f\xe8\x2a\xb0\xf8\x0c\x4c\x2a\x05\xa2\x1b\xf0\x4b\x24\x61\x05\x6f\x3d\x13\x18\xb7\xf1\x6b\xa8\x45\xda\xb0\xbe\xbc\xdc\x1a\xe9\x53\xaf\xf4\xd6\x46\x67\xe4\xf9\xb2\xf4\x4e\xd8\x14\x51\x3c\x87\xcb\x8a\x76\xd4\x5c\x06\xb3\x5d\x22\x97\xb5\x11\x2a\x25\x32\x5d\x62\x6b\x96\x49\x75\x97\xd2\x6f\x65\xab\xff\x71\x97\xac\xe1\xd5\x89\xae\xf2\xac\x51\x15\x84\x8d\xdb\x1e\x5d\xa5\x7c\x98\xf5\x83\xe6\x83\x46\x04\x76\xcf\x33\x96\x83\xb9\xf5\x48\x6d\xf4\xee\xfb\xfb\x07\xe8\x15\x48\x2e\x39\xf5\x41\xb2\xfe\xe1\x61\x38\x38\x42\xcd\x66\xdc\x86\x38\xbb\x72\xc3\xde\x26\x9e\xe4\xaa\xd6\x1b\x27\x69\x53\x36\xa6\xcf\xd3\xc3\x0a\xb1\xb0\x46\xd4\xfb\x1f\x22\x05\x51\x8f\xad\xe0\x3a\x55\x25\x28\x08\x62\x5b\xa1\x50\xb5\x82\x1b\x07\xd7\x68\xa9\xb9\xc6\x40\xff\xb9\x1b\xd4\xda\x61\xa9\xa6\x3d\xdf\x11\xc3\xa2\xfa\xf2\x41\xb6\xdd\xd1\x55\x5f\x1b\x27\x3d\x37\x92\xd9\xf7\x2d\x95\x47\xd9\x54\x51\x30\xac\xf1\x2e\x28\xa4\x59\x32\x55\x19\x0f\x6b\x2a\xaf\x75\x95\xde\x39\x2a\x65\x24\xb7\x5f\x68\x77\xbd\x27\x1d\x44\x44\xd2\x69\xac\x22\xf5\x8c\x77\x46\x9e\x47\x38\x43\x72\x26\xdb\x94\xfa\xab\x11\x82\x39\xa5\x75\x61\x94\xfa\x9e\x4a\x26\x79\x47\x9b\x71\x92\x13\xfd\xdf\x0c\x5f\xec\x2b\x66\x7f\x40\x4c\x2e\x65\x01\x26\xdd\x05\x8d\x3b\x4d\x87\x63\xe1\x1a\x3d\x65\x52\x7f\x08\x25\x17\xb7\x51\x93\xac\xe0\x61\x2f\x70\x92\xb3\x8d\x61\x2f\x1f\x62\xd0\x72\x65\x29\x15\xaa\x16\x43\x78\xf2\x5c\xc1\x23\x3d\x87\x31\x8b\x9d\x63\x35\x5d\xa9\x19\x4d\xde\x9e\x18\x4d\xdb\x9a\xda\x2a\x3a\xf3\x21\x12\x3c\x19\xa9\x8d\x03\xcc\x3d\x5c\xdb\x9d\x16\x5c\xee\xcd\x37\xc3\x15\xb4\x1c\x25\xec\xfb\x92\x33\x05\x02\xe6\xb2\xee\x14\x49\x52\xe2\x8b\xe0\x64\xb5\x87\x39\x95\x4f\x3a\x6c\x4f\xb5\x29\x6b\x3d\x9e\x05\xd3\x41\x49\xde\x49\x3e\xd3\xd2\x95\x6c\xf4\x0f\x51\x4d\x56\x8f\x7e\xd5\x3e\xc8\x59\xf1\xfe\xa3\x0f\xfb\xc1\x40\x1f\xc1\xcd\x1d\x60\x55\x31\x85\x00\x9e\xd3\x51\x52\x7f\x26\x62\x27\xc4\x58\xe3\x6e\xc9\x6d\xa5\x5e\xc3\xd5\x2c\x8c\x19\xa4\xc6\x05\x2a\x23\xd3\xc3\xed\xfd\x59\x68\x6e\x0e\xf4\xa9\x90\x9a\x8d\x51\xef\x71\x0c\x42\x15\xe8\xe9\xa1\x94\x4d\xa6\x47\xd6\xaa\xf0\xbe\x21\x3c\x1d\x38\xf2\x6a\x3d\xcf\x58\x77\x83\xb1\x91\x35\x7c\xfd\xd5\xeb\x73\x54\xbe\xf3\xbc\x77\x80\xf2\x05\x17\x6d\x41\x9c\xc6\xb3\x5e\x59\xb7\x4d\x71\xf7\xa5\x1e\xc8\x40\x74\x9e\xd9\x12\x8f\xd0\x68\x73\xd5\x36\x31\x0e\x65\x79\x5c\x3d\x27\x68\x34\x40\x26\xae\x06\xce\x9b\xa0\x50\xbc\x23\x57\x33\xd1\x3d\xad\xf2\x72\xe0\xda\xb3\x7b\xad\xa0\xc4\x17\x45\xf0\xb3\xdd\x36\xbd\x3a\xaa\x0d\xbe\x08\x3a\xf2\xfc\x9b\x0d\xb7\x32\x83\xaf\xaf\x19\x05\xaf\xf7\xa4\xa7\x0d\x37\x4f\xb7\x80\x3b\x34\x0d\x16\x4d\xaf\x65\xfe\xaa\x1b\xf5\xc8\x70\x9c\x7e\x15\xa0\x8c\xcc\xda\xa5\x13\xa8\xb1\x20\x33\x42\x76\xa2\x87\x7c\xbe\xc7\x34\x18\xe4\x97\x34\xc7\xe9\xe7\xe4\x74\x71\x3e\x42\x7b\x8b\x41\x40\x8c\xa5\xb9\x91\x22\xdb\x03\x9e\x70\xba\x37\xc3\x7e\x84\x9c\x24\xc9\xdd\x7a\x0d\x4a\xb6\x54\x91\x93\x94\x67\x14\x6c\x4b\x21\xe0\xf6\x5c\x94\x6f\x33\xf5\xd0\xa1\x75\xb4\xa8\x23\x15\x56\xc9\x95\x1d\x3f\x30\xae\x4a\xf3\xc5\x4c\xff\x53\xde\x82\xa6\x09\x80\x85\x8f\x92\xec\x0e\xc2\xe8\x82\x99\x1a\xab\xce\x86\x35\x9e\x40\x13\xa8\xba\xbc\xe9\x6a\x5d\x7e\x3a\xd3\x57\x66\x00\xed\x7d\x9c\xbe\x09\xb4\xab\x3e\x70\x24\xed\x57\x3f\x60\x13\x66\x9a\xeb\xb9\xad\xf5\x3c\x40\x0f\xcf\xed\x5c\x63\x1c\xa8\xf9\xf7\x15\x9a\xaf\xd1\x5a\xf6\xb2\x21\x27\xaf\x55\xc2\xe8\xe5\x67\x66\x88\x7c\x8d\xcc\xf8\x72\x32\x6f\xfd\x13\xb1\xba\x73\xd4\x52\x27\xed\xed\x89\xb8\xf7\x79\x5f\x50\xd2\xfb\x43\xad\xfc\x82\xb6\x46\x2e\xda\xa9\x76\x75\xe1\xdd\xc5\xe4\xd5\x66\x33\x76\x37\x63\xfe\x09\xf3\x8c\x1c\xe7\xd2\xbf\xd6\x31\xa3\xb7\x74\x10\xcf\x9a\xee\x47\x67\xb1\xd8\x7f\xc7\x1f\x20\x74\x19\x04\x1f\x3f\x2d\x0e\xc9\x84\x65\x49\xad\x50\x35\xf8\xd7\x94\x7f\x70\x5d\x5c\x1c\xfd\xa9\x4a\xdb\x41\xaf\x80\x5f\x7f\x5b\x64\xe1\x54\xbd\xef\xff\x2e\xe9\xe1\x5f\x01\x00\x00\xff\xff\x18\xd1\x45\x2c\x22\x14\x00\x00")
+var _chartSeederCrdTemplatesBmcTinkerbellOrg_baseboardmanagementsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\xcd\x6e\xe3\x36\x10\xbe\xeb\x29\x06\xe9\x61\x2f\xb5\x83\x74\x81\xa2\xd0\x6d\x9b\xb6\x68\xd0\x6c\x11\xac\xdd\xbd\x2c\xf6\x30\x92\xc6\x16\x1b\x89\xd4\x72\x86\x4e\xd3\xa2\xef\x5e\x0c\x25\xd9\xb2\x57\x34\x9c\x6e\x1b\xf9\x10\x92\xc3\xe1\x7c\xdf\xfc\x51\x5a\x2c\x16\x19\x76\xe6\x3d\x79\x36\xce\xe6\x80\x9d\xa1\x3f\x84\xac\x8e\x78\xf9\xf8\x1d\x2f\x8d\xbb\xde\xdd\x64\x8f\xc6\x56\x39\xdc\x06\x16\xd7\xbe\x23\x76\xc1\x97\xf4\x03\x6d\x8c\x35\x62\x9c\xcd\x5a\x12\xac\x50\x30\xcf\x00\xd0\x5a\x27\xa8\xd3\xac\x43\x80\xbf\xfe\xce\x00\x4a\x4f\x71\x6e\x6d\x5a\x62\xc1\xb6\xcb\xc1\x86\xa6\xc9\x00\x2c\xb6\x94\x43\x81\x4c\x85\x43\x5f\xb5\x68\x71\x4b\x2d\x59\xe1\x65\xd1\x96\x4b\x31\xf6\x91\x7c\x41\x4d\xb3\x74\x7e\x9b\x71\x47\xa5\xaa\xdd\x7a\x17\xba\x1c\x66\x24\x7a\x8d\xc3\xd9\x25\x0a\x6d\x9d\x37\xe3\x18\x60\x01\x07\xf9\x28\xd2\x43\xfb\x7e\x3c\xff\xed\xfe\xfc\xb8\xda\x18\x96\x5f\x52\x12\xf7\x86\x7b\xa9\xae\x09\x1e\x9b\x79\x14\x51\x80\x6b\xe7\xe5\xd7\x83\x5d\x6a\x47\xd1\xf6\x4b\xc6\x6e\x43\x83\x7e\x76\x77\x06\xc0\xa5\xeb\x28\x87\xb8\xb9\xc3\x92\xaa\x0c\x60\xd7\xfb\x6b\x50\xb6\x18\x38\xdc\xdd\x60\xd3\xd5\x78\x33\x9c\xc0\x65\x4d\x2d\x8e\xe7\x01\xb8\x8e\xec\x9b\x87\xbb\xf7\xaf\x57\x27\x0b\x00\x15\x71\xe9\x4d\xa7\x0e\x9a\x05\x0a\x86\x41\x6a\x82\x7e\x27\x6c\x9c\x8f\xc3\x24\xdc\xf1\x79\xf3\x70\x37\x19\x77\xde\x75\xe4\x65\xe2\x8c\xfe\x37\x09\xc0\xa3\xf9\x13\xbb\x5e\xa9\xf1\xbd\x1c\x54\x1a\x7b\xd4\x1b\x35\x90\x41\xd5\x80\x18\xdc\x06\xa4\x36\x0c\x9e\x3a\x4f\x4c\xb6\x8f\xc6\x13\xd5\x6e\x03\x68\xc1\x15\xbf\x53\x29\x4b\x58\x91\x57\x35\xc0\xb5\x0b\x4d\x05\xa5\xb3\x3b\xf2\x02\x9e\x4a\xb7\xb5\xe6\xcf\xbd\x6e\x06\x71\xf1\xd0\x06\x85\x06\xe7\x1f\x1e\x63\x85\xbc\xc5\x06\x76\xd8\x04\xfa\x1a\xd0\x56\xd0\xe2\x33\x78\xd2\x53\x20\xd8\x89\xbe\x28\xc2\x4b\x78\xeb\x3c\x81\xb1\x1b\x97\x43\x2d\xd2\x71\x7e\x7d\xbd\x35\x32\xa6\x5e\xe9\xda\x36\x58\x23\xcf\xd7\xa5\xb3\xe2\x4d\x11\xc4\x79\xbe\xae\x68\x47\xcd\x35\x9b\xed\x02\x7d\x59\x1b\xa1\x52\x82\xa7\x6b\xec\xcc\x22\x9a\x6e\x15\x30\x2f\xdb\xea\x2b\x3f\x24\x2b\xbf\x3a\xb1\x55\x9e\x35\xaa\x58\xbc\xb1\xdb\xa3\xa5\x98\x0f\x67\xfd\xa0\xf9\xa0\x11\x81\xc3\xf6\x1e\xee\x81\x6e\x9d\x52\x8e\xde\xfd\xb8\x5a\xc3\x68\x40\x74\xc9\x89\xda\x9e\xfd\xc3\x46\x3e\x38\x42\x69\x33\x76\x43\x1a\x68\x86\x61\xe3\x5d\x1b\x79\x27\x5b\x75\xce\x58\x89\x83\xb2\x31\x63\x9e\x1e\xfe\x38\x14\xad\x11\xf5\xfe\xa7\x40\x2c\xea\xb1\x25\xdc\xc6\xaa\x04\x05\x41\xe8\x2a\x14\xaa\x96\x70\x67\xe1\x16\x5b\x6a\x6e\x91\xe9\x7f\x77\x83\xb2\xcd\x0b\xa5\xf6\x72\x47\x4c\x8b\xea\xf4\x4f\x75\xe5\x43\xe4\x1e\x2d\x8d\xb5\x31\xe9\xb9\x99\xcc\x5e\x75\x54\x1e\x65\x53\x45\x6c\xbc\xc6\xbb\xa0\x90\xa6\xd2\xcc\xa6\x93\x33\x52\x79\xad\x4f\xe9\xac\xa5\x52\x66\x72\xfb\x33\xeb\x6e\xf7\xa2\x93\x88\x88\x36\xcd\x98\xb0\x57\xbc\x33\xf2\x3c\xa3\x59\x93\x71\xe3\x7c\x1b\x53\x7f\x39\x23\x70\xce\x68\x7d\x30\x48\xbd\xa2\xd2\x93\xbc\xa3\xcd\xbc\xc8\x89\xfd\x6f\xa6\x3b\xf6\x15\x73\x9c\x20\x4f\xb6\x24\x90\x1a\xa3\xed\x82\xc6\x72\x36\xa3\x72\x7f\xb8\x46\x4f\x19\xcd\x9f\x42\x51\x8f\x24\x28\x59\xc2\x7a\x7f\x60\x52\x73\x1b\x78\x7f\x3e\x04\xd6\x72\xd5\x52\x2c\x54\x1d\x32\x3f\x39\x5f\xc1\x23\x3d\xf3\x1c\x63\x97\xb0\xa6\x8f\x6a\x4c\xaf\x9e\x90\xa6\x6d\x4d\xb9\x0a\xd6\x7c\x0a\x04\x4f\x46\x6a\x63\x01\x63\x47\x8b\xed\x4e\x0b\xae\x1f\xe9\x3b\xa3\x15\x00\x81\x23\xf6\x7d\xc9\x49\x81\x38\x9b\x75\xd3\x67\x6f\xc4\x8b\xe0\xc4\x1d\x47\x39\xd5\xcf\x0c\xd8\x9e\x6a\x53\xd6\x3a\x7d\x46\x27\x8c\x50\xd4\x82\xde\x67\x5a\xba\x22\x47\x5f\x88\x2a\x59\x3d\xc6\xa7\x76\x2c\x79\x76\x01\xd6\x9f\x1d\xcb\x18\xe6\xba\x09\xee\x1e\x00\xab\xca\x13\x33\x38\x1f\xa7\xa2\xf9\x67\x22\x36\x71\x4c\x6b\xec\x3d\xd9\xad\xd4\x39\xdc\x64\x33\xeb\x97\x20\x35\x96\xa9\x0c\x9e\xd6\xf7\xab\x8b\xd0\xdc\x1d\xe4\x63\x21\x35\x1b\xa3\xde\xf3\x81\x85\x2a\x58\xdf\xaf\x26\xa5\x2c\x99\x1e\x3d\xb9\x85\x73\x0d\xa1\xcd\x66\x13\xc8\xf9\x33\xec\x6e\x30\x34\x92\xc3\xb7\xdf\xbc\xbe\xc4\xe4\x07\xe7\xf7\x0e\xe8\xf4\x7f\x1b\xda\x82\x7c\xbc\x9e\x8d\xc6\xda\x6d\xcc\xa9\x97\x7a\xa0\x07\xa2\xf7\x99\x2d\xf9\x19\x19\x6d\xae\xda\x26\xe6\xa1\x2c\x8e\xab\x67\x42\x46\x03\x24\xb1\x34\x71\x5e\x42\x42\xf1\x66\x2f\x8a\xee\xb4\xc9\x8b\x89\x6b\xb3\x0b\xf5\xb1\xa0\x84\xcf\x8a\xe0\x91\x7b\x66\xe8\x5e\xc5\x5d\x47\xb5\xc1\x15\xac\x77\xcf\xff\xb2\xe1\x56\x66\xf2\xf6\x75\xc6\xc0\xdb\xbd\xe8\x69\xc3\xed\x6f\xb7\x80\x3b\x34\x0d\x16\xcd\x68\x65\xec\x46\xf3\x5d\x6b\x7a\x9d\x7e\xc5\x50\x06\xef\xb5\x4b\x47\x50\x73\x41\x66\x84\xda\x59\x0b\x2f\xe9\x31\x0d\xb2\xfc\x16\xef\x71\xfa\x3a\x99\x92\x3a\x41\x7b\x8f\x2c\x20\xa6\xa5\x54\x2e\x1c\xa8\x83\x27\x4c\xf7\x66\xd8\x5f\x21\x93\x22\xfd\xc5\x23\x07\xb5\x70\xa1\x47\x66\x5f\x50\xb0\x5b\x62\xc6\xed\xa5\x28\xdf\xf6\xd2\x53\x87\xd6\xa1\x45\x0b\x9e\xb0\x8a\xae\x1c\xf4\x81\xb1\x55\xbc\x5f\x24\x0f\xd6\x5f\x45\x82\xa6\x61\xc0\xc2\x05\x89\xbc\x83\x78\xb4\x6c\x52\xd7\xaa\x8b\x61\xcd\x27\x50\x02\xd5\x90\x37\x43\xad\xeb\xb7\x9e\xb9\x09\x25\x95\x4e\xd2\x23\xbe\x13\xe8\x0b\xc1\xda\x07\xd2\x7e\xf5\x13\x36\x4c\x5f\x04\x29\x8a\x5c\x06\x68\xfd\xdc\x9d\x6b\x8c\x13\x33\xff\xbd\x41\xe7\x6b\xb4\x96\xbd\x9e\xc8\xe4\xb2\xe2\x99\x5d\x4c\x56\xc5\xe9\x32\x7a\x8f\x9f\xdf\xcc\x3b\xf7\x44\x5e\xdd\x39\xcb\xd4\x11\x47\x0f\x2a\x3a\xf6\xb7\xb1\xa0\xc4\xfd\x87\x5a\x99\xe0\x6f\xce\x8d\x64\x43\x3b\x4f\xc5\x02\xae\x9c\xbd\x4a\x2e\x6d\x36\x57\xd9\x8b\xe8\x4f\xd0\x33\x33\xad\x45\x95\xaa\x1c\xc4\x87\x91\x69\x16\xe7\x35\xdd\x8f\xe6\x42\x31\xde\x69\x27\x19\x33\x64\x90\x7e\x5f\x3b\x24\x13\x96\x25\x75\x42\xd5\xe4\x5b\x93\xbe\x75\xe6\x70\x75\x75\xf4\xa5\x2a\x0e\xf7\x51\xc6\x39\x7c\xf8\xa8\xdf\x9a\xc4\x79\xaa\x86\x8f\x2c\x9c\xc3\x87\x8f\xd9\x3f\x03\x00\x18\xd1\x45\x2c\x22\x14\x00\x00")
 
 func chartSeederCrdTemplatesBmcTinkerbellOrg_baseboardmanagementsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -103,12 +111,12 @@ func chartSeederCrdTemplatesBmcTinkerbellOrg_baseboardmanagementsYaml() (*asset,
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "chart/seeder-crd/templates/bmc.tinkerbell.org_baseboardmanagements.yaml", size: 5154, mode: os.FileMode(420), modTime: time.Unix(1773987736, 0)}
+	info := bindataFileInfo{name: "chart/seeder-crd/templates/bmc.tinkerbell.org_baseboardmanagements.yaml", size: 5154, mode: os.FileMode(436), modTime: time.Unix(1782200728, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _chartSeederCrdTemplatesBmcTinkerbellOrg_bmctasksYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\x5f\x8f\xdb\xb8\x11\x7f\xf7\xa7\x18\xa4\x0f\x79\x89\xbd\x48\x0f\x28\x0a\x03\x7d\x48\xf6\xee\xd0\x45\x93\x76\x91\x5d\xa4\x0f\x45\x1f\xc6\xd4\xc8\x62\x57\x22\x75\x9c\xa1\xf7\xdc\xc3\x7d\xf7\x62\x48\xc9\x96\xbd\x92\xec\x6d\x10\xbe\x89\x1a\x0e\xe7\x37\x7f\x7e\x43\x4a\xcb\xe5\x72\x81\xad\xfd\x4a\x81\xad\x77\x6b\xc0\xd6\xd2\xaf\x42\x4e\x9f\x78\xf5\xf4\x67\x5e\x59\x7f\xb3\x7b\xbf\x78\xb2\xae\x58\xc3\x6d\x64\xf1\xcd\x17\x62\x1f\x83\xa1\x1f\xa9\xb4\xce\x8a\xf5\x6e\xd1\x90\x60\x81\x82\xeb\x05\x00\x3a\xe7\x05\x75\x9a\xf5\x11\xe0\xb7\xdf\x17\x00\x26\x50\x9a\x7b\xb4\x0d\xb1\x60\xd3\xae\xc1\xc5\xba\x5e\x00\x38\x6c\x68\x0d\x9b\xc6\x08\xf2\x13\xaf\x36\x8d\x59\x89\x75\x4f\x14\x36\x54\xd7\x2b\x1f\xb6\x0b\x6e\xc9\xa8\xaa\x6d\xf0\xb1\x4d\xa2\xe7\x12\x59\x4b\xb7\x9f\x41\xa1\xad\x0f\xb6\x7f\x06\x58\xc2\x51\x3e\x4d\x65\x38\x1f\x3f\xdf\x3e\x22\x3f\xa5\x99\xda\xb2\xfc\x6d\x38\xfb\xc9\xb2\xa4\x37\x6d\x1d\x03\xd6\x47\x0b\xd3\x24\x57\x3e\xc8\xdf\x8f\x7b\xea\x1e\x9b\x26\x2f\x60\xeb\xb6\xb1\xc6\x70\x58\xb2\x00\x60\xe3\x5b\x5a\x43\x5a\xd1\xa2\xa1\x62\x01\xb0\xcb\x4e\xef\x34\x2c\x3b\x47\xec\xde\x63\xdd\x56\xf8\xbe\x53\xcb\xa6\xa2\x06\xfb\x4d\x00\x7c\x4b\xee\xc3\xfd\xdd\xd7\x1f\x1e\xce\x5e\x00\x14\xc4\x26\xd8\x56\x52\x20\x3b\x14\x60\x19\xa4\x22\xc8\xd2\x50\xfa\x90\x1e\x7b\x2c\xf0\xe1\xfe\x6e\xa0\xa1\x0d\xbe\xa5\x20\x03\xcf\xe5\x31\xc8\x90\x93\xf9\xb3\x3d\xdf\xaa\x61\x59\x0e\x0a\x4d\x0e\xca\x9b\x77\x40\xa9\xe8\xd0\x80\x2f\x41\x2a\xcb\x10\xa8\x0d\xc4\xe4\x72\xba\x9c\xa9\xf6\x25\xa0\x03\xbf\xf9\x0f\x19\x59\xc1\x03\x05\x55\xa3\x8e\x8f\x75\x01\xc6\xbb\x1d\x05\x81\x40\xc6\x6f\x9d\xfd\xef\x41\x37\x83\xf8\xb4\x69\x8d\x42\x5d\x04\x8f\xc3\x3a\xa1\xe0\xb0\x86\x1d\xd6\x91\xde\x01\xba\x02\x1a\xdc\x43\x20\xdd\x05\xa2\x1b\xe8\x4b\x22\xbc\x82\xcf\x3e\x10\x58\x57\xfa\x35\x54\x22\x2d\xaf\x6f\x6e\xb6\x56\xfa\xda\x30\xbe\x69\xa2\xb3\xb2\xbf\x31\xde\x49\xb0\x9b\x28\x3e\xf0\x4d\x41\x3b\xaa\x6f\xd8\x6e\x97\x18\x4c\x65\x85\x8c\xc4\x40\x37\xd8\xda\x65\x32\xdd\xa5\xfa\x58\x35\xc5\x1f\x42\x57\x4d\xfc\xf6\xcc\x56\xd9\x6b\xc6\xb0\x04\xeb\xb6\x27\xaf\x52\xf2\xce\xc6\x41\x13\x59\x23\x8f\xdd\xf2\x8c\xe5\xe8\x6e\x9d\x52\x1f\x7d\xf9\xe9\xe1\x11\x7a\x03\x52\x48\xce\x63\x90\xbc\x7f\x5c\xc8\xc7\x40\xa8\xdb\xac\x2b\x29\xe4\x50\x96\xc1\x37\x49\x27\xb9\xa2\xf5\xd6\x49\x7a\x30\xb5\x25\x77\x1e\x04\x8e\x9b\xc6\x8a\x46\xff\x97\x48\x2c\x1a\xb1\x15\xdc\x26\xda\x80\x0d\x41\x6c\x0b\x14\x2a\x56\x70\xe7\xe0\x16\x1b\xaa\x6f\x91\xe9\xbb\x87\x41\xbd\xcd\x4b\x75\xed\xf5\x81\x18\xb2\xde\xcb\x05\xd9\x77\x27\xaf\x7a\x22\x9b\x8c\x5c\x57\xb5\x0f\x2d\x99\x93\x0a\x2a\x88\x6d\xd0\x1c\x17\x14\xd2\xca\x18\x52\xd7\x71\x4c\xd5\xaf\x0e\xe3\x9d\x23\x23\x23\x35\xfc\xc2\x8a\xdb\x83\xe8\x20\xf2\xc9\x8e\x8f\xc8\xb4\xf1\x18\x8a\xcf\xe8\x70\x4b\x0d\x39\x39\x28\xde\x59\xd9\x8f\x68\x86\x14\xb4\xd0\xa4\x12\x5f\x8d\x08\xcc\x19\xad\x03\xa3\x54\x0f\x64\x02\xc9\x17\x2a\xc7\x45\xce\xec\xff\x30\x5c\x71\x60\xc0\x7e\x82\x02\xb9\x94\xed\x98\x6c\x17\xb4\xee\x3c\xed\x4f\x37\xd7\x2c\x31\xc9\xfc\x21\x94\x4c\x62\xa3\x2e\x59\xc1\xe3\x61\xc3\x49\xcd\x4d\xe4\xc3\xfe\x10\x59\x69\xa9\xa1\x44\x48\x2d\x32\x3f\xfb\x50\xc0\x13\xed\x79\xcc\x63\xd7\x78\x4d\x47\x6a\x28\x93\x6f\xcf\x9c\xa6\xad\x49\x7d\x15\x9d\xfd\x25\x12\x3c\x5b\xa9\xac\x03\xcc\x8d\x55\x5b\x96\x12\x6b\xe8\xdd\x37\xa3\x15\x94\x76\x12\xf6\x03\xb5\x4c\x81\x80\xb9\xea\x3a\x47\x92\x8c\x78\x15\x9c\x6c\xf6\xb0\x8e\xf2\x4c\x87\xed\xb9\xb2\xa6\xd2\xe9\x59\x30\x1d\x94\x14\x9d\x14\x33\xa5\xa8\xe4\xa3\x6f\x44\x35\xc9\x12\xfd\xa8\x3c\xcb\x55\xf9\xfe\x57\xcf\xd2\xa7\xb9\x2e\x82\xbb\x7b\xc0\xa2\x08\xc4\x0c\x3e\xa4\xa9\x64\xfe\x4c\xc6\x4e\x6c\xd3\x58\xf7\x89\xdc\x56\xaa\x35\xbc\x9f\x85\x31\x83\xd4\x3a\x26\x13\x03\x3d\x7e\x7a\xb8\x0a\xcd\xdd\x51\x3e\x11\xa6\x2d\xad\x46\x2f\x44\x16\x2a\x40\x67\x8f\x54\x36\x59\x1e\xd9\xaa\x8d\xf7\x35\xe1\xf9\xc1\x22\x8f\xd6\x87\x19\xef\x96\x18\x6b\x59\xc3\x9f\xfe\xf8\xc3\x35\x26\xdf\xfb\x70\x08\x80\xea\x05\x17\x9b\x0d\x85\x74\xdc\xea\x8d\x75\xdb\x94\x77\xaf\x8d\x40\x06\xa2\xe7\x96\x2d\x85\x11\x19\x6d\xa2\xda\x1a\xc6\xa1\x2c\x4f\xd9\x73\x42\x46\x13\x64\xe2\xd5\x20\x78\x13\x12\x8a\x77\xe4\xd5\x6c\x76\xeb\xd9\xf3\x62\x17\x4a\xc7\xd7\xd3\xe2\x4d\xc9\x60\x00\x73\x6f\x12\xaf\xb5\xd8\x52\x50\x4e\xa6\x62\xcc\x83\x0d\xfe\x7a\x7f\x24\xca\xd1\x1c\xbe\x44\xa4\xde\x91\xde\x59\x3e\x7a\x2f\x3f\xd2\xce\x1a\xfa\x30\xd9\x44\x5f\x40\xf8\xc7\xf8\xda\x61\x57\x45\xd8\xf4\xc9\x00\xcd\x21\x1b\x26\x99\xc5\x3b\x02\xb1\x0d\x01\x93\x68\x7a\x0b\x14\x49\xb1\xde\x0b\xc2\x64\x8b\xbd\x06\x67\x36\x5e\x75\x5d\xcd\xb1\x19\x13\x9f\x1f\x12\x06\x66\xf1\x3b\xb0\x0e\x7c\x28\x46\x73\xf7\x38\xb4\x50\x98\x24\x55\xc9\x01\xa2\xea\x59\xc1\x6d\x0c\x81\x9c\xd4\x7b\xf0\xae\xde\xa7\x0d\x4a\x1b\xb8\xdf\x61\x56\xad\x75\x39\x71\x6a\xf5\x90\xf6\x36\xa6\x42\xd3\x46\x9d\x77\xba\xcd\x8c\x1a\x2b\xd4\xcc\xf8\xec\x85\x57\x8e\xd1\x1e\x3a\xe6\x24\x56\xaf\xe6\xe1\x3c\xae\x6a\x95\xbd\x18\x86\x80\xe3\xe7\x31\x1d\x54\x5a\xb5\x73\x3e\xd4\x1d\x09\x96\x58\xf3\x9c\x9f\x4f\xd0\xff\xf4\xf3\x9d\x2a\x1e\x32\xb7\xd7\xc9\xec\x80\xc3\xfd\xb3\x87\x3e\x8b\xb7\x19\x9c\xa8\xfe\x59\x91\xd3\x26\x40\xef\x80\x1c\x6e\x6a\x62\xf0\x69\x4b\xfe\x0b\x95\x36\x29\x7f\xae\x6c\x4d\x7d\x1a\xcd\x3b\xf2\x34\x4b\x57\x70\x57\x66\x94\xef\xc0\xf9\x5e\x2f\x60\xa0\x74\x16\x1b\xa7\x96\x83\xae\x8b\x9d\xe6\x12\x49\x43\xe2\xd1\xd9\x74\xbe\x78\x56\x68\xfd\x33\x85\x57\x30\xd3\xfd\x51\xfe\x32\x1b\x65\xed\xd3\x9c\x74\x89\x7a\xc8\xc5\x66\x0e\xfa\x1b\xef\xde\xcc\xbe\x2e\xcb\xb9\xf7\xec\xcb\x69\xc2\x5c\xa6\x3b\x53\x9c\x3e\xe1\x2f\xc1\xec\x4d\x3d\x9d\xdf\x4b\x3d\xc5\x4e\x9e\xe3\x2f\x94\xe4\x4c\xdc\xa6\x73\x62\x09\xf2\xf2\x6a\x37\x7d\xa7\x4c\xf0\xae\xba\x55\x26\xc9\x93\x96\xea\x37\xac\xd7\xf9\x6f\xbc\x58\x36\x6d\x4d\xfd\xb7\xbd\x2b\x2e\x97\x43\xf1\x93\xde\xa1\xcf\xcf\xa9\xce\x2b\x4a\x3e\x80\x67\xe4\x5e\xff\x54\x15\xea\x55\xeb\x68\x42\x56\x62\x39\x37\x0b\xe5\xf9\x53\x85\xa5\x75\x96\x2b\x62\xe0\x68\x0c\x31\x97\xb1\xae\xf7\x63\x8a\xf3\x3d\x6f\x0d\x05\x0a\x2d\x55\xe9\x64\x74\x27\x82\x6f\xbc\x2b\xec\xe0\x2b\xe8\xac\x4b\x7a\xd1\xf3\x56\x9a\x3f\x62\x01\xee\xd0\xd6\x4a\x7a\x5d\xc0\xf2\xd7\xd5\xf1\xe3\xca\xe0\xab\xd9\x5b\x06\x93\xbb\x67\x8e\xef\x18\xce\x99\xee\x76\xf9\xc4\xd0\x10\x33\x6e\x67\x0e\x0c\x27\x30\x3f\x67\xe9\x21\xc6\x2a\x36\xa8\x04\x84\x45\x42\xd7\xe9\x03\xeb\x8a\x74\xe3\x9e\xa5\xf1\x82\x04\x6d\xcd\x80\x1b\x1f\x05\x6a\x64\x01\x09\xe8\xd8\xce\x51\xd1\x55\x4d\x74\xbc\xa4\x26\x50\x75\x55\xd5\x9d\xfe\xf3\xd2\x43\x87\xef\x3e\xc2\x1e\x72\x61\xae\x91\xdc\xa2\xd3\xf3\xec\x63\x88\xa4\xb7\xb6\x9f\xb5\x1f\x7d\x13\x8c\x24\x72\x1d\x88\xc7\x7d\x4b\xff\x87\xd1\x17\x8d\xb8\xd4\xf8\x2e\xb0\xf3\x32\xed\x30\xfa\xf2\x42\x47\x9c\x3b\x03\xb1\x60\x90\xab\xb8\xea\xa1\x97\x9c\xa6\xa9\xde\x5b\x49\x29\x15\x5a\x34\xca\x2b\xd6\x6d\xbf\x03\xab\x4c\x80\x1e\x99\xce\xb4\xbe\x4e\x27\xa6\x7e\x4a\x7c\xd0\x62\x3d\x99\x8b\x9b\xc3\xf7\xe7\xa3\x37\xba\xfc\x87\xdf\x7e\x5f\x1c\x4b\x01\x8d\xa1\x56\xa8\x18\xfc\xf4\xc8\x7f\x51\xde\xe4\xde\xdc\xff\x26\x49\x8f\x03\xf2\x83\x7f\xfd\x7b\x91\x37\xa7\xe2\x6b\xff\xc7\x43\x27\xff\x17\x00\x00\xff\xff\xae\x71\xb0\x44\x7b\x1a\x00\x00")
+var _chartSeederCrdTemplatesBmcTinkerbellOrg_bmctasksYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\xcd\x8e\xdc\xb8\x11\xbe\xeb\x29\x0a\xce\xc1\x17\x77\x0f\x9c\x05\x82\x40\x40\x0e\xf6\xac\x8d\x0c\x62\x27\x03\xcf\x60\x73\x58\xec\xa1\x44\x95\x5a\xcc\x48\xa4\x96\x55\xea\xd9\x4e\x90\x77\x5f\x14\xf5\xd3\xea\xb6\xa4\xee\xb1\xb1\x96\x0f\x23\xb2\xf8\xb1\x7e\xbf\xa2\xd8\x9b\xcd\x26\xc1\xc6\xfe\x44\x81\xad\x77\x29\x60\x63\xe9\x37\x21\xa7\x6f\xbc\x7d\xfa\x2b\x6f\xad\xbf\xd9\xbf\x4d\x9e\xac\xcb\x53\xb8\x6d\x59\x7c\xfd\x85\xd8\xb7\xc1\xd0\x8f\x54\x58\x67\xc5\x7a\x97\xd4\x24\x98\xa3\x60\x9a\x00\xa0\x73\x5e\x50\x87\x59\x5f\x01\xfe\xf7\xff\x04\xc0\x04\x8a\x63\x8f\xb6\x26\x16\xac\x9b\x14\x5c\x5b\x55\x09\x80\xc3\x9a\x52\xc8\x6a\x23\xc8\x4f\xbc\xcd\x6a\xb3\x15\xeb\x9e\x28\x64\x54\x55\x5b\x1f\x76\x09\x37\x64\x14\x6a\x17\x7c\xdb\xa4\x30\x23\xd1\xa1\xf4\xfb\x19\x14\xda\xf9\x60\x87\x77\x80\x0d\x1c\xe5\xa3\x48\x67\xce\xfb\xcf\xb7\x8f\xc8\x4f\x71\xa4\xb2\x2c\xff\x98\x8e\x7e\xb2\x2c\x71\xa6\xa9\xda\x80\xd5\x51\xc3\x38\xc8\xa5\x0f\xf2\xcf\xe3\x9e\xba\x47\x56\x77\x0b\xd8\xba\x5d\x5b\x61\x18\x97\x24\x00\x6c\x7c\x43\x29\xc4\x15\x0d\x1a\xca\x13\x80\x7d\xe7\xf4\x1e\x61\xd3\x3b\x62\xff\x16\xab\xa6\xc4\xb7\x3d\x2c\x9b\x92\x6a\x1c\x36\x01\xf0\x0d\xb9\x77\xf7\x77\x3f\xfd\xf0\x70\x36\x01\x90\x13\x9b\x60\x1b\xf5\xf2\x68\x05\x58\x06\x29\x09\x3a\x69\x28\x7c\x88\xaf\x83\x2d\xf0\xee\xfe\x6e\x82\xd0\x04\xdf\x50\x90\x89\xe7\xba\xff\x93\x0c\x39\x19\x3f\xdb\xf3\xb5\x2a\xd6\xc9\x41\xae\xc9\x41\xdd\xe6\xbd\xa1\x94\xf7\xd6\x80\x2f\x40\x4a\xcb\x10\xa8\x09\xc4\xe4\xba\x74\x39\x83\xf6\x05\xa0\x03\x9f\xfd\x87\x8c\x6c\xe1\x81\x82\xc2\x00\x97\xbe\xad\x72\x30\xde\xed\x29\x08\x04\x32\x7e\xe7\xec\x7f\x47\x6c\x06\xf1\x71\xd3\x0a\x85\xfa\x08\x1e\x1f\xeb\x84\x82\xc3\x0a\xf6\x58\xb5\xf4\x06\xd0\xe5\x50\xe3\x01\x02\xe9\x2e\xd0\xba\x09\x5e\x14\xe1\x2d\x7c\xf6\x81\xc0\xba\xc2\xa7\x50\x8a\x34\x9c\xde\xdc\xec\xac\x0c\xb5\x61\x7c\x5d\xb7\xce\xca\xe1\xc6\x78\x27\xc1\x66\xad\xf8\xc0\x37\x39\xed\xa9\xba\x61\xbb\xdb\x60\x30\xa5\x15\x32\xd2\x06\xba\xc1\xc6\x6e\xa2\xea\x4e\x0d\xe6\x6d\x9d\xff\x29\xf4\xd5\xc4\xaf\xcf\x74\x95\x83\x66\x0c\x4b\xb0\x6e\x77\x32\x15\x93\x77\x35\x0e\x9a\xc8\x1a\x79\xec\x97\x77\xe6\x1e\xdd\xad\x43\xea\xa3\x2f\x1f\x1e\x1e\x61\x50\x20\x86\xe4\x0c\xb6\xf3\xfe\x71\x21\x1f\x03\xa1\x6e\xb3\xae\x20\x4d\x28\xcb\x50\x04\x5f\x47\xbf\x93\xcb\x1b\x6f\x9d\xc4\x17\x53\x59\x72\xe7\x41\xe0\x36\xab\xad\x68\xf4\x7f\x6d\x89\x45\x23\xb6\x85\xdb\x48\x1b\x90\x11\xb4\x4d\x8e\x42\xf9\x16\xee\x1c\xdc\x62\x4d\xd5\x2d\x32\xfd\xe1\x61\x50\x6f\xf3\x46\x5d\x7b\x7d\x20\xa6\xac\x37\xfd\xa7\x58\x69\x9f\xb9\x27\x53\x03\x91\x2d\x46\xae\xaf\xda\x87\x86\xcc\x49\x05\xe5\xc4\x36\x68\x8e\x0b\x0a\x69\xf9\xf4\x82\x67\x58\x4b\xf5\xab\x8f\xf1\xce\x91\x91\x99\x1a\xfe\x4a\x8b\xdb\x51\x74\x12\xf9\xa8\xc7\x7b\x64\xca\x3c\x86\xfc\x33\x3a\xdc\x51\x4d\x4e\x46\xe0\xbd\x95\xc3\x0c\xb2\x16\x5d\xe1\x43\x1d\x4b\x7c\x3b\x23\xb0\xa6\xb4\x3e\xd8\x4a\xf9\x40\x26\x90\x7c\xa1\x62\x5e\xe4\x4c\xff\x77\xd3\x15\x23\x03\x0e\x03\x14\xc8\x19\x02\x29\x31\xea\x2e\x68\x1d\x2f\xa0\x76\x9b\x6b\x96\x98\xa8\xfe\xd4\x14\x8d\xc2\x82\x4b\xb6\xf0\x38\x6e\xb8\x88\x5c\xb7\x3c\xee\x0f\x2d\x2b\x2d\xd5\x14\x09\xa9\x41\xe6\x67\x1f\x72\x78\xa2\x03\xcf\x79\xec\x1a\xaf\xe9\xa3\x88\xcb\xb3\x67\x4e\xd3\xd6\xa4\xbe\x6a\x9d\xfd\xb5\x25\x78\xb6\x52\x5a\x07\x18\xbb\x52\x6c\x59\x4a\xac\x61\x70\xdf\x0a\x2a\x00\x02\x47\xdb\x47\x6a\x59\x32\x62\xb5\xba\xa6\xcf\xa8\xc4\x8b\xcc\x89\x2b\x4e\xea\xa8\x1b\xe9\x6d\x7b\x2e\xad\x29\x75\x78\x05\x13\x06\x53\x54\x83\x2e\x66\x4a\x51\xd1\x47\xdf\x69\xd5\x22\x4b\x0c\x4f\xe9\x59\xd2\xe4\x0a\x5b\xff\xee\x59\x86\x34\xd7\x45\x70\x77\x0f\x98\xe7\x81\x98\xc1\x87\x38\x14\xd5\x5f\xc9\xd8\x85\x6d\x6a\xeb\x3e\x91\xdb\x49\x99\xc2\xdb\x64\x66\xfe\x1a\x4b\xad\x63\x32\x6d\xa0\xc7\x4f\x0f\x57\x59\x73\x77\x94\x8f\x84\x69\x0b\xab\xd1\x0b\x2d\x0b\xe5\xf0\xf8\xe9\x61\x42\x65\x8b\xe5\xd1\x39\x37\xf3\xbe\x22\x74\xc9\x6c\x01\xf9\xb0\xe2\xdd\x02\xdb\x4a\x52\xf8\xcb\x9f\x7f\xb8\x46\xe5\x7b\x1f\xc6\x00\x34\xfa\xb7\x6b\xeb\x8c\x42\x3c\x6e\x0d\xca\xba\x5d\xac\xa9\x97\x46\xa0\x33\x44\xcf\x2d\x3b\x0a\x33\x32\xda\x44\xb5\x35\xcc\x9b\xb2\x39\x65\xcf\x05\x19\x4d\x90\x85\xa9\x49\xf0\x16\x24\xd4\xde\xe4\x85\xd9\xad\x27\xfd\x34\xb9\xe0\x54\x3d\x84\x9f\x15\x6f\x4c\x06\x03\x18\x63\xaf\x7c\x94\x11\x34\x14\xb4\xbd\x50\x3e\xe7\xc1\x1a\x7f\xbb\x3f\x12\x25\xbc\xfd\x86\xf6\xe3\x1d\xe9\x37\xcb\x7b\xef\xe5\x47\xda\x5b\x43\xef\x16\x9b\xe8\x57\x26\xfc\x6b\x7e\xed\xb4\xab\x22\x64\x43\x32\x40\x3d\x66\xc3\x02\x38\x80\x77\x04\x62\x6b\x02\x26\x81\xcc\x7b\x81\x3c\x2a\xa5\xdf\x05\x61\xb1\xc5\x5e\xdb\x30\x3a\xac\xe5\xf9\x33\xf3\x3a\x9b\x26\xc7\xf8\x8e\x82\x26\x6a\xf1\x1b\xb0\x0e\x7c\xc8\x29\xac\x80\x42\x2c\x14\x26\x89\x55\x32\x9a\xa8\x38\x5b\xb8\x6d\x43\x20\x27\xd5\x01\xbc\xab\x0e\x71\x83\xc2\x06\x1e\x76\x58\x85\xb5\x2e\xca\x73\xa5\x1e\xd2\xde\xc6\x94\x6b\xda\xa8\xf3\x4e\xb7\x59\x81\xb1\x42\xf5\x8a\xcf\xbe\xf2\xca\x31\xda\x53\xc7\x9c\xc4\xea\xc5\x3c\x7c\x25\xd5\x4e\xc5\x30\x04\x9c\x3f\x8f\xe9\x43\x85\x55\x3d\xd7\x43\xdd\x93\x60\x81\x15\x53\x72\xa5\xf5\x1f\x3e\xde\x29\xf0\x94\xb9\x3d\x7c\xf8\x78\x17\xdd\x7c\xfc\xfe\x1c\x4c\x5f\x81\x85\x49\x3d\x6c\xe1\xdf\x25\x39\x6d\x02\xf4\x06\xc8\x61\x56\x11\x83\x8f\x5b\xf2\xdf\xa8\xb0\x11\xfc\xb9\xb4\x55\x2c\x0c\x59\xf7\x0f\x9c\x67\xe9\x16\xee\x8a\xce\xca\x37\xe0\xfc\x80\x0b\x18\x28\x9e\xc5\xe6\xa9\xe5\xfa\x4e\x73\x89\xa4\xf5\xd9\xf4\xaa\x7c\xeb\x59\xa1\xf1\xcf\x14\x5e\xc0\x4c\xf7\x47\xf9\xcb\x6c\xd4\xa1\x2f\xc0\xc2\x65\xea\x21\xd7\xd6\x6b\xa6\xbf\xf2\xee\xd5\xea\x74\x51\xac\xcd\xb3\x2f\x64\x6d\x5a\x50\xda\xe5\x13\xfe\x06\xcc\xc1\x54\xcb\xf9\xbd\xd1\x53\x2c\xc9\xb7\x9d\x7e\x56\xe2\xb6\x9c\x13\x1b\xe8\x2f\x8d\xae\x42\xea\xcc\x4b\x93\x95\x60\x0f\x5f\x95\x51\xf2\xa4\xa5\xfa\x8c\xf5\x5e\xe5\x3b\x3f\x2c\xeb\xa6\xa2\xe1\x6e\x2f\x4d\x2e\x64\xde\xed\x89\xf8\x34\xf9\x22\x1b\x3f\xc7\x3a\x2f\x29\xfa\x00\x9e\x91\x07\xfc\xa5\x2a\xd4\x4f\xad\xa3\x0a\x5d\x73\xb4\xdc\x35\x0b\xe5\xf9\x53\x40\xbd\xb1\xe4\x92\x18\xb8\x35\x86\x98\x8b\xb6\xaa\x0e\x73\xc0\x7a\xa6\x40\x49\x41\xef\x22\x36\x0a\x9a\xbc\x30\xf8\xc6\xbb\xdc\x4e\x6e\x41\x57\x5d\x32\x88\x9e\xb8\x63\xbc\xc4\x02\xdc\xa3\xad\x94\xf4\xfa\x80\xc5\x6a\x9b\x4f\xe9\xe9\xad\xd9\x6b\x06\xd3\x75\xcf\x2e\xbe\xdb\xe4\x45\xdd\xed\xf2\x89\xa1\x26\x66\xdc\xad\x1c\x18\x4e\xcc\xfc\xdc\x49\x4f\x6d\x2c\xdb\x1a\x1d\x04\xc2\x3c\x5a\xd7\xe3\x81\x75\x79\xfc\xe2\x9e\xad\xa9\xe1\xc9\x49\xd0\x56\x0c\x98\xf9\x56\xa0\x42\x16\x90\x80\x8e\xed\x1a\x15\x5d\x08\xda\x5a\x49\x2d\x58\xd5\x57\x55\x7f\xfa\xef\x96\x8e\x1d\xbe\xbf\x84\x1d\x73\x61\x59\x2d\xd0\x1b\x30\xbd\xfe\x7a\x0c\x2d\xe9\x57\xdb\x47\xed\x47\xdf\x65\x46\x14\xb9\xce\x88\xc7\x43\x43\xdf\xa0\xf4\x45\x25\x2e\x35\xbe\x0b\xec\xbc\x89\xd1\x9a\x9d\x5c\xe4\xc3\xcb\x67\x20\x16\x0c\x72\x15\x57\x3d\x0c\x92\xcb\x34\x35\x78\x2b\x82\x52\xae\x4c\xa9\xbc\x62\xdd\xee\x0f\x60\x95\x05\xa3\x67\x86\x95\x25\x28\x4f\xe3\x89\x69\x18\x12\x1f\xb4\x58\x4f\xc6\xda\x6c\xb8\xa3\x99\xe4\x7b\x9f\xff\xfa\xc3\xcd\xb1\x14\xd0\x18\x6a\x84\xf2\xc9\x8f\x1e\x7a\x5b\x9a\xc2\xab\x57\x27\x3f\x93\xc4\xd7\x31\x77\x38\x85\x9f\x7f\xd1\xdf\x3f\xc4\x07\xca\xfb\x1f\x07\x38\x85\x9f\x7f\x49\x7e\x1f\x00\xae\x71\xb0\x44\x7b\x1a\x00\x00")
 
 func chartSeederCrdTemplatesBmcTinkerbellOrg_bmctasksYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -123,12 +131,12 @@ func chartSeederCrdTemplatesBmcTinkerbellOrg_bmctasksYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "chart/seeder-crd/templates/bmc.tinkerbell.org_bmctasks.yaml", size: 6779, mode: os.FileMode(420), modTime: time.Unix(1773987736, 0)}
+	info := bindataFileInfo{name: "chart/seeder-crd/templates/bmc.tinkerbell.org_bmctasks.yaml", size: 6779, mode: os.FileMode(436), modTime: time.Unix(1782200728, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _chartSeederCrdTemplatesBmcTinkerbellOrg_jobsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x5a\xcd\x72\xdb\x38\x12\xbe\xfb\x29\xba\x3c\x07\xef\x54\x59\x52\x25\x7b\xd9\xd2\x2d\xeb\x4c\xb6\x9c\x9d\x24\xae\xd8\x93\x4b\x2a\x87\x26\xd8\x14\x11\x83\x00\x07\x68\x4a\xd6\xa6\xf2\xee\x5b\x0d\x90\x12\x25\x91\x92\xe2\xdd\x99\xe1\x25\x21\x00\x36\xfa\xf7\xeb\x0f\x90\x27\x93\xc9\x05\xd6\xfa\x13\xf9\xa0\x9d\x9d\x03\xd6\x9a\x9e\x98\xac\xbc\x85\xe9\xe3\x3f\xc2\x54\xbb\xd9\xf2\xc5\xc5\xa3\xb6\xf9\x1c\x6e\x9a\xc0\xae\xfa\x48\xc1\x35\x5e\xd1\x6b\x2a\xb4\xd5\xac\x9d\xbd\xa8\x88\x31\x47\xc6\xf9\x05\x00\x5a\xeb\x18\x65\x38\xc8\x2b\xc0\xb7\xef\x17\x00\xca\x53\x1c\x7b\xd0\x15\x05\xc6\xaa\x9e\x83\x6d\x8c\xb9\x00\xb0\x58\xd1\x1c\xbe\xba\x2c\x4c\xb3\x4a\x4d\x59\xdb\x47\xf2\x19\x19\x33\x75\x7e\x71\x11\x6a\x52\x22\x66\xe1\x5d\x53\xcf\x61\x60\x45\x92\xd0\xee\xa5\x90\x69\xe1\xbc\xee\xde\x27\xb0\x5d\x1d\x07\x92\x21\x6f\x5d\x16\xdf\x8c\x0e\xfc\xef\x6e\xe4\x57\x1d\x38\x8e\xd6\xa6\xf1\x68\x92\x4e\x71\x20\x94\xce\xf3\xfb\xed\x2e\x13\xf8\x9a\xc6\xb5\x5d\x34\x06\x7d\x5c\x7a\x01\x10\x94\xab\x69\x0e\x71\x65\x8d\x8a\xf2\x0b\x80\x65\x72\x6d\xfc\x72\xd2\x1a\xbb\x7c\x81\xa6\x2e\xf1\x45\x12\xa2\x4a\xaa\x30\x09\x06\x70\x35\xd9\x57\x77\xb7\x9f\xfe\x7e\xbf\x33\x0c\x90\x53\x50\x5e\xd7\x1c\xc3\xf4\xd6\x65\xa0\x03\x70\x49\x90\xd6\x41\xe1\x7c\x7c\xcd\x2a\x25\x6a\xc3\xab\xbb\xdb\xcd\xa7\xb5\x77\x35\x79\xde\x38\x25\x3d\xbd\xb0\xf7\x46\xf7\x36\xba\x12\x5d\xd2\x2a\xc8\x25\xde\x94\x76\x6d\xad\xa2\xbc\x55\x1f\x5c\x01\x5c\xea\x00\x9e\x6a\x4f\x81\x6c\xca\x80\x1d\xc1\x20\x8b\xd0\x82\xcb\xbe\x92\xe2\x29\xdc\x93\x17\x31\xe2\xdd\xc6\xe4\xa0\x9c\x5d\x92\x67\xf0\xa4\xdc\xc2\xea\xff\x6c\x64\x07\x60\x17\x37\x35\xc8\xd4\x86\x68\xfb\x68\xcb\xe4\x2d\x1a\x58\xa2\x69\xe8\x1a\xd0\xe6\x50\xe1\x1a\x3c\xc9\x2e\xd0\xd8\x9e\xbc\xb8\x24\x4c\xe1\x9d\xf3\x04\xda\x16\x6e\x0e\x25\x73\x1d\xe6\xb3\xd9\x42\x73\x97\xee\xca\x55\x55\x63\x35\xaf\x67\xca\x59\xf6\x3a\x6b\xd8\xf9\x30\xcb\x69\x49\x66\x16\xf4\x62\x82\x5e\x95\x9a\x49\x71\xe3\x69\x86\xb5\x9e\x44\xd5\x6d\x4c\xf9\x69\x95\xff\xe4\xdb\x02\x09\x57\x3b\xba\xf2\x5a\x92\x23\xb0\xd7\x76\xd1\x9b\x88\x19\x79\x24\x02\x92\x9f\x12\x6c\x6c\x3f\x4d\x56\x6c\x1d\x2d\x43\xe2\x9d\x8f\xbf\xdc\x3f\x40\xb7\x75\x0c\xc6\xbe\xf7\xa3\xdf\xb7\x1f\x86\x6d\x08\xc4\x61\xda\x16\xe4\x53\x10\x0b\xef\xaa\x28\x93\x6c\x5e\x3b\x6d\x39\xbe\x28\xa3\xc9\xee\xbb\x3f\x34\x59\xa5\x59\xe2\xfe\x7b\x43\x81\x25\x56\x53\xb8\x89\x18\x00\x19\x41\x53\xe7\xc8\x94\x4f\xe1\xd6\xc2\x0d\x56\x64\x6e\x30\xd0\x1f\x1e\x00\xf1\x74\x98\x88\x63\xcf\x0b\x41\x1f\xbe\xf6\x17\x27\xaf\xf5\x26\x3a\x44\x1a\x89\xd7\x5b\x97\xdd\xd7\xa4\x76\xaa\x25\xa7\xa0\xbd\xe4\x33\x23\x93\x54\x41\x87\x3f\xdd\x33\x5c\xa1\x51\x35\x54\xa5\xb6\xf4\x91\x8a\xfd\x99\xbd\x7d\xdf\x6d\x16\xf6\x22\x1c\x77\x6f\x67\x7a\xb9\xe1\x80\x9e\x48\x35\x4c\x07\x22\x21\x7e\xf1\xd5\x65\x53\x78\x65\x4c\x7c\x61\x0c\x8f\x01\xb4\xed\x66\x00\x3d\x75\xdf\xe7\x1b\xdc\x09\x58\x6d\x76\x9a\x1e\x88\x1d\xb7\x0f\x8e\x20\xd1\xa0\x9d\xaf\xee\x6e\x3b\xf4\x49\xa0\x23\x86\x15\xe4\xc9\xf2\xe1\xbe\x30\x1e\xf3\xee\x29\x34\x99\xfc\x0e\xb9\x3c\x63\xef\xab\xdb\x22\x6d\x16\xeb\x90\x1d\x20\xd4\x9a\x14\xed\x00\x1b\x68\x1b\x98\x30\x07\x57\x0c\x4a\x94\x0e\x09\x92\xac\x9e\xda\x2f\xae\x53\xd5\xb5\xe5\xbd\x85\x43\x46\x6d\x01\xa5\xde\x75\x0e\x6f\xef\x3f\xbc\x9f\xfd\xcb\x8d\x88\x8c\x56\x00\x2a\x45\x21\xa4\x34\xab\xc8\xf2\x35\x84\x46\x95\x80\xa1\xcb\xc0\x7b\x99\x99\x56\x68\x75\x41\x81\xa7\xed\x1e\xe4\xc3\xe7\x97\x5f\x86\xbd\x07\xf0\xc6\x79\xa0\x27\xac\x6a\x43\xd7\xa0\x93\xc7\x37\x50\x12\x1d\xaf\x28\x36\x23\x71\xc7\x46\x22\xac\x34\x97\x7a\xbf\x01\x6c\x3c\x00\xb5\xcb\x5b\xb3\x57\xd1\x5c\xc6\x47\x02\xd7\x9a\xdb\x10\x18\xfd\x48\x73\xb8\x94\x62\xeb\xa9\xf9\x4d\xfa\xe7\xf7\xcb\x11\xa9\x7f\x5b\x95\xe4\x09\x2e\x65\xd1\x65\x52\x6e\xd3\x3b\x64\xac\xcb\x97\xad\x92\x5c\x22\x03\x7b\xbd\x58\x90\x8f\xdd\x7a\xe8\x89\x40\x28\xf0\xf2\x33\x38\x2f\x1e\xb0\xae\x27\x22\x0a\x96\xe8\xd5\xa4\x74\xa1\x29\x3f\x50\xfa\xf3\xcb\x2f\xa3\x1a\xef\xfa\x0b\xb4\xcd\xe9\x09\x5e\xa6\x6a\xd3\x41\xbc\xf4\xf3\x14\x1e\x62\x76\xac\x2d\xe3\x93\xec\xa4\x4a\x17\x68\xcc\xb3\xce\x9a\xb5\xd8\x5c\xe2\x92\x20\xb8\x8a\x60\x45\xc6\x4c\x12\x1a\xe5\xb0\xc2\xb5\x78\xa1\x0b\x9c\xe4\x1b\x42\x8d\x9e\x8f\x66\x6b\xd7\xb1\x1f\x3e\xbc\xfe\x30\x4f\x9a\x49\x42\x2d\xac\xa8\x23\x48\x5f\x68\xe9\xc0\xd2\x7a\x53\xff\x88\xd9\x78\xd0\x80\xba\x27\x34\x29\x7d\xd8\x81\x2a\xd1\x2e\xa8\x43\x97\xa2\x11\x44\x9f\x5e\x3d\xa7\x8e\x0f\xdb\x68\xf7\x0c\xb4\xd3\x7d\xe0\xf8\xcb\x1a\xd2\x99\xc6\x45\xda\x78\x86\x71\xef\x7b\x59\x7e\xd4\xb8\xc7\x26\x23\x6f\x89\x29\xda\x97\x3b\x15\xc4\x34\x45\x35\x87\x99\x5b\x92\x5f\x6a\x5a\xcd\x56\xce\x3f\x6a\xbb\x98\x48\x6a\x4e\x52\x0e\x84\x59\x24\xdb\xb3\x9f\xe2\x3f\xcf\xb6\x25\xb2\xe3\x73\x0d\x8a\x8b\xff\x0c\xab\x64\x9f\x30\x7b\x96\x51\x5d\x6f\x3d\xbf\x8f\x5d\xdd\x27\xc0\x50\xfb\xdf\x4a\x59\xac\x4a\xad\xca\x8e\x50\xb7\x18\x3b\x52\x4c\x5a\xd8\x5b\x9e\xa0\x19\xed\xfa\x0f\x4f\x65\x71\x68\xe3\x45\xa3\xf5\x24\x8a\x70\x66\x82\x36\x97\xff\x07\x1d\x58\xc6\x9f\xe5\xc1\x46\x9f\x55\xbe\xbf\xdd\xbe\xfe\x73\x12\xbc\xd1\xcf\xaa\xd5\x11\xd2\x18\xa7\x84\x48\x9d\x60\x71\x0f\x91\x6c\xf5\x08\x1c\xc6\x33\xaa\x98\x9c\x61\xa0\xcc\xa1\x97\xe3\x8d\xc5\x45\x6c\xf0\x80\x2a\x06\x66\x88\xc7\x39\xe1\xdf\x1d\x4f\x93\x26\xd2\x51\xb9\x1d\xfe\x16\x84\xb7\x5b\xd6\x68\xcc\x7a\x0a\x37\x29\xa0\x86\xfc\x80\xc4\x15\x0a\xd1\x17\xc2\xe7\x6c\x92\x15\x11\xdc\x09\x33\x60\x82\x8c\x0a\xb7\x91\xdc\x1d\x4a\x2c\x3d\xf1\x14\x6e\x0b\x39\xbd\x68\xbb\x30\x83\x8c\x53\x24\x15\xa8\x4d\xb8\x8e\xfc\xb2\x95\xe0\x2c\x04\x76\x75\x88\x6d\x25\x10\x07\x69\xba\x79\xbc\x6f\x80\x37\xa8\x8d\x18\x75\xd3\x8d\x0c\x88\xbd\x69\x15\x8b\x87\xa7\x40\x9c\x5a\xa3\x54\xc9\x0e\xb1\x5d\x09\x65\x08\x4d\x64\x4e\x45\x63\x0e\x59\x90\x66\xaa\x06\x69\xeb\x2e\x2d\x8d\x81\xd8\xa7\xde\x29\x3c\x6d\x2c\x6a\xf2\x85\xf3\x95\xe8\xfd\x6a\xdc\x1b\xad\x3f\x94\xb4\x5d\x51\xb8\xfd\x2a\xf9\x7c\x5d\x27\xae\x19\xc5\x4e\xfb\xd4\x0c\x48\x73\x49\x1e\xee\xdc\x8a\x7c\x52\x66\x50\xb8\xf3\xf0\xc1\xd2\x83\xae\xe8\x9f\xce\xf1\x6b\x5a\x6a\x45\x69\xf9\x10\xff\xab\xf0\xe9\x6e\x4b\xdd\xe1\xc5\xc0\x92\xe3\xd4\x5e\x08\xc9\xe0\x6e\xc3\x8b\xf7\x9c\x3a\xa2\xe9\x6e\x7d\x6c\xea\x62\x44\x22\xf4\xeb\x25\x7a\x51\x57\x14\x13\x22\x73\x8e\x21\x8f\x82\x41\x8c\xc0\x31\x37\x9c\x63\x67\x52\x5e\x64\x8d\xcf\xef\x99\x97\x6c\x0a\xfb\x49\xd3\x53\x2b\x5c\x0b\x35\x72\x3e\x1f\x2c\xc8\xed\x23\x45\x19\x88\x63\xd9\x6d\x4c\x14\x39\x53\xb8\x89\x60\xcd\x66\xdd\x52\x43\xa1\x59\xda\x87\x6e\x87\xa3\x62\x5b\x5a\x16\x8c\x4e\x14\xbf\x09\x94\x4b\x32\xa7\x6a\xea\x6f\x73\x44\xcc\x68\xfd\x8c\x78\x65\x1b\xed\xbe\x63\x76\x62\x15\xf1\xff\xa8\x44\x18\x3f\x8b\xf6\x9f\x13\x3d\x69\x77\x19\x7a\x8f\xeb\xd1\x55\x54\x68\xd1\xfc\xec\xe0\xff\xf2\xe6\x56\xd6\xc7\xd3\xa2\x6f\x54\x1b\xfb\xf6\xb8\x2f\x4e\x6e\x02\xc9\xa2\x93\xfe\x4d\xca\x65\xce\x19\xc2\xb1\x53\x81\xa7\xdf\x1b\x39\xff\x8d\x69\x37\x39\x9e\x0d\x47\x9a\x5a\x7a\xea\x2d\xea\x9c\x55\xd8\x3d\x94\x1a\x29\xe6\x7e\xd1\x46\xe9\xa3\x1e\x38\x59\xb9\x64\x9b\x6a\xdc\xf0\x4b\x67\xc7\x4e\x67\x32\x59\x14\xe3\xb3\xc1\x15\xc3\xee\x88\x93\x8c\xdc\x8c\x9d\x80\x26\xa0\xd6\x6a\x04\xfe\x65\x56\x1c\x32\x26\xfa\x64\xce\x2e\xb5\xe7\x06\xcd\x3b\xca\x35\xfe\x40\x4c\x3e\x1d\x7c\x76\x3a\x34\xa3\x41\x69\x75\x80\x4a\xa4\x49\x8e\x93\xe7\x59\xbc\x91\xfd\x5f\xf0\x75\xfc\x8c\x77\xa6\x6b\x20\x5e\xf6\xe5\x1a\x7f\xfb\xf8\xeb\xd9\x95\xda\x7d\xb0\x8f\xd3\x32\xd4\x52\x51\x5d\xe1\x42\x8a\xf6\x28\xd8\x64\xd4\x3a\x42\x28\x89\x6d\xef\x25\x76\x1c\x75\x2d\xed\x99\xaa\x9a\xe3\x11\x9e\x46\xcb\x6d\xc7\x94\xd3\xd8\x70\xd4\x21\xa7\xa1\x41\xbc\xfe\x3c\x60\x38\x3a\x5d\x69\x7b\x1b\x5b\xc3\x00\xad\x18\x03\xdc\x61\x65\x27\xbd\x5b\xd2\xbd\x89\xc8\xf1\xce\xba\xd5\x8d\xe5\x7a\xe2\x5e\x37\xae\xd9\xb9\xd9\x75\x59\x20\xbf\x7c\xde\xd5\x6e\xcb\x9c\xdb\x1f\xc5\x4e\x1c\x0c\x6e\x76\x16\xef\xa4\xa2\xbc\xaf\x4a\xda\x5e\xd0\xae\x30\x6c\x68\x79\x3e\x94\x1e\x0f\xf1\x12\xac\x13\x98\x24\xe8\x90\x08\x82\xf4\xf6\x1d\x69\x85\xb6\x3a\x94\x14\x7a\x24\xd9\xac\x0f\xa5\x0a\x49\x45\x9e\x43\x8e\x4c\x13\x91\x38\x12\xd4\xc1\x64\xdc\x30\xfb\x53\xe7\xa3\x0d\xe1\x3f\x60\x4d\xe9\xc7\x21\xc0\x25\x6a\x83\x99\xe9\x42\x83\x63\xe7\xa3\xfe\x95\xed\x55\x80\x74\xa6\xe5\x14\xc7\x1f\x38\x04\x9c\x82\xad\x8a\x42\xc0\xc5\x28\x27\xdc\xbd\xc2\x4f\x6b\xfb\x96\x95\x4d\x85\x82\xc3\x98\x47\x9b\x5a\x69\xa0\x6d\xae\x15\xf2\x31\x98\xcb\x89\xe5\x48\x05\x98\xb9\x86\xc1\x60\x60\x60\x8f\x36\xe8\x63\xad\xf2\x24\x5e\x0c\x95\xc9\x88\x35\x6d\xb5\xb4\x3f\x53\xa6\x0f\x3b\xbc\x7c\xeb\xb2\x6d\xcc\xe3\xef\x45\xa3\x76\x64\x04\x0f\xbe\x21\x41\xc6\x37\x68\xc2\x28\xa1\x3b\xa9\x7a\x5c\x70\x8e\xe2\x0f\xed\x29\xeb\x50\xd1\xe7\x6d\x7d\x0c\x61\x8f\xf0\x84\x49\x94\xfb\xa3\xa8\x3a\x4e\x55\x03\xa3\xe7\x33\x90\xe6\xbe\x5b\x37\x0e\x32\xad\x57\xda\xab\x82\x24\x79\xf0\xfa\xbc\xf6\x4e\x20\x23\x5d\x33\x7f\x75\xd9\xff\x15\x36\x06\xfd\x70\x30\x98\xf0\x79\x0e\xec\x9b\x24\x3b\xb0\xf3\x52\x91\xbd\x91\x26\xdb\xfc\x64\xdb\x39\xa7\x4d\x74\xf8\xf6\xfd\x62\x9b\xf3\xa8\x14\xd5\x4c\x79\xef\x4f\x01\xd2\xdf\x13\x5c\x26\x92\xd8\xfd\xe1\x40\x7c\xed\x61\x1a\x7c\xfe\x72\x91\x36\xa6\xfc\x53\xf7\xf7\x00\x32\xf8\xdf\x00\x00\x00\xff\xff\x7f\xee\xde\x29\x7f\x21\x00\x00")
+var _chartSeederCrdTemplatesBmcTinkerbellOrg_jobsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x5a\x4b\x6f\xdb\x48\x12\xbe\xeb\x57\x14\x3c\x07\xef\x00\x96\x84\x64\x2f\x0b\xdd\xbc\x4e\xb2\x70\x36\x0f\xc3\x76\x72\x09\x72\x28\x91\x45\xb1\x63\xb2\x9b\xd3\x55\x94\xac\x1d\xcc\x7f\x5f\x54\x37\x29\x51\x32\x49\x6b\x3c\x3b\xb3\x96\x80\x84\xfd\xf8\xba\xea\xab\x47\x57\x37\x35\x9d\x4e\x27\x58\x99\xaf\xe4\xd9\x38\xbb\x00\xac\x0c\x3d\x0a\x59\x7d\xe2\xd9\xc3\x3f\x78\x66\xdc\x7c\xfd\x6a\xf2\x60\x6c\xba\x80\xab\x9a\xc5\x95\xb7\xc4\xae\xf6\x09\xbd\xa1\xcc\x58\x23\xc6\xd9\x49\x49\x82\x29\x0a\x2e\x26\x00\x68\xad\x13\xd4\x66\xd6\x47\x80\x5f\x7f\x9b\x00\x24\x9e\x42\xdb\xbd\x29\x89\x05\xcb\x6a\x01\xb6\x2e\x8a\x09\x80\xc5\x92\x16\xf0\xc3\x2d\x79\xb6\x2c\x93\x99\x18\xfb\x40\x7e\x49\x45\x31\x73\x7e\x35\xe1\x8a\x12\x85\x59\x79\x57\x57\x0b\xe8\x19\x11\x11\x9a\xb5\x12\x14\x5a\x39\x6f\xda\xe7\x29\xec\x47\x87\x01\x51\x91\xf7\x6e\x19\x9e\x0a\xc3\xf2\xef\xb6\xe5\x83\x61\x09\xad\x55\x51\x7b\x2c\xa2\x4c\xa1\x81\x73\xe7\xe5\xd3\x7e\x95\x29\xfc\x88\xed\xc6\xae\xea\x02\x7d\x18\x3a\x01\xe0\xc4\x55\xb4\x80\x30\xb2\xc2\x84\xd2\x09\xc0\x3a\x52\x1b\xe4\x99\x36\xca\xae\x5f\x61\x51\xe5\xf8\x2a\x82\x24\x39\x95\x81\x39\x7d\x72\x15\xd9\xcb\x9b\xeb\xaf\x7f\xbf\x3b\x68\x06\x48\x89\x13\x6f\x2a\xe5\x30\xc8\x0f\x86\x41\x72\x82\x38\x0e\x32\xe7\xc3\xe3\xb2\x4c\x54\x6c\xb8\xbc\xb9\xde\x4d\xad\xbc\xab\xc8\xcb\x8e\x94\xf8\xed\x98\xbd\xd3\x7a\xb4\xd0\xb9\xca\x12\x47\x41\xaa\xf6\xa6\xb8\x6a\xa3\x15\xa5\x8d\xf8\xe0\x32\x90\xdc\x30\x78\xaa\x3c\x31\xd9\xe8\x01\x07\xc0\xa0\x83\xd0\x82\x5b\xfe\xa0\x44\x66\x70\x47\x5e\x61\x80\x73\x57\x17\x29\x24\xce\xae\xc9\x0b\x78\x4a\xdc\xca\x9a\xff\xec\xb0\x19\xc4\x85\x45\x0b\x14\x6a\x4c\xb4\xff\x18\x2b\xe4\x2d\x16\xb0\xc6\xa2\xa6\x0b\x40\x9b\x42\x89\x5b\xf0\xa4\xab\x40\x6d\x3b\x78\x61\x08\xcf\xe0\xa3\xf3\x04\xc6\x66\x6e\x01\xb9\x48\xc5\x8b\xf9\x7c\x65\xa4\x75\xf7\xc4\x95\x65\x6d\x8d\x6c\xe7\x89\xb3\xe2\xcd\xb2\x16\xe7\x79\x9e\xd2\x9a\x8a\x39\x9b\xd5\x14\x7d\x92\x1b\xa1\x44\x6a\x4f\x73\xac\xcc\x34\x88\x6e\x55\x61\x9e\x95\xe9\x4f\xbe\x09\x10\x3e\x3f\x90\x55\xb6\xea\x1c\x2c\xde\xd8\x55\xa7\x23\x78\xe4\x88\x05\xd4\x3f\xd5\xd8\xd8\x4c\x8d\x8a\xee\x89\xd6\x26\x65\xe7\xf6\xed\xdd\x3d\xb4\x4b\x07\x63\x1c\x80\x42\xc3\xfb\x7e\x22\xef\x4d\xa0\x84\x19\x9b\x91\xfa\x90\x61\xc8\xbc\x2b\x03\xe3\x64\xd3\xca\x19\x2b\xe1\x21\x29\x0c\xd9\x63\xfa\xb9\x5e\x96\x46\xd4\xee\xbf\xd4\xc4\xa2\xb6\x9a\xc1\x55\xc8\x01\xb0\x24\xa8\xab\x14\x85\xd2\x19\x5c\x5b\xb8\xc2\x92\x8a\x2b\x64\xfa\xd3\x0d\xa0\x4c\xf3\x54\x89\x3d\xcd\x04\xdd\xf4\xb5\xff\x53\x94\x45\xc3\x5a\xa7\xa3\xcd\x48\x03\xf6\x7a\xef\x96\x77\x15\x25\x07\xd1\x92\x12\x1b\xaf\xfe\x2c\x28\xa4\x51\xd0\xe6\x9f\xf1\x08\xd5\x4f\x89\x49\x6e\x2c\xdd\x52\x76\xdc\x73\xb4\xee\xc7\xdd\xc0\x8e\x85\xc3\xea\x4d\x4f\xc7\x37\x1c\xd0\x23\x25\xb5\xd0\x13\x48\x08\x33\x7e\xb8\xe5\x0c\x2e\x8b\x22\x3c\x08\xf2\x03\x83\xb1\x6d\x0f\xa0\xa7\x76\x7e\xba\xcb\x3b\x8c\xe5\x6e\xa5\xd9\x13\xd8\x61\xfd\xc6\x32\x51\xaf\x9e\x97\x37\xd7\x6d\xf6\x51\x26\x55\x28\x4f\x19\x79\xb2\xf2\x74\xdd\x11\x9b\xb7\x9f\xcc\x50\x91\xde\xa0\xe4\x27\xac\x7d\x7e\x9d\xc5\xc5\x14\x4b\xb3\x12\x42\x65\x28\xa1\x83\xc4\x06\xc6\xb2\x10\xa6\xe0\xb2\x5e\x44\xdd\x21\x41\x9d\xd5\x53\x33\xe3\x22\x46\x5d\x13\xde\xfb\x74\x28\x68\x2c\xa0\xc6\xbb\x49\xe1\xfd\xdd\xe7\x4f\xf3\x7f\xb9\x01\xc8\xa0\x05\x60\x92\x10\x2b\x10\x0a\x95\x64\xe5\x02\xb8\x4e\x72\x40\x6e\x3d\xf0\x4e\x1d\x70\x56\xa2\x35\x19\xb1\xcc\x9a\x35\xc8\xf3\xb7\xd7\xdf\xfb\xd9\x03\x78\xe7\x3c\xd0\x23\x96\x55\x41\x17\x60\x22\xe3\xbb\x54\x12\x88\x4f\x48\xf3\x53\xa0\x63\x87\x08\x1b\x23\xb9\xb1\x93\x5e\x48\x40\xa8\x5c\xda\xa8\xbd\x09\xea\x0a\x3e\x10\xb8\x46\xdd\x9a\xa0\x30\x0f\xb4\x80\x33\x0d\xb6\x8e\x98\xbf\xea\xfe\xf9\xdb\xd9\x00\xea\xdf\x36\x39\x79\x82\x33\x1d\x74\x16\x85\xdb\xed\x1d\xda\xd6\xfa\xcb\x5e\x48\xc9\x51\x40\xbc\x59\xad\xc8\x87\xdd\xba\xef\xa3\x53\x68\x4d\x56\x7e\x06\xe7\x95\x01\xeb\x3a\x10\x01\xd8\x30\xa8\xa4\x26\x33\x94\x3e\x11\xfa\xdb\xeb\xef\x83\x12\xef\x71\x94\x2f\x30\x36\xa5\x47\x78\x1d\xa3\xcd\xb0\xb2\xf4\xf3\x0c\xee\x83\x77\x6c\xad\xe0\xa3\x12\x9d\xe4\x8e\x69\x88\x59\x67\x8b\xad\xea\x9c\xe3\x9a\x80\x5d\x49\xb0\xa1\xa2\x98\xc6\x6c\x94\xc2\x06\xb7\xca\x42\x6b\x38\x75\x63\x84\x0a\xbd\x8c\x7a\x6b\xbb\x63\xdf\x7f\x7e\xf3\x79\x11\xad\xa6\x0e\xb5\xb2\x2a\x8e\x66\xfa\xcc\xe8\x0e\xac\x5b\x6f\xe8\x8c\xde\x68\x78\x00\x91\xeb\x80\xa7\x62\x26\x39\xda\x15\xb5\xd9\x25\xab\x75\x4b\x9d\x9d\x4f\x7a\x26\x3d\x17\xc7\x4f\xb7\xd1\xfe\x10\x0e\xdb\xe9\x71\xe2\xf8\xbf\x6d\x48\x27\x2a\xa7\x4e\x76\x8a\x72\x9f\x3a\x5e\x3e\xaa\xdc\x43\xbd\x24\x6f\x49\x28\xe8\x97\xba\x84\x55\xb5\x84\x2a\xe1\xb9\x5b\x93\x5f\x1b\xda\xcc\x37\xce\x3f\x18\xbb\x9a\xaa\x6b\x4e\xa3\x0f\xf0\x5c\x45\xe1\xf9\x4f\xe1\x9f\x17\xeb\x12\xaa\xe3\x53\x15\x0a\x83\xff\x0a\xad\x74\x1d\x9e\xbf\x48\xa9\x76\x6f\x3d\x7d\x1f\x3b\xd7\x2a\xc1\x64\x26\x39\x9e\xab\x61\xb1\xc9\x4d\x92\xb7\x05\x75\x93\x63\x7b\x21\x41\x23\xb0\xc4\x34\xa6\x66\xb4\xdb\x3f\xdd\x95\x95\xd0\xda\xab\x44\x5b\xf5\x72\xf1\xae\x98\xa2\x4d\xf5\xff\x6c\x58\xb4\xfd\x45\x0c\xd6\xe6\xa4\xf0\xfd\x72\xfd\xe6\xaf\x71\xf0\xda\xbc\x28\x56\x07\x8a\x46\xfd\x86\x42\x6a\x31\x19\xd5\xef\x5e\xc7\x74\x0b\x38\x0c\x67\x54\x55\x79\x89\x4c\x4b\x87\x5e\x8f\x37\x16\x57\x61\x83\x07\x4c\x94\x97\xbe\x44\x2b\x0e\x96\xfb\x3a\x4d\x37\x91\xb6\x94\x3b\xa8\xdf\x98\x7e\xa9\xd5\xba\x58\x14\xdb\x19\x5c\x45\x83\x16\xe4\x7b\x10\x37\xa8\x85\xbe\x16\x7c\xce\x46\x2c\x75\xd5\xc4\x69\x65\x20\x04\x4b\xca\xdc\x0e\xb9\x3d\x94\x58\x7a\x94\x19\x5c\x67\x80\xe1\xb0\x5c\xf4\x56\x9c\xc8\x0f\x90\xa1\x29\xf8\x22\xd4\x97\x0d\x82\xb3\xc0\xe2\x2a\x0e\x27\x3a\x26\x61\xdd\x74\xd3\x70\xdf\x00\xef\xd0\x14\xaa\xd4\x55\xdb\xd2\x03\x7b\xd5\x08\xa6\x1b\x11\x30\x49\xdc\x1a\x4d\x06\x78\x50\xd8\x6e\xb4\x64\xe0\x3a\x54\x4e\x59\x5d\x3c\xad\x82\x8c\x50\xd9\x5b\xb6\x1e\x18\xee\x32\x18\xe2\xb8\xf4\x8e\xe6\x69\x6c\x51\x91\xcf\x9c\x2f\x55\xee\xcb\x61\x36\x1a\x3e\x12\xdd\x76\x55\xe0\x66\x56\xe4\x7c\x5b\xc5\x5a\x33\xc0\xce\xba\xa5\x19\x90\x91\x9c\x3c\xdc\xb8\x0d\xf9\x28\x4c\x2f\xb8\xf3\xf0\xd9\x92\xde\xc2\xfc\xd3\x39\x79\x43\x6b\x93\x50\x1c\xde\x57\xff\x95\xf8\x78\xb3\x2f\xdd\xe1\x55\xcf\x90\xf1\xd2\x5e\x0b\x92\xde\xd5\xfa\x07\x1f\x91\x3a\x20\xe9\x61\x7c\xec\xe2\x62\x00\x11\xba\xf1\x12\x58\x34\x25\x05\x87\x58\x3a\x27\x90\x06\x60\x50\x25\x70\x88\x86\x53\xf4\xd4\x4f\xc4\x1a\xee\x3f\x52\x2f\xb2\x7f\x10\xee\xea\x34\x1d\xb1\xf8\x42\x4b\x23\xe7\x53\xf2\x23\xa0\x10\x4e\x61\x4c\x12\xc2\x6e\xa7\xa2\xe2\xcc\xe0\x2a\x24\x6b\x29\xb6\x4d\x69\xa8\x65\x96\xf1\xdc\xae\x30\x0a\xdb\x94\x65\x5c\x28\x43\x86\xa1\x66\x4a\xd5\x99\x99\x3a\x4c\x86\x65\x46\x60\x06\xe3\x67\x80\x95\xbd\xb5\xbb\xc4\x1c\xd8\x2a\xe4\xff\x51\x44\x18\x3e\x8b\x9e\x9c\xca\xf7\x9f\x38\x0c\xbd\xc7\xed\xe0\x28\xca\x8c\x4a\x7e\xb2\xf1\xdf\xbe\xbb\xd6\xf1\xe1\xb4\xe8\xeb\xa4\xb1\x7d\x73\xdc\x57\x92\x6b\x26\x78\xfb\xee\xfa\x59\x7e\xa3\x70\x4b\xe7\x0a\xc2\xfe\x98\x87\x70\x3b\xa3\xe7\xbf\x21\xe9\xa6\xe3\xde\x30\xb2\xa9\x35\xc1\xb1\xcf\x3a\x8b\xc9\x09\xca\x77\xb2\xd4\x40\x30\x77\x83\xb6\xd2\xd1\x03\xb0\xf0\x7c\xe4\x92\xad\xcb\x61\xc5\xcf\x9c\x1d\x3a\x9d\x69\x67\x96\x0d\xf7\xb2\xcb\xfa\xe9\x08\x9d\x82\x52\x0f\x9d\x80\xa6\x90\x6c\x93\x81\xf4\xaf\xbd\x4a\x88\x4c\x5e\xe8\xb3\x6b\xe3\xa5\xc6\xe2\x23\xa5\x06\x7f\x87\x4d\xbe\x3e\x99\xf6\xbc\x69\x06\x70\x77\x32\x40\xa9\x42\xa8\x8f\x93\x97\x79\xb8\x91\xfd\x23\xf9\x75\xf8\x8c\x77\x22\x35\xfa\x0d\x22\x7d\xb9\xfd\x30\x06\x74\x40\x4c\x3b\xa1\x4b\x87\xe6\xe9\x2f\xb7\x1f\x9a\x54\x04\xa6\xc4\x95\xde\xab\x8d\x40\x82\x96\x63\x91\x08\x4a\xc1\xd8\xe6\x5e\xe2\x80\xa8\x0b\xbd\x60\xa0\xb2\x92\x70\x84\xa7\xc1\x70\x6b\xff\xc2\xa4\xd9\x1f\x23\xe4\xf9\xd4\xa0\xac\xbf\x2c\x31\x8c\x76\x97\xc6\x5e\x87\xad\xa1\xa7\xac\x18\x4a\xb8\xfd\xc2\x4e\x3b\xb7\xa4\x47\x1d\x5a\x4b\xf1\xe4\x04\x99\xf4\xce\xac\x3e\x72\xbd\x03\x2f\xd0\x7b\xdd\x30\xe6\xe0\x66\xd7\x2d\x99\xfc\xfa\x65\x57\xbb\x4d\xe5\xdc\xbc\x14\x5b\x4c\x46\x9d\xf0\xea\x60\xf0\x81\x2b\x6a\x39\xb3\xc9\x69\x7f\x41\xbb\x41\xde\x95\xe5\x69\x9f\x7b\xe8\x59\x60\xbf\x7a\xdc\xc6\x0d\xc7\x02\x41\xf7\xf6\x03\x34\x7d\xc9\xc7\x39\x71\xa7\x48\x2e\xb6\x4f\x51\xb5\xb4\x45\x59\x80\xde\xf7\x4f\x15\x71\xf2\x3b\x9c\x71\x57\xd9\xf3\xb3\x34\xb4\x03\x8f\xa3\x31\xbe\x1c\x02\x5c\xa3\x29\x70\x59\xb4\xa6\xc1\xa1\xf3\x51\xf7\xca\xf6\x9c\x21\x89\x65\x52\xb4\xe3\x6c\x72\x72\x11\xf3\x5c\xda\x2a\x89\x19\x57\x83\x35\xe1\x81\x72\x1f\xe3\xd8\xae\x66\x79\x5d\xa2\x05\x4f\x98\x06\x9d\x1a\x34\xbd\x27\x34\x09\xca\x70\x54\xab\xf7\x88\x1e\xa9\x00\x97\xae\x16\x28\x90\x05\xc4\xa3\x65\x33\xb6\x55\x3e\x9b\x2f\xfa\xc2\x64\x40\x9b\x26\x5a\x9a\xd7\x94\x71\x62\x9b\x2f\xf5\xfd\xe5\xce\xe6\xe1\x7d\xd1\x00\x62\xc8\x9a\xf7\xbe\x26\xcd\x8c\xef\xb0\x60\x7a\xb1\xe8\x41\xb7\x53\x04\xbf\x6f\x4e\x59\x4f\x05\x7d\xd9\xd2\x63\x19\x76\xa4\x4e\x98\x06\xdc\x9e\x8e\x81\x0c\x36\x9e\x39\x41\x17\xf2\x72\x42\xa6\xb9\x6b\xc7\x0d\x27\x99\x86\x95\xe6\xaa\x20\x22\xf7\x5e\x9f\x57\xde\x69\xca\xd0\x63\x09\x6a\x32\xf9\x9f\xa6\x8d\x5e\x1e\x9e\x34\x6a\x12\xa0\x74\x01\xe2\xeb\x88\xcd\xe2\xbc\x46\x64\xa7\xa5\x5e\xb6\x77\x70\x3b\xd7\x6e\x1c\x5d\x7f\xbc\xb0\xf7\x79\x7d\xbf\x52\x09\xa5\x9d\x9f\x02\xe8\xe6\xb8\x80\xb3\xb3\x83\x1f\x0e\x84\xc7\x9d\xdb\xf0\x02\xbe\x7d\xd7\x5f\x07\x88\xf3\x94\x36\xb7\x7c\xbc\x80\x6f\xdf\x27\xff\x1d\x00\x7f\xee\xde\x29\x7f\x21\x00\x00")
 
 func chartSeederCrdTemplatesBmcTinkerbellOrg_jobsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -143,12 +151,12 @@ func chartSeederCrdTemplatesBmcTinkerbellOrg_jobsYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "chart/seeder-crd/templates/bmc.tinkerbell.org_jobs.yaml", size: 8575, mode: os.FileMode(420), modTime: time.Unix(1773987915, 0)}
+	info := bindataFileInfo{name: "chart/seeder-crd/templates/bmc.tinkerbell.org_jobs.yaml", size: 8575, mode: os.FileMode(436), modTime: time.Unix(1782200728, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _chartSeederCrdTemplatesBmcTinkerbellOrg_machinesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x3a\x5b\x6f\xdb\x38\xd6\xef\xf9\x15\x07\xf3\x3d\xe4\x5b\x60\xed\x20\x1d\xb4\x58\x18\xd8\x87\xd4\xed\xa0\xc1\x26\xad\xd1\x78\x66\xf7\x95\x16\x8f\x25\x4e\x24\x52\x43\x52\x76\x33\x45\xff\xfb\xe2\x90\xd4\xc5\xb6\x24\x53\x4d\x66\x76\x97\x2f\x89\x79\x39\x3c\xf7\x9b\x38\x9b\xcd\x2e\x58\x29\x7e\x41\x6d\x84\x92\x0b\x60\xa5\xc0\x2f\x16\x25\xfd\x32\xf3\xc7\xbf\x99\xb9\x50\x57\xbb\xeb\x8b\x47\x21\xf9\x02\x96\x95\xb1\xaa\xf8\x8c\x46\x55\x3a\xc1\x77\xb8\x15\x52\x58\xa1\xe4\x45\x81\x96\x71\x66\xd9\xe2\x02\x80\x49\xa9\x2c\xa3\x69\x43\x3f\x01\xbe\x7e\xbb\x00\x90\xac\xc0\x05\x14\x2c\xc9\x84\x44\x33\xdf\x14\xc9\xdc\x0a\xf9\x88\x7a\x83\x79\x3e\x57\x3a\xbd\x30\x25\x26\x74\x20\xd5\xaa\x2a\x17\xd0\xb3\xc3\x43\x09\x50\x13\x66\x31\x55\x5a\xd4\xbf\x67\xd0\xee\x76\x13\x1e\xe5\x7b\x7f\xa3\x9b\xc9\x85\xb1\xff\xe8\xce\xde\x09\x63\xdd\x4a\x99\x57\x9a\xe5\x2d\x7e\x6e\xd2\x08\x99\x56\x39\xd3\xcd\xf4\x05\x80\x49\x54\x89\x0b\xf8\x48\x78\x94\x2c\x41\x7e\x01\xb0\xf3\xcc\x73\x78\xcc\x02\xa1\xbb\x6b\x96\x97\x19\xbb\xf6\x80\x92\x0c\x0b\xe6\xd1\x04\x50\x25\xca\x9b\xd5\xed\x2f\x3f\x3e\x1c\x4c\x03\x70\x34\x89\x16\xa5\x75\x82\x08\x18\x82\x30\x60\x33\x04\xbf\x17\xb6\x4a\xbb\x9f\x35\x9e\x70\xb3\xba\x9d\x37\x00\x4a\xad\x4a\xd4\xb6\x61\x89\x1f\x1d\xf1\x76\x66\x8f\xae\xbb\x24\x8c\xfc\x2e\xe0\x24\x57\xf4\xf7\x06\xda\x90\x07\x22\x40\x6d\xc1\x66\xc2\x80\xc6\x52\xa3\x41\xe9\x25\x7d\x00\x18\x68\x13\x93\xa0\x36\xbf\x62\x62\xe7\xf0\x80\x9a\xc0\x80\xc9\x54\x95\x73\x48\x94\xdc\xa1\xb6\xa0\x31\x51\xa9\x14\xbf\x37\xb0\x0d\x58\xe5\x2e\xcd\x99\xc5\x20\x98\x76\x08\x69\x51\x4b\x96\xc3\x8e\xe5\x15\xfe\x15\x98\xe4\x50\xb0\x27\xd0\x48\xb7\x40\x25\x3b\xf0\xdc\x16\x33\x87\x7b\xa5\x11\x84\xdc\xaa\x05\x64\xd6\x96\x66\x71\x75\x95\x0a\x5b\xab\x75\xa2\x8a\xa2\x92\xc2\x3e\x5d\x25\x4a\x5a\x2d\x36\x95\x55\xda\x5c\x71\xdc\x61\x7e\x65\x44\x3a\x63\x3a\xc9\x84\xc5\xc4\x56\x1a\xaf\x58\x29\x66\x0e\x75\xe9\x54\x7b\x5e\xf0\xff\xd3\xc1\x10\xcc\xe5\x01\xae\xf6\x89\x54\xc4\x58\x2d\x64\xda\x59\x70\xfa\x38\x22\x01\xd2\x4c\x12\x37\x0b\x47\x3d\x15\x2d\xa3\x69\x8a\xb8\xf3\xf9\xfd\xc3\x1a\xea\xab\x9d\x30\x8e\xb9\xef\xf8\xde\x1e\x34\xad\x08\x88\x61\x42\x6e\x51\x7b\x21\x6e\xb5\x2a\x1c\x4c\x94\xbc\x54\x42\x5a\xf7\x23\xc9\x05\xca\x63\xf6\x9b\x6a\x53\x08\x4b\x72\xff\xad\x42\x63\x49\x56\x73\x58\x3a\x5b\x87\x0d\x42\x55\x72\x66\x91\xcf\xe1\x56\xc2\x92\x15\x98\x2f\x99\xc1\x3f\x5c\x00\xc4\x69\x33\x23\xc6\xc6\x89\xa0\xeb\xa6\x8e\x37\x7b\xae\x75\x16\x6a\x7f\x34\x20\xaf\x60\xa0\x0f\x25\x26\x8d\xc5\x70\x34\x42\x23\xaf\xcd\x13\x8c\x65\x16\xe7\x07\x30\xfa\x6d\x94\x46\xa2\xa4\xc4\xc4\x9e\xd8\xe9\xc9\xcd\xcb\x66\x23\x9d\xb1\x4c\x48\xd3\x39\x0c\x44\xa0\x73\x14\x0c\xde\x32\x83\x1b\xc5\x34\x87\x7b\x26\x59\x8a\xc5\xa9\x5c\x69\x2c\x89\xfb\x2a\xcf\x51\xcf\x4f\x56\x87\xf1\xa5\xc1\x2a\x9b\x3d\x60\xa2\xd1\x7e\xc6\x6d\xdf\x86\x23\xd4\x6f\xba\xfb\x1b\xdf\x56\x4f\xa0\x46\xe9\x54\x9a\xd9\x86\xb2\x5e\x98\xfe\x62\x52\x84\xc4\xb9\x1f\xa7\x62\xba\xf0\xff\x3b\x0f\x85\xb5\x7c\xe6\xb0\x6e\xae\x80\xa2\x32\x0d\xe4\x01\xc0\x95\x21\x2f\x53\xa0\xf3\x2f\x25\x33\x66\xaf\x34\x87\x47\x7c\x32\x04\x49\x18\x42\x5a\x39\x6a\x58\x0e\xcc\x80\xb0\x34\x43\x56\x40\x96\x41\xd2\x1f\x00\xbc\xcf\x50\x42\x65\x1a\x2b\x5e\x2d\x89\xb7\x3b\xc1\xfb\xb8\x7e\x9e\xf3\x34\x5c\xa8\x19\x58\x3b\x62\xbc\x23\x49\x18\xa8\xa4\xf8\xad\x42\xd8\x0b\x9b\x09\x09\xcc\x07\x54\x0a\x64\xe4\x7b\x75\x2d\x82\x41\x98\x40\xde\xc9\xf3\xb2\xf6\x40\xfd\xc8\xc3\xb0\x11\x1e\x53\xe0\xae\x9f\x40\x86\x47\xb7\x1b\xa4\xfc\x4c\xa0\x69\x9f\x89\x24\xa3\xe9\x11\x22\x02\x09\x8e\x29\x4e\x27\xc8\x83\x39\xce\x3c\x83\x9a\x01\x37\xd2\x8e\x2f\xb3\xc7\x6a\x83\x5a\xa2\x45\x33\x2b\x58\x39\xf3\x27\x98\x55\x85\x48\x7a\x4e\x64\xca\xd8\x08\x9b\xfa\xa0\x8c\xad\x4d\x89\x8e\xc0\xed\x0a\x18\xe7\x1a\x8d\x01\xa5\xdd\x94\x23\xf4\xc8\x2e\x7a\x01\x17\x42\xde\xa1\x4c\x6d\xb6\x80\xeb\x11\x22\x07\xb9\x20\xa4\xc1\xa4\xd2\xb8\xbe\x7b\x88\xc0\xfc\xb6\xdd\xed\xbc\xad\xd8\x0a\x92\xa8\xae\x8c\x45\x0e\x34\xdb\xba\x35\xd3\x8f\xb0\xc7\x67\xa3\x54\x8e\xac\xcf\xa4\x4b\xa5\x07\x79\xb8\x65\x55\x6e\x17\xf0\xe6\xd5\x8f\xe7\x51\x5d\x29\xdd\x30\x99\x60\x82\xac\x8a\x0d\x6a\xe7\x67\x6b\x24\x65\xea\x74\xf0\x3c\x97\x3d\xd2\x94\xd0\xa4\xa8\xfb\x90\x0e\x7e\xe1\x53\xd9\xc9\xa2\xc7\xd1\x3b\x3c\xd1\xc6\x85\x1a\x54\xcd\xdf\x24\x38\xaf\x01\x76\x9e\xf7\x39\x84\x75\x7e\x73\xbf\x8e\x34\xd8\xdb\xb0\xbd\xc5\x88\xb8\x13\x70\x20\x9f\x93\xb8\x92\x42\xfc\x8e\x67\x4c\xb6\x01\x34\xee\x34\xe3\x88\x80\x51\xcd\xe8\x25\xc4\x29\x80\x8b\x4c\x35\x07\x60\x2f\xf2\x9c\xe2\x85\x57\x02\x96\xe7\x03\x4c\xad\xc7\x39\xb1\xfb\x51\x87\x91\x61\xdc\x66\x0e\xf7\x33\x8e\x6a\xc4\x0f\x01\x88\xb2\x10\x56\xa9\x3c\x56\x88\xab\xfb\xdb\xf5\xa7\x4f\x77\xcf\x17\x62\xb8\xf7\xc5\x84\x98\x88\x32\x43\xfd\x50\x09\x3b\x12\x41\x4e\xe8\x59\xb6\xa7\x82\x48\x6b\xbc\xbe\x4f\xa4\xa3\x21\x0e\x9e\xa5\x6b\xcf\x43\xec\x9c\xae\x45\xa8\x8a\x46\xbe\x15\x26\x8b\xd4\x94\xcf\x7e\xf7\xb3\x15\xa5\x86\xf3\x1f\x37\xf6\x40\xfe\xff\xb0\xad\xeb\x32\x89\x15\xde\x6a\x19\x27\x38\xda\x39\xc6\xec\x97\x32\x6e\x25\x4d\x55\xa0\xfe\xf9\xf3\xdd\x14\xe3\x6e\x4f\xd5\x01\x9b\xfe\xdd\x67\xa8\x29\xa9\x27\x7e\x34\x90\xaf\x72\x61\x2c\xca\x11\x09\xf9\x21\x0c\xe8\x4a\x4a\x8a\xf0\x54\x16\x58\x15\xb2\xcc\x3d\x7a\xc5\x30\x28\xb9\x5b\xd1\x98\xa0\xd8\x21\xb0\xd0\x83\x1a\x1e\x52\x59\x0a\xc7\x6c\x24\x18\xd7\x23\xd2\xc9\xe0\x97\x12\xb5\xa0\x12\x8f\x8d\x38\xf6\x13\x7e\xbd\xef\x1c\x1b\x4f\x0e\xea\x11\x27\x3d\x1a\x49\xe8\x17\xba\x96\xc1\x8a\x3d\xe5\x8a\x8d\xe8\x7a\x2f\x7a\xcb\x1e\x10\x4d\xce\x2e\x24\xfc\x6a\x94\x1c\x47\x17\xe2\x59\xe8\x6e\x57\x76\xc5\xec\x88\xc3\xeb\x45\xf3\xf2\x9d\x3f\xd6\xe4\x88\xf4\x7f\x68\x67\x11\x8a\xe3\x36\xda\x1d\x5e\x51\xe9\xdc\xa6\x48\x72\xb1\x81\x43\xda\xbf\x7e\x23\x32\xaa\xc4\x7a\xcd\xdb\x8c\x79\xd1\x7a\x60\xb1\x41\xce\x91\xcf\xe1\x27\xa5\x01\xbf\xb0\xa2\xcc\x1b\xb7\x31\xe7\xcc\xb2\xf9\x46\xf1\xa7\xcb\x97\x63\x63\x84\x5f\xa2\x91\x15\x6c\xc4\x39\xc1\x49\xa5\x73\x7f\xb3\xac\x19\x5c\xfb\xa6\xca\xa0\x33\xc8\x44\x23\xb3\x08\xcc\x6d\x3a\x43\x88\x11\xa9\x64\xb6\xd2\x23\xd5\x1e\x4c\xd2\xf3\x52\xe3\x56\x7c\x79\x10\xe9\x3b\x61\xd8\x26\x1f\x73\xe8\xbd\x84\x5d\xae\x8e\x01\x00\x47\x8b\xba\x70\x25\xee\x3e\x43\x9b\x9d\xf5\x51\x34\x88\x31\x2c\x4f\x95\x16\x36\x2b\x6a\x05\x09\xd8\x79\x36\xb9\x72\x39\x8e\x7c\x3f\xde\xd7\xda\x62\x32\xf6\xea\xf5\x9b\xbf\xb3\x4d\x72\xfd\xea\xc7\x58\x55\x19\x2e\xcc\xba\xc3\x97\xe3\x67\xb9\x0c\x54\xd6\x0a\xdf\x7a\x59\x45\xcb\x86\x86\xb0\x58\x44\x6d\x3c\x92\xcb\x71\x67\xaa\x6d\xa9\x02\x0b\x8b\x51\x40\x29\xa1\x09\x30\xe6\x70\x6b\x21\x63\x06\x50\xaa\x2a\xcd\x0e\x3a\x57\xae\x05\x63\xb5\xc0\x5d\x8c\x55\x43\xdb\xc8\x10\x12\x98\x7c\x6a\xbb\x23\x51\xa7\xe3\xd5\xbb\x1e\xe3\xfd\xa6\xe3\x31\xad\xff\x14\x0d\x16\x0e\x3a\x55\x53\xfa\x51\x27\x70\xe2\x83\x42\x3d\x22\xfa\x55\xc7\x63\x62\xff\x6a\x02\x1f\x9a\x4e\xd7\x77\xf5\xb3\x8e\xc7\x64\x6e\x44\xfa\xf8\x76\x4c\xed\x7f\xf5\x5f\xc8\xb4\x66\x4f\xd3\xdc\xab\xb7\x54\x03\x8c\x32\x40\x28\x58\xe9\x3e\x50\xd5\x7e\xd2\xe5\xb7\xc1\x05\x45\x60\xe1\x02\x0e\x55\x01\xe4\x46\x85\x4c\x63\x93\x8f\x08\x46\x45\x6e\xcc\x55\xfa\xb1\x9b\x3d\xc6\x05\x9d\x03\x8e\xdc\x0d\x80\x98\x1e\x76\x34\x9a\x52\x49\x83\xe1\x63\x52\x6f\x76\x6d\x9a\x50\x94\xab\x34\x1d\xec\x90\xd7\x43\x69\xca\x8e\x63\x72\xe2\xf3\xe1\x25\x7c\xae\x9a\xc0\x9a\x90\x6e\x9d\xc9\x33\xc6\xcb\x57\xf0\x75\x17\x7c\x58\xaf\x57\x35\x0a\x2f\x95\x6b\x64\xd6\x96\x4b\x25\x2d\x4a\xbb\x26\x26\x4c\x33\x05\xc2\xa8\x73\xba\xa6\x32\xf1\x53\x8e\xad\x60\x55\x9c\x19\x34\x5f\xa3\x89\xd4\x40\xe5\x41\x5d\xf3\xa2\x79\x39\xd1\x7d\x8f\x36\x53\x53\x93\x2b\x22\xd9\x1f\xac\xa9\x75\x62\x29\xfc\x94\x55\x44\x4a\x04\xc1\x7f\x2a\xb1\xc6\x32\x2b\x92\x0f\xc8\x38\xea\xff\x8e\xbc\x68\x62\x78\xf8\x7e\x5f\xdd\xa5\xdc\x79\xec\x52\xa3\x0f\x96\x1c\x32\x3f\x1d\x95\x09\xb3\xa6\x42\x22\xf6\x78\xe3\xc5\x1d\xea\xa7\x38\x83\x84\x69\x11\xce\x8a\x02\x8d\x65\x45\xf9\x93\xcb\xe6\x26\xaa\xe8\xfa\xf0\x74\xad\xa7\x04\x14\x7c\x7a\x48\x7f\x22\x0b\x80\x06\x95\xc0\xae\x17\x55\xcc\x06\xb8\x97\xd0\xd4\x3a\x67\x7d\x78\xbc\xf9\x6c\xe5\x7f\xb9\xfc\x85\x24\x17\x41\x69\xfb\x98\xc4\x32\x21\x0f\x09\x9f\xb7\x55\xcb\xbf\x66\x6f\xef\x97\x77\xb7\x6f\x67\xcd\xcd\x7f\x7e\x95\xdb\xd4\x5b\x13\xa2\xd0\x43\x7d\xa6\x37\x0e\xb9\xaf\xfb\x9c\x9f\x17\x17\x93\xbe\x68\x6e\x50\x20\x2b\xa0\xc9\x3f\x20\x30\xb1\xb2\x44\xc9\x6f\xf2\x54\xad\x95\x17\xef\xf7\x56\xc3\x37\x83\x90\x80\x63\x22\xf8\xb4\x9a\x58\x05\xcc\x8e\xaa\xe3\xe3\x62\x38\x28\x61\x04\xc4\x90\x9f\xf4\x28\x59\x2b\xb5\x0d\x26\xaa\x40\xd3\xb3\x34\x7b\xf5\xfa\x4d\xc4\x25\xff\xcc\x50\x82\x41\xeb\xf0\xd4\xee\xc1\x53\x6d\x26\x87\x6e\x8d\x74\x01\x59\x92\x45\xc0\x6c\x88\x1f\xc5\x9d\x10\x74\xcd\xcc\x9e\xa5\xd7\xd7\xaf\x5e\xb6\xf4\xf7\x24\x7d\x8c\x2a\x2c\x0f\x95\xe4\x43\x73\x72\xc8\x8b\x04\x1f\x11\xc1\x98\xae\x17\x69\x14\xe2\xff\xcd\x5f\x46\xf9\xf4\x82\x8e\x04\x40\xc8\x24\xaf\x38\xf2\xd0\xe5\x8b\x8e\xfc\x87\x3c\xb9\xed\x85\xe2\xa2\x68\x08\x9d\xb0\xcf\x54\x54\xba\xe3\x9f\xd0\x35\xba\x56\xe3\x07\xc7\x5c\x82\xd2\xdf\x35\xa9\x97\xd4\x30\xf3\xfe\x69\xe6\x1b\xbc\xb3\x10\x10\x6e\xf2\xbc\x45\x55\x9c\xed\xa2\xd3\xd8\x50\x31\xcd\xab\x32\xa7\x44\x0c\xf9\xfc\xbc\x5c\xa2\xd3\x9e\x49\x49\x4f\x6c\xca\x13\x15\x35\x62\xbe\x0c\x75\xbe\x8f\x7c\xff\x07\xa2\xd1\x0d\xc3\x58\xcc\xdc\xbb\x92\x9e\xe9\xce\x0b\x90\x93\xd5\xc1\xbb\xfa\xef\x99\x75\x9e\x7f\x5c\x44\xc0\xa1\xa4\xb9\x32\x11\x4f\xf6\xdc\xbe\x83\x0e\x8c\xda\x18\xd4\x3b\xe4\xfe\xc5\x1e\xa8\x6d\xff\x03\x8e\xd1\xc7\x7b\x3e\x05\xef\xd1\xab\xe3\xcf\x53\x61\x63\xb7\x9b\xd8\xbe\x7a\x05\xb6\x63\x22\xa7\x60\x17\x90\xf2\x05\x7a\x8f\xec\xba\x8f\x6c\x2f\x0d\x24\x95\xd6\x54\xc2\xf5\xbc\x39\x84\x31\x9d\xef\xe3\x50\x83\x64\xc3\x24\x77\x53\xe0\x51\x43\xab\x43\xa1\x57\xab\x46\x9e\xbf\x9c\x4b\x28\x72\x66\xec\xcf\xee\x35\x29\xa5\x6c\x43\xfa\x7f\xd8\xcd\x38\x38\x52\x3f\x74\x6a\xd0\x1c\x72\x4d\x3e\xb3\x5e\x00\x1d\x9c\x51\xf2\x38\xb0\xef\xac\x17\x28\xd0\x18\x96\xc6\xe1\x7a\xef\xf7\xfa\x87\xbe\x59\x55\x30\x09\x1a\x19\x77\x02\x2f\xea\x35\xc9\x5d\x51\x39\xe2\x74\xdc\x43\x23\x8e\x96\x89\xdc\xd4\x04\x13\xe7\xc0\x6a\x26\xcd\x28\xd9\x67\xc9\xe9\xb3\xa3\x01\x6a\x82\x29\xc5\x72\x3c\xee\x0d\x5d\xcc\xc5\xae\x79\x71\xf8\xa2\xed\xd9\xd7\x8f\xf9\xdc\x59\xe0\x4a\xef\x12\xc1\xed\x59\x18\x75\xad\xc3\xd1\xa2\x54\x7b\xd4\xc4\xd8\x1e\x4e\x1c\xbd\x52\xd8\xb7\x25\x54\x6d\xfe\xee\x74\xeb\xc6\x46\xdf\xa2\xa1\xac\x8a\x3e\xd7\xfe\x83\x92\x3f\xf4\x4e\x6f\xb7\x7d\xf3\x95\x7c\x94\x6a\x7f\x9a\xe9\x0d\xb2\xbb\x97\x31\x27\x93\xde\xdb\x2c\x5c\xf6\xeb\x27\xac\xd2\x64\x66\x9d\x99\x6a\xd3\xbc\xc1\xaf\x09\x09\xda\x0b\x5f\xbf\xfd\x3b\x00\x00\xff\xff\x9c\x75\xfd\x8e\xdc\x32\x00\x00")
+var _chartSeederCrdTemplatesBmcTinkerbellOrg_machinesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5a\x6d\x6f\xe3\x36\xf2\x7f\xaf\x4f\x31\xe8\xff\x45\xfe\x07\x9c\x1d\x64\x8b\x16\x07\x03\xf7\x22\x75\x5b\x6c\x70\xc9\xae\x91\x64\x7b\xf7\x76\x2c\x8d\x2d\x36\x12\xa9\x92\x54\xb2\xe9\x62\xbf\xfb\x61\x48\xea\xc9\xd1\x93\x13\xb7\x77\x17\x19\xd8\x35\x4d\x0e\x67\x7e\xf3\xc0\x99\x11\x17\x8b\x45\x84\x85\xf8\x85\xb4\x11\x4a\xae\x00\x0b\x41\x9f\x2d\x49\xfe\x66\x96\x0f\x7f\x33\x4b\xa1\xce\x1f\x2f\xa2\x07\x21\x93\x15\xac\x4b\x63\x55\x7e\x4b\x46\x95\x3a\xa6\x1f\x69\x27\xa4\xb0\x42\xc9\x28\x27\x8b\x09\x5a\x5c\x45\x00\x28\xa5\xb2\xc8\xc3\x86\xbf\x02\x7c\xf9\x1a\x01\x48\xcc\x69\x05\x39\xc6\xa9\x90\x64\x96\xdb\x3c\x5e\x5a\x21\x1f\x48\x6f\x29\xcb\x96\x4a\xef\x23\x53\x50\xcc\x0b\xf6\x5a\x95\xc5\x0a\x7a\x66\x78\x2a\x81\x6a\x8c\x96\xf6\x4a\x8b\xea\xfb\x02\x9a\xd9\x6e\x82\x67\xf9\xc6\xef\xe8\x46\x32\x61\xec\x3f\xda\xa3\xd7\xc2\x58\xf7\x4b\x91\x95\x1a\xb3\x86\x3f\x37\x68\x84\xdc\x97\x19\xea\x7a\x38\x02\x30\xb1\x2a\x68\x05\x1f\x30\x27\x53\x60\x4c\x49\x04\xf0\xe8\xc1\x73\x7c\x2c\x82\xa0\x8f\x17\x98\x15\x29\x5e\x78\x42\x71\x4a\xb9\xc3\x86\xbf\xa9\x82\xe4\xe5\xe6\xea\x97\x6f\xef\x3a\xc3\x00\x09\x99\x58\x8b\x82\x91\xab\x39\x04\x61\xc0\xa6\x04\x7e\x2e\xec\x94\x76\x5f\x2b\x3e\xe1\x72\x73\xb5\xac\x09\x14\x5a\x15\xa4\x6d\x0d\x89\xff\xb4\xd4\xdb\x1a\x3d\xd8\xee\x8c\x39\xf2\xb3\x20\x61\xbd\x92\xdf\x37\xc8\x46\x49\x10\x02\xd4\x0e\x6c\x2a\x0c\x68\x2a\x34\x19\x92\x5e\xd3\x1d\xc2\xc0\x93\x50\x82\xda\xfe\x4a\xb1\x5d\xc2\x1d\x69\x26\x03\x26\x55\x65\x96\x40\xac\xe4\x23\x69\x0b\x9a\x62\xb5\x97\xe2\xf7\x9a\xb6\x01\xab\xdc\xa6\x19\x5a\x0a\x8a\x69\x1e\x21\x2d\x69\x89\x19\x3c\x62\x56\xd2\x5f\x01\x65\x02\x39\x3e\x83\x26\xde\x05\x4a\xd9\xa2\xe7\xa6\x98\x25\xdc\x28\x4d\x20\xe4\x4e\xad\x20\xb5\xb6\x30\xab\xf3\xf3\xbd\xb0\x95\x59\xc7\x2a\xcf\x4b\x29\xec\xf3\x79\xac\xa4\xd5\x62\x5b\x5a\xa5\xcd\x79\x42\x8f\x94\x9d\x1b\xb1\x5f\xa0\x8e\x53\x61\x29\xb6\xa5\xa6\x73\x2c\xc4\xc2\xb1\x2e\x59\x60\xb3\xcc\x93\xff\xd3\xc1\x11\xcc\x59\x87\x57\xfb\xcc\x26\x62\xac\x16\x72\xdf\xfa\xc1\xd9\xe3\x88\x06\xd8\x32\x59\xdd\x18\x96\x7a\x41\x1b\xa0\x79\x88\xd1\xb9\xfd\xe9\xee\x1e\xaa\xad\x9d\x32\x3a\x44\x21\xe0\xde\x2c\x34\x8d\x0a\x18\x30\x21\x77\xc4\x56\x24\x0c\xec\xb4\xca\x1d\xe2\x24\x93\x42\x09\x69\xdd\x97\x38\x13\x24\x0f\xe1\x37\xe5\x36\x17\x96\xf5\xfe\x5b\x49\xc6\xb2\xae\x96\xb0\x76\xbe\x0e\x5b\x82\xb2\x48\xd0\x52\xb2\x84\x2b\x09\x6b\xcc\x29\x5b\xa3\xa1\x3f\x5c\x01\x8c\xb4\x59\x30\xb0\xf3\x54\xd0\x0e\x53\xcd\x1f\x53\x59\x05\xd4\x5a\x3f\x54\xf1\x68\x40\x5f\xc1\x41\xef\x0a\x8a\x6b\x8f\x49\xc8\x08\x4d\x49\x15\x2f\xc0\x58\xb4\xd4\xf8\xe7\xb0\x8f\xf2\x13\x2b\x29\x29\xb6\x2f\xfc\xf4\xc5\xce\xeb\x7a\x22\xfb\x92\x45\x21\x4d\x6b\x31\xb0\x80\x2e\x50\x20\xfc\x80\x86\xb6\x0a\x75\x02\x37\x28\x71\x4f\xf9\x4b\xbd\xf2\xb3\x66\xf3\x57\x59\x46\xba\xcb\xeb\x38\xbf\xfc\x60\x69\xd3\x3b\x8a\x35\xd9\x5b\xda\xf5\x4d\x38\x60\xfd\xb2\x3d\xbf\x8e\x6d\xd5\x00\x69\x92\x31\x81\x4d\xd1\xd6\x92\xf5\xd2\xf4\x1b\xb3\x21\xc4\x2e\xfc\x38\x13\xd3\xb9\xff\xbf\x8b\x50\x54\x05\xd0\x25\xdc\xd7\x5b\x40\x5e\x9a\x9a\xf2\x00\xe1\xd2\x70\x94\xc9\xc9\xc5\x97\x02\x8d\x79\x52\x3a\x81\x07\x7a\x36\x4c\x49\x18\x66\x5a\x39\x69\x30\x03\x34\x20\x2c\x8f\xb0\x17\xb0\x67\xb0\xf6\x07\x08\x3f\xa5\x24\xa1\x34\xb5\x17\x6f\xd6\x50\x68\xf5\x28\x92\x3e\xd4\xa7\x91\xe7\x87\xf9\x1c\xfa\xed\x00\x78\x9e\xca\x8c\x96\x52\xfc\x56\x12\x3c\x09\x9b\x0a\x09\xe8\x4e\x2b\x77\x90\x71\xec\xd5\x95\x0a\x06\x69\x02\x20\x18\x8f\x65\x15\x81\xfa\x99\x1f\x71\xc2\xf6\x53\x6f\x7f\x84\x18\x6e\x7e\xe7\x90\xf2\x23\x41\xa6\xa7\x54\xc4\x29\x0f\x0f\x52\x84\x4a\x04\xde\xdd\xdb\x04\x47\x30\x87\xcc\x1b\xa4\x19\x08\x23\xcd\xf3\x79\xf1\x50\x6e\x49\x4b\xb2\x64\x16\x39\x16\x0b\xbf\x02\xad\xca\x45\xdc\xb3\x22\x55\xc6\xae\xa2\x49\x4c\xde\x2b\x63\x2b\x57\xe2\x25\x70\xb5\x01\x4c\x12\x4d\xc6\x80\xd2\x6e\xc8\x09\x7a\xe0\x17\xbd\x84\x73\x21\xaf\x49\xee\x6d\xba\x82\x8b\xe8\x15\x28\x08\x69\x28\x2e\x35\xdd\x5f\xdf\xcd\xe0\xfc\xaa\x99\xed\xa2\xad\xd8\x09\xd6\xa8\x2e\x8d\xa5\x04\xee\xaf\xef\x5a\x61\xcd\xf4\x6b\xc6\xf3\xb3\x55\x2a\x23\x3c\xcc\x42\xf8\x29\x94\x1e\xc4\x70\x87\x65\x66\x57\xf0\xfd\xbb\x6f\xa7\x59\xdd\x28\x5d\x83\xcc\x34\x41\x96\xf9\x96\xb4\x8b\xb3\x15\x93\x72\xef\xfc\x6a\x1a\x65\xcf\x34\x27\x34\x7b\xd2\x3d\x33\xaa\xb8\xf0\xb1\x68\x65\xd1\xe3\xec\x75\x57\xd4\xd1\xb3\x0e\x31\x15\xbe\x71\x08\x5e\xe6\xb5\x31\x87\xb9\xce\x2e\x6f\xee\x87\x7e\x3f\x60\xec\x2a\x4c\x6f\x38\x62\x74\x02\x0f\x1c\x73\x62\x57\x52\x88\xdf\x69\xc2\x65\x6b\x42\x95\x44\xfd\x02\xcc\x13\x62\xdc\x32\x7a\x05\x71\x06\xe0\x4e\xa6\x0a\x01\x78\x12\x59\x06\xa5\x21\x6f\x04\x98\x65\x66\x39\x4a\x6f\x4a\xed\xfe\xa9\x8e\x91\x61\xde\x16\x8e\xf7\xc1\x9f\x27\xe3\x10\x80\x28\x72\x61\x95\xca\x56\xd1\x2c\xd9\xaf\x36\x37\x57\xf7\x1f\x3f\x5e\xbf\x5d\x89\x61\xdf\x93\x29\x31\x16\x45\x4a\xfa\xae\x14\x96\x8e\xd0\xe5\xba\x59\xe5\x93\x8d\x0a\x8f\x57\xaa\x74\xe4\x50\x78\xab\xad\xbd\x8d\xb1\x29\x5b\x9b\x61\x2a\x9a\x92\x9d\x30\xe9\x2a\x9a\xc5\xf9\xad\x9f\xfd\x66\x43\xa9\xe8\xfc\xc7\x9d\x3d\x88\xff\x3f\xec\xeb\xba\x38\xa8\x62\x06\xa5\xbe\xdd\xac\xe7\x29\x8e\x93\xd7\x41\x92\x70\x32\xa5\xc5\x4a\x9a\x32\x27\xfd\xe9\xf6\xfa\x08\xdd\xad\x9b\x55\xd5\x81\xfd\xe9\xf6\x1a\x9e\x52\xd2\x04\x28\x41\x17\x71\x4d\xf9\x9c\x3b\x42\x24\x47\x34\x14\xe2\xa5\x01\x5d\x4a\xc9\xb9\x3b\x97\x05\x56\x85\x2c\xf3\x89\xb3\xce\x2c\x03\x43\x32\x71\x05\x83\xa6\x98\xc4\x23\x01\x86\x1e\xd4\xf0\x23\x95\x15\xbb\x50\xbe\x9c\x26\xc8\xd0\xe7\x82\xb4\xe0\x12\x0f\xb3\x23\xf0\xfa\xa9\xb5\xac\xd2\xf8\x38\x3f\xf3\xb4\xc7\x4f\x1c\xfa\x85\xae\x65\xb0\xc1\xe7\x4c\xe1\x88\xad\xf7\xb2\xb7\xee\x21\x51\xe7\xec\x42\xc2\xaf\x46\xc9\x71\x76\x8f\x80\x90\x3f\x89\xb2\x1b\xb4\x23\x01\xaf\x97\xcd\xb3\x1f\xfd\xb2\x3a\x47\x44\x9b\x56\xed\x2c\x66\x71\xdc\x47\xdb\x7f\xde\x50\x79\xdd\x36\x8f\x33\xb1\x85\xae\xec\x5f\xbe\xb2\x25\x94\xb1\xf5\x96\xb7\xa5\x68\x92\x22\x50\xbe\xa5\x24\xe1\xee\xcc\xcf\x4a\x03\x7d\xc6\xbc\xc8\xea\xb0\xb1\xe4\x7e\xc1\x72\xab\x92\xe7\xb3\x68\x90\xc2\xb1\x30\xce\x88\x4b\xfc\x49\x73\x1c\x09\x4e\x2f\x40\x7e\x7f\x73\xb9\x06\xd1\x8d\x4d\xa5\x21\xe7\x90\xb1\x26\xb4\x04\xe8\x26\x8d\x92\x04\x30\x62\x2f\x91\x9b\x7a\xa7\xb2\xf3\x42\xd3\x4e\x7c\xbe\x13\xfb\x1f\x85\xc1\x6d\x36\x16\xd0\x7b\x05\x3b\xdb\x1c\x12\x80\x84\x2c\xe9\xdc\x95\xb8\x4f\x29\xd9\x74\x32\x46\xf1\x87\x81\xc1\x8c\xbb\xe2\x36\xcd\x2b\x03\x09\xdc\x79\x98\x78\xc6\x4c\xf1\xfd\xe7\xa7\xca\x5a\x4c\x8a\xef\xbe\xfb\xfe\xef\xb8\x8d\x2f\xde\x7d\x3b\xd7\x54\x86\x0b\xb3\xf6\x9f\x2f\xc7\x27\x51\x06\x2e\x6b\xdd\xfb\x06\xcc\x36\xb3\x75\xc3\x1f\x61\x29\x9f\x35\xf1\x40\x2f\x87\x9d\xa9\xa6\xa5\x0a\x18\x7a\x4a\xb3\x88\x02\xd4\x34\x96\x70\x65\x21\x45\x03\x24\x55\xb9\x4f\x3b\x9d\x2b\xd7\x82\xb1\x5a\xd0\xe3\x1c\xaf\x6e\x35\x32\xb8\x95\x23\x9f\x9b\xee\xc8\xac\xd5\xf3\xcd\x7b\x5e\xbf\x69\x14\xc9\xc9\xfe\xd3\x6c\xb2\xd0\xe9\x54\x1d\xd3\x8f\x7a\x75\x34\x6b\x9e\x9a\xdd\x37\xc0\x30\xde\xbf\x9a\x4d\x17\x42\x0e\xe2\x08\xbc\xa2\x9f\xf5\x66\x34\x66\xc6\xf8\xd7\xf7\xbf\x0e\xff\xc2\x74\xad\xf1\x39\x3a\x0a\x75\xef\xa9\x06\x90\x33\x40\xc8\xb1\x70\x2f\xa8\xaa\x38\xe9\xf2\xdb\x10\x82\x66\x70\xe1\x0e\x1c\xae\x02\x38\x8c\x0a\xb9\x5f\x46\x27\x03\x6a\xe6\xc4\x4c\xed\x3f\xb4\xb3\xc7\x79\x87\x4e\x07\x91\xeb\x01\x12\xc7\x1f\x3b\x9a\x4c\xa1\xa4\xa1\xf0\x32\xa9\x37\xbb\x36\xf5\x51\x94\xa9\xfd\x9e\x92\x09\x92\x4a\x83\x54\x76\x19\x9d\xe2\x78\x09\xaf\xab\x8e\x80\x26\xa4\x5b\x13\x79\xc6\x78\xf9\x1a\xea\x2e\x78\x7f\x7f\xbf\xa9\x58\x58\x46\xa7\x09\xc6\xfc\x22\x93\x5f\xd8\x90\xb4\xf7\x6c\x2f\x13\xd3\x0f\xa4\x63\x8e\x5a\xab\x2b\x29\xb9\xec\x23\x7e\xfd\xc7\x63\x56\x4d\xd2\x84\xba\x16\xe6\xd5\x2c\x6a\x90\xb2\x53\xd7\x8c\x8b\x7c\x64\xd0\x61\xb9\x6f\xc8\xa6\xea\xd8\xe4\x8a\x45\xf6\x0b\x2b\x69\x79\x04\x72\x3f\x64\x15\x8b\x32\x49\x11\xfe\x5c\x61\xf9\xc5\xa1\x88\xdf\x13\x26\xa4\xff\x3b\xf2\xa2\x23\x98\x6f\xa6\xbf\x26\x56\xb7\x25\x77\x11\xbb\xd0\xe4\x0f\xcb\x04\x52\x3f\x3c\x67\x7f\x6e\xeb\x55\x51\x07\xb9\xf8\x61\xe7\xa5\x47\xd2\xcf\x95\xf6\x4e\x18\xb8\x01\xac\xc8\xc9\x58\xcc\x8b\x9f\x5d\x36\xb7\x3a\x4e\xe8\xfb\xee\xea\xca\x4e\x99\x28\xdb\x5d\x8e\x96\xff\x99\xa4\x09\xf5\x2a\xc7\x4a\x80\xeb\xa4\x86\x59\x13\xf7\xb6\x79\xa4\x9c\x67\xb5\xa0\x7e\x79\x25\xa8\x67\xd4\x65\x47\x4e\x73\x93\x54\xa1\x75\x99\x84\xfb\x55\x5d\xc1\x97\x4d\xd5\xf2\xaf\xc5\x0f\x37\xeb\xeb\xab\x1f\x16\xf5\xce\x7f\x7e\x95\x5b\xd7\x5b\xab\x68\x36\x52\x77\xd5\x9a\xde\x73\x88\x63\x11\xd7\x42\x93\xea\x42\xe9\xea\xe1\x86\x05\xf6\x02\x94\x7f\xc8\xc1\x84\x45\x41\x32\xb9\xcc\xf6\xea\x5e\x79\xf5\xce\x4b\x4c\x5e\x5a\xc9\xe5\x20\x25\x48\x28\x16\xc9\x71\x35\xb1\x0a\x9c\x1d\x54\xc7\x87\xc5\x70\x30\xc2\x19\x14\x43\x7e\xd2\x63\x64\x8d\xd6\xb6\x14\xab\x9c\x4c\xcf\x4f\x8b\x77\xdf\x7d\x3f\x63\x93\x7f\xf2\x75\x01\x43\x96\xf9\xb7\xda\x5d\x78\xaa\xdc\xa4\x1b\xd6\xd8\x16\x08\xe3\x74\x06\xcd\x5a\xf8\x51\xde\x99\x41\xd7\xcc\xec\xf9\xe9\xbb\x8b\x77\xa7\x2d\xfd\xbd\x48\x1f\x66\x15\x96\x5d\x23\x79\x5f\xaf\x1c\x8a\x22\x21\x46\x4c\xd2\x85\x4e\x14\xa9\x0d\xe2\xff\xcd\x5f\x46\x71\x3a\x61\x20\xe1\xdb\x6d\x71\x56\x26\x94\x84\x2e\xdf\xec\x93\xbf\x8b\xc9\x55\x2f\x15\x77\x8a\x86\xa3\x13\x9e\x52\x35\x2b\xdd\x71\x97\xcf\x9a\xc4\xbd\xe2\x0f\x0e\x51\x82\xc2\xef\x75\x54\x2f\xa9\x06\xf3\xe6\x79\xe1\x1b\xbc\x8b\x70\x20\x5c\x66\x59\xd0\xa3\xdf\x7b\x06\xd5\x2d\x17\xd3\x49\x59\x64\x9c\x88\x51\xb2\x3c\x8b\x4e\x96\xf6\x1c\xa1\xc0\xf9\x29\xcf\xac\x53\x63\xce\x9b\xa1\xaa\xce\xfa\x74\x7b\x1d\xbd\x7a\xb3\xd1\x09\xc3\x5c\x2c\xdc\xbd\x92\x9e\xe1\xd6\x0d\x90\x68\xf6\x5e\xfd\xfb\x2c\x5a\xd7\x3f\xa2\x19\x74\x38\x69\x2e\x0f\xf4\xda\xf1\x8f\x70\x29\xe3\xce\xcd\xeb\x74\x60\xd4\xd6\xf0\x25\xd5\xc4\xdf\xd8\xe3\xf6\x40\xef\x05\x8e\xe1\xc3\x30\x56\x32\x11\x03\xb7\x35\x3a\x3c\xac\xeb\x89\xed\x6e\x62\x73\xeb\x15\xf0\x11\x45\xc6\x87\x5d\x60\xca\x15\x17\x7d\x29\x6f\xfb\x92\xed\x99\x81\xb8\xd4\x9a\x4b\xb8\x9e\x3b\x87\xa3\x36\xdf\x87\x50\xcd\x64\x0d\x12\xca\x06\xa3\x5a\x56\xc6\x09\x7b\x48\x42\x3f\x7a\xf3\x12\x8a\x0c\x8d\xfd\xe4\x6e\x93\x72\xca\xd6\x3f\xe7\x80\xeb\xeb\xce\x92\xea\x02\x60\xcd\xe6\x32\x1a\xac\xe8\x38\x55\xe7\x0b\x93\xb4\xe0\xc4\x36\x7a\x65\x14\xc8\xc9\x18\xdc\xcf\xe3\xf5\xc6\xcf\xe5\x33\x0b\x21\x2d\x73\x7e\x23\x49\x98\x38\x85\x07\x3a\x20\x64\xc2\xb1\x6c\x2c\xe8\x70\xb3\x90\x9b\x35\x28\x32\x53\x09\xcc\xc8\x81\xd5\x28\xcd\xa8\xd8\x93\xe2\xf4\xf9\xd1\x80\x34\xc1\x95\xe6\x22\x3e\xb9\xb5\x7d\x2e\xe6\xc1\xc8\xad\x8f\x4a\xee\x60\x6c\x6f\xde\x7e\x38\xda\x71\x2c\xf2\xa8\xf4\xfe\xc4\x74\x7b\x7e\x18\x08\x53\x53\xa7\x45\xa1\x9e\x48\x33\xb0\x3d\x48\x74\x30\xd8\xf0\xc4\x2a\xf9\xa9\xdc\xdf\xad\x6e\xc2\xd8\xe8\x5d\x34\x92\x65\xfe\x72\x8f\x05\x7c\xa3\xe4\x37\xbd\xc3\xbb\x5d\xdf\x78\x29\x1f\xa4\x7a\x92\xd1\x6c\xb8\x7b\x81\x79\x31\xc8\xc1\x8f\x92\x95\xcb\x7e\xfd\x80\x55\x9a\xdd\xac\x35\x52\x6e\xab\xb6\x7f\x6d\xaf\xc6\xa2\x2d\xcd\x0a\xbe\x7c\xfd\xf7\x00\x9c\x75\xfd\x8e\xdc\x32\x00\x00")
 
 func chartSeederCrdTemplatesBmcTinkerbellOrg_machinesYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -163,12 +171,12 @@ func chartSeederCrdTemplatesBmcTinkerbellOrg_machinesYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "chart/seeder-crd/templates/bmc.tinkerbell.org_machines.yaml", size: 13020, mode: os.FileMode(420), modTime: time.Unix(1773987915, 0)}
+	info := bindataFileInfo{name: "chart/seeder-crd/templates/bmc.tinkerbell.org_machines.yaml", size: 13020, mode: os.FileMode(436), modTime: time.Unix(1782200728, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _chartSeederCrdTemplatesBmcTinkerbellOrg_tasksYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x4f\x6f\xdc\xba\x11\xbf\xef\xa7\x18\xa4\x87\x5c\xb2\x6b\xb8\x01\x8a\x62\x6f\xae\x93\xa0\x46\x93\xd6\x88\x8d\x5c\x8a\x1e\xb8\xd4\x68\xc5\x5a\x22\x15\xce\x70\x9d\x7d\x41\xbe\xfb\xc3\x90\xd2\xae\x56\xd6\xbf\xbc\x87\xe8\x26\x72\x38\x9c\xdf\xfc\xf9\x0d\xc9\xf5\x7a\xbd\x52\xb5\xf9\x82\x9e\x8c\xb3\x5b\x50\xb5\xc1\x6f\x8c\x56\xfe\x68\xf3\xf4\x77\xda\x18\x77\x75\xb8\x5e\x3d\x19\x9b\x6d\xe1\x36\x10\xbb\xea\x33\x92\x0b\x5e\xe3\x3b\xcc\x8d\x35\x6c\x9c\x5d\x55\xc8\x2a\x53\xac\xb6\x2b\x00\x65\xad\x63\x25\xc3\x24\xbf\x00\xdf\x7f\xac\x00\xb4\xc7\x38\xf6\x68\x2a\x24\x56\x55\xbd\x05\x1b\xca\x72\x05\x60\x55\x85\x5b\x60\x45\x4f\xb4\xd9\x55\x7a\xc3\xc6\x3e\xa1\xdf\x61\x59\x6e\x9c\xdf\xaf\xa8\x46\x2d\x7a\xf6\xde\x85\x7a\x0b\x03\x12\x49\x45\xb3\x99\x56\x8c\x7b\xe7\x4d\xfb\x0f\xb0\x86\xb3\x7c\x1c\x4a\x58\x1e\x15\x3d\xc5\xdf\xd2\x10\xff\xeb\x34\xf4\xd1\x10\xc7\xe1\xba\x0c\x5e\x95\x8d\x61\x71\x84\x0a\xe7\xf9\xdf\xe7\xad\xa2\xea\x34\x63\xec\x3e\x94\xca\x27\xe9\x15\x00\x69\x57\xe3\x16\xa2\x70\xad\x34\x66\x2b\x80\x43\xf2\x71\xb3\x78\xdd\xe0\x3e\x5c\xab\xb2\x2e\xd4\x75\xa3\x91\x74\x81\x95\x6a\xf5\x03\xb8\x1a\xed\xcd\xfd\xdd\x97\xb7\x0f\xbd\x09\x80\x0c\x49\x7b\x53\x73\x8c\x9b\x98\x0e\x86\x80\x0b\x84\x24\x0a\xb9\xf3\xf1\x37\x4e\xdd\xdc\xdf\x6d\x3a\x6b\x6b\xef\x6a\xf4\xdc\xf1\x52\xfa\x3a\xa9\x70\x31\xde\xdb\xed\xb5\x98\x94\xe4\x20\x93\x2c\xc0\xb4\x73\x03\x11\xb3\x06\x07\xb8\x1c\xb8\x30\x04\x1e\x6b\x8f\x84\x36\xe5\x45\x4f\xb5\xcb\x41\x59\x70\xbb\xff\xa3\xe6\x0d\x3c\xa0\x17\x35\xe2\xed\x50\x66\xa0\x9d\x3d\xa0\x67\xf0\xa8\xdd\xde\x9a\xdf\x4e\xba\x09\xd8\xc5\x4d\x4b\xc5\xd8\xc4\xec\xfc\x19\xcb\xe8\xad\x2a\xe1\xa0\xca\x80\x6f\x40\xd9\x0c\x2a\x75\x04\x8f\xb2\x0b\x04\xdb\xd1\x17\x45\x68\x03\x9f\x9c\x47\x30\x36\x77\x5b\x28\x98\x6b\xda\x5e\x5d\xed\x0d\xb7\x45\xa0\x5d\x55\x05\x6b\xf8\x78\xa5\x9d\x65\x6f\x76\x81\x9d\xa7\xab\x0c\x0f\x58\x5e\x91\xd9\xaf\x95\xd7\x85\x61\xd4\x1c\x3c\x5e\xa9\xda\xac\xa3\xe9\x36\x16\xc2\xa6\xca\xfe\xe2\x9b\xb2\xa1\xd7\x3d\x5b\xf9\x28\xb9\x42\xec\x8d\xdd\x5f\x4c\xc5\x44\x9d\x8c\x83\xe4\xad\x84\x5d\x35\xcb\x13\x96\xb3\xbb\x65\x48\x7c\xf4\xf9\xfd\xc3\x23\xb4\x06\xc4\x90\xf4\x63\x10\xbd\x7f\x5e\x48\xe7\x40\x88\xdb\x8c\xcd\xd1\xa7\x50\xe6\xde\x55\x51\x27\xda\xac\x76\xc6\x72\xfc\xd1\xa5\x41\xdb\x0f\x02\x85\x5d\x65\x58\xa2\xff\x35\x20\xb1\x44\x6c\x03\xb7\x91\x1f\x60\x87\x10\xea\x4c\x31\x66\x1b\xb8\xb3\x70\xab\x2a\x2c\x6f\x15\xe1\x2f\x0f\x83\x78\x9b\xd6\xe2\xda\xe5\x81\xe8\xd2\xdb\xcb\x05\xc9\x77\x17\x53\x2d\x69\x8d\x46\x4e\x8a\xf2\xa1\x46\x7d\x51\x3e\x19\x92\xf1\x92\xe0\xac\x18\xa5\x2c\x44\x6a\xd3\x53\x33\x56\xba\xf2\x69\x67\x2d\x6a\x1e\x28\xdf\x17\x06\xdc\x9e\x44\x3b\x41\x8f\x56\x7c\x52\xba\x30\x16\x4f\xca\x0e\x86\x63\x02\x38\x5f\xc5\xea\xed\x1b\x34\x67\x94\x7c\x2a\x70\xf1\x80\xda\x23\x7f\xc6\x7c\x58\xa4\x67\xdf\x4d\x77\xc5\x89\xd9\xda\x01\xf4\x68\x63\x22\x2b\x16\x3b\x59\x19\xdb\xcf\xe8\xcb\xcd\x25\x01\x74\x34\xbf\x0b\x25\xf1\xd3\x09\xf2\x06\x1e\x4f\x9b\x40\x15\xe8\xa4\x7b\x54\x75\x20\x61\x99\x0a\x23\xbf\xd4\x8a\xe8\xd9\xf9\x0c\x9e\xf0\x48\x43\x5e\x5a\xe2\x29\xf9\x62\x67\x18\x9d\xed\x39\x4a\x7a\x8c\xf8\x27\x58\xf3\x35\x20\x3c\x1b\x2e\x8c\x05\x95\x7a\xa2\xf4\x1e\xe1\x49\xdf\xba\x6c\x42\x2b\x08\x8b\x24\xec\x2d\x53\x8c\x81\x80\xa9\x62\xe9\x23\x89\x46\xfc\x14\x9c\x64\x76\xb7\x32\xd2\x48\x83\xed\xb9\x30\xba\x90\xe1\x49\x30\x0d\x94\x18\x9d\x18\x4b\x61\x9c\xe8\xa3\x3f\x89\x6a\xb4\xe8\xdb\xaf\x70\xc4\x8b\x72\xfc\x9f\x8e\xb8\x4d\x6d\x59\x04\x77\xf7\xa0\xb2\xcc\x23\x11\x38\x1f\x87\xa2\xf9\xbd\x2c\x1d\x51\x5d\x19\xfb\x11\xed\x9e\x8b\x2d\x5c\x4f\x9a\x3e\x81\xce\x58\x42\x1d\x3c\x3e\x7e\x7c\x58\x84\xe0\xee\x2c\x1f\x39\xcf\xe4\x46\x22\xe6\x03\x31\x66\x20\xa3\x67\x4a\x1a\x2d\x89\x64\xd5\xce\xb9\x12\xd5\x70\xa9\xd5\xce\x4f\x78\x34\x57\xa1\xe4\x2d\xfc\xed\xaf\x6f\x97\x98\x7c\xef\xfc\xc9\xe9\xa2\x17\x6c\xa8\x76\xe8\xe3\x71\xa9\x35\xd6\xee\x63\xae\x2d\xf1\x7a\x32\x5e\x8e\x1b\x7b\xf4\x03\x32\xd2\xfb\x84\xd4\x87\xcd\x5f\x5f\x32\xe3\x88\x8c\x24\xc2\xc8\x54\x27\x60\x23\x12\x82\x71\x60\x6a\x32\x8b\xe5\x14\x3b\xdb\x41\xe2\xb9\xf2\xb2\x48\x63\x02\x68\x50\xa9\xaf\xb0\x93\x9a\xab\xd1\x0b\xdf\x62\x36\xe4\xc1\x4a\x7d\xbb\x3f\x13\xe2\x60\xde\xce\x11\xa6\xb3\x28\x77\x8a\x7f\x38\xc7\xef\xf0\x60\x34\xde\x8c\x36\xc0\x17\x10\xfe\x33\xbc\xb6\xdb\x11\x15\xec\x14\xe1\xce\x29\x2f\x07\x48\xab\xf6\x58\xbd\x3c\xe8\x5c\x58\x03\x6c\x2a\x04\x42\x96\x94\x66\xc8\xa2\x62\x39\xc8\xfb\xd1\xf6\xb9\x04\x67\x32\x5e\x74\x2d\xe6\xd2\x84\x89\xfa\x0d\xbe\x63\x16\xbd\x01\x63\xc1\xf9\x6c\x30\x77\xcf\x9f\x14\x07\x21\xc7\xca\x38\x41\x14\x3d\x1b\xb8\x0d\xde\xa3\xe5\xf2\x08\xce\x96\xc7\xb8\x41\x6e\x3c\xb5\x3b\x4c\xaa\x35\x36\x25\x4e\x29\x1e\x92\x1e\x46\x98\x49\xda\x88\xf3\x2e\xb7\x99\x50\x63\x18\xab\x09\x9f\xbd\xf0\xca\x39\xda\x5d\xc7\x5c\xc4\x6a\x11\xdf\xa6\x6f\x51\x1b\x6c\xc5\x94\xf7\xea\x38\x2a\x85\xb9\x11\xdb\x16\x87\xf7\xfd\x87\x3b\x91\x17\x0e\x60\x1f\x74\x13\xdd\xaa\x39\xbe\xb1\x13\x77\x8a\xd0\xac\x07\xe7\x09\x78\x8e\xc7\x20\x52\xcd\x64\xc4\x67\xdb\x66\xed\x9e\xd1\xff\x44\xf1\xde\x9f\xe5\xe7\x0b\x36\x69\x1f\x2f\xdb\xb9\xea\x44\x1b\xaa\x29\xe8\xaf\x9c\x7d\x35\x39\x9d\xe7\x53\xf3\xe4\xf2\x71\x4e\x59\xc7\x0b\x41\x18\x3f\xe0\xae\x41\x1f\x75\x39\x5e\x6a\x6b\x39\xd0\xe1\xd8\x06\x33\x19\x3c\x11\xb7\xf1\x9c\x58\xb7\x0f\x21\x8b\x34\x25\x78\xf3\xf7\xa5\x28\x76\xd1\x72\xdc\x8e\xe4\x96\xda\xbb\x32\xfd\xd4\x8d\xa9\xaa\x4b\x6c\x1f\xa6\x16\xdc\x9a\xba\xe2\x17\xc4\x2a\xff\xcf\x05\x26\x46\x13\xf4\xf0\xac\xa8\xd5\x3f\xdc\xfd\x20\xde\x37\xce\x26\x24\x25\x86\x12\x93\x0a\x09\x5e\x2a\xcc\x8d\x35\x54\x20\x01\x05\xad\x91\x28\x0f\x65\x79\x1c\x52\x9c\x2e\x38\x5b\x90\xfb\xf5\x5a\x94\x8e\xc6\x75\x24\xec\xda\xd9\xcc\x74\x9e\xf0\x26\x5d\xd2\x8a\xf6\xfb\x4c\x7a\x98\x01\x75\x50\xa6\x54\xbb\xb2\x8d\x56\x7a\x1a\x1c\xee\xe5\x9d\x97\xa0\xd7\x04\x3a\xb5\x96\x14\xdc\x21\x9c\x13\xd4\x3f\xdf\x4e\x2b\x24\x52\xfb\x89\x6e\x7a\x01\xf3\x53\x92\xee\x62\x2c\x42\xa5\x84\x7a\x54\x16\xd1\x35\xfa\xc0\xd8\x2c\x5e\x35\x27\xfb\x41\x86\xac\x4c\x49\xa0\x76\x2e\x30\x94\x8a\x18\xd8\x2b\x4b\x66\x8a\x84\x16\x75\x9b\xe1\x62\x1a\x41\xd5\x94\x54\x73\x1c\x4e\x4b\xdb\xf6\x17\xcf\x77\xa7\x44\x88\x0f\x37\x13\x80\x76\x08\x8f\x3e\xa0\xdc\x5b\x3e\xa8\x92\x26\xba\xe6\xd2\x2b\xd6\x32\x04\x8f\xc7\x1a\x47\x2c\xfe\xe3\x16\xcc\x35\xbb\x19\x46\x5e\xc7\x1d\x06\x27\x67\xba\xe0\xd4\x31\x81\x58\x79\x5e\xc4\x52\x0f\xad\xe4\x38\x41\x45\x57\x45\x8d\x98\x49\xad\x08\x9d\x18\xbb\xff\x05\x64\x32\x82\x78\x60\x38\x51\xf9\x56\xae\x8f\xed\x1e\xc4\xce\x4b\x8d\x5e\x8c\x85\xdd\xe9\x29\xf5\xec\x8a\x26\xed\xe1\xfb\x8f\xd5\xb9\x02\x94\xd6\x58\x33\x66\x9d\x47\xfb\xf4\xf8\xff\x2a\x35\xe3\xf6\x8d\x3f\xfe\x76\x38\x0f\xfe\xfb\xbf\x55\xda\x1c\xb3\x2f\xed\xb3\xbd\x0c\xfe\x1e\x00\x00\xff\xff\x9b\x65\x0b\xdf\x2f\x19\x00\x00")
+var _chartSeederCrdTemplatesBmcTinkerbellOrg_tasksYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\x5f\x8f\xdb\xb8\x11\x7f\xd7\xa7\x18\xa4\x0f\x79\x39\x7b\xb1\x3d\xa0\x28\xf4\x96\xee\x25\xe8\xa2\x49\xbb\x88\x8d\x7b\x39\xdc\xc3\x98\x1a\x59\xec\x4a\xa4\x8e\x33\xf4\x9e\x5b\xf4\xbb\x17\x43\x49\xb6\xec\xb5\x64\x6f\x0e\xb1\xf2\x10\x91\xc3\xe1\xfc\xe6\xcf\x6f\x28\xee\x62\xb1\xc8\xb0\xb5\x3f\x53\x60\xeb\x5d\x0e\xd8\x5a\xfa\x5d\xc8\xe9\x1b\x2f\x9f\xff\xca\x4b\xeb\xef\x76\xf7\xd9\xb3\x75\x45\x0e\x0f\x91\xc5\x37\x5f\x89\x7d\x0c\x86\x7e\xa2\xd2\x3a\x2b\xd6\xbb\xac\x21\xc1\x02\x05\xf3\x0c\x00\x9d\xf3\x82\x3a\xcc\xfa\x0a\xf0\xdf\xff\x65\x00\x26\x50\x1a\x5b\xdb\x86\x58\xb0\x69\x73\x70\xb1\xae\x33\x00\x87\x0d\xe5\x20\xc8\xcf\xbc\xdc\x34\x66\x29\xd6\x3d\x53\xd8\x50\x5d\x2f\x7d\xd8\x66\xdc\x92\x51\x3d\xdb\xe0\x63\x9b\xc3\x05\x89\x4e\x45\xbf\x99\x41\xa1\xad\x0f\x76\x78\x07\x58\xc0\x51\x3e\x89\x74\x58\xd6\xc8\xcf\xe9\xb5\xb6\x2c\xff\x38\x0c\x7d\xb6\x2c\x69\xb8\xad\x63\xc0\xba\x37\x2c\x8d\x70\xe5\x83\xfc\xf3\xb8\x55\x52\xdd\xcd\x58\xb7\x8d\x35\x86\x4e\x3a\x03\x60\xe3\x5b\xca\x21\x09\xb7\x68\xa8\xc8\x00\x76\x9d\x8f\xfb\xc5\x8b\x1e\xf7\xee\x1e\xeb\xb6\xc2\xfb\x5e\x23\x9b\x8a\x1a\x1c\xf4\x03\xf8\x96\xdc\x87\xa7\xc7\x9f\x7f\x5c\x9d\x4d\x00\x14\xc4\x26\xd8\x56\x9d\xda\xa1\x01\xcb\x20\x15\x41\x27\x0a\xa5\x0f\xe9\x35\x4d\x7d\x78\x7a\x5c\x8e\xd6\xb6\xc1\xb7\x14\x64\xe4\xa5\xee\xdf\x28\x15\x4e\xc6\xcf\x76\x7b\xaf\x26\x75\x72\x50\x68\x16\x50\xb7\x73\x0f\x91\x8a\x1e\x07\xf8\x12\xa4\xb2\x0c\x81\xda\x40\x4c\xae\xcb\x8b\x33\xd5\xbe\x04\x74\xe0\x37\xff\x26\x23\x4b\x58\x51\x50\x35\xc0\x95\x8f\x75\x01\xc6\xbb\x1d\x05\x81\x40\xc6\x6f\x9d\xfd\xcf\x41\x37\x83\xf8\xb4\x69\x8d\x42\x7d\xcc\x8e\x8f\x75\x42\xc1\x61\x0d\x3b\xac\x23\xfd\x00\xe8\x0a\x68\x70\x0f\x81\x74\x17\x88\x6e\xa4\x2f\x89\xf0\x12\xbe\xf8\x40\x60\x5d\xe9\x73\xa8\x44\x5a\xce\xef\xee\xb6\x56\x86\x22\x30\xbe\x69\xa2\xb3\xb2\xbf\x33\xde\x49\xb0\x9b\x28\x3e\xf0\x5d\x41\x3b\xaa\xef\xd8\x6e\x17\x18\x4c\x65\x85\x8c\xc4\x40\x77\xd8\xda\x45\x32\xdd\x29\x60\x5e\x36\xc5\x9f\x42\x5f\x36\xfc\xfe\xcc\x56\xd9\x6b\xae\xb0\x04\xeb\xb6\x27\x53\x29\x51\x67\xe3\xa0\x79\xab\x61\xc7\x7e\x79\x07\xf7\xe8\x6e\x1d\x52\x1f\x7d\xfd\xb8\x5a\xc3\x60\x40\x0a\xc9\x99\xda\xce\xfb\xc7\x85\x7c\x0c\x84\xba\xcd\xba\x92\x34\x9b\x2c\x43\x19\x7c\x93\xfc\x4e\xae\x68\xbd\x75\x92\x5e\x4c\x6d\xc9\x9d\x07\x81\xe3\xa6\xb1\xa2\xd1\xff\x2d\x12\x8b\x46\x6c\x09\x0f\x89\x1f\x60\x43\x10\xdb\x02\x85\x8a\x25\x3c\x3a\x78\xc0\x86\xea\x07\x64\xfa\xee\x61\x50\x6f\xf3\x42\x5d\x7b\x7b\x20\xc6\xf4\x36\xfe\xa9\xae\xbc\xcf\xdc\x93\xa9\x81\xb4\x26\x23\xa7\x45\xb9\x6a\xc9\x9c\x94\x4f\x41\x6c\x83\x26\xb8\xa0\x90\xd6\x8e\x4a\x8d\xcb\x76\xae\x74\xf5\x31\xde\x39\x32\x72\xa1\x7c\x5f\x19\xf0\x70\x10\x1d\x05\x3d\x59\xf1\x05\x4d\x65\x1d\x1d\x94\xed\xac\xa4\x04\xf0\xa1\x49\xd5\x7b\x6e\xd0\x35\xa3\xf4\xc1\x28\xd5\x8a\x4c\x20\xf9\x4a\xe5\x65\x91\x33\xfb\x3e\x8c\x57\x1c\x98\x6d\x18\xa0\x40\xce\x10\x48\x85\xa2\x76\x0a\x5a\xc7\x13\x5a\xbb\xcd\x35\x01\x4c\x32\x7f\x0c\x45\x7d\x3c\x82\xbc\x84\xf5\x61\x13\x68\x22\x1f\x74\x4f\xaa\x8e\xac\x2c\xd3\x50\xe2\x97\x16\x99\x5f\x7c\x28\xe0\x99\xf6\x7c\xc9\x4b\xb7\x78\x4a\x1f\xd5\x38\x3d\x7b\xe6\x28\xed\x31\xea\x9f\xe8\xec\x6f\x91\xe0\xc5\x4a\x65\x1d\x60\x6a\x2f\xa9\xf7\x28\x4f\x86\xc1\x65\x33\x5a\x01\x10\xb8\xc3\x3e\x30\xc5\x14\x88\xd9\x62\x19\x3f\x07\x23\xde\x04\x27\xad\x38\xa9\x8c\x6e\xa4\xc7\xf6\x52\x59\x53\xe9\xf0\x8c\x4e\x18\xa0\xa8\x05\x5d\x2c\x95\x71\x92\x8f\xfe\x20\xaa\xc9\xa2\x1f\x9e\xca\xb3\xe4\xd9\x0d\x58\xff\xee\x59\x86\xd4\xd6\x45\xf0\xf8\x04\x58\x14\x81\x98\xc1\x87\x34\x94\xcc\x3f\xcb\xd2\x09\xd5\x8d\x75\x9f\xc9\x6d\xa5\xca\xe1\x3e\xbb\x30\x7f\x0b\x3a\xeb\x98\x4c\x0c\xb4\xfe\xbc\xba\x09\xc1\xe3\x51\x3e\x71\x9e\x2d\xad\x46\x2c\x44\x16\x2a\x60\xfd\x79\x35\xa2\xa4\xc9\x92\xe8\x1c\xba\xf1\xbe\x26\x74\xd9\xc5\xa2\xf1\x61\xc6\xa3\x25\xc6\x5a\x72\xf8\xcb\x9f\x7f\xbc\xc5\xe4\x27\x1f\x0e\x4e\x6f\xf5\xff\x2e\x36\x1b\x0a\xe9\xb8\x34\x18\xeb\xb6\xa9\x8e\x6e\xf1\x7a\x67\xbc\x1e\x37\xb6\x14\x2e\xc8\x68\xef\x53\x52\xbf\x6c\xfe\xe2\x94\x19\x27\x64\x34\x11\x26\xa6\x46\x01\x9b\x90\x50\x8c\xd9\x1b\xb3\x58\x4f\xb1\x79\x76\xc5\x91\xda\x9c\xce\x8a\x34\x25\x80\x01\x4c\xf1\x56\xde\xd9\x10\xb4\x14\x4a\x1f\x1a\x2a\x2e\x79\xb0\xc1\xdf\x9f\x8e\x84\x08\xf7\xdf\xd0\x5a\xbc\x23\xfd\xa6\xf8\x9b\xf7\xf2\x13\xed\xac\xa1\x0f\x93\x0d\xf0\x15\x84\x7f\x5d\x5e\x3b\xee\x88\x08\x1b\x64\xda\x78\x0c\x7a\x80\x74\xb8\xa5\xe6\xf5\x41\xe7\xf8\xf3\x8e\x40\x6c\x43\xc0\x24\xb0\xf1\x5e\xa0\x48\x46\xe9\x41\x3e\x4c\xb6\xcf\x5b\x1b\x43\xa7\x6b\x7a\xfe\x0c\x5e\x87\x69\x74\xfa\xee\xa8\x66\x64\x16\xff\x00\xd6\x81\x0f\x05\x85\x19\xa5\x90\x8a\x83\x49\x52\x65\x1c\x20\xaa\x9e\x25\x3c\xc4\x10\xc8\x49\xbd\x07\xef\xea\x7d\xda\xa0\xb4\x81\x87\x1d\x66\xd5\x5a\x97\xe4\xb9\x56\x0f\x69\x0f\x63\x2a\x34\x6d\xd4\x79\xa7\xdb\xcc\xa8\xb1\x42\xcd\x8c\xcf\x5e\x79\xe5\x18\xed\xb1\x63\x4e\x62\x75\x13\xdf\xde\x48\xa9\x63\x31\x0c\x01\xf7\x93\x52\x54\x5a\xb5\xed\xe6\xf0\x7e\xfc\xf4\xa8\xf2\x60\x1d\x4b\x88\xa6\x3f\xbe\x35\xfd\xf1\x4d\xbc\xba\x13\x3e\x7e\x7a\xbc\xea\xc1\xeb\x04\x7c\x8d\xc7\xf4\x59\xcc\x47\xfc\x6a\xdb\x6c\xfd\x0b\x85\x37\x14\xef\xd3\x51\xfe\x7a\xc1\x76\xda\x27\xd4\xc2\xf5\xea\x24\x17\x9b\x39\xe8\xef\xbc\x7b\x37\x3b\x5d\x96\x73\xf3\xec\xcb\x69\x4e\x59\xa4\x0f\x82\x38\x7d\xc0\x5d\x80\xd9\x9b\x7a\xba\xd4\x16\xfa\xe9\x47\x92\x7d\x53\x06\xcf\xc4\x6d\x3a\x27\x16\xc3\x45\xc8\x4d\x9a\x3a\x78\x79\x36\x13\x6c\x6d\x36\xab\x24\x76\xd2\x72\xfc\x86\xf5\xba\xe0\xec\x93\x29\xbb\x9d\x59\x8d\x6f\xda\x9a\x86\x8b\xa9\x3c\xbb\x92\x73\x0f\x27\xe2\xe3\xb4\x4b\x54\xf5\x52\x51\xc7\x68\x8a\x1e\x5e\x90\x07\xfd\x97\xbb\x1f\xa4\xef\x8d\xa3\x09\x5d\xe7\xb0\xdc\x31\xa9\x92\xe0\xa9\x42\xbd\x6e\xe3\x8a\x18\x38\x1a\x43\xcc\x65\xac\xeb\xfd\x25\xc5\xda\x70\x51\x72\xd0\xef\xeb\x85\x2a\xcd\xde\x18\x76\xe3\x5d\x61\x47\x57\x78\xb3\x2e\x19\x44\x4f\xdc\x71\xb8\x98\x01\xdc\xa1\xad\x71\x53\x0f\xd1\x4a\x75\x76\x39\x99\xc7\x37\x41\xef\x19\x4c\xd7\x5a\xba\xe0\x2e\xb3\x37\x51\xff\xf5\x76\xda\x10\x33\x6e\x67\xba\xe9\x09\xcc\x2f\x9d\xf4\x18\x63\x15\x1b\x74\x10\x08\x8b\x84\xae\xd7\x07\xd6\x15\xe9\x53\xf3\x62\x35\x0d\x4f\x41\x82\xb6\x66\xc0\x8d\x8f\x02\x35\xb2\x80\x04\x74\x6c\xe7\x48\xe8\x4a\xd0\xe6\x8a\x69\x02\x55\x5f\x52\xfd\x71\xb8\x5b\x3a\xb4\x3f\x2d\xb9\x63\x22\xa4\x8b\x9b\x49\xa5\xa0\xf7\x39\xeb\x10\x49\xbf\x5b\x3e\x61\xcd\xf4\x87\x30\x24\x98\xb7\x21\x58\xef\x5b\x9a\xb0\xf8\xdb\x2d\xb8\xd6\xec\xae\x30\xf2\x22\xed\x70\x71\x72\x92\x03\xaf\x1f\x13\x58\x30\xc8\x4d\x2c\xb5\x1a\x24\xa7\x09\x2a\x05\x37\x69\xa4\x42\x09\x52\xe9\xc4\xba\xed\x77\x20\x93\x09\xc4\x17\x86\x95\x1c\xa8\xc8\x41\x42\x1c\xf6\x60\xf1\x41\x6b\xf4\x64\x2c\x6e\x86\xfb\x89\x51\x9a\xf7\x69\xaf\x7f\x6c\x38\x56\x00\x1a\x43\xad\x50\x31\xba\xb4\xd7\x8b\xbf\x1c\xde\xbd\x3b\xb9\xe3\x4f\xaf\x87\xc4\xe1\x1c\x7e\xf9\x55\x2f\xf1\xc5\x07\x2a\xfa\x7b\x6e\xce\xe1\x97\x5f\xb3\xff\x0f\x00\x9b\x65\x0b\xdf\x2f\x19\x00\x00")
 
 func chartSeederCrdTemplatesBmcTinkerbellOrg_tasksYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -183,12 +191,12 @@ func chartSeederCrdTemplatesBmcTinkerbellOrg_tasksYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "chart/seeder-crd/templates/bmc.tinkerbell.org_tasks.yaml", size: 6447, mode: os.FileMode(420), modTime: time.Unix(1773987736, 0)}
+	info := bindataFileInfo{name: "chart/seeder-crd/templates/bmc.tinkerbell.org_tasks.yaml", size: 6447, mode: os.FileMode(436), modTime: time.Unix(1782200728, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _chartSeederCrdTemplatesMetalHarvesterhciIo_addresspoolsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x57\x4f\x8f\xe3\xb6\x0f\xbd\xe7\x53\x10\xf8\x1d\x7e\x97\x3a\xc1\xa0\x97\xc2\xb7\x41\xb6\x40\x07\xdd\x5d\x04\x93\x62\xee\x8c\xcd\xd8\xda\x91\x25\x2f\x49\xa7\x4d\xff\x7c\xf7\x42\x92\x3d\x71\xec\x24\xcd\xb4\x68\x75\x09\xcc\x27\x3e\x51\x7c\x14\xa5\x64\x59\xb6\xc0\xd6\xbc\x10\x8b\xf1\x2e\x07\x6c\x0d\xfd\xa2\xe4\xc2\x97\x2c\x5f\xbf\x93\xa5\xf1\xab\xc3\xc3\xe2\xd5\xb8\x32\x87\x75\x27\xea\x9b\x67\x12\xdf\x71\x41\x1f\x68\x6f\x9c\x51\xe3\xdd\xa2\x21\xc5\x12\x15\xf3\x05\x00\x3a\xe7\x15\x83\x59\xc2\x27\xc0\x6f\x7f\x2c\x00\x1c\x36\x94\x03\x96\x25\x93\x48\xeb\xbd\x95\x65\x70\xb2\xcb\x1a\xf9\x40\xa2\xc4\x75\x61\x96\xc6\x2f\xa4\xa5\x22\xf8\x55\xec\xbb\x36\x87\xcb\x93\x12\x5f\xcf\x9f\x62\x7b\x4c\xd4\x1b\xef\x6d\xb4\x5a\x23\xfa\xe3\x14\xf9\x68\x44\x23\xda\xda\x8e\xd1\x9e\x07\x14\x01\x31\xae\xea\x2c\xf2\x19\xb4\x00\x90\xc2\xb7\x94\xc3\xe7\xb0\x6c\x8b\x05\x95\x0b\x80\x43\xca\x5a\x0c\x23\x0b\xf3\x63\x32\xd0\x6e\xd8\x38\x25\x5e\x7b\xdb\x35\x43\x12\x32\xf8\x22\xde\x6d\x50\xeb\x1c\x96\xa2\xa8\x9d\xf4\x3f\x11\x1e\x12\x34\x8a\x75\x3b\x46\xf5\x18\x56\x17\x65\xe3\xaa\x5b\x7c\xac\x3d\xc3\x19\xeb\x76\x0e\xdc\x45\x68\x51\x2e\xf2\x7d\x9c\xd9\xef\xa2\x73\xa4\x0d\xca\xeb\x19\xd5\x67\xd2\x4f\x27\xdb\x8c\x26\x4d\x3a\x3c\xa0\x6d\x6b\x7c\x48\x0a\x15\x35\x35\x98\xf7\x1e\xbe\x25\xf7\xb8\x79\x7a\xf9\x76\x7b\x66\x06\x28\x49\x0a\x36\xad\xc6\xb2\x1e\xa5\x15\x8c\x80\xd6\x04\xeb\xe7\x0f\xb0\xf7\x0c\x0d\x1a\xa7\x68\x9c\x71\x15\x3c\xf6\x9a\x43\xac\x87\x08\xff\x30\x54\xde\x1b\x31\x80\xf3\x25\x09\xa0\x2b\xe1\xe5\x69\xf3\x7f\x79\x43\x5a\xf6\x2d\xb1\x9a\xa1\x30\xd3\x18\x1d\xaf\x91\x75\x12\xe0\xef\xd9\x19\x06\x10\xf6\x94\xbc\xa0\x0c\xe7\x8c\x52\xd4\x7d\xc9\x51\xd9\xa7\x01\xfc\x1e\xb4\x36\x02\x4c\x2d\x93\x90\x4b\x27\x2f\x98\xd1\x81\xdf\x7d\xa1\x42\x97\x13\xea\x2d\x71\xa0\x01\xa9\x7d\x67\x4b\x28\xbc\x3b\x10\x2b\x30\x15\xbe\x72\xe6\xd7\x37\x6e\x01\xf5\x71\x51\x8b\x4a\xa2\x10\x8b\xda\xa1\x85\x03\xda\x8e\xbe\x09\x09\x98\x30\x37\x78\x04\xa6\xb0\x26\x74\x6e\xc4\x17\x1d\x64\x1a\xc7\x27\xcf\x04\xc6\xed\x7d\x0e\xb5\x6a\x2b\xf9\x6a\x55\x19\x1d\x9a\x4e\xe1\x9b\xa6\x73\x46\x8f\xab\xc2\x3b\x65\xb3\xeb\xd4\xb3\xac\x4a\x3a\x90\x5d\x89\xa9\x32\xe4\xa2\x36\x4a\x85\x76\x4c\x2b\x6c\x4d\x16\x37\xe2\x62\xe3\x59\x36\xe5\xff\xb8\x6f\x53\x72\xb6\xec\xac\xc2\xd2\x88\x3d\xe4\x1d\xf2\x84\xce\x12\x2a\x09\x7b\xaa\xb4\xc5\x93\x0a\xc1\x14\x52\xf7\xfc\xfd\xf6\x27\x18\x22\x49\x4a\x25\x51\x4e\x53\x67\x79\x19\xf4\x09\xd9\x34\x6e\x4f\x9c\xfc\xf6\xec\x9b\xc8\x49\xae\x6c\xbd\x71\x1a\x3f\x0a\x6b\xc8\x29\x48\xb7\x6b\x8c\x86\x32\xf8\xda\x91\x68\x90\x6e\x4a\xbb\x8e\x8d\x19\x76\x04\x5d\x5b\xa2\x52\x39\x9d\xf0\xe4\x60\x8d\x0d\xd9\x35\x0a\xfd\xc7\x5a\x05\x55\x24\x0b\x22\xdc\xa5\xd6\xf8\xba\x99\x4e\x4e\xe9\x1d\x01\xc3\x85\x72\x1a\x97\xcf\x69\x18\x85\x29\x79\x6a\xbb\x1a\x45\x18\x15\x2a\xfd\x8c\xc7\x77\xf9\xf4\x6d\xf0\x5d\x3e\xa1\x54\xf8\x40\x65\xdf\xc8\xe6\x91\x03\x18\xa5\xe6\x82\xf9\x26\xed\x00\x22\x33\x1e\x17\xe7\x0b\x7e\xed\x0c\xd3\xe4\x50\x64\x31\x43\x13\x53\x9f\x83\xbb\xb4\x88\x17\xc1\xbd\x6a\xf4\xdd\xf8\xd1\x5a\x5f\xc4\xb6\x36\xdf\xdd\xf8\xd2\xbd\x46\x73\x7b\x91\x34\xe6\xe7\x7f\xba\x97\x2b\xf9\x83\xe1\x92\xfa\x27\xce\xf1\x45\xf1\x37\x19\x2e\x0b\x95\x46\x16\xf7\x75\x11\x08\xcb\x5e\x05\x62\x3c\x57\x2b\x69\xa6\xea\x5f\x82\x78\x40\x63\x71\x67\xe9\x46\xf9\x26\xf7\x70\xc7\x54\xc4\x13\x74\xf4\x0e\xf9\xd7\x4f\xda\xf8\x11\xf5\x5e\xc7\x59\x6d\xdf\x74\xb9\x76\xc4\x66\x65\x3f\xc5\x67\xd9\x9c\x4c\x98\x3f\xdb\x06\xe4\xfc\x05\x36\x58\x2f\xbc\x1b\x47\xd0\xe9\x11\x3a\xde\xcf\x44\xe7\x99\x31\xb5\xab\x1c\x94\xbb\x54\x49\xa2\x9e\xb1\xa2\xb1\xa5\xdb\xbd\xdd\xd1\x43\x0e\xfa\x24\x86\xff\x0c\x7f\x06\x00\x00\xff\xff\xb8\xd4\x96\x6e\x9b\x0c\x00\x00")
+var _chartSeederCrdTemplatesMetalHarvesterhciIo_addresspoolsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x4b\x6f\xe3\x36\x10\xbe\xeb\x57\x0c\xd0\x43\x2f\x2b\x07\x8b\x5e\x0a\xdd\x52\xef\x02\x0d\x9a\x5d\x04\x71\x90\x3b\x2d\x8d\xad\x59\x53\xa4\x96\x1c\x3a\x71\xb7\xfb\xdf\x8b\xa1\x24\x3f\x64\x49\xb1\x83\xa2\x56\x80\x40\x9c\x07\xe7\xc5\x6f\x46\x4c\xd3\x34\x51\x35\x3d\xa3\xf3\x64\x4d\x06\xaa\x26\x7c\x65\x34\xf2\xe6\x67\x9b\xdf\xfd\x8c\xec\xcd\xf6\x63\xb2\x21\x53\x64\x30\x0f\x9e\x6d\xf5\x88\xde\x06\x97\xe3\x27\x5c\x91\x21\x26\x6b\x92\x0a\x59\x15\x8a\x55\x96\x00\x28\x63\x2c\x2b\x59\xf6\xf2\x0a\xf0\xe3\x67\x02\x60\x54\x85\x19\xa8\xa2\x70\xe8\x7d\x6d\xad\xf6\x33\x11\xd2\xb3\x52\xb9\x2d\x7a\x46\x57\xe6\x34\x23\x9b\xf8\x1a\x73\x91\x5b\x3b\x1b\xea\x0c\x86\x99\x1a\x7d\xad\xfe\xc6\xb6\xdb\x46\xf5\x83\xb5\x3a\xae\x6a\xf2\xfc\x57\x9f\x72\x4f\x9e\x23\xb5\xd6\xc1\x29\x7d\x6a\x50\x24\x78\x32\xeb\xa0\x95\x3b\x21\x25\x00\x3e\xb7\x35\x66\xf0\x55\x55\xe8\x6b\x95\x63\x91\x00\x6c\x9b\xa8\x45\x33\x52\xe1\x8f\xc1\x50\xfa\xc1\x91\x61\x74\x73\xab\x43\xd5\x05\x21\x85\x6f\xde\x9a\x07\xc5\x65\x06\x33\xcf\x8a\x83\x6f\xff\xc5\x6d\xbb\x00\x1d\xd9\xba\x38\xa6\xf2\x4e\x76\xf7\xec\xc8\xac\xa7\xf4\x39\x6e\x35\x9c\x68\x5d\x9c\x13\x2e\x52\xa8\x95\x1f\xd4\x77\xaf\xfc\x7b\xd4\x19\xe4\x4a\xf9\xcd\x89\xaa\xaf\xc8\x5f\x0e\x6b\x67\x6a\x9a\xa8\x6c\x3f\x2a\x5d\x97\xea\x63\x5c\xf2\x79\x89\x55\xac\x34\x79\xb3\x35\x9a\xdb\x87\xbb\xe7\xdf\x16\x27\xcb\x00\x05\xfa\xdc\x51\x2d\x19\x39\x09\x2b\x90\x07\x2e\x11\xe6\x8f\x9f\x60\x65\x1d\x54\x8a\x0c\x2b\x32\x64\xd6\x70\xdb\xe6\x1c\x62\x81\x46\xf2\x9f\x5d\xe5\xed\x15\x03\x18\x5b\xa0\x07\x65\x0a\x78\xbe\x7b\xf8\xb5\x0b\x01\x40\xed\x6c\x8d\x8e\xa9\x2b\xcc\xe6\x39\x3a\x5e\x47\xab\x3d\x03\xff\x49\x4f\x68\x00\xe2\x53\x23\x05\x85\x9c\x33\x6c\xac\x6e\x4b\x0e\x8b\x36\x0c\x60\x57\xc0\x25\x79\x70\x58\x3b\xf4\x68\x9a\x93\x27\xcb\xca\x80\x5d\x7e\xc3\x9c\x67\x3d\xd5\x0b\x74\xa2\x06\x7c\x69\x83\x2e\x20\xb7\x66\x8b\x8e\xc1\x61\x6e\xd7\x86\xfe\xde\xeb\xf6\xc0\x36\x6e\xaa\x15\xa3\x67\x88\x45\x6d\x94\x86\xad\xd2\x01\x3f\x48\x00\x7a\x9a\x2b\xb5\x03\x87\xb2\x27\x04\x73\xa4\x2f\x0a\xf8\xbe\x1d\x5f\xac\x43\x20\xb3\xb2\x19\x94\xcc\xb5\xcf\x6e\x6e\xd6\xc4\x1d\xe8\xe4\xb6\xaa\x82\x21\xde\xdd\xe4\xd6\xb0\xa3\x65\x60\xeb\xfc\x4d\x81\x5b\xd4\x37\x9e\xd6\xa9\x72\x79\x49\x8c\x39\x07\x87\x37\xaa\xa6\x34\x3a\x62\xc4\x7d\x3f\xab\x8a\x5f\x5c\x0b\x53\x87\xfc\x0c\x56\x58\xf3\x17\x31\xe4\x8a\xf4\x08\xb2\x48\x25\xa9\x56\x55\x13\x93\x43\x16\x64\x49\x42\xf7\xf8\x79\xf1\x04\x9d\x25\x4d\xa6\x9a\xa4\x1c\x58\xfd\x58\x7e\x24\x9a\x64\x56\xe8\x1a\xb9\x95\xb3\x55\x4c\x07\x9a\xa2\xb6\x64\x38\xbe\xe4\x9a\xd0\x30\xf8\xb0\xac\x88\xa5\x0c\xbe\x07\xf4\x2c\xa9\xeb\xab\x9d\x47\x60\x86\x25\x42\xa8\x0b\xc5\x58\xf4\x19\xee\x0c\xcc\x55\x85\x7a\xae\x3c\xfe\xcf\xb9\x92\xac\xf8\x54\x92\x70\x51\xb6\x8e\xdb\xcd\xe1\xd7\x30\x37\xe1\x3d\x22\x74\x0d\x05\x60\xfa\x9c\xca\x93\x53\xe1\xfa\x6b\xa3\x56\x48\x25\xac\x15\xe3\x8b\xda\x5d\x25\x43\xb5\xaa\xce\x05\xa6\xeb\x4d\x9e\xbb\x87\xdb\x2f\x72\x5a\x57\xb4\x0e\xae\xc5\x83\xda\xd9\x2d\x15\xe8\xe0\xa5\xa4\xbc\x8c\x4b\x4a\x6b\x9b\x4b\x7e\xbb\x0e\x26\x58\xe5\x50\xaa\x50\x0a\xab\x80\x17\xe2\x72\x06\xb7\x27\x44\x6b\xf4\x0e\xd8\xa9\x7c\x83\x05\x90\x19\xd8\x5d\x54\x0b\x2c\x42\xd3\x1b\xe0\xa5\x44\x03\x52\x50\x12\x5f\x5a\x11\xf6\xc1\x60\x2a\xce\xf2\x18\xe4\xa5\x7d\x1d\xa2\x5c\x12\x0c\x79\xbe\x22\xff\x61\x5f\x25\x2c\x8b\x1a\xf3\xce\x41\x3f\x1e\x05\x0f\x54\x1f\xbd\x93\x69\x8d\xf8\x00\xd6\x81\x82\x28\xee\x80\xaa\x5a\x63\x75\x74\x88\x1b\xa6\x11\x23\x24\x9b\xb2\x35\xcb\xec\x34\xc8\x33\x1d\x06\x79\xc8\x78\xcc\x83\xc3\xc5\x86\xea\xa7\xfb\xc5\x33\x3a\x5a\x0d\xd4\x54\xf7\x34\xb5\xb5\xb4\x56\xa3\x1a\x4a\x95\x3c\x6c\x37\x68\x16\x98\x3b\xe4\x47\x5c\x8d\xeb\x3a\x89\xf4\xd3\x89\x10\x38\x5c\xa1\x43\x93\x4b\x8d\x80\x8f\xba\x62\xf1\xc4\xa0\x8c\x79\x7b\xb4\x3d\x90\x89\xac\xcd\xcb\x06\x77\xb1\x67\x48\x3f\x53\x41\xf3\xbe\xbd\xc4\xaa\x32\xdd\x60\x35\xaa\xf4\xed\x30\xee\x07\x86\x09\x7a\xcf\x63\x61\x17\x1c\x0f\x86\xbe\x07\x8c\xde\x91\x01\x75\x30\x47\x8c\xdc\xc7\x61\x52\x2f\x1c\x82\xd4\x01\xfe\x2c\x99\xe0\x9e\x82\x88\xe3\xdf\xde\x94\x2b\xdd\x8a\x32\x27\xc3\x43\xb3\xd2\xfa\x18\x01\x63\x52\x23\x34\x42\x8d\x4b\x62\x05\x54\xc1\x37\x0d\x24\x46\xeb\x3f\xf0\x6e\x04\xb2\x4f\x9f\xd7\x74\x13\x96\xe8\x0c\x32\xfa\xb4\x52\x75\xda\x48\x29\xb6\x15\xe5\x23\x52\xc1\xe9\x2c\x79\xb7\x69\xd2\x45\xc9\x61\x91\x0d\x52\xd3\xde\xe1\x1a\x61\x0a\x4e\x27\xef\xf0\xb9\x43\xf4\xe1\xbd\xdb\xb3\x93\x01\x99\xb9\x0e\xbd\xb9\x74\xa4\x18\x04\x20\x1f\x5a\xad\x4f\xbb\x1a\x81\xc6\x7b\x47\x87\x8d\x83\x5a\x21\x8e\x95\x71\x38\x3e\xef\x28\x83\x12\x68\xc2\x40\xab\x93\xbf\xf4\x0d\x0f\xd2\x16\x9a\x93\xab\x13\x38\x11\xdf\xf6\x03\x24\x4b\xae\x50\xd8\x39\xb9\xef\x97\xe7\xd2\xc4\x58\x0d\x82\xd2\x05\x76\x2a\xe7\xd4\x2e\x79\xbb\xf8\xd2\x38\x9b\xf4\x96\xda\xe9\x23\xb9\xc0\xfd\xa6\x6b\x67\xc9\x65\x88\xda\xd6\xc1\x6d\x33\x4a\x9c\x7d\xba\xb4\x2c\xfb\xcf\xdd\x29\x60\x7e\x0b\xb6\xcf\x27\xef\x0b\xe3\xf7\x16\xda\x5f\x24\x3c\x09\xac\x6f\x68\x98\x42\x89\x34\xfa\x35\x48\x10\x9b\x47\x09\x63\x2d\x70\xa2\xa8\x27\x89\x6a\xab\x48\xab\xa5\xc6\x89\xf2\x6d\xc4\xe5\xeb\x6e\x7d\x76\x18\xe5\x32\xc8\x19\xa5\xef\x3e\xf9\xf7\x97\xc0\x64\x1c\x4f\xa0\xea\xf3\x61\x3b\xa8\x54\xed\x07\xc7\xb8\x76\x6c\xa0\x42\xe0\x88\x4b\xa4\xbe\xd1\x87\x63\x1b\x6b\xb7\x1b\x43\x3a\x5f\x9a\x91\xad\x43\xbf\x6b\xa2\x29\x82\x8b\x9d\xc9\xb3\x69\x2f\x04\x70\x85\x4d\xbe\xf1\xac\x93\x41\x27\x7e\x44\x7b\x19\x0c\x72\x6b\x72\xd2\xd8\x9a\xde\x79\xd5\xf9\x29\x77\x69\x9d\xca\xc3\x6f\x3f\x73\x5d\xe8\xc1\xf4\x91\x93\x2f\x08\x4d\x39\x0f\x12\x7b\x9e\xcc\x3b\xde\x78\x9d\x36\x3e\x59\xc7\x61\x42\x7a\xc2\xa0\x4a\x80\xe0\xb1\x80\xe5\x0e\x2c\x97\x72\xf3\xd0\x26\x64\xd4\x81\x49\x50\x7d\xb3\xa2\xa6\xc0\xb5\xad\x6a\xe7\xac\xbb\xc0\xfd\xcf\xc2\xb7\xcf\xe2\x4b\xb9\x3b\x64\xd2\x4b\x7e\x57\x8a\x34\x16\x13\x06\x8c\x5a\x28\x37\x6b\x52\x22\x58\x5c\x60\xc6\xfd\x9e\xb9\x6b\xdd\x4c\xd5\xbe\x84\x44\x15\xf8\x90\xe7\xe8\xfd\x2a\xe8\x68\xd9\xf5\x26\x4d\x54\xfd\xd1\x2d\x60\x96\x5c\xa1\xf4\x3d\xdd\xf6\xf8\x0a\xf3\x5a\x41\x0e\xd7\x88\x8c\xb5\xd9\xb3\xd6\xd7\xa7\x9f\x21\x6a\x8f\xe1\xfc\xd2\xb4\xa3\xb4\x01\xe9\xad\x0e\xdc\xda\x1e\x91\x0e\x57\xc0\x13\x79\x3a\x5b\x14\xec\xc3\x22\x03\x76\xa1\xe9\x26\x9e\xad\x53\x6b\x3c\x5e\x09\xcb\xee\x33\x65\x1f\x36\xcf\x8a\x83\xcf\xe0\xc7\xcf\xe4\xdf\x01\x00\x52\xe1\x9e\xb8\x19\x18\x00\x00")
 
 func chartSeederCrdTemplatesMetalHarvesterhciIo_addresspoolsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -203,12 +211,52 @@ func chartSeederCrdTemplatesMetalHarvesterhciIo_addresspoolsYaml() (*asset, erro
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "chart/seeder-crd/templates/metal.harvesterhci.io_addresspools.yaml", size: 3227, mode: os.FileMode(420), modTime: time.Unix(1774588771, 0)}
+	info := bindataFileInfo{name: "chart/seeder-crd/templates/metal.harvesterhci.io_addresspools.yaml", size: 6169, mode: os.FileMode(436), modTime: time.Unix(1792439149, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _chartSeederCrdTemplatesMetalHarvesterhciIo_clustersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\xc1\x72\xe3\x36\x0f\xbe\xfb\x29\x30\xf3\x5f\x7f\xd9\x93\xe9\xa5\xa3\x5b\xc6\xe9\x21\xb3\xbb\xad\x27\x49\xf7\x4e\x8b\xb0\x84\x8d\x44\xb2\x24\xa8\xd6\xdd\xee\xbb\x77\x48\x4a\x89\x2c\x4b\xb2\x37\x99\x69\x2f\xe5\x25\x23\x80\xf8\x00\x02\x1f\x40\x3a\x59\x96\xad\x84\xa1\xcf\x68\x1d\x69\x95\x83\x30\x84\x7f\x30\xaa\xf0\xe5\xd6\xcf\x3f\xba\x35\xe9\x4d\x7b\xb3\x7a\x26\x25\x73\xd8\x7a\xc7\xba\x79\x40\xa7\xbd\x2d\xf0\x0e\x0f\xa4\x88\x49\xab\x55\x83\x2c\xa4\x60\x91\xaf\x00\x84\x52\x9a\x45\x10\xbb\xf0\x09\xf0\xf5\xdb\x0a\x40\x89\x06\x73\x28\x6a\xef\x18\xad\x5b\x07\x83\x7a\x5d\x09\xdb\x62\x10\x54\x05\xad\x49\xaf\x9c\xc1\x22\xd8\x94\x56\x7b\x93\xc3\xf4\xa6\x84\xd5\x61\x77\x71\x25\xd8\x28\xa9\xc9\xf1\x87\xa1\xf4\x23\x39\x8e\x1a\x53\x7b\x2b\xea\xd7\x20\xa2\xd0\x91\x2a\x7d\x2d\xec\x8b\x78\x05\xe0\x0a\x6d\x30\x87\x9f\x83\x1b\x23\x0a\x94\x2b\x80\x36\x65\x28\xba\xcd\x40\x48\x19\x0f\x2e\xea\x9d\x25\xc5\x68\xb7\xba\xf6\x4d\x7f\xe0\x0c\xbe\x38\xad\x76\x82\xab\x1c\xd6\x8e\x05\x7b\xd7\xfd\x89\xea\x3e\x19\x5d\x7c\x8f\x43\x0d\x1f\x83\x67\xc7\x96\x54\x39\x8b\xc5\xfa\x19\xd5\x14\xd4\xd3\x40\x71\x15\x52\x77\xe6\x5b\x29\x2d\xba\xc9\xe8\x4e\x55\x67\xa0\x69\x6f\x7b\x23\x6a\x53\x89\x9b\x94\xd1\xa2\xc2\x46\xe4\x9d\x85\x36\xa8\x6e\x77\xf7\x9f\x7f\x78\x3c\x11\x03\x48\x74\x85\x25\xc3\x91\x76\x9d\x33\x20\x07\x5c\x21\xa4\xbd\x70\xd0\x36\x7e\xf6\x05\x83\xdb\xdd\xfd\x8b\xbd\xb1\xda\xa0\x65\xea\x99\x90\xd6\x80\xcb\x03\xe9\xc8\xdb\x5f\xd9\x89\x0e\x02\x6e\x67\x05\x32\x90\x1a\x53\x18\x5d\xcd\x51\x76\x67\x02\x7d\x00\xae\xc8\x81\x45\x63\xd1\xa1\x4a\x34\x0f\x62\xa1\x40\xef\xbf\x60\xc1\xeb\x11\xf4\x23\xda\x00\x03\xae\xd2\xbe\x96\x50\x68\xd5\xa2\x65\xb0\x58\xe8\x52\xd1\x9f\x2f\xd8\x0e\x58\x47\xa7\xb5\x60\x74\x0c\x91\x55\x4a\xd4\xd0\x8a\xda\xe3\xff\x41\x28\x39\x42\x6e\xc4\x11\x2c\x06\x9f\xe0\xd5\x00\x2f\x1a\xb8\x71\x1c\x9f\xb4\x45\x20\x75\xd0\x39\x54\xcc\xc6\xe5\x9b\x4d\x49\xdc\x77\x78\xa1\x9b\xc6\x2b\xe2\xe3\xa6\xd0\x8a\x2d\xed\x3d\x6b\xeb\x36\x12\x5b\xac\x37\x8e\xca\x4c\xd8\xa2\x22\xc6\x82\xbd\xc5\x8d\x30\x94\xc5\x83\xa8\xd8\xe5\xeb\x46\xfe\xcf\x76\x33\xc1\x9d\xb8\x3d\xa3\x4b\x5a\xb1\x69\xbf\xa3\x3c\xa1\x9d\x03\x35\x44\x07\x95\x8e\xf8\x5a\x85\x20\x0a\xa9\x7b\xf8\xe9\xf1\x09\xfa\x48\x52\xa5\x52\x51\x5e\xb7\x9e\xe5\xa5\xaf\x4f\xc8\x26\xa9\x03\xda\x64\x77\xb0\xba\x89\x98\xa8\xa4\xd1\xa4\xb8\x23\x22\xa1\x62\x70\x7e\xdf\x10\x07\x1a\xfc\xe6\xd1\x71\x28\xdd\x18\x76\x1b\xa7\x20\xec\x11\xbc\x91\x82\x51\x8e\x37\xdc\x2b\xd8\x8a\x06\xeb\xad\x70\xf8\x0f\xd7\x2a\x54\xc5\x65\xa1\x08\x57\x55\x6b\x38\xdb\xc7\x9b\x53\x7a\x07\x8a\x7e\x82\xcf\x94\xb6\x1f\x79\x06\x8b\x93\x4e\x93\xe8\xc8\x86\x5e\x60\xc1\x18\xfa\x69\x38\xd1\xfb\x35\xdd\xf1\x61\x75\x03\x62\xab\xd5\x81\xca\xb1\x72\xc9\x30\xac\xbd\x56\xf2\x17\x33\xb8\xaf\xc6\x6b\x38\xec\x97\x80\x16\x72\x78\x31\x6f\x2f\x27\x89\x47\xf8\xf5\xe1\xe3\xb4\x83\x0b\xf0\x45\xbc\x9f\x77\x56\xb7\x14\x06\x17\xa9\xf2\x09\x1b\x13\x26\xca\x9b\xe0\xe2\x3d\x9b\xfa\x63\xda\x9e\x18\x9b\xf7\xa6\x42\x58\x2b\x8e\x13\x7a\xe7\xaa\x0f\x78\xfc\x37\x1c\xb3\x45\xd1\xdc\x37\xa2\xc4\x4f\x5a\x2e\x66\x6e\xaf\x75\x8d\x42\x4d\xec\x68\x6b\xa1\xee\xef\xa6\x6d\x25\x1e\x84\xaf\x39\x87\x9b\x49\x75\x43\x8a\x1a\xdf\xcc\xa9\x93\xe7\x70\x3d\x94\xa3\xfe\x48\xeb\x77\x32\x78\x47\xee\x79\x26\x3d\xcb\x81\x2f\xb0\x93\x42\x42\x26\x89\xb9\x90\x6f\xa5\xe5\x54\xaf\xcc\x96\x6f\xb9\x53\x63\x2b\x86\xd7\xc8\x4e\xeb\xfa\x01\x0f\x68\x51\x15\x33\x05\xba\x8c\x05\xfd\xf3\x65\x56\x7b\x05\x95\x7a\x90\xf8\x4e\x7c\x27\x52\xb8\x4e\xc2\x14\x9c\x83\xc9\xa2\xa7\x45\x65\x0c\x63\xb1\x2d\x66\x27\x0f\x00\xc5\x5b\x42\xdb\xe3\x7f\xa9\x9d\x50\xbe\x2b\xb5\xe1\x5e\xa3\xa2\x7b\x4b\xbf\x71\x6e\x2d\x9d\x21\x9b\xec\x8c\xc9\x8d\xe7\x55\x9e\xd8\xb6\x78\xa0\xf9\xf1\xd9\x4e\x3d\xbd\x2f\x1c\xad\x25\xf3\xb6\x7b\xfb\xfa\x59\x70\x99\xae\xcb\x64\xbd\x82\x60\x57\x10\xf5\x22\xca\x32\x49\x17\x28\x7a\x89\xa0\x17\xe8\x79\x05\x39\x17\x63\x9f\x8f\xfb\x4a\x5a\xce\xc6\x37\x8d\x9c\xa5\x6b\x65\x24\xeb\xb8\x37\x96\xf6\xec\xba\xea\xf1\x1a\x7f\x14\x5f\xf1\x7c\x8d\xfb\x4e\x1e\xb0\x7a\x1f\x1f\x4a\xef\x7d\xc1\xce\x16\x61\xa1\x00\x53\x51\x5f\x30\x89\xff\x40\xf8\x0e\x8b\xc9\x7c\x9d\x09\x53\x06\x72\x60\xeb\x53\x8d\x1d\x6b\x2b\x4a\x1c\x4a\xfc\xfe\xe5\x97\x62\xef\xbf\x8b\x1f\xbe\x7e\x5b\xfd\x1d\x00\x00\xff\xff\x87\x3f\xde\x4a\x8e\x12\x00\x00")
+var _chartSeederCrdTemplatesMetalHarvesterhciIo_bmcdiscoveriesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x5f\x8f\xdb\xb8\x11\x7f\xd7\xa7\x18\xa0\xaf\x67\x2f\xf6\x92\x1e\x0a\xbd\x5d\x9c\x43\xb1\x68\xb6\x5d\xc4\x41\xde\x29\x72\x6c\xf1\x56\x22\x75\x1c\xca\x7b\xee\x35\xdf\xbd\x18\x52\xb2\x65\x8b\x92\xe5\xb4\xb8\x95\x81\x44\x1c\x72\xfe\xcf\x6f\x86\x5a\xad\x56\x99\x68\xf4\x57\x74\xa4\xad\xc9\x41\x34\x1a\x7f\xf7\x68\xf8\x8d\xd6\xaf\x7f\xa3\xb5\xb6\x0f\x87\xc7\xec\x55\x1b\x95\xc3\xa6\x25\x6f\xeb\xcf\x48\xb6\x75\x12\x3f\xe2\x4e\x1b\xed\xb5\x35\x59\x8d\x5e\x28\xe1\x45\x9e\x01\x08\x63\xac\x17\xbc\x4c\xfc\x0a\xf0\xc7\xb7\x0c\xc0\x88\x1a\x73\x28\x6a\xa9\x34\x49\x7b\x40\xa7\x91\xd6\x7c\xac\x5a\x97\xc2\x1d\x90\x3c\xba\x52\xea\xb5\xb6\x19\x35\x28\xf9\xe4\xde\xd9\xb6\xc9\x21\xbd\x29\x72\xec\x24\x44\xed\x3e\x3c\x6f\x3e\x76\xcc\x8f\x61\xb9\xd2\xe4\xff\x31\x22\x7d\xd2\xe4\x03\xb9\xa9\x5a\x27\xaa\x6b\xa5\x02\x89\xb4\xd9\xb7\x95\x70\x17\xc4\x63\x06\x40\xd2\x36\x98\xc3\x3f\x45\x8d\xd4\x08\x89\x2a\x03\x38\x44\xe7\x05\x5d\x56\x20\x94\x0a\x3e\x11\xd5\x8b\xd3\xc6\xa3\xdb\xd8\xaa\xad\x7b\x5f\xac\xe0\x57\xb2\xe6\x45\xf8\x32\x87\x35\x79\xe1\x5b\xea\xfe\x09\x72\x7b\x3f\x6d\x87\x4b\xfe\xc8\x22\xc9\x3b\x6d\xf6\x93\x4c\x2a\x41\x7e\x2b\x85\xf9\xa2\x6b\xbc\x60\xf5\x69\x4c\x58\xc4\xb0\x46\x22\xb1\xbf\xe4\xf5\x7c\xb1\x36\x62\x13\x37\x1d\x1e\x45\xd5\x94\xe2\x31\x2c\x91\x2c\xb1\x0e\x69\xc1\x6f\xb6\x41\xf3\xf3\xcb\xd3\xd7\x77\xdb\x8b\x65\x00\x85\x24\x9d\x6e\xd8\x6f\x97\xc1\x02\x92\xc2\x10\x18\xf4\x6f\xd6\xbd\x12\xec\xac\x03\x87\x6a\xa7\xa9\x04\x34\xaa\xb1\xda\x78\x02\x61\x14\xec\xd1\xa0\x13\xbe\x0b\x60\xfc\x3d\x99\x03\x1a\x6f\xdd\x11\x6c\xf1\x2b\x4a\x1f\xcf\xf7\xf1\x44\x05\xb5\x90\xa5\x36\x83\x33\x8d\xb3\x0d\x3a\xaf\xfb\xcc\x8a\xcf\xa0\x42\x06\xab\x57\x6a\xff\x67\x75\x41\x03\x60\x4b\xe3\x29\x50\x5c\x2a\x48\xe0\x4b\xec\xd3\x05\x55\xe7\x1c\xb0\x3b\xf0\xa5\x26\x70\xd8\x38\x24\x34\xb1\x78\x78\x59\x98\x4e\xf1\xf5\x15\xeb\x2d\x3a\x66\x03\x54\xda\xb6\x52\x20\xad\x39\xa0\xf3\xe0\x50\xda\xbd\xd1\xff\x3e\xf1\x26\xf0\x36\x08\xad\xd8\x33\x1e\x42\x42\x1a\x51\xc1\x41\x54\x2d\xfe\xc0\x8e\xbb\xe2\x5c\x8b\x23\x38\x64\x67\x41\x6b\x06\xfc\xc2\x01\xba\xd6\xe3\xd9\x3a\x04\x6d\x76\x36\x87\xd2\xfb\x86\xf2\x87\x87\xbd\xf6\x3d\x6e\x48\x5b\xd7\xad\xd1\xfe\xf8\x20\xad\xf1\x4e\x17\xad\xb7\x8e\x1e\x14\x1e\xb0\x7a\x20\xbd\x5f\x09\x27\x4b\xed\x51\xfa\xd6\xe1\x83\x68\xf4\x2a\x18\x62\xd8\x7c\x5a\xd7\xea\x2f\xae\x43\x9a\x61\x4c\x13\x79\x17\x7f\x01\x04\xee\x08\x0f\x23\x03\x68\x02\xd1\xb1\x8a\x3e\x39\x47\x81\x97\xd8\x75\x9f\x7f\xd9\x7e\x81\x5e\x93\x18\xa9\x18\x94\xf3\x56\x9a\x8a\x0f\x7b\x53\x9b\x1d\xba\x78\x6e\xe7\x6c\x1d\xc2\xd1\xa7\x6e\x78\x91\x95\x46\xe3\x81\xda\xa2\xd6\x9e\xd3\xe0\xb7\x16\xc9\x73\xe8\xae\xd9\x6e\x02\xb6\x42\x81\xd0\x36\x4a\x78\x54\xd7\x1b\x9e\x0c\x6c\x44\x8d\xd5\x46\x10\xfe\xc9\xb1\xe2\xa8\xd0\x8a\x83\xb0\x28\x5a\xc3\x8e\x71\xfe\x8b\x9b\xa3\x7b\x07\x84\xbe\x23\x2c\x0d\xed\x10\x41\xb6\x0d\xca\x8b\xfa\x3b\x61\x89\xb7\x01\x5d\xd2\x98\x12\x6a\x23\xec\x57\xb8\x13\x6d\xe5\x2f\xad\x62\x4c\x68\x2a\x8d\x8a\x0b\x4c\x8f\x60\xa6\x07\x23\x75\x13\x70\xa6\x41\x87\x9f\xa2\x96\x2f\xd6\xf9\xeb\x65\xb6\x3f\x28\x95\xc3\x4f\x3f\xbe\x4b\x10\x07\xce\xf9\xf0\xbc\x61\x16\x9c\xe9\x6c\x4d\xc3\xff\x27\xf4\x60\x4d\xb0\xae\x10\x84\x85\x15\x2e\x80\x88\x41\xd9\x41\xcf\x88\x27\x9c\x00\x56\x9d\xec\xed\xbb\xe5\xf0\x2f\x46\x90\x71\x66\x8f\xee\x8a\x2a\xb5\x72\x23\x13\x01\xb4\xc7\x3a\xb1\x3c\x99\x3a\x5d\x02\x69\xf3\x14\x0e\xc2\xe3\x88\x16\x0f\x0a\xe7\xc4\xf1\x8a\x26\x1d\x2a\x4e\x5a\x51\x51\x3e\xef\xb7\x51\x52\xf1\x6f\x73\x3e\x0e\xc2\x21\x10\x4a\x87\x9e\xd8\x77\x5e\x68\xc3\x90\x21\xa0\x25\x74\xdc\x0a\x43\x06\x35\x82\xe8\xcd\x3a\xb5\x86\x5f\x84\x2c\xbb\x03\x21\x18\x8e\xb3\x47\x1b\xb0\x4e\x8d\x3c\xc5\x3f\xb1\x17\xda\x90\x07\x31\xcc\x9f\x3e\x3f\xcf\xe9\xb9\xd3\x8e\x38\x9a\xc8\x99\x28\x5a\x5f\xb2\x79\x52\x78\x64\x21\x2d\xa1\x82\xe2\x18\x22\x3d\x8e\xdf\x71\x79\x28\x6e\xbb\x06\x60\x1b\x6c\xfb\x8c\x3b\x74\x68\xe4\x00\x49\x19\x63\x23\x11\x4e\xd4\x35\x3c\x79\x28\x05\x01\x1a\xdb\xee\xcb\x00\x4c\xae\x8e\x9d\xcf\x5b\x70\xe8\x9d\xc6\x43\xef\xe1\xa4\x3c\x6d\x40\x98\x63\x18\x73\xc2\x2c\x96\xd8\x34\x5d\x59\x83\xb1\x26\x49\xb9\x32\x99\x37\x06\x87\x1a\xfd\x5b\x8b\xf0\xa6\x7d\xc9\xe2\xcf\xc2\xd9\xfb\xae\x37\x6e\x82\x23\x70\xaf\x89\x7e\xe8\xfb\xc9\x3a\x4b\xee\x9b\x4f\xfd\x5e\xf3\x60\xf5\x62\xf5\xc3\xee\x0b\x24\x8c\x2b\x9d\x2d\x6f\xa5\x96\x65\x5c\x9e\x76\x79\x2f\x19\xea\x96\x62\x1f\x0a\xfe\xf8\x4e\x2b\x26\xd0\xbe\x7f\x7e\x5f\xbd\xb6\x05\x3a\x83\x1e\x69\x55\x8b\x66\xd5\x95\xb5\xb7\xb5\x96\xa3\xfd\xdf\x87\x07\xc8\x95\x90\x48\x8e\xf9\xc4\x41\x23\x8a\x0a\xaf\xa6\x8d\x11\x34\xef\x44\x45\xe9\x54\x88\x86\x14\xd6\x56\x28\x4c\x62\x47\x63\xab\x4a\x9b\xfd\x13\x4f\x6b\x07\x51\xdd\x90\xf3\x58\x26\xe9\xb1\x9e\x72\x50\xad\x0b\x65\x95\xdd\x1d\x21\x9e\x44\xb4\x4b\xd9\xb9\xea\x5d\x90\xdd\x11\xd3\xc6\xe9\x5a\xb8\xe3\x47\x4d\xaf\x79\x36\x69\x0d\x8f\x1c\x0f\xa4\x44\x76\x87\xaa\x8d\xb3\x05\xf2\xcd\xc7\xb6\x73\xbd\xf2\xaf\x94\xdd\xed\xa4\x19\xa9\xdd\xcc\x70\xa3\x41\xbf\x7f\xff\xee\xae\x26\xc9\x23\xc9\x74\xe0\x4f\x6c\x7f\x7c\x5f\xfe\x5f\x8d\xe1\xcb\xef\xee\xf8\xe5\xd3\x36\xcf\x66\x91\x24\x89\xfd\x5f\xfb\xc3\x7c\xb9\xd1\x3b\xdd\xe1\x8b\xe4\x0a\xda\x85\x66\x44\x7c\x9d\x19\xf4\xb1\xa2\x96\x04\x6f\x25\x9a\x61\xcb\xe2\xf6\xc9\x68\x14\x0f\x9f\x5b\x6d\x6c\x76\x09\x7b\x60\x6e\x84\x49\x0f\x2c\x6b\xf8\xc0\xa2\x79\xdc\xb5\xa6\x3a\x72\xb3\x06\xc2\x6a\x07\xa4\xf7\x06\xd5\x85\xce\x3f\x00\xd9\x84\xcc\x0b\xb3\x78\x0a\xe0\x99\xbc\x33\x3c\x74\xdb\x2e\x48\x6b\x78\x71\xb6\x60\xa3\x78\x08\x64\x4d\xfb\x29\x93\xd0\x1d\x34\x77\x48\x6b\x79\x18\x33\x8a\xc0\xd8\x0b\x8b\x13\x62\xd9\x07\x06\x0f\xe8\xce\x4e\x1e\x6a\x92\x2d\x07\x99\x74\x5d\xaf\xe2\x7c\x76\xbd\x76\x56\x2a\x5b\x50\xe7\xf1\xe3\x41\x9e\x2d\xc3\xd2\x73\x42\x3c\x77\x73\x71\xbe\x7c\x24\x99\x87\x68\xe0\x0f\x31\x0e\x69\x82\x38\x5b\x0b\xfd\x78\xd1\x4d\x49\x53\x1c\x6e\x29\xd0\x37\xcb\x69\xea\x02\x2d\x16\xf5\xfa\x85\x9c\xa6\xf1\xbc\x8f\x36\x4b\x9a\x25\x4e\x0d\x5a\x33\x29\x71\x7e\xba\x4f\x4a\x79\xf6\x9d\x16\xa4\x92\x6b\xf1\xf1\x39\xe3\x57\x7d\xb2\x24\x69\x17\xdf\xe7\x16\x1b\x3c\x3d\x73\x0c\x3f\xd5\xe5\xd9\x1d\x66\x4c\xfa\x6f\xe6\x0c\xb7\x93\x17\x67\xf7\xe9\x52\xb8\x0d\xed\xdb\xc1\x79\x1e\x80\xf9\xea\xf8\x56\xea\x0a\x79\x96\xe5\xdb\xb3\x26\xd0\x06\x9a\x6e\xcb\x1a\x3e\x8e\x6a\x1a\x4a\x5b\x29\xea\x00\x90\xf8\x2a\xcd\xcd\x80\x5f\xd9\x13\x09\x99\xd2\xd6\x4d\x85\x1e\xa3\x80\xd6\x78\x5d\x85\xd3\xe1\x75\x20\xec\xb4\x91\xb2\xfb\x6a\x73\x09\xee\x8c\xdc\x93\x30\x8c\xc1\x9f\x15\xeb\x6f\xf5\x03\xc6\x50\x5c\x87\xbd\xff\x3b\x59\x42\x16\x76\x22\x75\xf3\x9b\x01\xbd\xa5\xb8\x73\x03\xfc\x6e\x24\xcd\x1d\x20\xb8\x54\xa1\x25\x60\xb8\x58\xab\xc5\xa0\x78\x07\xc7\x5b\xe0\x78\x13\x20\x97\x81\xe4\x4d\xdc\xb8\x59\xec\x77\x5a\x36\x0f\x9a\x0b\xd9\xdc\x72\xce\x1c\x80\xde\x00\xd1\x45\x0e\x99\x06\x53\x7e\x6c\xc1\x03\x15\xaa\xbf\xc7\x81\x6f\xf4\x99\x3e\x59\xce\xff\x1a\x1d\xea\x3f\x91\x75\x73\x63\x37\x45\xf2\x0a\x7f\x7f\x4c\x72\x04\x28\x90\x07\x3c\xae\x67\x83\x6a\x0d\x5f\xfa\xe2\x76\x48\x5e\x38\x4f\xa0\xcf\x2c\x40\x96\xc2\xec\x13\x68\x35\x1c\xde\xb5\xf1\x3f\xbd\x4f\xee\x98\xbb\x35\xf4\x50\x6f\x50\xfd\x1c\x4b\x7f\xaa\x18\x6f\x71\xf1\xd6\x8b\xea\x7f\xe2\x31\x9d\x2c\xab\x44\xa8\x12\x9b\xae\x0d\x49\x6c\xb9\xd4\x32\xbb\x23\xa1\xa6\xea\x61\xb2\x0a\x92\xbc\x46\x8b\x31\x99\x72\xf0\xae\x8d\xa5\x4f\xde\x3a\xae\xdf\xc1\x4a\x5b\xf4\x9f\x7d\x4e\xf2\xc9\x0b\xdf\x52\x0e\x7f\x7c\xcb\xfe\x3b\x00\xfa\x47\xa4\xdb\x8b\x1d\x00\x00")
+
+func chartSeederCrdTemplatesMetalHarvesterhciIo_bmcdiscoveriesYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_chartSeederCrdTemplatesMetalHarvesterhciIo_bmcdiscoveriesYaml,
+		"chart/seeder-crd/templates/metal.harvesterhci.io_bmcdiscoveries.yaml",
+	)
+}
+
+func chartSeederCrdTemplatesMetalHarvesterhciIo_bmcdiscoveriesYaml() (*asset, error) {
+	bytes, err := chartSeederCrdTemplatesMetalHarvesterhciIo_bmcdiscoveriesYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "chart/seeder-crd/templates/metal.harvesterhci.io_bmcdiscoveries.yaml", size: 7563, mode: os.FileMode(420), modTime: time.Unix(1792439149, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _chartSeederCrdTemplatesMetalHarvesterhciIo_clusteraddonsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x56\x4d\x6f\xe3\x46\x0c\xbd\xfb\x57\x10\xe8\x75\x6d\x23\xe8\xa5\xf0\x2d\x48\x0b\x34\x68\x53\x2c\xd6\x8b\xbd\xd3\x23\xda\x62\x23\xcd\x4c\x49\xca\xa8\xfb\xf1\xdf\x0b\xce\x58\xb1\x65\x3b\x9b\xb4\x87\x46\xbe\x68\x38\xf3\x48\xbe\xc7\x79\xca\x7c\x3e\x9f\x61\xe6\x2f\x24\xca\x29\xae\x00\x33\xd3\xef\x46\xd1\xdf\x74\xf1\xfc\x9d\x2e\x38\x2d\xf7\x77\xb3\x67\x8e\xcd\x0a\x1e\x06\xb5\xd4\x7f\x22\x4d\x83\x04\xfa\x9e\xb6\x1c\xd9\x38\xc5\x59\x4f\x86\x0d\x1a\xae\x66\x00\x18\x63\x32\xf4\x65\xf5\x57\x80\x3f\xff\x9e\x01\x44\xec\x69\x05\xa1\x1b\xd4\x48\xb0\x69\x1c\xde\x4f\x75\x8b\x16\x65\x4f\xbe\xda\x06\x5e\x70\x9a\x69\xa6\xe0\x07\x77\x92\x86\xbc\x82\xdb\x9b\x2a\xe0\x31\xc1\xb1\xb8\x8a\x7d\xef\xd8\x65\xb9\x63\xb5\x9f\xae\x42\x3f\xb3\x5a\x09\xe7\x6e\x10\xec\x2e\x6a\x2a\x11\xe5\xb8\x1b\x3a\x94\x69\x6c\x06\xa0\x21\x65\x5a\xc1\x2f\xd8\x93\x66\x0c\xd4\xcc\x00\xf6\x95\xba\x52\xca\xfc\xd8\xe6\xfe\x0e\xbb\xdc\xe2\x5d\x45\x0b\x2d\xf5\x85\x19\x7f\x4b\x99\xe2\xfd\xc7\xc7\x2f\xdf\xae\x27\xcb\x00\x0d\x69\x10\xce\xce\xdb\xb4\x60\x60\x05\x6b\x09\xea\x01\xd8\x26\x29\xaf\x93\x2d\xf7\x1f\x1f\x5f\x80\xb2\xa4\x4c\x62\x3c\xb2\x53\x9f\x33\x91\xcf\x56\x2f\xd2\xfe\x35\x9f\xc4\x00\xbc\xd2\x7a\x0a\x1a\x57\x9b\x6a\x29\xc7\x9e\xa9\x39\x36\x07\x69\x0b\xd6\xb2\x82\x50\x16\x52\x8a\x55\x7f\x5f\xc6\x08\x69\xf3\x2b\x05\x5b\x5c\x40\xaf\x49\x1c\x06\xb4\x4d\x43\xd7\x40\x48\x71\x4f\x62\x20\x14\xd2\x2e\xf2\x1f\x2f\xd8\x0a\x96\x4a\xd2\x0e\x8d\xd4\x80\xa3\x91\x44\xec\x60\x8f\xdd\x40\x1f\x00\x63\x73\x81\xdc\xe3\x01\x84\x3c\x27\x0c\xf1\x0c\xaf\x1c\xd0\xcb\x3a\x9e\x92\x10\x70\xdc\xa6\x15\xb4\x66\x59\x57\xcb\xe5\x8e\x6d\x1c\xfd\x90\xfa\x7e\x88\x6c\x87\x65\x48\xd1\x84\x37\x83\x25\xd1\x65\x43\x7b\xea\x96\xca\xbb\x39\x4a\x68\xd9\x28\xd8\x20\xb4\xc4\xcc\xf3\xd2\x48\xf4\xf6\x75\xd1\x37\xdf\xc8\xf1\xb2\xe8\x24\xad\x1d\x7c\x8e\xd4\x84\xe3\xee\x2c\x50\x06\xf9\x5f\xc8\xe3\xd3\xed\xe3\x81\x47\xa8\xca\xc9\x49\x05\x5f\x72\xea\x3e\xfd\xb0\xfe\x0c\x63\x25\x55\xa9\x2a\xca\x69\xab\xbe\xa6\x8f\xb3\xc9\x71\x4b\x3e\x75\xac\xb0\x95\xd4\x17\x39\x28\x36\x39\x71\xb4\xf2\x12\x3a\xa6\x68\xa0\xc3\xa6\x67\xf3\x31\xf8\x6d\x20\x35\x97\xee\x12\xf6\xa1\xd8\x03\x6c\x08\x86\xdc\xa0\x51\x73\xb9\xe1\x31\xc2\x03\xf6\xd4\x3d\xa0\xd2\xff\xac\x95\xab\xa2\x73\x17\xe1\x5d\x6a\x9d\x9b\xde\xe9\xaf\x6e\xae\xf4\x9e\x05\x46\x57\x7b\xaf\xb4\xe7\xd7\x7b\x9d\x29\x4c\xee\x5f\x8f\x91\xb7\x85\x60\x8c\x0d\xbc\x78\x23\x28\x99\x71\xdc\x29\x60\xce\x1d\x53\xe3\x57\xe7\x68\x61\x2e\xca\x96\x84\x62\x18\x87\xa2\x98\xf0\x02\x9e\x46\xac\x8b\x02\x50\xe8\x05\x66\x43\x5b\xbf\x25\x23\xfc\x07\xd0\x74\xca\x15\x30\x56\x6c\xcf\x56\xfb\x56\x08\x42\x2e\x2e\x6c\x0e\xd3\x82\x27\x49\x6e\x3b\x95\x3f\xe3\xfe\x87\x14\xb7\xbc\x7b\xc2\x7c\xb5\xe3\x2d\xfe\xfc\x79\xba\x42\x29\x5d\x85\x02\xda\x63\x56\xe0\x78\xa2\xa2\x78\x77\xb1\x75\x37\x23\x43\x8e\xce\xd4\xf3\xb0\x21\x89\x64\xa4\xa7\x26\x16\xf0\xb9\x9d\xc0\xa0\xd0\x8d\xf4\x23\x7b\x1c\x21\x49\x43\x52\xbc\xaa\xe4\x7b\xa6\x83\xba\x39\x12\x86\xf6\x84\xe3\xd5\x68\x12\xa7\xad\xec\xbf\x82\x64\xa3\xfe\x06\x11\xaf\x0e\xe8\x79\x10\x45\xf0\x70\x11\x1b\x25\x7c\x83\xdb\xf5\xa8\xb4\x93\xf7\x95\x61\x1b\xfb\x7c\x7f\xdd\x93\x34\x3f\x8e\xc8\xc7\x7c\xe3\x77\xaf\x7a\x9a\x7f\x4a\xae\x93\xdf\xc0\x7c\x7d\xa8\xea\xe3\x2a\xdf\x8e\xbc\xc1\xa3\xff\x4a\x2d\xff\xf1\xb4\x9b\x22\x0b\x5d\x18\x7c\xfd\xd5\x7f\x1c\x6e\x06\x4a\xca\x1b\x91\x57\x7c\xe6\xeb\x9a\xdf\x3c\x74\xb5\xa8\x6e\xfd\xcd\x0a\x4c\x8e\x99\xd5\x92\xe0\x8e\x56\x60\x32\xd0\xec\x9f\x01\x00\x3f\xd1\xf6\x06\x34\x0a\x00\x00")
+
+func chartSeederCrdTemplatesMetalHarvesterhciIo_clusteraddonsYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_chartSeederCrdTemplatesMetalHarvesterhciIo_clusteraddonsYaml,
+		"chart/seeder-crd/templates/metal.harvesterhci.io_clusteraddons.yaml",
+	)
+}
+
+func chartSeederCrdTemplatesMetalHarvesterhciIo_clusteraddonsYaml() (*asset, error) {
+	bytes, err := chartSeederCrdTemplatesMetalHarvesterhciIo_clusteraddonsYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "chart/seeder-crd/templates/metal.harvesterhci.io_clusteraddons.yaml", size: 2612, mode: os.FileMode(420), modTime: time.Unix(1792439149, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _chartSeederCrdTemplatesMetalHarvesterhciIo_clustersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x5f\x8f\xe3\x36\x92\xf8\xbb\x3e\x45\x61\x7e\x3f\xe0\xee\xb0\xb6\x27\x93\xcb\x06\x77\xc6\x62\x81\xde\xee\x49\xb6\x77\xa6\x7b\x06\xdd\x3d\xb3\x0f\x87\xbb\x05\x2d\x95\x6d\xa6\x25\x52\x21\x29\x77\x3b\xd9\x7c\xf7\x43\xf1\x8f\x2c\xd9\xa2\x24\x6b\x66\x72\x79\x88\x6d\x20\x69\x89\x2c\x56\x15\xab\x8a\x55\xc5\x22\x67\x3e\x9f\x27\xac\xe4\x1f\x51\x69\x2e\xc5\x12\x58\xc9\xf1\xd9\xa0\xa0\xbf\xf4\xe2\xf1\x3f\xf4\x82\xcb\x97\xbb\x57\xc9\x23\x17\xd9\x12\x2e\x2b\x6d\x64\x71\x87\x5a\x56\x2a\xc5\x2b\x5c\x73\xc1\x0d\x97\x22\x29\xd0\xb0\x8c\x19\xb6\x4c\x00\x98\x10\xd2\x30\x7a\xac\xe9\x4f\x80\x9f\x7f\x49\x00\x04\x2b\x70\x09\x69\x5e\x69\x83\x4a\x2f\xa8\x43\xbe\xd8\x32\xb5\x43\x7a\xb0\x4d\xf9\x82\xcb\x44\x97\x98\x52\x9f\x8d\x92\x55\xb9\x84\xee\x46\x0e\x96\x87\xed\xf1\x72\x60\xed\x93\x9c\x6b\xf3\xa6\xf9\xf4\x2d\xd7\xc6\xbe\x29\xf3\x4a\xb1\xfc\x80\x84\x7d\xa8\xb9\xd8\x54\x39\x53\xf5\xe3\x04\x40\xa7\xb2\xc4\x25\xdc\xb2\x02\x75\xc9\x52\xcc\x12\x80\x9d\xe3\x90\x1d\x76\x0e\x2c\xcb\x2c\xe1\x2c\x7f\xaf\xb8\x30\xa8\x2e\x65\x5e\x15\x81\xe0\x39\xfc\xa0\xa5\x78\xcf\xcc\x76\x09\x0b\x6d\x98\xa9\xb4\xff\x8f\x1d\x32\x30\xc3\xe3\x77\xdf\x7c\x63\xf6\x34\xb2\x36\x8a\x8b\x4d\x14\x96\x91\x8f\x28\xba\x40\x3d\x34\x5e\x8c\x82\xe4\x69\xbe\xc8\x32\x85\x5a\x77\x81\x6c\xbf\x1a\x00\x5a\x62\x1a\x40\x3e\x60\x51\xe6\xcc\x20\x31\xb1\x05\x37\xbc\xf0\x0f\x4b\xc5\xa5\xe2\x66\xbf\x84\x57\xe3\xc6\xb0\xdc\x5a\x28\x26\xd2\x2d\xaa\xeb\xa2\x94\xca\x2c\xca\x2d\xd3\xed\x51\xee\xdc\xfb\xf1\x83\xb8\x6e\xbb\x57\x2c\x2f\xb7\xcc\xa1\xa2\xd3\x2d\x16\x56\xa4\xe9\x2f\x59\xa2\xb8\x78\x7f\xfd\xf1\xdf\xef\x5b\x8f\x01\x32\xd4\xa9\xe2\x25\x89\x43\xcd\x35\xe0\x1a\xcc\x16\xc1\xb5\x85\xb5\x54\xf6\x4f\xcf\x1b\x0d\x17\xef\xaf\xeb\xfe\xa5\x92\x25\x2a\xc3\x83\x48\xbb\x6f\x43\x29\x1b\x4f\x8f\x46\xfb\xe7\xbc\xf5\x0e\x08\xae\xef\x05\x19\x69\x27\x3a\x34\xbc\xf0\x62\xe6\x69\x02\xb9\x06\xb3\xe5\x1a\x14\x96\x0a\x35\x0a\xa7\xaf\xf4\x98\x09\x90\xab\x1f\x30\x35\x8b\x23\xd0\xf7\xa8\x08\x0c\xe8\xad\xac\xf2\x0c\x52\x29\x76\xa8\x0c\x28\x4c\xe5\x46\xf0\x9f\x6a\xd8\x1a\x8c\xb4\x83\xd2\x24\x6b\x03\x56\x3d\x04\xcb\x61\xc7\xf2\x0a\x67\xc0\x44\x76\x04\xb9\x60\x7b\x50\x48\x63\x42\x25\x1a\xf0\x6c\x07\x7d\x8c\xc7\x8d\x54\x08\x5c\xac\xe5\x12\xb6\xc6\x94\x7a\xf9\xf2\xe5\x86\x9b\x60\xaa\x52\x59\x14\x95\xe0\x66\xff\x32\x95\xc2\x28\xbe\xaa\x8c\x54\xfa\x65\x86\x3b\xcc\x5f\x6a\xbe\x99\x33\x95\x6e\xb9\xc1\xd4\x54\x0a\x5f\xb2\x92\xcf\x2d\x21\x82\xc8\xd7\x8b\x22\xfb\x7f\xca\x1b\xb7\x20\xf1\x11\x71\x71\x3f\x6b\x7d\xce\x98\x1e\xb2\x4b\x24\x1a\xcc\x83\x72\x3c\x39\xcc\x02\x3d\x22\xd6\xdd\xbd\xbe\x7f\x80\x80\x89\x9b\x29\x37\x29\x87\xa6\x3a\x36\x3f\xc4\x4d\x2e\xd6\x48\x12\xc7\x35\xac\x95\x2c\xec\x74\xa0\xc8\x4a\xc9\x85\xf1\x82\xc8\x51\x18\xd0\xd5\xaa\xe0\x86\xc4\xe0\xc7\x0a\xb5\xa1\xa9\x3b\x06\x7b\x69\xcd\x39\xac\x10\xaa\x32\x63\x06\xb3\xe3\x06\xd7\x02\x2e\x59\x81\xf9\x25\xd3\xf8\x2b\xcf\x15\xcd\x8a\x9e\xd3\x24\x8c\x9a\xad\xe6\x22\x75\xf8\xb8\xc6\x8e\xbd\x8d\x17\x61\x29\x8a\x4c\xad\xd7\xf3\xfb\x12\xd3\x96\xa6\x65\xa8\xb9\x22\x5d\x30\xcc\x20\xe9\x93\x6f\xd8\x82\xd4\xad\xf1\xf4\x65\x59\x56\x2f\x9b\xd1\xb1\x4f\xc4\x8a\x7e\x17\xb6\x27\x30\x85\x16\x0f\x32\x67\xba\x31\xbe\x7f\xcd\x85\x7d\xab\x59\xe1\x9b\xd0\xf2\x36\x83\xa7\x2d\x4f\xb7\xb6\x2f\x2b\xcb\x9c\x63\x06\x5c\x80\x54\x19\x2a\x90\x22\xc5\xa6\xed\x02\xde\xe6\xb5\xfb\xaa\x4a\x88\x36\xb3\xdd\x97\x1b\x2c\x3a\xc8\x89\x4e\x51\xf3\x25\x53\x8a\xed\x8f\xde\x31\x65\xf8\x9a\xa5\x66\x1a\x8b\x7c\x67\x3b\x69\x2c\xcf\xe5\x93\x06\xb9\x43\xa5\x78\x16\x14\x2f\x97\xa9\xb5\x84\x96\x75\xf4\xa0\x76\x3e\x40\x61\x8e\x4c\xe3\x01\x85\x63\x61\xa7\xef\x6b\x96\x6e\xa1\x52\x39\xa4\x4c\x90\xce\x30\x01\xf8\x5c\xe6\x3c\xe5\xc6\x3e\x96\x0a\x18\x6c\x24\x18\xbf\x08\x06\xd6\x5b\x53\x2c\x32\x24\xd1\x79\xe2\x66\x0b\x8b\xeb\x82\x6d\xf0\xc3\xdd\xdb\x19\x2c\x82\x45\x67\x22\x83\xc5\x85\x4a\xb7\x8b\x1e\xe2\x74\x63\x32\x49\x71\x49\x8e\xf9\x9a\xa6\x34\xc3\x35\xab\x72\x13\xcc\xf3\x29\x65\x39\xdb\xcb\xca\x40\x45\x78\x40\x18\xff\x64\xa8\xb8\xf0\xd2\x97\x1c\x42\x75\x64\x13\xc7\xf5\xa4\x6f\xa5\xf2\xd8\xab\x01\x91\xe9\xd1\xe4\xf0\xe5\x5a\xfe\x26\xf1\x7a\x44\x25\x30\xff\x4d\xa2\xa6\xd8\x93\x95\x83\xa9\xc8\xa5\x5b\x4c\x1f\x75\x55\xc4\xde\x8f\xd1\xda\xf0\xb9\xf4\xb0\x82\x66\x2a\xf6\x04\x9c\x90\x0b\x46\x6d\x2d\x55\xc1\x0c\xfc\x89\xe5\x1b\x72\xf6\xb6\xc5\x9f\x97\x7f\xda\xe2\x33\x64\x7c\x83\xda\xfc\x79\x66\xbd\x30\x7c\x66\x45\x99\x23\xe8\x2d\xfb\xfa\x8f\xdf\x2e\xd9\x2a\xcd\x16\x8b\x45\x12\x1d\x15\xee\x11\x49\x1d\x32\x89\xda\xea\xd3\x0e\x15\x5f\xef\xad\x45\xb4\xa3\x2f\xe0\x61\x8b\x35\xa1\xb4\xb8\x97\x4c\x6b\xcc\x82\x9a\x69\xa3\x90\x15\x0d\x6d\x63\x29\x19\x18\x60\x1a\xae\x6f\xbe\xff\xc7\xe5\x5f\x5f\x5f\xbe\xb9\xff\x70\x33\xeb\x41\x81\xf4\xbe\x1e\x8f\x46\x90\x22\xdf\x53\x40\xe2\xf4\x9a\x3b\x7e\x78\xb8\xb6\xd1\x0c\x34\x1a\x67\x48\x0e\x38\xcc\x6b\x1c\xe6\xb6\x11\x3c\xe2\xde\x33\xb3\x67\x70\x6d\xe9\x27\x2f\x69\xcd\x37\xe4\xf5\xad\xf9\xa6\x60\xe5\xcc\x93\x0c\xbc\x4b\x6e\xbc\x7c\x30\x43\xae\xdf\x12\xfe\xe7\x5f\x1d\xbb\xff\xa9\xb7\xec\x8f\xaf\xbe\xfe\xb7\xe5\x7f\xb1\xf9\xfa\x62\xfe\xdd\x57\xf3\xff\xfc\xef\x3f\xfc\xff\xe9\xd2\xfb\xa5\xa5\x5f\x4a\xb3\xd6\x53\x65\xff\x0b\xa2\xd6\xf3\xd2\xaf\xd2\x97\x76\x9e\x96\xc9\x79\x68\xb3\xb5\x41\x75\x2d\xb4\x61\x79\x7e\x29\x8b\x82\x89\x2c\x42\x5e\x4b\x6d\x2f\x3a\xba\xd9\xf5\x47\x55\x82\x94\x93\x41\xba\x55\x52\x9a\xa0\xb9\xdc\x8d\x80\xc7\x71\x40\xf8\x4a\x3d\x73\xa8\x78\xe1\xf3\x52\x57\x29\xef\x63\xad\x08\x56\x2e\x59\x76\xe4\x56\x0d\x3a\x1d\x67\x70\xbe\xcb\xf9\xa0\xef\x4a\x8a\xec\x5d\xd9\x48\x6e\x1c\x7f\x9a\x99\x81\x21\x11\xf9\x34\x29\x00\xcf\x97\x0f\x77\x6f\x97\xc9\x04\xf0\xa9\x4d\xe6\xbc\x57\x72\xc7\x29\xcc\xe4\x62\x13\x42\xf3\x49\xe0\x32\x24\x7f\x9e\xeb\xd3\x90\xb5\x53\x68\xa2\xb6\xfe\xaa\x01\x07\x34\xa3\x04\xd3\x4f\xa8\x81\x5b\xcf\x5f\x2a\x0a\x15\x0b\xb9\xc3\xec\x10\xd9\x78\x99\x9f\x81\x54\xb0\x56\x48\x2e\xd4\x16\xc5\x91\xd3\x0a\x19\xe6\x68\x30\x8b\x19\xda\x15\xae\x6d\x60\x69\xc8\xc0\x2a\x34\x95\x12\x07\x23\x5e\x4a\x99\x4f\x34\x03\x85\xcc\x22\xfc\xa4\x9f\xf7\xc9\x96\x20\xa4\xc0\xa4\xa3\xc1\x19\x8c\xa3\xdf\x8d\xcc\x10\x9e\xa4\x7a\x5c\xe7\xf2\x09\xca\x67\xa7\x2c\x4e\x6d\x0c\x17\x8f\xa8\x56\x98\xe7\xb0\x95\xf2\x11\xa4\xa6\x58\x1c\x54\x25\x28\x20\xad\x3b\x3d\xf1\x92\xbc\x61\x96\xe7\x90\x71\xfd\xa8\x67\xa0\x30\x5b\x73\xbd\x3d\x44\x89\xac\x07\x03\x8d\x69\xa5\x10\x50\x91\x47\x49\xf9\x04\x82\xa3\xf8\x0e\x35\xec\x38\xb3\x88\xfc\xe5\xe6\xd2\xa6\x01\x2c\xd1\x9e\xd7\xcd\x09\x6e\x30\xdd\xae\x61\xe4\x94\x7a\xac\x2c\x46\xd1\xd1\x51\xf4\xb9\x1a\xf3\x7e\x26\xcf\x6b\x16\xf4\x34\xf1\xac\x88\xb6\x18\xd0\x11\xfa\x51\xf8\x6e\xfe\x72\xfd\xee\x7e\x39\x6e\xbe\xef\x42\x7b\x4a\x08\xa0\xd1\xb0\xe2\x52\xd3\xfa\x6e\xb8\xd8\x84\x64\x0b\x57\x41\x94\xba\xa2\xb3\xf0\xa1\x09\x08\x93\x69\xe3\x3a\xcb\x4e\xd8\xb2\x1d\xc2\x0a\x51\xc0\x13\x2f\x31\x1b\x20\x6e\x25\x65\x8e\x4c\x24\x1d\x0d\xa8\x0d\x2f\x50\x56\x66\x84\xc4\x7f\xbd\x1d\x47\xff\x83\x83\xe8\x57\x04\x1f\x2e\xd5\xb2\xe2\xfe\xde\x32\xe7\x9c\x11\x11\x51\xa8\x50\x5b\x93\x8c\x74\xfc\xc7\x8a\x29\x46\x29\x97\x1e\x8a\x9d\x4b\xb9\x84\xac\x52\x36\x2c\x9c\x3e\xef\x03\x96\xfc\x07\xc9\xc5\x5f\x98\x49\xb7\xf7\xfc\xa7\x88\xb9\x18\x67\x04\xfe\xd6\x04\x04\x39\xb7\xe9\x1d\x52\x3a\x51\x15\x2b\x0a\xe7\xd7\x76\x2c\x10\x32\x43\xb2\x73\x64\x1e\x6c\xb8\x6f\x64\x6b\x6d\x56\xc0\x8c\x8d\xfd\x17\xf0\xb7\x43\x7b\x5a\xd4\xb7\x98\x67\x50\x09\xc3\xbb\x2d\x22\x58\x30\xa9\x42\xca\x7f\xd0\x28\x94\x93\x04\x26\xf4\x13\x2a\x5a\xd7\x45\x06\x48\x41\xf2\x8a\x90\x84\x27\x46\xf8\x85\xf4\x68\xa9\x70\xc7\x65\xa5\xfd\x4b\x23\x21\x95\xe4\xad\x9b\x1a\x2f\x3b\x0b\x31\x77\xfd\x2b\x60\x99\xa5\x97\x6c\x57\x83\x4a\x4f\x49\x67\xaf\x82\x0b\x5e\x54\xc5\x12\xbe\xea\x7c\xed\xa6\x8d\x52\x98\x9b\x4e\x67\xc3\xc5\x6e\x17\x6a\xa3\x47\x4c\xda\x9b\xba\x71\x48\xb5\xa0\xc8\x0e\x8b\x8c\x83\x45\x24\x93\x07\x05\x39\x8f\x1a\xab\x63\x3f\xca\xd1\xf9\xab\xfb\x42\x05\x7b\xf6\x5e\x1f\x17\x9b\x5b\x42\xe1\x53\x24\xf7\xe6\x04\x5a\xb7\xf8\x5a\x5a\x03\xed\x76\xa5\x72\xd3\x3b\x23\x3e\xb2\x9d\xe4\x19\x68\x66\xac\xc2\xfa\xa4\x8e\x8b\x76\xb4\xcd\x8f\x46\x06\x27\x86\x57\x65\xce\xc5\xe3\x02\xee\xb0\x60\x9c\xb2\x59\x41\x7c\x14\xf6\x2b\x8a\x0e\x7f\xf8\xd4\x51\x10\xda\x05\x7c\x45\x4b\x28\x5b\xe5\xde\x71\xb5\xf4\x7c\x09\x31\xb4\xa9\x3c\x4b\x9f\x5e\xfe\xea\x62\x20\x4c\x79\xdf\x37\x76\x6b\xfa\x6f\x1f\xde\x87\x44\x75\x9d\xaa\x34\xa5\x9f\x1c\x0d\x15\x45\xce\x2b\x17\x5e\xff\xdf\x08\x75\xd9\xf0\x87\x6f\xa2\xbe\x5b\xbd\x8a\x95\xcf\x38\x4c\x74\x54\xe6\x9b\xce\x37\x0d\x46\x1e\xbd\x51\x32\xd7\xb0\x95\x4f\x5e\xfc\xc8\x42\x1f\x25\xec\x6a\xd9\x5b\x58\x17\x8f\xfc\x32\x4e\xc1\x91\xeb\x60\x24\x65\x1e\xe5\x21\xd3\x1b\x19\xfc\xed\xd7\xa0\x71\x53\xd0\x3e\x00\xd3\xa0\x0b\x74\xd9\xc8\x1c\x61\xc7\x95\xa9\x58\x7e\x83\x19\x67\x50\xc8\x4a\x90\x4d\x15\x70\x7d\xff\xae\xe9\xc3\x59\x5b\x2e\x10\x33\x1a\x18\xae\xfe\x7a\xf9\x3e\x39\xcf\x29\x9b\x47\xb9\x37\x6f\xa1\x90\x4c\x98\xe6\x52\xc9\xe7\xfd\x72\x78\x6a\xde\x53\x3b\xe0\x1d\x92\x57\xa7\x5d\x42\x0c\x61\x24\x28\x5a\xba\x3a\x81\x02\xd0\xb6\xb9\x12\x2c\x07\x81\xe6\x49\xaa\x47\x3d\x31\x60\xa0\x4d\xad\xf7\x71\xec\x47\xd0\x1e\xa0\xe8\x4f\x07\x23\xe4\x00\x8c\x5e\x55\x1c\x39\xc8\x90\x4a\x0e\x7a\x4f\x7e\x33\xf8\x0e\x37\x5c\x1b\x32\xfc\xa3\x22\xd0\xbb\xd3\x5e\xc0\xed\x7e\xb2\x6e\xc7\x8e\x82\x66\xbe\xb5\x9f\x7c\xfc\xa5\xf5\x27\x84\x8e\x91\x9d\x90\x71\x93\xef\xc7\xa4\x4d\xf3\x58\x93\x23\x2a\xfc\xfe\x0e\xf5\xa8\xf3\x2b\x96\x08\xcc\x1a\x14\x04\xfc\xfb\xd2\x8c\xce\x95\xf6\x96\xad\xde\xc5\x0d\x30\xea\xdd\x22\xab\x17\x22\x6e\x56\x46\x4d\x39\x17\x2e\x4e\xbc\x7f\xe4\xe5\xc3\xdb\xfb\x8f\x94\xcb\xdc\x8f\xa4\xf8\xba\xab\x2f\xe8\x47\x5e\x6a\x9f\x14\x4d\xeb\xdd\xec\xfe\xdc\xa6\x67\x8a\x5f\x7d\x20\xa5\xb9\x59\xf3\xf4\x50\x9c\x30\x31\x06\xa2\x2a\x8c\x7b\x4c\x15\x9a\x3b\x5c\x8f\xa4\xea\xa1\xd5\xc9\x6f\x12\xdb\x3f\x6d\x0c\x0c\xac\xc6\x96\x95\x3c\x0a\xd2\x0f\x1e\x52\xe2\xee\x8f\x47\xec\xd6\xaa\x71\x22\x59\x17\x47\xf4\xbc\x3f\xa2\x85\x9a\x13\x05\x95\xe0\x3f\x56\x68\xf1\xb7\x69\xc0\x83\x0c\x91\x46\xe1\x1a\x15\xc6\xfc\xf2\xc3\xa7\x66\x43\xd8\x0f\xef\xcb\xd6\x8f\x12\xbe\xda\x63\xa2\xcd\xcf\x33\xc9\x72\xe8\x37\x77\x7c\xdd\x13\x4f\xa3\xd5\xa1\x5e\x88\x2e\x3e\xf2\x24\x11\x16\x50\x54\x9a\x22\x57\xcf\xad\xcf\x40\xdd\x80\xb5\x74\xbf\xe7\xf9\x63\xb5\xa2\x88\xc3\xa0\x9e\x17\xac\x9c\x7b\x1b\x6c\x64\xc1\xd3\x29\x29\xed\x16\xab\x3e\xdc\xbd\x0d\xd6\xa8\xad\x64\xc9\x64\xca\xbc\x97\x13\xd9\x5b\x9c\x1f\x69\x5d\xa4\x51\xa5\xf2\x64\x02\xcb\x94\x5b\x23\xf6\x37\x5c\x29\x39\xca\xcf\xbd\x6b\xf7\x38\xa4\xb0\xe9\xff\x0c\xe3\x02\x95\x0d\xfc\xca\x2a\xcf\xdd\x36\x56\xb7\xbf\x00\xc4\xc6\xaa\xf4\xbb\x48\x1e\x0f\x8e\xbe\xae\xa3\x70\xf8\x24\x67\xaf\xcc\x3d\xb8\xb6\x84\xdb\x0d\x50\xd7\x8e\xe8\x06\xf6\x11\xc8\x60\x49\xd2\x9e\x26\x5f\x51\x74\x4c\xc0\xde\xa2\x9f\x4c\x37\x49\xa9\xc2\x8c\x0a\x41\x58\xae\xeb\x29\x8f\xb7\x3e\xa2\xf7\xb2\xa3\xf3\xa9\xc1\x25\xd9\xad\x34\xaa\xde\xa5\x2e\xc4\x8e\xb4\xe7\xf7\x24\x55\x46\x7b\x6a\xde\x97\xa4\x68\xb4\x32\x5b\xc2\x92\x56\x94\x03\x50\xc7\xd3\x1e\x90\xe3\x6c\xf2\x18\xab\xfc\x25\xed\xf2\xd9\x96\x79\x84\x8e\x87\x6f\x8d\xd0\xd9\xe4\x7d\x06\xfb\xfc\x69\x16\x7a\x34\x95\xa3\xac\xf4\x34\x3b\x0d\x07\x85\x5d\x26\x23\x99\xf7\xba\x56\xf1\x10\x97\x57\x2a\xaf\xcb\x60\x9c\xcc\xce\xc0\x28\xde\x93\x2e\xa5\x5f\x28\x1c\xea\x69\xd4\x6b\x99\x26\xf0\x30\x1e\x37\x4c\x70\x35\xa7\x3b\x9b\x3d\x20\xa1\xc1\xc4\x51\x6e\xe6\x38\x47\xf3\xb0\x30\x8d\xa6\x27\xd8\xfa\x50\xa0\x7a\x62\x99\x67\xa0\x2b\x2a\x1f\x8a\x2d\x47\x1e\xa6\x4c\x1f\x51\xb9\x6a\xec\x4f\x98\xc3\xfe\x35\x9d\xf6\x64\x6a\x41\x8e\xb6\x08\x88\x27\x13\xd5\xac\x5f\x86\xb4\xde\xbe\xc1\x7d\x44\x5a\x7b\x05\x79\x90\xfc\x81\x81\xed\x8a\x6f\xab\x6f\xe2\x69\xa8\x21\x21\xd1\x7b\x9d\x9a\xfc\xd7\xd8\xa9\x6e\x09\xd9\xbd\x1b\xd6\x66\x4e\x7d\x56\xbb\x64\x8a\x15\x68\x6b\x9f\xa9\x34\x45\x8a\x81\x14\xdf\xc0\xb4\x35\xd3\x43\xcb\x61\x84\xa2\x09\xb8\x8f\x0d\x38\x27\xb5\x9c\x94\xef\xb2\xd9\x2f\xcc\x5a\x79\x2f\xbb\xbb\x5c\xd1\xc1\x81\x76\xa6\xac\x99\x3a\xb4\xfb\xbe\xb1\x95\xe2\xc1\x03\x77\xc9\x33\x23\x0f\x39\xbe\x3a\xb3\xe7\xdc\x85\x3a\xe5\xb7\xf0\x2f\x16\xce\x8f\xfc\x47\xa5\x72\xda\x02\xa4\xf5\x7a\x71\x19\xaa\x00\x42\x31\xa1\xf5\xb4\x63\xfa\x4b\xa3\x94\xa8\x2c\xeb\x1b\x29\x45\x5f\xdf\x53\xef\xa9\xfb\xca\x8b\xa0\x7e\xc9\x34\x3f\x85\x6b\x19\xad\x4e\x18\x3f\x49\xf4\xbd\xbe\x7f\x47\x31\x05\xd7\x93\x4a\x2a\x6b\x82\x7d\x6d\xa5\x05\x36\x6b\xb1\x6e\x41\x19\x94\xbe\xf4\x88\x6d\x60\x9d\x89\x19\x2c\xfc\xa9\x88\x19\x2c\x6e\xd1\x14\x4c\x3f\xce\x60\xf1\x3d\x33\xf8\xc4\xf6\x33\x58\xdc\x5c\x5c\x1e\x1a\x7c\xcc\x99\xb8\xbe\x1a\x5b\xc6\x19\x3e\x57\x47\x69\x18\x12\x97\x50\x79\x1a\x16\x65\x9f\x9a\x89\x42\x19\x69\x81\xe2\x4a\x66\x31\x1f\x48\x80\xbf\x4a\x3a\xde\x1e\xf6\x33\x5e\x4d\xda\xcf\xa0\x7d\xe8\x2b\xda\x9b\x9e\x66\xf6\x9e\x14\x37\xf8\x1d\xcf\x51\x8f\xb0\x0d\x7f\xaf\x1b\x5b\x7b\x45\x7d\x0d\x55\x8f\xc8\x83\x85\x72\xf5\x24\xb5\xb2\x74\xc2\xa4\xfa\xd2\x5a\x7d\xb3\xf3\x97\x8b\x6e\x9c\x08\x28\x83\x35\xcf\x3f\x05\xb1\x11\xa8\x8d\x0b\x3a\x28\xf8\x43\xd1\xb3\xb9\x3f\x42\xe6\xe8\x87\x22\x95\x54\x72\xdd\x07\xa8\xc5\x8f\xd7\xbe\x43\x2d\xf9\x0e\x91\x9e\xee\xfd\xa5\x20\xe4\x59\xac\xbe\xfd\xa6\xff\x3d\xd3\x38\xd0\x64\xf3\xd3\xc0\x6b\x5e\x0e\x34\xf8\xc3\xa8\x51\x78\x39\xae\xdd\x1f\x86\x68\xda\xfc\xc4\xcb\x81\x46\xa3\x26\x50\x3e\x09\x54\xa3\x67\xef\x1d\xb5\x0e\x53\x47\xb2\x3c\x0b\xf9\x49\x8a\xaf\x97\x1b\x25\xab\xd2\x57\xef\x7e\x2a\x62\x25\x1d\x44\x1b\x8b\x17\x9d\x28\x0b\x7e\x30\x5b\x69\x99\x57\x06\x2d\x84\x26\xae\x9f\x8c\x11\x2a\x5f\xcc\xa6\xc7\x23\x76\xe8\xd3\x44\x85\xb8\x26\x53\x43\x5b\x4c\xfe\x80\x65\xdf\x7a\x05\xc1\x91\x87\xaf\xbe\xfd\xe6\x9b\x2f\xed\xa7\x7b\xd3\x10\x7d\x4f\x6c\x8d\xbc\x1c\x58\x85\xfa\x1d\xe5\x9e\xce\x7e\x79\x0c\x45\x8d\xdd\x7b\x2a\xc3\x1e\xc8\xe5\x29\x18\x12\x1a\x56\xef\xae\x04\xc7\xa3\xff\x70\x8d\x75\x0e\x33\xef\x59\xd6\x9b\x2c\x72\x5d\x57\x72\x75\x0c\xdd\x3e\xbf\xe1\x7d\xe6\x30\xac\x5d\x93\xdc\x9e\x93\xab\xb6\xc9\x92\x33\x66\xd6\xa6\xe7\x3a\x7d\xb3\x9e\x4e\xe4\x42\xdd\x74\x1e\xa2\x1a\xc7\xca\xdb\x46\x7f\x28\x58\xd9\x2c\xfa\x63\xc6\x9d\xd6\x23\x0e\x49\xc8\xd9\x0a\x29\x80\x10\x59\xf3\x38\x71\x60\x41\xbd\xe0\xb9\x55\x70\x01\x17\x81\xa3\x04\xb5\x3c\x45\xdc\xaf\x81\x36\x2f\xc7\xd7\x6d\xbe\x26\xa3\x97\xe9\x16\x81\x4d\x5a\x6e\xdc\xa0\x50\x56\xab\x9c\xeb\x2d\xd5\x8c\x88\x2e\xd2\x3a\x60\x02\x95\x8f\x30\x47\xaf\x75\x65\x6b\x6a\xbb\x89\x4d\xce\x5f\xb9\x1f\x31\x9a\x1e\x68\x51\xf4\xa6\x3e\x0e\xd0\x85\x4e\x90\xe3\x20\xba\x11\x88\xb4\x01\xd5\x7d\x6e\xfb\x25\xac\x25\x9d\xb9\x3a\x6c\xb3\xbb\xed\x9c\x08\xa0\x1e\x31\xf4\xd6\xcd\xf6\x5e\x26\xe7\xaf\xff\x73\x58\x15\xa9\x77\xd0\xa3\x4d\xea\xe9\x6b\x1c\x60\x1e\x68\x65\x83\x83\x68\xd3\x82\x89\x8a\xbc\xf7\x4a\x45\x1d\xb5\xb9\x8d\x1a\x63\x75\x75\x73\xda\xa7\xe4\x2c\xbf\xad\x8a\x55\x0f\x08\x2a\x0f\xf5\xa1\x46\xbc\x4d\x91\x7e\xc7\x55\xf1\xc4\x14\x0e\x35\xdd\x94\xf5\xd9\xf4\xe3\xef\x1c\xca\x94\x5f\xe1\x8e\x1f\x9f\x96\x3d\x7c\xe6\xf5\x89\xba\xa9\xd3\x6c\x98\xda\x60\xd4\xe5\xf4\xd2\xb8\x6c\x08\xea\x18\x51\x7f\xb0\x40\xc3\xda\x4f\xa2\x46\x92\x1f\xce\x87\x2e\xe0\xad\x95\x7f\x7b\x38\xd7\xd6\x29\x45\x60\x02\x18\x55\x09\x4a\xe4\x91\x99\xa2\xe2\xb0\x1d\xcb\x79\x06\xe9\x96\x29\x96\xda\x64\x87\xc2\x32\xf7\x37\x08\x9c\x2f\xa6\x56\x0d\xa3\x6f\x07\x49\x1e\xe0\x6d\xdf\xa2\x3e\x8f\x2b\x67\xef\x72\x1d\x5f\xaa\x45\x77\x7d\x60\xd4\xd8\x0e\x59\x35\xe6\xf4\xf7\xbd\x94\xf9\x5d\xd8\xb1\xe8\x6e\x39\x0c\x6b\xcc\x66\xca\xa0\xa0\x8e\xde\xb7\xf8\x2c\xfe\x56\xcf\xae\xd4\xfc\x80\x46\xa4\x45\xef\x14\xd2\xaf\x36\x6b\xbf\xb3\xf6\x73\xb3\x96\x2e\xde\xe0\x61\xf5\x59\x26\x93\xe8\xe8\xa3\x61\xde\xa9\x19\x49\xef\xe2\xd5\xd7\x6c\xa2\xba\xeb\x92\x29\x24\xe5\x5c\x26\xbd\x96\xf8\x3e\xb4\x03\x8d\x39\xd2\xf1\x65\x3a\xc1\x73\x40\xcd\x7b\x1d\x74\x9c\xda\x9b\xd2\x68\xd2\xd8\xe6\xf6\x52\xc5\x69\xbf\x35\xa7\xd4\x66\x46\xeb\x1b\x6c\x91\xe5\x1d\xb1\x47\xbf\xe0\xb2\xca\xc8\x3b\x37\xde\x32\x19\x5c\x4e\x3a\x9d\x5d\xfa\x5d\x1c\xc0\x04\xf4\x43\x95\xe6\xd3\x56\xea\x26\x9d\x0a\xa9\x68\x4b\x47\x09\x08\x55\x40\x96\xb1\x87\xb3\x4f\x74\x32\x29\x96\x47\xbc\x6d\x94\x2f\x5b\x52\x28\x86\x40\x2a\xec\x67\xf6\x9c\x86\x3f\x84\x63\x93\xd0\x48\x78\x84\xda\x51\x14\x54\xb3\x5c\x47\x1c\x35\x92\xfe\xac\xab\xab\x51\xa5\x24\xb5\xbb\x12\x22\xb6\xf8\xb7\xfa\xd6\x32\x56\x47\xb4\x8c\x87\xd2\x75\x8a\x9c\xc8\x1f\x5f\xd4\x05\xac\x7e\xe3\x56\xe1\x0c\xb4\x04\x23\xa9\x24\x96\xae\x26\xd8\x87\x83\xfa\x21\x0a\xa2\x6e\xb3\xa4\x3f\xfe\xdd\x70\x23\x4b\x3d\xb3\xe9\x76\xa2\x90\x6f\x04\x1d\xf5\x4a\xb7\x4c\x50\x89\x82\x91\xcd\xc1\xa5\x82\x47\xc4\xb2\x35\x75\xbe\x88\x3b\x9b\x94\x8c\x74\x72\x2d\xd5\x08\x39\xba\xf7\x4d\xa1\xa0\xf3\x0f\xd8\xa3\x0b\x54\x2e\xa4\xa3\x5e\x85\x0d\x29\x2c\xfb\x92\x69\x36\xdb\x8e\xff\xfa\x99\xee\x86\x19\xc8\x5c\xb4\xf0\x3f\xee\x46\x0e\x16\xb3\x17\x32\xd1\xa4\x5b\x6f\xa6\x66\x47\x14\x62\x6d\xde\xa8\x32\x59\x3b\x91\x6b\x3e\xb1\x02\x7d\x71\x7b\x75\x7a\x13\xc9\x08\xaf\xa2\x13\xed\xa8\xfa\x7a\x25\x3e\xc2\xbc\x89\x4d\x28\x1b\xf1\x6f\xcc\x96\x99\x50\x22\xa3\xdd\xe5\x2e\x74\xe6\x85\xaa\x42\x66\x3e\x98\x05\x32\x3b\x2c\x34\xee\x1d\x58\x21\xa5\x15\x9c\x8f\x4a\x67\xb5\x99\x88\xdd\x89\x73\xde\xec\x0e\x86\x85\x9d\x5c\x22\x0c\xbc\xc3\xec\xf8\x41\x0f\x88\x06\xbf\x5f\x34\x38\xad\x5e\x36\xed\x05\x23\x5d\x37\xcd\x9c\xb9\x08\x1e\xbe\x81\xa3\x67\x91\x33\x30\xe9\x4d\xb8\x8d\x4b\x77\xdc\x5c\xfe\x0b\x6d\x2d\xe5\x36\xda\xd0\x5b\x5e\x92\x4d\x21\x21\xb0\x52\x3e\x3c\x41\x7e\xe7\xd1\x46\x0a\x61\x08\x67\xa6\xaf\xc5\x0c\x6e\xa5\xa1\xff\xbc\x7e\xe6\xf6\xa4\xa5\xc8\xe0\x4a\xa2\xbe\x95\xc6\x3e\xf9\x6c\x3c\x73\x68\x7e\x6e\x8e\xf9\x88\x89\x94\x42\x38\xb7\x80\x58\xd2\xbc\xeb\x48\x2f\xe0\xda\xd9\xff\x9a\xbb\x5c\xc3\x35\x15\x8d\x78\xd2\x07\x07\xa1\xce\x75\x68\xa6\xd8\xbe\x2e\xcf\x11\x52\xcc\xb1\x28\xcd\xbe\x73\x0c\xcf\x51\xa9\x5a\x0c\xfd\x84\xe1\xfc\x50\x0f\x74\x3b\x93\xa3\x95\x1f\xe2\x3d\x3a\x32\x48\x6b\x95\xbd\xe9\x89\x19\xdc\xf0\x74\x70\xa4\x02\xd5\xc6\x26\xa0\xfb\xb7\x06\x47\x19\xb8\x33\xc5\xa1\xcf\x95\xeb\xad\x48\x22\xe3\x3e\xae\x24\xa9\xdf\x6d\x0d\x9f\x79\x6f\xb5\x32\xbd\x0f\x73\xda\xd3\x68\xd0\x09\x1f\x4b\xf0\x24\x52\xed\x2a\x68\x33\x08\x3d\x33\x74\x4e\xf1\xc5\xe8\x99\x1c\xaf\xae\x0d\x1c\xdd\x12\x56\xb0\x92\x54\xf5\x67\x5a\xa9\xac\xb4\xff\x02\x25\xe3\xca\xe6\x56\xa9\xd2\x21\xc7\xd6\x3b\x9f\xea\x6e\x80\xe9\x1d\xac\xa4\x41\x68\xd5\xdc\xb1\x9c\xce\x1b\x91\xc1\x14\x80\xb9\x5d\xd1\x69\xdc\x63\xcf\x61\xe6\xfd\x63\x5a\x63\xd6\x9c\x4e\x9c\x72\x0d\x2f\x1e\x71\xff\x62\x36\x50\xd3\xdf\x54\xf9\x17\xd7\xe2\xc5\xac\x3e\xc7\xd3\x52\xe2\x7a\x91\xb6\x77\xa9\xbc\xb0\xef\x5e\x4c\x73\x36\x06\xa5\x6d\xb0\x41\x4b\xcc\x06\x6b\xb1\xe3\x6a\x34\x8f\x2f\xc3\x3d\x38\x18\x59\xca\x5c\x6e\xf6\xf7\xa5\x42\x96\x5d\x4a\x41\x16\xab\xbb\x76\xb0\x25\x60\x0f\xb1\x7e\xa0\xed\x93\xda\x47\x77\x8e\x35\x4b\x95\xec\xcc\xb7\x86\x60\x80\xae\x0d\xc8\x64\xe1\x66\x65\xdd\x8e\x1e\xe2\x69\xc4\xa8\x25\x1c\x85\x6b\xf7\x11\x53\x8f\x78\x07\x50\x9f\xc7\x02\x6f\xe4\x9b\x3b\x3e\x6d\x12\x92\xf3\x1d\xb3\x82\x3d\xdb\x88\xed\x3d\xaa\x2b\xcb\x86\xc1\xa4\x67\x77\x71\xc5\x11\xe9\x37\xc7\x60\x81\x1f\xd3\x1b\x8e\xd4\xba\x8b\x4f\x93\xfe\x03\x42\x14\x7e\xe8\x2d\xb9\x2b\x6c\x98\xe6\x11\xb5\x20\xc3\xd5\x20\xe1\x42\xd6\x21\x76\x14\x4c\xb0\x8d\xd5\xdb\x49\xd9\xd6\xc1\xee\x73\xba\xcc\x22\x99\x68\x9f\x83\x96\xbd\x89\xbb\xdd\xfd\xe8\x51\x66\x3a\x45\x11\x2f\xf3\x98\xd3\x85\x45\x45\xfc\x25\x4b\x1f\xa3\x2f\x4b\xf9\x14\xc4\x63\x2a\x81\xb4\x1f\xf9\x41\x68\x66\xb8\x5e\x73\xca\x1f\x0c\xce\xd7\x95\xbc\x95\x86\x6e\x48\xcd\xaa\x1c\xc7\x88\x72\xcf\x92\xf6\xf7\xe3\xd1\xdb\xd0\xfd\xfd\xa2\x21\xf9\x42\x4b\x82\x8d\x8f\xc3\x6d\x79\x3b\x2e\x29\xd6\x0a\xc5\x25\xde\x3a\x84\xf3\xb0\x01\xca\x85\xd8\x3f\xf5\x78\x0b\x76\x29\x29\x6d\xfd\xbc\x6e\x83\x77\x5c\xd9\x03\x37\x0b\xf8\x68\x87\xe2\xe1\xb2\x46\x97\xf7\x39\xd8\x92\x60\x34\x29\x4f\x58\x59\xa5\x5c\x49\xca\x6b\x31\x8d\x7a\x92\xdc\x8c\xe1\xf2\x7c\x1c\x89\x03\x32\x10\x5f\x98\x68\x84\x86\x02\x24\x67\xae\x91\x71\x57\xcd\x5f\x6c\xbb\x4c\xce\x40\x75\xc7\xcb\x89\xd7\x63\x8d\xde\x72\x18\x32\xf5\x43\x39\xf1\x01\x46\x8f\xcc\x87\x0f\x42\xe9\x9b\xb0\xde\x4c\xf8\x50\x1e\xbc\x77\x36\x47\xe5\xc0\x7b\x71\x8f\xe3\x3d\x32\xfb\x1d\xc5\xaf\x1b\xf2\xbc\x23\xe7\x3c\x0f\xb2\x77\xfc\x34\x48\x57\x32\x62\x40\x62\x44\x75\xc4\x81\xae\xd3\xbd\xee\x8a\xf0\x56\x71\xb2\x5c\xd9\x32\xdf\x2f\x7b\xd3\xac\xbf\x35\x36\xe4\xa6\xc9\x3e\x29\xd4\x74\x89\xa8\x5c\x1f\xf2\xb1\x74\x28\xbe\xb6\x5b\x8c\xba\x4c\xf4\xd2\x3c\x05\x76\x54\x4f\xf2\xc0\xd0\x9d\x19\x57\x4f\x5c\xc8\x23\x7b\xcc\x92\xf3\xd5\x34\x67\xda\x5c\x29\xbe\x36\x97\x52\x29\x4c\x4d\x97\xc4\x75\x90\xf1\xf6\xa4\xdb\x21\x71\xa6\x8d\x4d\xbd\x7b\x51\xa0\x74\x73\x04\x22\x1c\xae\x78\x72\x89\xea\x93\x15\xe2\x09\xed\xf2\x31\xf7\x57\xf6\x26\x13\x8d\x00\xe1\xf4\xc1\xe6\xf1\xb3\xe5\x54\x18\x05\x6a\x1d\xbd\x26\x74\x44\xff\x3e\x6b\x38\xd8\x39\x68\xc2\xf7\x28\xb0\xef\xf0\xff\xd1\x2c\xbd\x3b\xe9\x16\x66\x69\x73\x78\xe2\xe3\x90\x6e\xa1\x0e\x1f\xe2\xa0\xcf\x6b\x66\x49\xff\x45\x52\x5c\x98\x6f\xbf\x99\xec\x06\xdb\x6b\xee\x27\xf2\x29\x6e\x33\x7b\x2c\xfd\x1c\x9a\x37\xeb\x8f\x30\x68\x43\xeb\xb5\x97\xdd\xa8\xe9\xef\x21\xc2\x15\xb9\x51\x28\x73\x87\x2c\xeb\x70\xa2\x5b\xb3\x7b\xd9\x6e\x4d\x53\x6b\x13\xb4\xf5\x6d\xd6\x91\x0b\xaa\x4e\xa0\xba\xf3\x9d\x8d\xdb\xa4\xc2\xcd\xce\x74\xd5\x54\xbc\xe8\xae\x7b\x3f\x88\x86\xbb\xc3\x42\xee\x58\xae\x07\x08\xb8\x6d\x34\xad\x37\x07\x09\xf3\x52\xc9\x0d\xb9\x21\x87\xc0\x6d\x85\x64\x85\xfd\xb5\x88\x27\x50\xe1\xe4\xa2\xc4\x89\xd6\x39\xe2\x7d\x37\x10\xf5\x36\xdb\x28\x96\x3e\x1e\xd5\xc0\x79\xec\x4e\x70\x59\x34\x76\x29\x53\xa9\x32\x29\x7c\x2d\x4d\x46\x1e\x38\x66\x33\xc8\xa5\xd8\x6c\xa5\x72\xfb\xbf\x3c\x8d\x1c\x4c\xa3\x78\x94\x2a\x91\x42\x29\x0e\x0d\x71\x48\xaa\xf8\x5d\xc6\xc3\x25\x90\x75\x9b\x82\xa5\x5b\x2e\xfc\xbb\xc3\xc5\x93\x98\xb5\x2f\x84\xb4\xf1\x11\x66\x20\xd7\xeb\x8e\x7f\xc0\xc0\x4f\x7c\x2b\x61\x61\xaf\xde\x47\xcc\x26\x2c\x3b\x35\x90\x01\x2f\x73\x18\xd2\x90\x6d\x1d\x50\xb9\xb3\xbc\xcd\x51\x90\xfa\xac\x50\xaf\x25\x1a\xe3\x75\x0e\x58\xa5\xdf\xcc\x62\x27\xb3\x48\x31\xf2\x48\x00\x5f\x6e\x19\x38\x95\xbc\x5f\x63\x51\x20\xe5\xf4\x5b\xed\x94\x7d\x19\xb2\x8c\x9d\x76\xe8\xf6\x08\x46\x30\x42\x36\x0c\x6f\xa8\x25\x19\x11\x96\x92\xbd\x6c\xd4\x1f\xe8\x99\xbb\xd0\x30\x9c\x17\xa7\x7b\x60\x35\x25\x7b\x28\xe9\x88\xfe\xf2\xed\x8c\x4e\xf2\x9b\x7d\xd2\x79\x43\xc3\x51\x41\xc3\x44\x0b\x7b\x44\x44\xed\x02\x93\x61\xd4\x2d\x2c\x20\x65\x2a\x7a\x14\x9b\xfe\x4d\x03\x67\x68\x59\x40\xea\xc0\x80\x66\x71\x85\xdf\xad\x22\x6e\x70\x33\xc1\x50\xf9\x58\xab\xfb\xe5\xa0\x28\x82\x47\xee\x3a\xe0\xf6\xbb\x95\xfb\x4c\x56\x6e\x2b\xb5\xe9\x63\xc8\x20\x09\x56\x6b\x7e\x9f\x97\xcf\x3b\x2f\xa3\xaa\xf6\x3a\xdf\x1d\xe9\x49\x67\x9b\x30\xe7\x9d\x2f\xdb\xf3\x99\x9c\x89\x7a\xdc\x72\xfb\xbb\x7d\xae\x0b\x0a\xd2\x97\x49\xaf\x71\xf3\xd7\xad\xb9\xb6\x51\x8f\xd6\x5d\xc0\x76\x54\xe5\x75\x02\x18\xfa\x2f\x65\xeb\x17\x4c\x0f\x34\x7e\xb2\xf5\x34\x23\x71\x7d\x15\x8c\x7c\xcf\xdd\x6a\xc9\x04\xa9\x1c\xf4\x47\x06\xfa\xf7\xfa\x22\x03\x7d\x7b\xdc\x88\xde\x9e\x71\x39\x8e\x79\x06\x3d\xd2\xd5\x95\x01\x1b\xc0\x20\x9c\xb7\xb2\x99\x99\x65\xd2\x3b\x7f\x9d\xbe\x42\x38\xca\x65\x01\xd8\xf2\x34\xed\xcf\xd8\x61\x9e\xe9\xb0\x3f\xd1\x71\xc3\x7c\x7d\xc6\xdc\xef\x4e\xdb\x5d\x63\xba\xfc\x92\x62\x14\x3c\x94\xed\x75\xef\x50\x87\xce\x8b\x1a\x81\xba\x04\x31\xfc\x63\x3d\x3e\x89\x40\x99\x2b\xa4\x72\x15\xd2\x03\x3f\xb6\x1e\xef\x53\xf4\xce\x5e\x5c\x99\xed\x05\x5a\xcb\xe4\x0c\x68\x21\xa5\x7f\xd8\xd2\x18\x98\x8e\x87\x93\x0e\x2d\x43\x10\x2a\x63\x69\xcb\x24\x93\xee\x98\x96\xdb\x39\x39\x01\x0b\xfe\x7e\x3b\x07\xaf\xde\x71\xae\xb7\x6e\xbe\x34\xbb\x3a\x45\xfa\xe4\xa1\xcb\x30\x2d\xc1\xa8\xca\x49\x84\x36\x52\x91\xbe\x36\x9e\x54\xab\x70\x7d\x52\x8d\x9d\x36\xcc\x54\x7a\x09\x3f\xff\x92\xfc\xef\x00\xef\x40\xf8\x5f\xa0\x73\x00\x00")
 
 func chartSeederCrdTemplatesMetalHarvesterhciIo_clustersYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -223,12 +271,32 @@ func chartSeederCrdTemplatesMetalHarvesterhciIo_clustersYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "chart/seeder-crd/templates/metal.harvesterhci.io_clusters.yaml", size: 4750, mode: os.FileMode(420), modTime: time.Unix(1774588771, 0)}
+	info := bindataFileInfo{name: "chart/seeder-crd/templates/metal.harvesterhci.io_clusters.yaml", size: 29600, mode: os.FileMode(436), modTime: time.Unix(1792439149, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _chartSeederCrdTemplatesMetalHarvesterhciIo_inventoriesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x1b\x5d\x93\xdb\xb6\xf1\xfd\x7e\xc5\xce\xf4\x21\xed\x4c\xa9\x9b\x73\x1a\x4f\x47\x33\x7d\x38\xcb\x49\xad\xf6\xce\xd1\xf8\x94\xb4\xaf\x10\xb9\x22\x11\x93\x00\x03\x80\x92\x95\xd4\xff\xbd\xb3\x00\x49\x91\x14\x3f\x40\xdd\xb9\xc9\x74\x5f\xec\x03\xb0\x8b\xfd\xc2\xee\x62\x09\x05\x41\x70\xc3\x72\xfe\x23\x2a\xcd\xa5\x58\x02\xcb\x39\x7e\x32\x28\xe8\x2f\xbd\xf8\xf8\x57\xbd\xe0\xf2\xf6\x70\x77\xf3\x91\x8b\x68\x09\xab\x42\x1b\x99\x7d\x40\x2d\x0b\x15\xe2\x5b\xdc\x73\xc1\x0d\x97\xe2\x26\x43\xc3\x22\x66\xd8\xf2\x06\x80\x09\x21\x0d\xa3\x61\x4d\x7f\x02\xfc\xfa\xf9\x06\x40\xb0\x0c\x97\xc0\xc5\x01\x85\x91\x8a\xa3\x5e\x10\x4e\xba\x48\x98\x3a\xa0\x36\xa8\x92\x90\x2f\xb8\xbc\xd1\x39\x86\x84\x16\x2b\x59\xe4\x4b\xe8\x5f\xe4\xc8\x95\xe4\x1d\x6b\xeb\x92\xf2\xc9\x8e\xa5\x5c\x9b\x7f\xb6\xc7\x1f\xb8\x36\x76\x2e\x4f\x0b\xc5\xd2\x16\x2f\x76\x5c\x73\x11\x17\x29\x53\xe7\x19\xa2\xa5\x43\x99\xe3\x12\xde\xd3\x7e\x39\x0b\x31\xba\x01\x38\x38\x6d\xd9\xfd\x03\x60\x51\x64\x95\xc0\xd2\x8d\xe2\xc2\xa0\x5a\xc9\xb4\xc8\x2a\xe1\x03\xf8\x49\x4b\xb1\x61\x26\x59\xc2\x42\x1b\x66\x0a\x5d\xfe\x63\xa7\x2b\xc5\xd4\x6c\x3e\x35\xe7\xcc\x89\xf6\xd6\x46\x71\x11\x0f\x52\x8b\x51\xa0\x62\x06\xa3\x0d\xd3\xfa\x28\x55\xd4\x22\xfc\xf7\x81\x59\x2f\xd2\xf9\x27\x7c\x23\xa5\x59\x49\xb1\xe7\xf1\x82\x45\x91\x42\xdd\xe6\xfb\x3e\x4d\x65\x48\xe4\xdf\xcb\x08\xef\x5b\x0b\x2e\x76\x70\x18\x87\x3b\x96\xe6\x09\xbb\x73\x3a\x0f\x13\xcc\xd8\xb2\xc4\x90\x39\x8a\xfb\xcd\xfa\xc7\xaf\x9f\x5a\xc3\x00\x11\xea\x50\xf1\xdc\x58\x17\xad\x55\x05\x5c\x83\x49\x10\xdc\x6a\xd8\x4b\x65\xff\x6c\xd8\x15\xee\x37\xeb\x9a\x48\xae\x64\x8e\xca\xf0\xca\x6f\x1c\x34\x9c\xbf\x31\xda\xd9\xf2\x3f\x41\x6b\x0e\x88\x6e\x89\x05\x11\x9d\x02\x74\x9c\x94\x8e\x81\x51\x29\x18\xc8\x3d\x98\x84\x6b\x50\x98\x2b\xd4\x28\xdc\xb9\xa0\x61\x26\x40\xee\x7e\xc2\xd0\x2c\x3a\xa4\x9f\x50\x11\x19\xd0\x89\x2c\xd2\x08\x42\x29\x0e\xa8\x0c\x28\x0c\x65\x2c\xf8\x2f\x35\x6d\x0d\x46\xda\x4d\x53\x66\x50\x1b\xb0\xae\x27\x58\x0a\x07\x96\x16\xf8\x67\x60\x22\xea\x50\xce\xd8\x09\x14\xd2\x9e\x50\x88\x06\x3d\x8b\xa0\xbb\x7c\x3c\x4a\x45\xba\xdc\xcb\x25\x24\xc6\xe4\x7a\x79\x7b\x1b\x73\x53\x85\x84\x50\x66\x59\x21\xb8\x39\xdd\x86\x52\x18\xc5\x77\x85\x91\x4a\xdf\x46\x78\xc0\xf4\x56\xf3\x38\x60\x2a\x4c\xb8\xc1\xd0\x14\x0a\x6f\x59\xce\x03\x2b\x88\xb0\x61\x61\x91\x45\x7f\x50\x65\x10\xd1\xad\x6d\x2f\x7c\xc6\x81\x3d\xe2\x33\xcc\x43\x47\x9f\xbc\x83\x95\xa4\x9c\x88\x67\x2b\xd0\x10\xa9\xee\xc3\xb7\x4f\x5b\xa8\x38\x71\x96\x72\x46\x39\x2f\xbd\xd0\x4b\x65\x1f\xd2\x26\x17\x7b\x54\x0e\x6f\xaf\x64\x66\x69\xa2\x88\x72\xc9\x85\xb1\x7f\x84\x29\x47\x61\x40\x17\xbb\x8c\x1b\x72\x83\x9f\x0b\xd4\x86\x4c\xd7\x25\xbb\xb2\x61\x13\x76\x08\x45\x1e\xd1\x81\xea\x2e\x58\x0b\x58\xb1\x0c\xd3\x15\xd3\xf8\x3f\xb6\x15\x59\x45\x07\x64\x04\x2f\x6b\x35\x93\x41\x77\xb1\x53\x6f\x63\xa2\x8a\xf7\x03\xa6\x3d\xc7\xc5\x1c\xc3\xd6\x59\x8b\x50\x73\x45\xa7\xc1\x30\x83\x74\xa2\xda\x19\xa0\x82\xfe\x53\x4f\x40\x52\x77\xc7\x68\xf7\x3d\x2b\x52\xb3\x04\x96\x45\xaf\xff\x72\x31\x8d\xa2\xc8\x2e\x91\x82\x81\xd5\x01\x30\x95\xf5\x8c\x0f\x28\x8e\x60\xc7\x34\xee\x24\x53\xd1\xd3\x85\x62\x2e\x94\xf3\xc8\xc2\x84\x0b\x6c\xa9\xa6\x52\x4b\xe6\xe6\x9c\x7a\xba\xfe\x32\xa6\x16\x82\x50\x0a\x81\xa1\xb9\x08\x8a\xbd\x5c\xac\xea\xc5\x84\x67\x18\x17\xba\x41\x00\xc8\x13\x6c\x6c\x66\xf0\xa6\x92\xad\x97\x28\xc0\x23\x13\x2c\xc6\x8c\x4e\xcc\x8a\x3c\x55\xa6\x29\xaa\x4b\xde\xa7\xf9\x27\x60\x85\x49\x9e\x30\x54\x68\x3e\xe0\x7e\x68\xd1\x54\x20\x69\xc2\x7d\x93\x60\x9d\x7b\xaa\x01\x54\x28\x6c\x0c\x61\xe6\xac\x06\xe2\x81\xce\x51\xe8\xc2\x3e\x9d\x50\x95\xd5\x29\x80\xf0\x4b\x13\xf6\x0b\xe9\x60\x5b\x6f\x03\x59\xa1\x6b\xea\x50\x68\x8a\xf7\x19\x52\xa4\x87\xbc\xcc\xee\xf0\x11\x4f\x7a\x01\x5b\x0a\x49\x14\xcd\x72\x57\x99\x00\xd3\xc0\x0d\x8d\x50\x90\xa1\x30\x64\x9d\xe4\x98\x20\xd1\xb9\xf4\xc2\x26\xd8\x40\xb9\x59\x91\xca\x0f\x3c\x1a\x32\x08\x78\x19\x05\xaa\x32\x60\x64\xbe\x63\x13\x2b\x22\xd7\x50\x08\xfe\x73\x81\x70\xe4\x26\xe1\x02\x98\xab\x00\xa9\x22\xa3\x3c\xa8\x2a\x03\x8c\xd2\x05\x4a\x08\x4e\x93\x55\xd0\x1f\x53\xfc\xe8\x39\xed\x4a\x64\x59\x99\x29\x96\x63\xbf\x19\xd4\xdc\x48\x29\xe3\x31\xe1\x61\x32\x21\x90\x45\x72\x22\x59\x45\x59\x0f\xa1\x24\x62\xb5\xf5\x02\xd2\x0d\x84\xed\x36\x7c\x0a\x3e\x16\x3b\x54\x02\x0d\xea\x20\x63\x79\xe0\xb0\x98\x91\x19\x0f\x07\xb0\x12\xa9\x8d\xe7\xb1\x7c\x27\xb5\xa9\x0e\x1c\xa1\xc1\x7a\x03\x65\x31\x0a\x52\xd9\x21\x2b\xbc\x3b\x53\x23\x32\x4f\x9e\xb6\x8c\x8b\x07\x14\x31\xd5\xc0\x77\x13\x2a\x19\xd5\x1b\x17\x1a\xc3\x42\xe1\xf6\xe1\xc9\x53\xc6\xf5\x19\xc3\xe6\x44\xbe\xa7\xfa\xd5\xa8\x42\x1b\x8c\x80\x46\xcf\x31\xf5\xa2\x26\xe9\xf2\xb6\x93\x32\x45\x26\x06\x56\xe5\x52\x8d\x6a\xbe\x4c\x80\xaf\x5f\x7d\xed\xc7\xfa\x46\xaa\xda\x3c\x44\x1b\x44\x91\xed\x50\xd9\xa0\x5f\x31\x2d\x62\xeb\xd5\xcf\xb5\x8f\x13\x8f\x4a\xdd\x18\xd5\x90\x78\x65\x9c\xfa\x3e\x6f\xdc\x41\xa7\x85\x68\x63\x9d\x63\x78\x45\xae\xb2\x4a\x58\x06\xd5\x11\x23\xf8\xc5\x41\x92\x22\xbd\x7f\xdc\xce\x08\x1a\xeb\x12\xe5\xcc\x1d\xe9\xbc\xe4\x87\xe2\x60\x68\x2f\xe8\xfc\x97\xa9\x38\x48\x68\x35\xb1\xe9\xc0\xee\x2f\x14\x4c\xfa\x57\xaf\x60\xd6\x85\x6c\xee\xac\xb4\x02\x47\x9e\xa6\x94\xe3\x9c\x1b\xb1\x34\x1d\x51\x78\x2d\x96\x87\x7b\x38\xa8\x52\xe0\x38\x9f\x81\x95\xc5\x23\x88\x4e\xc4\x47\x00\x9e\x67\xdc\x48\x99\xce\x31\xf6\xe6\x71\xbd\xfd\xfe\xfb\x87\x97\x31\x76\xb9\xff\x8b\x1b\x3b\xe4\x79\x82\xea\xa9\xe0\x66\x22\xfb\x5d\xc8\xb7\x3a\x63\x96\xa6\xaf\x78\x6c\x9a\x7e\x92\x26\xcc\x73\x8e\xc9\x64\x0e\xcf\xf6\xe0\x3e\x31\x5e\xde\x83\x3d\x1d\x4f\x61\xb4\xe7\xba\xe7\xa2\x33\x28\xc9\x07\x87\xf1\x22\x6e\x57\xd1\xfa\x5d\x85\x98\x52\x25\xff\x67\x11\x46\xe5\x3d\xd7\xc5\x26\xb4\x8d\xbc\x59\x4d\x1b\x78\x22\x5b\x13\xf8\x5d\x0c\x60\x5e\x48\x91\x42\x17\x19\xaa\x1f\x3e\x3c\xcc\xb4\xf1\xe8\xfd\xad\x82\xd5\x99\x7c\x55\xb5\xd0\x7f\x8f\x09\x2a\xba\x4b\x91\x22\x6b\x16\x6e\x53\xae\x0d\x0a\x54\xb4\x52\x15\x42\x4c\xc7\x0e\x02\xba\x91\x19\xe9\x0a\x78\x38\xa2\xf3\x34\x8d\x22\xb2\x33\x0a\x43\xe4\x07\x04\x96\xa6\x74\x1d\xa3\x9a\x82\x4d\x54\x14\x15\xcc\x88\x61\xf8\x29\x47\xc5\xe9\x32\xcd\x26\xb2\x0e\x74\xd5\xf8\x6d\x03\x75\xba\xda\xa9\xc0\xdf\xc0\x04\x61\xf9\x29\xc1\x36\xc4\x36\xec\x94\x4a\x36\x71\x54\x7a\x59\x5d\xf5\x90\xa9\x2f\x41\x5c\xd8\x9e\xf6\x34\xeb\x30\x4f\xb5\x96\x0b\x69\x6c\xaf\x7c\x3e\xcb\x5f\xbd\x75\xa8\x75\xc9\x4c\xff\x2f\x7b\xb9\xc4\xae\x17\x45\xa8\x1a\x95\xce\x6d\x09\x77\x97\x85\x29\xdf\x41\x5b\x17\xbf\x7e\x26\x91\x8a\xd1\xc8\xd1\x04\xeb\xa9\x3b\x04\xcc\x76\x18\x45\x18\x2d\xe0\x3b\xa9\x00\x3f\xb1\x2c\x4f\xeb\x28\xb4\x88\x98\x61\x8b\x9d\x8c\x4e\x5f\xbd\xbc\x6a\x3d\xc3\x1d\x41\x92\xb1\x89\x98\x07\x17\x57\xc9\xc7\xfb\x55\xa5\xf8\x2a\xe4\x15\x1a\xed\x71\x0d\x15\x32\x83\xc0\x3c\x64\xb2\x64\x34\x8f\x05\x33\x85\x9a\xb8\x66\xc3\xec\xb3\x91\x2b\xdc\xf3\x4f\x4f\x3c\x7e\xcb\x35\xdb\xa5\x53\x39\xa4\x57\xd0\xaf\x36\x5d\x22\x10\xa1\x41\x95\xd9\x5e\xc3\x31\x41\x93\x4c\x24\xb0\x33\x90\xb2\x58\x1a\x4b\xc5\x4d\x92\xd5\x2e\xe2\xb8\x74\xaa\xb3\x6d\x08\x7f\x75\x38\xf8\xb6\xf2\x2a\x9d\xb0\x57\xdf\xbc\xfe\x1b\xdb\x85\x77\xaf\xbe\x9e\xe3\x52\xe3\xf7\xdc\x26\xb8\x1e\x89\x97\xf6\xa1\xf5\x45\x6f\x8e\xdd\x08\xb8\xc1\xcc\x7b\xf1\x35\xe9\xab\x82\x6e\xe7\xf1\xfc\xc5\x02\x58\xd5\x2f\xac\x67\x17\xb0\x36\x90\x30\x0d\x28\x64\x11\x27\xad\x4e\xa4\x6d\x9f\x19\xc5\xf1\x50\xb5\x92\x66\x70\xc1\x05\x30\x71\x3a\x77\xb3\xbc\x51\xe7\x9d\x88\x0a\xa6\x7b\x87\x5d\x98\xd7\x4b\x9c\x45\x1a\x5a\x9d\xc7\xb9\xbd\xc5\x0b\x5a\xf3\xf2\x4f\x05\x9e\xbd\xc7\x2e\xf8\xf6\x22\x67\x2a\xa4\xd9\xb9\x1c\xea\x4d\xce\x24\xe9\xd3\xc9\xec\xc2\x55\xba\x9c\x91\x78\xce\x70\x4d\xe7\xb3\x7f\x63\xa6\x14\x3b\xcd\x8f\xf3\xee\xa4\x6b\x60\x54\xbc\x42\xc6\x72\xfb\x71\xb9\x0a\xd6\x54\xcf\x7b\x72\x51\x46\x48\x97\x0d\xe9\x46\x44\xf1\x9c\x8b\x78\x4e\xf5\xe4\xa9\xbc\x19\x8b\x53\x19\xbf\x6f\x96\xc8\xfe\x19\xb1\xa5\xa5\x87\x01\x32\xd7\xe5\x44\x85\x3a\x97\x42\x63\xf9\xd5\xb7\xf7\xc2\xa0\xeb\x3c\x99\xca\x38\xc6\xa1\x4f\x5e\x4d\x90\x8a\xae\x03\xbe\x97\x00\xbf\xdc\x57\x7e\x71\x9e\xa9\xae\xb2\x86\x1c\x2f\x94\x3c\x24\x22\x64\xd2\xce\xbb\xed\x76\x53\xb1\xf2\xd2\xc5\x52\x62\x4c\xbe\x92\xc2\xa0\x30\x5b\x52\xcc\xfc\x23\x44\xdc\x35\x28\x54\x52\x87\x6e\xc8\xaa\xdb\xf7\x24\xcb\xba\x9f\x50\x89\x5e\x4a\xdd\xba\xe8\x7d\x91\x0b\x09\xe9\xe1\x11\x4d\x22\xaf\xa9\x16\x49\x05\x0e\xb9\x92\xde\x9a\x2c\x73\x43\xde\x31\xe4\x37\x13\x5e\x1b\x66\x78\xf8\x0e\x59\x84\xea\xf7\x57\xe4\x5d\x91\x8e\x9e\x97\x13\x9a\xda\xb0\x99\x21\x57\xe8\x52\x7b\x04\x89\x1b\xf6\x2e\xfd\x99\xa9\x23\x19\xa3\x1b\x21\x39\x39\x1e\x50\x9d\xfc\x0f\x34\xcc\xcf\xae\x86\x67\xa8\x0d\xcb\xf2\xef\x6c\x9d\x7a\x85\x4b\x6f\xdb\x14\x2a\xbf\x26\xc2\xe0\x8a\x5f\x4f\x15\x54\x0e\x5d\xb3\x54\xaa\xf0\x8b\x38\x72\xbd\x89\xb3\xde\x35\x17\xbf\x6d\x9b\x44\xfd\xe1\xd4\xfd\x45\x95\xd8\x1c\xdb\x9f\x9f\xa1\xd9\x47\x07\x2d\x45\x2c\xea\x2b\x9c\x27\xc5\x7f\x07\x6f\x1e\x57\x0f\xeb\x37\x41\xcd\xe3\x6f\xdb\x40\xa8\xaf\xac\x33\xf3\xe3\x53\x85\xd7\x9b\x21\xed\x63\x97\x28\xf2\x6d\x15\x76\x9a\x09\x74\xbe\x68\xf0\x0b\xa6\x4c\x96\xe7\x28\xa2\xfb\x34\x96\x5b\xe9\x9c\xe4\x19\x8d\x06\xef\x4b\xeb\xfd\xe0\xae\x10\x61\xc8\xa3\x73\x09\x66\x55\x60\x57\x77\x5a\x0f\xdd\x4e\x43\xe5\xd4\xbe\x95\x93\x83\xba\xef\x50\xbb\xe3\xd9\x9e\x3b\x0c\x65\x86\xba\x67\x2a\x78\xf5\xcd\x6b\xcf\x0d\xfe\x95\xa0\x00\x8d\xc6\xf2\xab\xec\x63\xcc\x8a\xd3\x76\x28\x25\x4f\x41\x16\x26\x67\x11\x17\x63\xdc\x11\x0b\xb6\x83\xdc\x33\xf5\xcd\xdd\xab\x2f\xd2\x38\x71\x7c\xbf\xf7\xbe\x77\xb7\x63\xd1\xbb\x1a\xbb\x27\x0c\xd9\x00\xe3\x7b\x3b\xb9\x0c\x43\xb5\x17\xfc\x51\xff\x69\x54\x6d\x5f\x20\xc6\x00\x70\x11\xa6\x45\x84\x51\xd9\x67\x9d\x55\x7a\x5c\x77\x7e\xd6\xbd\x3b\xda\xf4\x5e\xe6\x74\x38\x26\x52\x63\xf9\x9e\xb7\x76\xb5\x8a\x53\xe8\xea\x0d\x72\x47\xa9\x4f\x79\x8f\xa7\xc0\xb5\xd6\x03\xb7\x8f\xef\x19\x4f\xd3\x33\x33\xe5\xfe\x11\x46\x45\x9e\xf2\xb0\xef\x51\x6b\x3f\xcc\x2a\xaf\x66\x17\x57\x73\x4a\x2b\xef\x5c\xe2\xfb\xb5\xaf\xf1\x6d\xeb\xf9\x1f\xfd\x26\x17\x8d\x73\x15\xd8\x97\x53\x03\x53\x8d\x17\x4c\xbd\x2b\x46\xf7\x1e\xde\x37\x68\x3c\x63\xba\x98\x1c\xa1\x89\x07\x14\x7d\xed\xdb\xf1\xc4\x87\x62\x24\xab\xd5\x0f\x9d\xf6\x2c\xd5\xfd\x35\xd1\x54\xb0\xcc\x65\x9a\x72\x11\xaf\x85\x41\x75\x18\xfa\xcc\x56\xef\x73\xd7\xff\x98\xcf\x15\xa3\x4b\x88\x0a\xc5\x7a\xd5\x02\x53\x5e\x3e\xa6\xee\x52\x05\x73\x74\x9d\xd5\xcf\x70\xad\x60\x7b\x16\xe2\x23\x0b\xcb\x1f\x77\x5c\xee\x31\xc2\x5a\x2e\x8f\xa8\xee\xad\xb1\xcb\x96\x42\x1f\x93\x63\x04\x14\xcf\x98\x3a\xbd\xe5\xfa\xe3\x0c\xbc\x7e\x7d\x04\xed\xb7\xd5\x9d\x39\xe7\x60\x9d\xc1\x51\x4d\x74\xd6\x36\x58\xbd\xf1\x50\xb4\xfb\x85\x8d\xd7\xcb\x77\xbb\xb2\xd5\x9a\x95\x3b\x8d\xea\xf0\x9c\xc7\xef\xa1\x14\xee\xfa\xdb\x63\xd0\xc1\xf0\x3b\x55\x65\xa6\x4c\x9b\xad\x62\x42\x5b\xca\x54\xe3\x0f\x85\xc3\xc9\xa0\x4d\xa4\x7e\xb0\x3f\x84\x78\x16\x99\x0c\xb5\x66\xf1\xf5\xf8\x0a\x99\x1e\x7a\x04\xef\x81\xde\x67\xe4\x19\xe8\x66\xa4\x9f\x35\x81\x3c\x16\xf9\x03\x68\xfd\x00\xad\x3d\x35\xd0\xed\x1a\x0d\xf7\xc3\x19\xf5\xe2\xb7\x69\xb3\x4e\x7f\xc2\x54\x74\x64\x0a\xd7\x6f\x67\xa1\x95\x3f\x7d\xd8\x50\xf0\xa1\xc3\xd3\xa3\xc3\xce\xcb\xa0\x6a\x61\xf3\x23\x9a\x8d\x5d\xe7\x03\xc6\x86\x1f\xa7\x8e\xb0\x22\x8f\x02\xd5\x2a\x2d\xb4\xe9\xbb\xcb\x8f\x9f\xa7\xe1\x6f\x5d\x13\xb6\x9f\xf8\x24\x74\x75\x2a\xe9\x6d\x1d\x04\x23\x1f\xcf\x46\x9c\xa6\x91\x19\xe6\xea\x85\x59\xac\xa7\x91\xa3\x35\xa1\x1f\x8a\x2d\x93\x49\xc9\x93\xce\x3f\xe4\x6e\xf8\x6e\x34\x8a\x3f\xa6\x9c\xe6\xcf\x2e\x67\xab\x67\x28\x4f\x7b\x48\x14\x33\x83\x47\x76\xba\xda\xeb\xca\xdf\xc6\xf5\xe3\x8f\x56\xf5\x7e\xb1\x70\xb0\x6a\x17\x68\x32\xd6\x57\x23\x4c\xd2\x1e\x31\xc3\x50\xf4\x1e\xa4\xd7\x4b\xeb\x62\xd0\xa5\xee\xa5\xed\x0c\xb8\x01\x23\x15\x25\xa9\xc6\x48\xb1\xab\x7f\x1f\x59\xed\x5f\x72\x03\xbf\x7e\xbe\xf9\x6f\x00\x00\x00\xff\xff\xa0\x83\x15\x57\xb5\x3d\x00\x00")
+var _chartSeederCrdTemplatesMetalHarvesterhciIo_clustertemplatesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x3b\xdb\x72\xe3\x36\x96\xef\xfa\x8a\x53\xb5\x8f\x91\xe4\xee\x6c\x2a\xb5\xa5\x37\xc7\x9d\xdd\x75\xd2\xee\x76\x59\x4e\xcf\xe3\x14\x44\x1c\x89\x88\x40\x80\xc1\x45\xb2\x7b\x66\xfe\x7d\xea\xe0\x42\x51\x32\x49\xd1\x72\xd2\xd3\xd4\x43\x93\x00\x0e\xce\xfd\x06\x78\x36\x9b\x4d\x58\x2d\xbe\xa0\xb1\x42\xab\x05\xb0\x5a\xe0\x93\x43\x45\x6f\x76\xbe\xfd\x1f\x3b\x17\xfa\x6a\xf7\x7e\xb2\x15\x8a\x2f\xe0\xc6\x5b\xa7\xab\x07\xb4\xda\x9b\x02\x3f\xe0\x5a\x28\xe1\x84\x56\x93\x0a\x1d\xe3\xcc\xb1\xc5\x04\x80\x29\xa5\x1d\xa3\xcf\x96\x5e\x01\xfe\xf1\xaf\x09\x80\x62\x15\x2e\xa0\x90\xde\x3a\x34\x0e\xab\x5a\x32\x87\x76\x4e\x0b\xe5\xbc\x64\x66\x87\x34\x50\x16\x62\x2e\xf4\xc4\xd6\x58\xd0\xda\x8d\xd1\xbe\x5e\x40\xf7\xa4\x08\x33\xed\x91\xf0\x8b\xe0\x1f\x13\xf8\x30\x22\x85\x75\xbf\x76\x8d\x7e\x14\xd6\x85\x19\xb5\xf4\x86\xc9\x97\xc8\x85\x41\x2b\xd4\xc6\x4b\x66\x5e\x0c\x4f\x00\x6c\xa1\x6b\x5c\xc0\x27\x56\xa1\xad\x59\x81\x7c\x02\xb0\x8b\x9c\x0c\x68\xcd\x12\xd5\xbb\xf7\x4c\xd6\x25\x7b\x1f\x01\x16\x25\x56\x81\x51\xf4\xa6\x6b\x54\xd7\xf7\xb7\x5f\xfe\x7b\x79\xf4\x19\x80\xa3\x2d\x8c\xa8\x89\x8d\x2f\x30\x07\x61\xc1\x95\x08\x71\x0d\xac\xb5\x09\xaf\xa7\xb3\xae\xef\x6f\x1b\x70\xb5\xd1\x35\x1a\x27\x32\xbf\xe2\xd3\x92\x7c\xeb\xeb\xc9\xe6\xff\x9c\x1d\x8d\x01\x10\xbe\x71\x15\x70\x52\x01\x8c\xd8\x24\xca\x91\x27\x12\x41\xaf\xc1\x95\xc2\x82\xc1\xda\xa0\x45\x15\x95\x82\x3e\x33\x05\x7a\xf5\x3b\x16\x6e\x7e\x02\x7a\x89\x86\xc0\x80\x2d\xb5\x97\x1c\x0a\xad\x76\x68\x1c\x18\x2c\xf4\x46\x89\xaf\x0d\x6c\x0b\x4e\x87\x4d\x49\x90\xd6\x81\x50\x0e\x8d\x62\x12\x76\x4c\x7a\x9c\x02\x53\xfc\x04\x72\xc5\x9e\xc1\x20\xed\x09\x5e\xb5\xe0\x85\x05\xf6\x14\x8f\x3b\x6d\x10\x84\x5a\xeb\x05\x94\xce\xd5\x76\x71\x75\xb5\x11\x2e\xdb\x43\xa1\xab\xca\x2b\xe1\x9e\xaf\x0a\xad\x9c\x11\x2b\xef\xb4\xb1\x57\x1c\x77\x28\xaf\xac\xd8\xcc\x98\x29\x4a\xe1\xb0\x70\xde\xe0\x15\xab\xc5\x2c\x10\xa2\x88\x7c\x3b\xaf\xf8\x7f\x99\x64\x41\xf6\x68\x5b\xf7\x4c\xda\x64\x9d\x11\x6a\xd3\x1a\x08\xaa\xfd\x0a\xf1\x90\xb2\x93\x86\xb0\x04\x2a\xf2\xe4\x20\x05\xfa\x44\xac\x7b\xf8\x79\xf9\x08\x19\x93\x28\xa9\x28\x94\xc3\x54\xdb\x27\x1f\xe2\xa6\x50\x6b\x24\xc5\x13\x16\xd6\x46\x57\x41\x1c\xa8\x78\xad\x85\x72\xe1\xa5\x90\x02\x95\x03\xeb\x57\x95\x70\xa4\x06\x7f\x78\xb4\x8e\x44\x77\x0a\xf6\x26\xf8\x0c\x58\x21\xf8\x9a\x33\x87\xfc\x74\xc2\xad\x82\x1b\x56\xa1\xbc\x61\x16\xbf\xb1\xac\x48\x2a\x76\x46\x42\x18\x25\xad\xb6\x27\x3c\xfc\x8b\x93\x23\x7b\x5b\x03\xd9\xcf\x8d\x15\xed\x89\x85\x2f\x6b\x2c\xa0\xd4\x92\x47\x03\xb4\xe8\x9c\x50\x1b\x0b\xb6\x64\x06\x39\xac\x9e\xb3\xcf\x22\xe6\xaf\xd1\xa0\x2a\xb2\xf0\xb3\x17\x9b\xc3\x32\xaf\xda\x97\xa2\x28\x81\x19\x04\xa5\xdb\x48\x66\x44\xc5\x5a\x20\x07\xad\x80\x65\xb0\x64\xfe\xcc\x4b\x17\x8d\x51\x5b\x24\xdb\x6e\x43\x87\x7d\x89\x2a\xe9\x42\xc0\x9c\xf4\xb2\x30\x48\x22\x3e\xda\xa1\xdb\x3d\xd1\xc3\x38\x6f\x02\x49\x2f\xa3\xae\xc3\xa4\x80\x3b\x6d\x46\x5e\xd7\x12\x2e\x89\x5f\x79\xb8\xae\x25\x91\xe0\x74\x46\xe7\x58\xa2\xf1\x49\xe8\x1d\x74\x3a\x13\xf3\x62\xae\x70\x58\x75\x60\xd6\xab\x1a\xed\x41\x66\x0c\x7b\x3e\x19\x4b\x48\xdd\x68\xb5\x16\x9b\x97\x70\xfb\x79\x44\x0f\x5b\x3b\x34\xb7\xca\x3a\x26\xe5\x8d\xae\x2a\xa6\x78\xe7\xbc\x53\xce\x75\x2c\x0b\x7c\x34\x5e\x81\x08\xb2\x2e\x8d\xd6\x2e\x4b\x56\xc4\x1d\x90\x77\x82\x06\xd0\x76\x1a\x51\x01\x8b\xc8\xd1\x90\x0b\x5f\x8b\x8d\x37\x29\x48\xac\x08\x96\xd4\x8c\xa3\xe9\x84\xd0\xcb\xd3\xb3\x7c\x1d\xe6\x2d\x3d\x2b\xad\xf8\xe7\xba\x95\x98\x9c\xfe\x63\x9c\x87\x74\x86\xc9\xfb\x41\x5e\xbf\x02\x97\x17\x26\x9f\x9f\xc8\x97\xdf\x1e\x3e\x2e\x26\x17\x80\x2f\x42\x22\x76\x6f\xf4\x4e\x50\x1c\x16\x6a\x93\x7d\xc2\x45\xe0\x38\x92\x9b\x14\xf6\x65\x26\xd0\xa9\x34\x2f\xfc\x52\x7e\x3e\xb4\xe0\x80\x65\x94\x1c\x7e\x45\x0b\x22\x38\x54\x6d\x28\x02\x57\x7a\xd7\x36\xae\xa4\xf3\x53\xd0\x06\xd6\x06\x91\x77\xba\x0c\x8e\x12\x1d\xf2\x69\xcf\xb6\x2b\x5c\x87\x78\xed\xc8\xbd\x18\x74\xde\xa8\x68\xe6\x04\xa6\xd6\x5a\x76\xae\x1b\xb6\x27\x7a\x2a\xcd\x7b\xf8\x49\xbf\xe4\xfc\x16\xa0\xb4\xc2\x49\xcf\xa4\x91\x8c\xa3\xdf\x9d\xe6\x08\x7b\x6d\xb6\x6b\xa9\xf7\x50\x3f\x45\x63\x89\x66\xe3\x84\xda\xa2\x59\xa1\x94\x50\x6a\xbd\x05\x6d\x29\xc5\x01\xe3\xc9\xad\x1d\x16\xed\x45\x4d\x0e\x9e\x49\x09\x5c\xd8\xad\x9d\x82\x41\xbe\x16\xb6\x3c\x04\x5f\x36\x80\x81\xc5\xc2\x1b\x04\x34\x2c\xba\xf2\x00\xc7\x88\x1d\x5a\xd8\x09\x16\x10\xf9\xe9\xee\x26\x64\x57\x81\xe8\xc4\xeb\xb6\x80\x5b\x4c\x87\xbd\x70\xa5\xf6\x2e\x63\x15\x30\xea\xdd\x1d\x95\xaf\xfa\x79\x3d\x1b\x66\xf2\xac\x61\xc1\xc0\x94\xc4\x8a\xde\x19\x67\x6c\x84\x7e\x94\x15\xb9\x9f\x6e\x3f\x2f\x17\xe3\xe4\xfd\x90\xe7\x53\x9e\x85\xce\xc2\x4a\x68\x7b\x88\xd3\x91\x59\xa2\x89\xa3\x5d\xe1\x28\xff\x23\x01\x64\x61\x6a\x55\x60\x14\x30\x94\x6c\x87\xb0\x42\x54\xc4\x65\xe4\x67\x88\x5b\x69\x2d\x91\xa9\x9e\x59\x4e\x54\xa8\xbd\x1b\xa1\xf1\xdf\x97\xe3\xe8\x7f\x8c\x10\x53\x44\x88\x09\xc6\x41\x57\xe2\x7b\xc9\x2c\x25\x1c\x81\x88\x5e\xa8\xd0\x78\x13\x4e\x36\xfe\x87\x67\x86\x51\x26\x3b\x40\xf1\x5a\x9b\x8a\xb9\x05\x70\x6f\x42\xdd\x71\xb9\xdc\xcf\x78\xf2\xdf\xb5\x50\x3f\x31\x57\x94\x4b\xf1\xb5\xc7\x5d\x8c\x73\x02\xbf\xb4\x01\x81\x14\x21\x6b\x26\xa3\x53\xbe\x5a\xa1\x21\x8b\xa4\xbd\x40\x69\x8e\xe4\xe7\xc8\x3d\x20\xa7\xda\x47\x1f\xc5\x66\x03\xcc\x05\x1d\x99\xc3\x2f\x87\xf9\x14\xd4\x4b\x94\x1c\xbc\x72\xa2\xdb\x23\x42\x00\x13\x13\xa0\xb0\x8a\x4a\x3d\x60\xca\xee\xd1\x50\x5c\x57\x1c\x90\x15\x25\xac\x08\x49\xd8\x33\xc2\x2f\x17\x9f\xb5\xc1\x9d\xd0\xde\xa6\x41\xca\xb1\x74\x55\x93\xdb\xce\x78\x05\x29\xcc\x27\x9d\xfb\xc2\x3b\x60\x3c\xd0\x4b\x3e\xa7\x45\x65\xa2\xa4\x73\x55\x25\x94\xa8\x7c\xb5\x80\x77\x9d\xc3\x51\x6c\x54\x19\x6e\x3a\x93\x8d\x2d\x1a\x85\xf2\xda\x6c\xec\x08\xa1\xfd\xda\x4c\x0e\xd9\x11\xab\x6b\x54\xfc\x10\x64\x22\x2c\x22\x99\x32\x28\x90\xa2\xd7\x59\x9d\xe6\x51\x91\xce\x6f\x9e\x0b\x55\xec\x29\x65\x7d\x42\x6d\x3e\x11\x0a\x6f\xd1\xdc\xbb\x17\xd0\xba\xd5\x37\xd0\x9a\x69\xa7\x98\x90\xc4\x3b\x25\x3e\xb2\x9d\x16\x1c\x2c\x73\xc1\x60\x53\x9d\x22\x2a\xb6\xa1\xca\x86\xda\x02\x3d\x9b\x13\xc3\x7d\x2d\x85\xda\xce\xe1\x01\x2b\x26\x14\x41\x4e\xea\x63\x70\xd8\x50\x6c\x7e\x09\xda\x69\x1b\xa5\x9d\xc3\x3b\xf2\xb0\x6c\x25\x53\xe2\x1a\xe8\xf9\x2b\xd4\x30\x14\x2b\xb1\xac\x5e\x7c\x73\x35\x50\xae\x5e\x0e\xed\x7d\x24\xfe\x4f\x8f\xf7\xb9\xfe\x6f\x2a\x2d\x57\x27\xe1\x58\xf0\x36\xd6\x9c\xe1\xfb\x7f\x44\xa9\xeb\x56\x3e\x7c\xd7\x9b\xbb\x35\x51\xac\x7e\xc2\xf3\x44\xf7\xea\x7c\x3b\xf9\xa6\xcd\xa8\xd2\x71\x46\x4b\x0b\xa5\xde\x27\xf5\x23\x0f\x1d\xb4\xa7\xe9\x5d\x1e\x74\x6f\x1e\x52\x3c\xca\xcb\x04\x15\x47\x71\x81\xd3\xd4\x04\xd1\x31\x01\xb6\xac\xea\xf3\x22\x1f\xbf\x07\x8b\x9b\x8a\xda\x2b\xcc\x82\xad\x10\xa7\xb0\x2f\x85\x44\xd8\x09\xe3\x3c\x93\x77\xc8\x05\x83\x4a\x7b\x45\x3e\x55\xc1\xed\xf2\x73\x3b\x87\x0b\xbe\x5c\x21\x72\xda\x18\x3e\xfc\xff\xcd\xfd\xe4\x75\x49\xd9\xac\x97\x7b\xb3\x23\x14\x26\x17\x88\xb9\x36\xfa\xe9\x79\x71\x5e\x34\xf7\x34\x0f\x44\x87\xe6\x05\xf2\xda\x35\x84\xd3\x60\x28\x74\x75\x02\x05\xa0\x96\x77\x68\x1f\x2a\x74\x7b\x6d\xb6\xf6\xc2\x82\x81\x7a\x85\xf7\xfd\xd8\x8f\xa0\x3d\x43\xb1\x6f\x07\xa3\xf4\x19\x18\x83\xa6\x38\x72\x93\x73\x26\x79\x36\x7b\x32\x4c\x15\x25\x9a\x07\xdc\x08\xeb\xc8\xf1\x8f\xaa\x40\x1f\x5e\xae\x02\x51\xd5\xda\x38\x7b\x24\xf7\xe0\xf2\xd3\x16\x9d\x50\x21\xc4\x9f\x5c\x3a\x7a\xa5\xfa\x48\x3d\x2f\xfc\xb4\x27\x9d\x06\xf4\x4d\x39\xa1\x22\xb5\xa7\x68\x45\xd3\x5f\x09\x44\x20\x6f\x51\x90\xf1\xef\xab\x7e\xe9\x89\xa9\x74\xf2\x6c\x4d\x73\x3c\xc3\x50\xf9\x80\x22\xd8\x85\xea\x77\x2b\xa3\x44\x2e\x54\xac\x13\x97\x5b\x51\x3f\x7e\x5c\x7e\x41\x23\xd6\xcf\x23\x29\xbe\xed\x5a\x0b\x76\x2b\x6a\x4b\x27\x27\x62\x2d\x8a\xe6\x90\xc0\x95\xfd\x68\x36\x7a\x93\xa2\x0f\x14\x24\x9b\xb0\x1a\xdf\x56\x03\xe9\x2d\xaa\x25\x16\x06\xdd\x03\xae\x47\x52\xf5\x78\xb4\x28\xf5\xde\x03\x8c\x50\x03\x03\x6b\xb0\x65\xb5\xe8\x05\x99\x36\x27\x89\x93\x26\xc4\x97\x2d\x76\x5b\xd5\x38\x95\xcc\xf9\xc5\xd0\xf8\x09\x2d\x34\x9d\x28\xf0\x4a\xfc\xe1\x31\xe0\x1f\xda\x80\x07\x1d\x72\xba\xe9\x26\x0f\x09\x88\x9e\x86\x0d\xf9\x98\xa1\x2f\xfd\x1f\xad\x7c\x4d\xc6\x44\xe7\x6d\xaf\x24\x2b\xa2\xdf\x3e\xb2\x8a\x5f\x12\x8d\xc1\x86\x06\x21\x42\x6a\xb3\x07\x92\x08\x0b\xa8\xbc\x75\x14\xb1\x23\xb7\xfe\x04\xea\xce\x78\xcb\xf8\x7b\x9a\x6d\xfd\x8a\x2a\x0e\x87\x76\x56\xb1\x7a\x96\x7c\xb0\xd3\x95\x28\x7a\x56\x79\x23\x17\x93\x51\xac\xfa\xed\xe1\x63\xf6\x46\xc7\x46\x36\xb9\x98\xb2\x94\xe5\xf0\x45\xe7\xe8\xec\xc4\xea\x7a\x26\x79\x23\x27\x17\xb0\xcc\xc4\xc8\xf2\x7c\x27\x8c\xd1\xa3\xf2\xdc\x87\xe3\x15\x87\x16\x36\xfd\xcf\x31\xa1\xd0\x84\xc2\xaf\xf6\x52\xc6\x02\xa5\x3b\x5f\x00\x62\xa3\xaf\xad\x33\xc8\xaa\x8c\x87\xc0\x74\x5c\x56\x45\x7c\x26\xaf\x8e\xcc\x03\xb8\x1e\x29\x77\xdc\xa0\x39\x92\xb3\x2d\xec\x7b\x20\x43\x20\xc9\x26\x9a\xd2\x41\xed\x29\x01\xcf\x01\xfd\xc9\xe5\x2e\xa9\x30\xc8\xe9\x7c\x8d\x49\xdb\x88\xbc\x7f\xf6\x09\xbd\x37\x1d\x8b\x5f\x3a\x5c\xd2\x5d\x6f\xd1\x0c\x86\xba\x5c\x3b\xd6\xcc\xda\xbd\x36\x1c\xb6\xf8\x9c\x72\x49\xaa\x46\xbd\x2b\x09\x4b\x8a\x28\x07\xa0\x91\xa7\x03\x20\xc7\xf9\xe4\x31\x5e\xf9\xaf\xf4\xcb\xaf\xf6\xcc\x23\x6c\x3c\x3f\x0d\x42\xaf\x26\xef\x4f\xf0\xcf\x6f\xf3\xd0\xa3\xa9\x1c\xe5\xa5\x2f\xf3\xd3\x70\x30\xd8\xc5\x64\x24\xf3\x7e\x6e\x4c\x3c\xd7\xe5\xde\x48\x9b\x9d\x78\xd4\xd9\x29\x38\x23\x06\xda\xa5\xf4\x13\x0a\xb4\xe9\x3b\x99\x1b\xe1\x99\x2e\xe0\x61\x7f\xdd\x70\x41\xaa\x79\x79\xb2\x39\x00\x12\x5a\x4c\x1c\x95\x66\x8e\x4b\x34\x0f\x81\x69\x34\x3d\xd9\xd7\xe7\xeb\x3f\x2f\x3c\xf3\x14\xac\xa7\x23\xfc\xbe\x70\x94\x60\xea\x62\x8b\x26\xde\xa0\x7a\x83\x0c\x87\x63\x3a\x9d\xc9\x34\x8a\xdc\x3b\x23\x23\x3e\xb9\xd0\xcc\x86\x75\xc8\xda\xf2\x57\x7c\xee\xd1\xd6\x41\x45\x3e\x4b\xfe\x99\x8d\x43\xc4\xbf\xa5\x28\xda\xdf\x86\x3a\xa7\x24\xf6\xd9\x16\x4e\x7e\x8b\x93\xea\x23\x25\x5b\xc6\x6d\x43\x97\x3b\x75\xb5\x6b\x66\x58\x85\x8e\xfa\x7e\x16\x5d\xee\x4e\xf5\xf7\xad\xcf\x88\xad\xdd\x1e\x5a\x9c\x47\xa8\xb7\x01\xf7\xa5\x05\x27\xdc\x84\x69\x47\x0e\xea\x77\x85\xee\x17\xf2\xa3\xbe\x57\x38\x5d\xf6\x74\xa9\xef\xb8\x53\xd6\x6e\x1d\x86\x73\xdf\xbe\x48\xf1\x98\x80\xc7\xe6\x99\xd3\x87\x1e\x5f\xd3\xd9\x8b\xe9\x42\xd3\xf2\x9b\xa7\x81\x79\xcc\x23\xff\xee\x8d\xa4\x23\x40\x8a\xd7\xf3\x9b\x7c\x0b\x60\x9a\x4a\xf8\x90\x69\xf7\xd9\x2f\xed\x52\xa3\x09\xcd\xc5\x03\xfc\x94\x9f\x1e\xce\xd4\xd3\xcd\x8b\x6c\x7e\x93\xcb\xf2\x14\x61\x75\xef\xed\x84\xf1\x42\xa2\xe7\x76\xf9\x99\x6a\x0a\xca\xd2\x14\xe0\x53\x2d\x45\x21\x1c\x10\x1b\xb4\x01\x06\x1b\xdd\x5c\xb1\xc9\x5c\xa0\x5e\x0c\x9d\xaf\xd0\x2d\xa6\x86\xe0\xc0\xd5\x79\x04\x36\x3d\x62\xdd\x9c\x3a\x28\x43\xed\x91\x30\x21\x24\x13\x53\x98\x5f\x73\x6e\xd0\xda\x29\xcc\x3f\xa1\xab\x98\xdd\x4e\x61\xfe\x7f\xcc\xe1\x9e\x3d\x4f\x61\x7e\x77\x7d\x73\x98\xf0\x45\x32\x75\xfb\x61\x0a\xf3\x7c\xdf\x91\xf2\xc5\xf9\xb5\x29\xca\xa1\x44\xe2\xc3\x49\x1b\x86\x74\x91\x51\x7b\x82\x15\xcd\x3d\x9a\xd4\x9a\xe9\x85\x32\xd2\x03\xf5\x1b\x59\xc0\xfc\x4c\x03\xfc\xfd\xf0\x79\xc6\xfb\x8b\xce\x33\xe8\x1c\xfa\x03\x9d\x4d\x5f\xe6\xf6\xf6\x46\x38\xfc\x5f\x21\xd1\x8e\xf0\x0d\x7f\x6b\x26\x07\x7f\x45\x6b\x1d\x5d\x38\xd3\x07\x0f\x15\xef\x93\x34\xc6\xd2\x09\x13\x28\xa2\x66\xf3\xe5\xaf\x0f\x17\xdd\x38\x11\x50\x06\x6b\x21\xdf\x82\xd8\x08\xd4\xc6\x15\x1d\x54\xfc\xa1\x1a\x38\xdc\x1f\xa1\x73\xf4\x43\x55\x68\x2e\xd4\x66\xd1\x3b\xe3\x84\x1f\x3f\xa7\x05\x8d\xe6\x47\x44\x06\x96\x0f\x5f\x05\xa1\xcc\x62\xf5\xe3\x0f\xc3\xe3\xcc\xe2\x99\x29\x9b\xaf\x67\x86\x45\x7d\x66\xc2\x77\xa3\x76\x11\xf5\xb8\x79\xdf\x9d\xa3\x69\xf3\x55\xd4\x67\x26\x8d\x12\xa0\xde\x2b\x34\xa3\xa5\xf7\x99\x66\x67\xd1\x91\x2e\x4f\x73\x7f\x92\xea\xeb\xc5\xc6\x68\x5f\xd3\x05\xf4\x8a\xb9\xb7\x22\x56\x33\x57\x8e\xc6\xeb\x9e\xb9\x32\xe7\xc1\x6c\x65\xb5\xf4\x0e\x03\x84\x36\xae\x6f\xc6\x08\x4d\xba\xcc\x66\xc7\x23\x76\x58\xd3\x46\x85\xb8\xa6\x0b\x47\x47\x4c\xe9\x8f\x23\x86\xe2\x15\xe4\x44\x1e\xde\xfd\xf8\xc3\x0f\x7f\x75\x9e\x9e\x5c\x43\xef\x38\xb1\xb5\x67\xf0\x4c\x14\x1a\x4e\x94\x07\x16\x87\x0e\x54\x67\xfa\x31\x40\x71\xfa\x03\x84\xd7\xad\x11\x75\x0a\xf7\xf7\x5a\xcb\x87\xdc\x3f\x59\x4c\x06\x85\xfc\xe5\xf6\xbe\x6b\x55\xa3\x90\x71\x2c\xde\x82\x1b\x8e\xf6\x3b\x51\xd3\x2a\x26\xa5\x2e\x9a\x6b\xc7\x93\xd7\x39\xf8\xfe\x5e\xd2\x00\xe1\x23\x5a\x35\x83\xab\xfb\xd5\x2a\xfe\xd5\x4b\xcf\xe7\xb0\xdd\x78\x4d\xe8\x1c\x78\xf1\x31\x24\xcd\x7c\x01\xce\xf8\xd8\xf9\xb2\x4e\x1b\xb6\xc1\x05\x38\xe3\x71\xf2\xef\x01\x00\x65\x36\x09\xe6\xe4\x34\x00\x00")
+
+func chartSeederCrdTemplatesMetalHarvesterhciIo_clustertemplatesYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_chartSeederCrdTemplatesMetalHarvesterhciIo_clustertemplatesYaml,
+		"chart/seeder-crd/templates/metal.harvesterhci.io_clustertemplates.yaml",
+	)
+}
+
+func chartSeederCrdTemplatesMetalHarvesterhciIo_clustertemplatesYaml() (*asset, error) {
+	bytes, err := chartSeederCrdTemplatesMetalHarvesterhciIo_clustertemplatesYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "chart/seeder-crd/templates/metal.harvesterhci.io_clustertemplates.yaml", size: 13540, mode: os.FileMode(420), modTime: time.Unix(1792439149, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _chartSeederCrdTemplatesMetalHarvesterhciIo_inventoriesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x3d\x5d\x73\xe3\x36\x92\xef\xfc\x15\xa8\xbd\x87\xdc\x55\xad\x34\x99\xc9\x26\xb5\xa5\xda\xdb\x2a\x8f\x67\x92\xf1\x9d\x3d\xd1\xd9\x9e\x5c\x5e\x21\xb2\x25\x22\x26\x01\x06\x00\xad\xd1\xe6\xf2\xdf\xaf\x1a\x1f\xfc\x90\x48\x10\x94\x3c\x97\xad\x1b\xba\x2a\x31\x09\x34\xba\x1b\xfd\x85\x46\x03\x5e\x2c\x16\x09\xad\xd8\x4f\x20\x15\x13\x7c\x45\x68\xc5\xe0\xb3\x06\x8e\xbf\xa9\xe5\xd3\x5f\xd5\x92\x89\x57\xcf\xaf\x93\x27\xc6\xb3\x15\xb9\xae\x95\x16\xe5\x3d\x28\x51\xcb\x14\xde\xc1\x96\x71\xa6\x99\xe0\x49\x09\x9a\x66\x54\xd3\x55\x42\x08\xe5\x5c\x68\x8a\xaf\x15\xfe\x4a\xc8\x6f\xbf\x27\x84\x70\x5a\xc2\x8a\x30\xfe\x0c\x5c\x0b\xc9\x40\x2d\xb1\x4f\xb1\xcc\xa9\x7c\x06\xa5\x41\xe6\x29\x5b\x32\x91\xa8\x0a\x52\xec\xb6\x93\xa2\xae\x56\x64\xb8\x91\x05\xe7\xc0\x5b\xd4\x6e\x1c\xe4\x83\x79\x57\x30\xa5\xff\xb3\xff\xfe\x96\x29\x6d\xbe\x55\x45\x2d\x69\xd1\xc3\xc5\xbc\x57\x8c\xef\xea\x82\xca\xf6\x0b\xc2\x52\xa9\xa8\x60\x45\x3e\xd2\x12\x54\x45\x53\xc8\x12\x42\x9e\x2d\xb7\xcc\xf8\x0b\x42\xb3\xcc\x30\x81\x16\x6b\xc9\xb8\x06\x79\x2d\x8a\xba\xf4\xc4\x2f\xc8\x2f\x4a\xf0\x35\xd5\xf9\x8a\x2c\x95\xa6\xba\x56\xee\x3f\x66\x50\xcf\x98\x06\xcd\x87\xee\x37\x7d\xc0\xb1\x95\x96\x8c\xef\x46\xa1\xed\x80\x83\xa4\x1a\xb2\x35\x55\x6a\x2f\x64\xd6\x03\xfc\xc3\xc8\xd7\x28\xd0\xd5\x67\x78\x2b\x84\xbe\x16\x7c\xcb\x76\x4b\x9a\x65\x12\x94\xc7\xcd\x82\xbf\x2a\x0a\x91\x22\xf8\x8f\x22\x83\xab\x5e\x83\xa8\x11\x4a\x9a\xe6\x8c\xc3\x5a\xec\x41\x22\xe9\xd0\x83\x7e\xf2\x3a\x0a\xe6\xaf\x35\x95\x94\x6b\xc6\x61\x69\x05\xb5\x07\xf3\xbf\x9a\xaf\x71\x30\x2b\x48\x97\x8d\x40\x5c\x17\x54\x29\x94\x85\x1e\x48\xf3\x36\x1e\x9a\xe1\x18\x13\x7c\x29\x69\xfa\xd4\x03\x74\xdf\xbe\xa8\x24\x13\x92\xe9\xc3\x8a\xbc\x1e\x83\x6c\x67\xe0\xf9\x35\x2d\xaa\x9c\xda\x56\x2a\xcd\xa1\x34\x5a\x88\xbf\x89\x0a\xf8\xd5\xfa\xe6\xa7\x6f\x1e\x7a\xaf\x09\xc9\x40\xa5\x92\x55\x28\xb5\x1d\xd1\x23\x4c\x11\x9d\x03\xb1\xad\xc9\x56\x48\xf3\xab\x27\x9e\x81\x22\x57\xeb\x9b\x06\x48\x25\x45\x05\x52\x33\xaf\x87\xf6\xe9\x18\x93\xce\xdb\xa3\x21\xff\x67\xd1\xfb\x46\x10\xae\xeb\x45\x32\xb4\x2a\x60\x31\x71\x8a\x06\x99\x23\x8c\x88\x2d\xd1\x39\x53\x44\x42\x25\x41\x01\xb7\x76\x06\x5f\x53\x4e\xc4\xe6\x17\x48\xf5\xf2\x08\xf4\x03\x48\x04\x43\x54\x2e\xea\x22\x23\xa9\xe0\xcf\x20\x35\x91\x90\x8a\x1d\x67\xff\x68\x60\x2b\xa2\x85\x19\xb4\xa0\x1a\x94\x26\x46\x95\x39\x2d\xc8\x33\x2d\x6a\xf8\x33\xa1\x3c\x4b\x7a\x80\x49\x49\x0f\x44\x02\x8e\x49\x6a\xde\x81\x67\x3a\xa8\x63\x3c\xee\x84\x04\xc2\xf8\x56\xac\x48\xae\x75\xa5\x56\xaf\x5e\xed\x98\xf6\x26\x36\x15\x65\x59\x73\xa6\x0f\xaf\x52\xc1\xb5\x64\x9b\x5a\x0b\xa9\x5e\x65\xf0\x0c\xc5\x2b\xc5\x76\x0b\x2a\xd3\x9c\x69\x48\x75\x2d\xe1\x15\xad\xd8\xc2\x10\xc2\x91\x7c\xb5\x2c\xb3\x7f\x91\xce\x28\x7b\xed\x1b\x91\x19\xfb\x63\x4c\xe6\x8c\xe9\x41\x53\x8a\xd2\x41\x1d\x28\xcb\x93\x76\x16\xf0\x15\xb2\xee\xfe\xfd\xc3\x23\xf1\x98\xd8\x99\xb2\x93\xd2\x36\x55\x63\xf3\x83\xdc\x64\x7c\x0b\x28\x74\x4c\x91\xad\x14\xa5\x99\x0e\xe0\x59\x25\x18\xd7\xe6\x97\xb4\x60\xc0\x35\x51\xf5\xa6\x64\x1a\xc5\xe0\xd7\x1a\x94\xc6\xa9\x3b\x06\x7b\x6d\xdc\x10\xd9\x00\xa9\xab\x0c\x0d\xd4\x71\x83\x1b\x4e\xae\x69\x09\xc5\x35\x55\xf0\x7f\x3c\x57\x38\x2b\x6a\x81\x93\x10\x35\x5b\x5d\xe7\xda\xfe\xb3\x8d\x2d\x7b\x3b\x1f\xbc\xff\x1c\x99\xda\xd6\xcf\x54\x90\xf6\x74\x2d\x03\xc5\x24\x6a\x03\x9a\x5b\xd4\xa8\xa6\x69\x0f\xda\xb0\xd6\xe3\x43\xd3\x14\x2a\x4d\x79\x0a\xc7\x5f\x8e\x70\xb8\x6a\x1a\x92\x34\x87\xf4\xc9\xea\xba\xf3\x04\x64\x03\x5b\xa3\x29\x9a\xa4\x94\xe3\xfc\x51\xef\x62\x4e\xa0\x12\x54\x59\x4a\xd2\xa2\xc6\x00\xe2\xe4\xf3\x38\xaa\xf8\x6c\x6a\xc9\x6f\x8e\x8c\xd4\x20\xba\x6f\x4d\x43\x52\x7d\x06\xb2\x11\x42\xf7\xb1\x65\xdc\x59\x0d\xcd\xf8\x13\xc8\x0d\x14\xc5\x20\x44\x42\x72\x21\x9e\x88\x50\xc6\x90\x10\x59\x73\xd4\xa6\x67\x5a\xb0\xcc\x9a\xb0\xbd\x90\x4f\xdb\x42\xec\x07\x7b\x87\x29\xc1\xc7\xf9\xe6\xb5\x10\xc5\x3d\x6c\x41\xc2\xe0\x34\x0c\xd2\x77\x35\xd0\xd5\xbb\x82\x4a\x88\x82\xd4\x0a\x32\xc3\xe9\x9e\x7b\x1f\x7a\xba\x9c\xd9\xe7\xac\x00\xc3\x9a\x01\x2a\x0d\x03\x46\x01\x4d\x93\xdb\xb8\xbf\xc0\xf7\x51\x7d\x3a\x7e\xb8\x8f\xed\x5e\x00\x1a\x5a\x24\x54\xa3\x71\x50\x0b\xe3\xeb\x27\x3e\x1b\x74\x46\xdb\x8c\xa8\x7e\xf7\xc9\x98\x7a\x7a\x04\xa5\x1f\x20\x15\x3c\x0b\xf0\x31\x83\x2d\xad\x0b\xbd\x22\xdf\x7c\xfd\x75\x9c\xbc\xbc\xeb\x83\xf6\xa2\x22\x6b\xae\x59\x69\x2c\x87\xf9\x15\x68\x46\x04\x2f\x0e\xa3\x40\x09\xd9\x32\x41\x8c\xaf\x95\x35\x27\x74\x47\x19\x57\x9a\x00\x4d\x73\x83\xfe\x68\x47\x4b\x3e\xfa\xe7\xdd\x80\xd6\x7b\x9b\x59\x0a\x79\x40\x0e\xdc\xb1\xb7\x11\xd4\xbf\xfe\xfa\xcd\x5f\xe2\xc8\xbf\xeb\x42\xf6\xc4\xd3\x52\xd4\x5c\x23\xed\x76\x60\x43\xd6\xa0\xc5\xf2\xcf\xe6\x40\x4a\x28\x35\x0c\x5a\xae\x39\x94\xa2\x34\x29\xeb\x43\x57\x71\x14\x7c\x6c\x7b\x10\x2a\x81\x98\xff\xcf\x7c\xf8\xe3\xec\x14\x79\x66\x94\x64\x79\x5a\xfd\x79\x14\x28\x2e\xf5\x32\x03\x81\x03\x64\xd6\x46\xe0\x14\xd2\xa2\x30\x90\x90\x38\xa2\x85\x28\xc6\x4d\x06\xd3\x50\x06\xd0\x8e\x54\x3a\xdb\x88\x4a\x49\xc7\xa4\x0d\x25\x53\xd4\x3a\x42\x10\xfe\x92\x8f\xb6\xd9\x0a\x59\x52\xbd\x22\x59\x2d\x8d\xcd\x4e\xce\x46\x3a\x6c\x25\x16\x83\xd6\x3c\x39\xc3\x12\x94\x8c\xb3\xb2\x2e\xef\xed\x70\x25\x70\x3d\xc2\xec\xbe\x84\x9f\xf6\x32\xb3\x6c\x9c\x35\x64\x8d\xa6\xe2\x1c\xe7\x54\x66\x7b\x2a\x87\xb1\x33\x66\x28\x15\xcf\x80\x71\x05\xca\x93\x84\x6c\xcb\x54\x9e\x9c\x67\xf4\xd3\xaa\xbe\x16\x12\x54\xc4\x2c\xfe\xf5\x22\x95\x42\xeb\xf3\x43\x94\xd9\x78\xf3\xed\x0c\xa3\xf9\x43\x6b\x2f\xdc\xc4\x90\x94\x56\x34\x65\xfa\xe0\xad\x66\x41\xe5\x0e\x5c\x9e\x62\xf8\xb9\xd8\x32\x16\x8c\x3f\x3d\x54\x00\xd9\xdd\xa6\x52\xab\x38\xec\x6f\xbb\x7d\x8e\x69\x40\x80\x44\xe1\x57\x47\xc5\x28\x4c\x42\x4a\xca\xe9\xce\x88\x94\xc1\x52\x6e\x69\x6a\x97\x56\x08\x14\xe3\x75\x2f\x63\x6c\x6b\x7e\xc5\x68\x96\x6d\x19\x64\x17\x91\x6c\x6d\x72\xdc\x8c\x7e\xf3\xe6\x82\xa1\x82\xea\x18\xf8\x88\x8b\xbb\x55\x12\x9c\x82\x2b\x99\xe6\xc8\x24\x61\x7e\xa7\x45\xc3\xb5\x61\x15\x73\x0c\x3c\x81\x49\x5a\x96\x2e\xc9\x07\xa7\xbc\x9e\xf9\x4d\x26\x89\xa0\x2f\xb7\x46\x9c\xba\x71\x9f\xb8\xd8\xf3\x13\x70\xc0\xeb\xf2\x14\xf1\x05\xa1\x65\xf6\xdd\xa9\x3f\x5d\x10\x2a\xcb\x81\xf7\x01\x7b\xb9\xa1\x0a\x36\x82\xca\xec\xe1\x64\x5d\x73\xc2\xa2\x3b\x1b\x93\xf7\x56\x36\x7e\x55\xe3\xa3\x52\x4c\x11\x9d\x2c\xf7\xa6\x8c\x4f\x2a\x38\x87\x54\x9f\xe4\x34\x06\xb1\xb8\x6e\x1a\x63\xae\x41\x63\x50\xd3\x01\x40\x70\x21\x67\x52\x2b\x94\xbc\xf5\xb4\x0d\x02\x25\xe4\xae\x55\x96\x6b\x4c\x0a\x88\xa2\x00\xb9\x3c\xd3\x78\xd2\x5a\xe7\x0f\x90\x4a\xd0\xf7\xb0\x1d\x6b\x34\x95\x07\xe8\xfe\xbb\xea\x02\xf4\x36\xa1\x79\xe1\x96\x11\x3a\xa7\xba\x65\x03\xe2\x80\xcb\x60\x9b\xfe\x32\x0b\x6c\x59\x36\x19\x1c\xec\xef\xa6\x70\x98\x48\xfb\x3c\x36\xc3\x90\xb2\x56\x0d\x74\x5c\xa5\x48\x8c\x85\x8c\x5e\x54\x2e\xd9\x49\x9e\xe0\xa0\x96\xe4\x11\x33\x11\x1d\xe5\x21\x54\x11\xa6\xbd\xd8\x7b\x6f\x4c\xf6\x39\x70\x52\xab\x31\xaf\xed\x84\x15\xf3\x1c\xeb\x6b\x14\x99\x67\x96\x8d\x4d\x48\xdc\xa4\xf8\xf8\x2d\xf4\xfd\x68\x4e\xb0\x39\x22\x5e\x73\xf6\x6b\x0d\x64\xcf\x74\xce\x38\xa1\xed\xaa\x01\xa3\x30\x19\x0c\x1a\xda\x87\x12\x65\x39\xe9\x73\x36\x21\xc6\x07\xf5\xb4\xfb\x34\xa8\xcc\x24\xcb\xf4\xe9\xe5\x24\xec\x1b\x47\xe3\x3e\x67\xe9\x78\x70\xd6\x4e\x8e\x23\x09\xb1\xb0\x12\x82\x39\x20\xc3\xad\x17\xa0\x2e\x68\xe1\xfd\xf3\x79\xf1\x54\x6f\x40\x72\xd0\xa0\x16\x25\xad\x16\x2e\x40\xd5\xa2\x64\xe9\x48\xaf\x5c\xa8\x60\x78\xda\xe1\xd5\x07\x81\xe9\x49\xe5\xa2\x75\xa5\xc9\xcd\xda\x47\x8c\x44\x48\xf3\xca\x10\x3f\xe9\x8d\x27\xb5\xad\x64\xfc\x16\xf8\x4e\xe7\x6d\x16\xfa\x2c\xbe\x31\xae\x20\xad\x25\x3c\xde\x3e\x44\xd2\x78\xd3\xf6\x68\x3c\x96\x22\x5a\x62\xa6\x27\x23\x8f\xb7\x0f\x1d\x9b\x7a\x92\x52\x6c\x1f\x8b\xdb\x46\x88\x02\x28\x4f\x46\xd4\x54\xc8\x98\x85\xc1\x77\x6f\xbe\x89\x43\x7d\x2d\x64\x33\x3d\x15\xfe\x3f\xaf\xcb\x0d\x48\x63\xf4\x3d\xd2\x7c\x67\x34\xf7\xd2\xf9\x89\x09\x7e\xbc\x9d\xfa\xb1\xea\x6c\xc9\x4d\x13\xd1\xef\xd5\xda\x70\x0f\xce\xcf\x4a\xea\x8c\xaa\xba\xd4\x0e\x22\x15\xc5\xd5\xdd\x63\xa8\xcd\x11\x92\x37\xae\x4b\x8b\x1d\xaa\x84\xc3\x07\xed\x60\x6a\xf6\x2b\xd9\x3f\x20\xc2\x6c\x34\xc0\x3c\x85\xe3\x04\xc5\x13\x35\x2d\x5f\x83\x84\x19\x11\x32\xbe\xd3\x73\x85\xec\x59\x61\x32\x71\x56\x8c\x68\x51\xa8\xe5\x24\xcc\x18\xf1\x88\x4d\x5b\xe1\xb3\x30\xb4\x04\x9b\x44\xd9\x47\x42\x58\x55\x32\xcc\x0c\xac\x92\x68\x9e\xdc\xac\xef\x6e\x1e\x7f\xfc\xf1\xf6\x65\x26\xdb\x8d\xff\xe2\x93\x9d\xb2\x2a\x07\xf9\x50\x33\x0d\x33\xe7\xfc\xba\xed\xe9\xa6\xde\xe3\xd8\x9d\xfa\x49\x98\x64\x9e\x70\x4c\xb8\xbb\x97\x90\xe0\x21\x32\x5e\x5e\x82\x23\x05\xcf\x2d\x8f\x56\x49\x34\x25\xf7\x6e\x41\xf5\x12\x62\xe7\x61\xfd\x53\x99\x18\xbf\x62\xfc\xff\x65\x61\x64\x35\xb0\x5c\x1c\xe5\xc6\xfd\xfa\x7a\xda\xae\x4c\x78\x6b\xfc\x89\x5b\x18\xcc\x34\x29\x82\xab\xba\x04\xf9\xe9\xfe\x76\xe6\x1c\x07\xd7\x6f\xfe\xb9\x6e\xc1\xfb\xa8\xe5\xd3\xfd\x2d\xae\x85\x24\x10\xca\x89\xac\xd2\x06\x85\x57\x58\x57\x83\x49\x02\x6c\x29\x6b\xce\xa7\x6d\x07\x3e\xb8\x22\xd3\xc2\x06\xf0\x64\x8f\x01\x7d\x51\x10\x05\x3c\x33\x6b\x35\x09\x29\xb0\x67\xb3\xcf\x87\x39\x1f\xb6\x75\xeb\xc3\x97\xb5\x61\xf0\xb9\x02\xc9\x30\xf3\x44\x8b\x99\x6c\x7c\xdf\xe9\xea\x05\x63\x1a\xb7\xf8\x09\xc6\x27\x75\x95\x55\x66\x3f\x7b\x4d\x0f\x85\xa0\x13\xaa\x32\x88\xea\xf5\x00\x98\x66\x11\xc4\xb8\x29\x96\x99\x46\x7d\x26\x6b\xf1\x27\x13\xda\x94\xb8\xcc\x47\xf9\xab\x77\xb6\x6b\x13\x32\x53\x9d\xfb\xbd\x08\x44\x37\x0a\x22\x71\x06\xc1\x89\x2d\xf6\xdd\x94\x69\xc1\x36\xa4\xcf\x8b\xdf\x7e\x47\x69\xa9\x83\x96\xa3\xfb\x18\x49\xdd\x00\x81\x72\x03\x59\x86\x39\xb3\xef\x85\x24\xf0\x99\x96\x55\xd1\x58\xa1\x25\xe6\x74\x96\x1b\x91\x1d\xbe\x7a\x79\xd6\x46\x9a\x3b\xfc\xc9\x4b\x3a\x61\xf3\x4e\x98\xff\xe1\xee\xea\x9a\xb0\xbe\xc9\xf3\xdb\xbd\xa9\x04\xac\x04\xa0\x93\x10\x89\x05\xa3\xd8\x8e\x53\x2c\x4f\x79\x69\xdd\xa8\x24\x6c\xd9\xe7\x07\xb6\x7b\xc7\x14\xdd\x14\x53\x3e\x64\x90\xd0\xaf\xd6\xc7\x40\x48\x06\x1a\x64\x69\x72\x0d\xfb\x1c\x74\x0e\x32\x0a\xac\xf5\xe4\xb4\xd8\x61\x79\x56\x5e\x36\x22\x62\xb1\x6c\xf7\xd1\x66\xb0\xc3\xfe\xbc\xf7\x52\xa5\x72\xfa\xe6\xdb\xef\xfe\x9d\x6e\xd2\xd7\x6f\xbe\x99\x23\x52\xe1\x75\x6e\xf7\x9f\xcd\x91\x44\x71\x9f\xf4\x0a\x1c\xe7\xcc\x5b\xd4\x06\x5f\x60\xce\xa2\xdc\x97\x7f\x8e\x33\x8f\x6d\xc1\x11\xa1\x3e\x5f\xd8\x7c\x5d\x92\x1b\x4d\x72\xaa\x08\x70\x51\xef\xf2\x5e\x26\xd2\xa4\xcf\xb4\x64\xf0\xec\x53\x49\x33\xb0\xc0\x54\x1c\x3f\xb4\xd9\xac\xe8\xae\xf3\x34\x22\x3e\x77\x18\x64\xf0\x64\x2e\x71\x16\x68\xd2\xcb\x3c\xce\xcd\x2d\x5e\x64\x24\xdb\xa7\x41\xfd\x42\xb6\x8c\xe4\x22\x67\x01\x25\xbd\xcc\xe5\x58\x6e\x72\x26\xc8\x98\x4c\xe6\x8b\xf0\x72\x86\xe3\xb9\x2c\xf3\x79\xfc\x6f\x7a\x37\x3f\x30\x77\x56\xd3\xed\x7e\x35\x25\x25\xad\x70\x4f\xb2\x31\xd6\x18\xcf\x47\x62\xe1\x2c\x24\x2e\x88\x32\xb3\x22\x42\x7b\xce\xf8\x6e\x99\xbc\x38\xf3\x66\x34\x2e\xc4\xee\x63\x37\x44\x8e\xf7\x88\x3d\x2e\xdd\x8e\x80\x39\xcf\x27\x4a\x50\x95\xe0\x0a\x5c\xd1\xe6\xe0\x82\x41\x35\x7e\xb2\x10\xbb\x1d\x64\x11\x60\x85\xc4\xe5\xc0\x32\x79\x49\xdf\xe7\x0a\x46\x67\xb2\xcb\xc5\x90\xe1\x40\x69\x12\xa4\x0d\x1c\x90\x3b\x1f\x1e\x1f\xd7\x1e\x95\x65\xf2\xb2\xae\x01\x6b\x8b\x71\xb7\x10\xb8\x7e\x44\xb9\x8a\xe8\x72\x44\x2d\x62\xd7\x81\xe0\xa9\xc6\xe5\x31\x6e\x45\x22\xbb\xa3\x80\x1a\x7f\xe0\xf3\x09\x9e\x74\x47\x75\x6f\xa1\x37\xcd\x82\x33\x8c\x18\xf2\xe1\x0e\x74\x2e\xce\x89\x16\x91\x05\xb6\xb3\xa7\x1e\xdf\xe0\x61\x94\x5c\x64\xf1\x36\xe4\x0f\x23\x1e\x77\xb9\x59\xfa\x01\x68\x16\xac\x0a\xfb\x83\x82\xbc\x99\xc4\x5c\xee\x13\xba\xdc\x30\x9e\xa1\x92\x60\x5d\x7b\x46\x72\xfb\x3a\x16\x0f\x4c\xcc\x7a\x4b\x46\x33\x57\xf4\x06\xcf\x20\x0f\x7e\x76\xbf\x80\x83\xb0\xf5\x6b\x4a\xd3\xb2\xfa\xde\xc4\xa9\xab\xf9\x4c\x78\xec\x43\xf0\x72\x8d\x80\xd1\xbd\x95\x34\x06\x0d\x57\x05\xd7\xf4\x34\x28\x39\x16\x7e\x11\x41\x6e\x06\xb1\xb2\x7c\x06\xdd\x5f\x35\x84\x5b\x10\x9e\x70\x8b\x74\xb8\x16\xb7\xff\xcf\x24\x4b\xdb\x53\x24\x98\x2b\xec\x33\x62\xd9\x2c\xe1\x22\x21\xfe\xbc\x78\x7b\x77\x7d\x7b\xf3\x76\xd1\xe0\xf8\xc7\x26\x10\x9a\x25\xeb\x2a\x99\xc5\xe3\x07\xdf\x6f\xd0\x43\xa2\xc0\xa0\x75\x89\x9a\x70\xca\x8f\x92\x09\xa8\x5f\x94\x7f\x51\x97\x49\xab\x0a\x78\x76\x55\xec\xc4\xa3\xb0\x42\x12\x1f\x56\x9d\xbf\x68\xbd\x1a\x1d\x95\x64\x90\xb2\xac\x0d\xc1\x0c\x0b\x4c\xeb\xa3\xd4\xc3\x71\xa6\xc1\x0b\x75\x6c\xe4\x74\x94\x77\x68\xc4\xb1\x9d\xcf\x0d\xa4\xa2\x04\x35\xf0\x69\xf1\xe6\xdb\xef\x22\x07\xf8\x6f\x2c\xab\x51\xa0\x91\x0e\x2d\x6b\x2c\xf8\xf3\x98\xf6\x4d\x29\x4a\x8a\x29\xff\x6e\x48\x6c\x55\x6a\x04\x05\x93\x41\x1e\xf8\xf4\xed\xeb\x37\x49\x0c\x72\x73\x13\x27\x16\xef\x8f\xd1\xeb\xee\x9e\x6c\x7c\xf5\xa1\xe9\x3d\x60\x86\x8c\x73\x89\x02\x4a\x86\xcc\x50\x23\x05\xff\xaa\xfe\x2d\xc8\xb6\x2f\x60\x63\x08\x61\x3c\x2d\xea\x0c\x4f\x99\x9a\xd4\xf5\xac\xd0\xe3\x3c\xfd\xb9\x19\x1c\xd1\xb8\x77\xe7\xd3\xc9\x3e\x17\x0a\xec\x59\xb5\x76\xfd\xe1\x31\x25\xc7\x7c\x23\x95\x85\x34\xc4\xbc\xbb\xc3\xc2\xa6\xd6\x17\x76\x9c\x48\x1c\xaf\x8a\xc2\xcd\x70\x3b\x7e\x06\x59\x5d\x15\x2c\x1d\x3a\x93\xf6\x02\xe1\xd5\xcc\x79\x9b\x17\x5a\x45\xfb\x92\xd8\xdd\x3e\xbf\x4e\xfc\x74\x7f\x9b\x5c\x3c\xf0\x64\xa3\x30\x56\x0b\x53\x39\x35\xf2\xa9\x53\xc1\x94\xcc\x1e\x7b\x7c\xdc\x45\xa7\x8c\x29\x99\x01\xd3\x95\xb5\xb6\xe7\xa3\x57\xc9\x68\xe1\x52\xcd\x6d\xd5\x75\x96\xcc\x57\xbc\x77\xc7\xe3\xa0\xdd\x42\xab\xc3\x78\x2d\x6a\x55\x1c\x08\x60\xea\x34\x3d\x3a\x15\xe0\xab\x6d\x2b\xc4\xcf\x9d\x28\x94\x80\x5b\xbb\x90\x91\xcd\xc1\xb4\x79\x7b\x77\x3d\x24\xfe\xb4\xd6\x82\x3c\x01\x60\x9d\xb9\x3f\x80\xd8\x1e\x01\xec\x1d\xf8\xb3\xe0\xb1\xec\x9c\xdb\x6a\xe8\xad\x84\xf6\xb8\xf2\xa1\xfd\xbc\xdd\x9e\x0c\x34\x56\xb8\xfc\x27\xc1\xff\x34\xf8\x7a\xbb\x1d\x7a\x3f\xce\xdb\x85\xa1\x24\x99\xa1\x9e\xf0\x3c\x7c\x3e\x23\x1c\xcc\x00\x0f\x44\x2a\x8d\x0c\x6c\x69\xa1\x20\x39\xc7\x01\x56\xa2\x28\x18\xdf\x61\x75\x94\x7c\xa6\xc5\xc4\x38\xaf\xf3\xe4\xec\x93\x33\x41\xcb\x15\x52\x21\xc7\x82\x39\xfa\x73\x7a\xa0\x7f\x15\xd6\x8e\x9b\x93\x0e\xa4\x60\xe6\x0c\xb2\x93\xcd\x4e\x11\x73\x03\xfc\x04\x24\xf1\x9b\x43\x4d\x8a\x59\x19\xc1\xfd\xb5\x16\x9a\x2a\xdf\x3f\xed\x5c\x27\x10\xc5\x1e\x8f\xc0\x04\x0d\xb7\x47\x78\x3a\x2d\x5d\x92\xef\x19\x14\x99\x72\x69\x6a\x74\xa0\x53\x47\x2f\xb0\xcd\x96\x15\x18\xab\x36\xe7\xb4\x7d\x19\x49\x9a\x53\xa5\x98\x6a\x90\x9a\x29\xcf\xb8\x97\x9a\x02\x4a\xdb\xd0\xd7\x20\x1b\xf0\xc7\xe8\xfc\x3b\x51\x52\x16\x53\xab\xbf\x6e\x5b\xdb\x0b\x47\x94\x3f\x28\xa0\x88\xca\x29\x8e\x41\xa8\xb3\x62\x5b\x80\x6c\xec\x2c\x5c\xe7\x00\x4b\xd7\xcc\x39\x96\x9c\x43\x07\x5e\x0d\x71\x16\x03\xb0\xe3\x5a\x28\x16\x79\x5a\xe1\xbe\xd3\xdc\xc7\xa4\x85\xd8\x63\x96\x0a\x21\x61\xe1\xb4\x26\x22\x4d\xeb\x8a\x19\x9a\x92\xc9\x83\xbf\xc9\x39\x75\x42\x52\x88\x72\x15\xe8\x39\x42\x6e\x40\xc3\x51\x02\x34\xf0\x88\xe3\xe8\x77\x6d\x4b\xd2\x5e\x1d\xa2\xfa\xaa\x6c\x1d\x4c\x25\xad\x91\x26\xec\x78\x38\x7c\x8c\x2a\x6c\xc0\x08\xcd\xa0\xc3\x9a\xa9\x0a\xf0\xb9\x62\xf2\x10\x31\x89\xef\x4d\x43\x22\xa1\x00\xaa\x8e\x31\xb7\x68\x75\xd8\x41\xc4\xf8\xc1\x00\x3c\x37\x01\x59\xd8\x86\x53\x0d\x0b\xcc\xcb\xcc\x9f\x2e\x8c\x84\xa8\x12\xfc\x8c\x99\x6e\x52\xf7\x90\xbd\x8d\x61\x89\x4b\xd9\x63\x6b\x14\x6b\x5c\x7d\xba\xb8\xa3\xac\x31\x1d\x88\x05\xd8\xb0\x31\x67\x5d\xad\x49\x1e\x04\x49\x70\xfb\x47\xe2\x3a\xa2\x1d\xbe\xcb\xca\xf9\x74\x84\xfc\x98\x65\xce\x3c\x21\xf7\x27\x84\x6e\xfc\x69\xba\x3b\x9a\xba\x03\xfd\xab\x24\xc8\xa1\xbb\x50\xdf\x89\x93\x66\x27\x90\xc9\xc0\xd9\xb3\x80\x07\x09\xb0\x68\x80\xa4\x07\x28\x20\xd5\x42\xce\x27\xc8\xf7\x24\xac\xdd\xad\xa9\x58\xfa\xe4\xec\x95\x6f\x7f\x02\x97\xb4\xa7\x13\x5b\x07\xd7\xbc\x1a\x3b\x73\x37\x53\xbd\xf1\xdc\xe4\xa7\x6a\xe8\xcb\x11\x61\x78\xfa\xf2\x53\x65\x0e\xd3\x93\x92\xea\x34\x07\xd5\x45\x06\x37\x99\xb1\x50\x8f\xa6\x1a\xcb\xe8\x10\xec\x59\x91\xde\x78\x31\x41\x0f\x9b\x8f\xed\xd1\x92\x66\xc2\xcd\x4e\x21\x07\x77\x69\xce\x96\x9e\xa3\x19\x04\x6f\xd8\x78\x1f\x8e\x66\x3b\x68\xac\x7f\x7e\xef\x1a\x87\x19\xb3\xfe\xf9\x3d\xd2\x3c\x34\xc7\x9d\xf0\xf9\x2c\x86\x3d\x17\x94\xdf\xbc\x8b\xc0\xf5\x27\xd3\x70\x14\xcf\xd4\x5c\xaf\x55\xe3\x31\xc5\x89\x43\x21\x38\xe2\x19\xfe\x35\x60\x41\x4c\x6c\x73\x65\x8e\xd1\x7c\x60\x0a\x17\x5b\xb7\x18\xd6\xae\x92\xf1\x10\xff\xeb\x24\x48\xec\x7a\x18\xa2\x0f\x2e\xdc\x61\x18\xb1\x75\x61\x15\x1d\x5e\xfb\xa2\xa5\x4c\x85\xcc\xf0\x8e\x1f\xcc\xb4\xdb\x7c\x4d\xef\xe2\xb4\xf6\x71\x27\x91\x87\x0e\x29\x85\x18\xd3\xa1\xbd\x71\x17\xab\x30\x71\x83\x8b\xe4\xf5\x00\x9c\xe6\x8a\x22\x4a\x04\xc7\x4b\x31\x3c\xbd\x42\xa2\x44\x69\x82\x6b\x3a\x1b\x8d\xfb\x0d\x15\x17\x3f\x0d\x2d\x8a\x95\xd8\x6a\x95\xd7\x3a\x13\x7b\xee\x3d\x11\xde\x1f\xb3\x93\x34\x85\x6d\x5d\x10\xff\xd1\xad\x83\xb1\x78\x9b\x6c\x30\x7e\x33\xeb\x65\x3c\xa4\xdf\x34\x21\x74\x8b\x6b\x67\x04\xf9\xe0\x5e\xe1\xf6\x83\xa8\x07\x13\xc6\x15\xde\xc0\x85\x35\xbf\x1a\xb1\xc6\xc3\xe7\x66\x84\xfe\x6b\xbc\x1a\x27\xcd\x29\xdf\xb9\xca\x4b\xa4\x2f\x83\x67\x96\xda\xed\x4f\x2c\xec\x55\xf5\x46\x21\xde\x5c\x1b\xf2\x55\xf4\x82\xdc\xa3\x3d\xf0\xc9\x30\x74\x40\x76\xd0\x93\x0e\xaa\xfc\xa2\xc7\xc8\x81\xcf\x06\x62\x7a\x48\x8b\x53\x05\x5c\x90\x3d\x95\x25\xde\x61\x35\x04\xd7\x33\x67\xe4\xd3\x86\x89\x53\x8a\x6d\xaf\x34\x93\xa2\x1c\xf8\x76\xca\xf8\xc9\x46\xd5\x67\x48\x66\x98\xdc\x4a\xb2\x92\xca\x03\x5e\xe7\xb2\x9a\xd3\x6f\x40\x72\x02\xe6\xe2\xdb\x32\x19\x8d\x24\xc7\xb2\x01\xa3\x83\x0f\x47\x4f\x8b\xfe\x29\xf1\xa3\x6f\x36\x62\x3f\x7a\xd9\xa1\x3d\x89\xb0\x94\xd6\xee\xac\x92\x51\xbb\xd0\xa4\x07\xf0\x0e\xc3\x5a\xf5\x0a\xc7\xc4\xc6\x5d\xb0\xf2\x02\x37\x6b\xdd\x83\xaa\x0b\xad\x26\xac\xd4\xd5\x71\x7b\xbf\x51\xe0\x6e\xea\x31\x40\xd0\x87\xdb\xcd\x96\xa6\xf5\x09\x54\x62\xef\x63\xe8\xdd\xcf\x13\x5a\xea\x8d\x66\xaa\xc3\x91\x90\x1b\x66\xf8\x53\x40\x1c\xfc\x53\x82\x52\x74\x07\x67\xf7\xb7\xab\x9e\x70\xf7\xf1\x30\x60\x3c\xa4\x47\xd1\x34\x94\x0d\x7e\x19\x5d\x6b\x05\xdc\x75\x28\x5d\x9f\x0a\x6e\x4b\x38\xd4\xea\xe5\x26\xa6\xa0\x4a\x3f\x4a\xca\x6d\x86\x00\xd5\x7d\xb8\x5d\x04\x97\x11\xd4\x27\x73\x17\xdf\x45\x60\x2e\x9d\xec\xd0\x12\x34\xa2\xfb\x90\x29\x98\xd1\x5d\x07\x6a\xb2\x26\x3a\x87\xe5\x6c\x24\x34\xc2\x4f\x23\x15\x5b\x67\x8a\x59\xbb\xf6\xf1\xd7\x87\x4c\x58\xa3\x77\x27\x1d\xfa\xe6\xc8\x5f\x21\x84\xc5\x87\x94\x15\x18\xf1\xd9\x14\xda\x09\x58\x12\x4a\xaa\x85\x25\x79\xf8\x86\x95\x08\xae\xa3\xeb\x1e\xbc\xd0\x34\xb6\x7f\x99\x7e\xcf\x64\x89\x04\x5e\x02\x26\x7c\xf7\x51\x38\xfc\xb7\x97\x06\x8d\x74\x1d\xb5\x0c\x31\xd6\x01\x1f\x7f\x85\x51\xf0\x7a\x9d\x69\x14\xa7\xd6\xa0\x51\x5c\x9a\x52\x13\x67\x92\x5b\x8c\x93\x33\xee\xe8\x0b\xea\x4d\x58\x77\xf0\xd9\x55\x63\xe6\xa3\xa7\x35\x3f\xac\x3f\x29\x82\xc7\xed\xdc\x65\x4b\x22\x83\xa2\x71\xd9\xbb\xaa\x6e\xb4\x04\xef\x32\xa1\x18\x3c\xa4\xa0\x94\x90\xf3\x27\x79\x92\xa7\x61\x7a\xda\x85\xec\x6a\xfe\xd8\x31\x02\xe6\x96\xe8\xe3\x0d\xa6\x5d\xf4\x74\xc2\x65\x0e\x9c\x32\x90\x62\x9b\xc1\xd6\x17\x92\xf7\xe9\xbc\xc9\x1c\xda\xd4\xf4\xcd\x60\xb1\xba\x1c\x4a\x8f\xc4\xc3\x99\x56\xe7\x76\x3a\xfe\x00\x6d\x1e\xdf\x7a\x1b\xdf\x7e\xb3\x6f\x37\x98\xa1\x32\xa7\x15\xa9\x8f\xa7\x31\x47\xe2\x2e\x50\x9c\xdc\x6b\xf2\xe9\x4c\x55\x99\x9b\x36\xfd\x96\x38\x17\x58\x38\x46\x53\x29\x94\x22\x5b\xca\x0a\xac\xe2\xca\xcc\x0e\x97\x4a\xce\xd3\xc0\xa9\x1d\xb9\x48\x39\x9d\xdc\x99\xfb\x62\xbb\x73\x67\xee\xd0\x45\xd2\x35\xbe\x53\x37\x03\x40\x78\xc7\xee\xb2\x5d\xbb\x51\x90\xa4\xd9\x12\x09\xec\xdc\x45\xeb\xe9\xe8\x0e\x5e\x14\x1b\x26\xd4\xb0\x0c\x6d\x56\xac\x92\x33\x86\x9c\xb8\x13\x70\x8a\xe4\x2a\x65\xef\x4c\x86\xeb\x4b\x79\xbd\x6d\x4c\xd0\x18\x45\x69\xc3\xc1\x7a\x4b\xcd\x95\xe4\xf2\x72\x60\x18\x8d\xfc\x33\x38\xbf\x69\xef\xf0\xa5\x4c\x7f\xa0\xf3\xc9\x1f\xe4\x58\x25\x33\x48\xf3\xcb\xa1\x9b\x77\xf3\xba\xb9\x5b\xbf\x56\x49\xd0\x72\x0c\x26\xb0\x3f\x34\x37\x86\x3d\x83\x94\xa6\xf4\x18\x63\x4e\x97\xc3\x33\x3e\xa5\x77\xab\xd8\xdf\xb0\xf1\xdf\x17\x7f\x6b\xaa\x58\xfe\xde\xec\x11\xe2\x46\xab\xb9\x46\xaf\xb7\x07\x3d\x30\xa6\x84\xaa\xc0\xa0\x91\x50\xe3\xa6\x20\xb3\xc3\x30\x1e\xd8\x2e\x0f\x90\xef\x4c\x58\xb8\x20\xae\xc3\x87\x4e\x45\x5b\xe7\xa4\x70\xb7\x5e\x0d\xcf\x0d\x8e\xdf\xc0\x15\x40\x45\xec\x39\xc8\x6b\x4b\xc2\x2a\x99\xa7\xfb\xe3\x2a\x11\x18\x30\xe2\xdc\x6b\xb0\xf7\xb8\x12\x8d\xa8\x4f\xe8\x8e\xf2\xb8\xbd\xa6\xb9\x7c\xb1\xbb\x44\x0f\x81\xdc\xcb\x04\x7f\x5c\x99\x22\x7a\x90\xff\x10\x9b\xf1\xfa\xed\x9e\x90\xbc\x3f\xe9\xe4\x9d\xed\x2f\x62\xe3\x76\x78\x5c\x8d\x87\x04\xdc\x3c\x3b\x65\x87\x43\xae\xf3\xf7\x15\x3a\x32\x76\x0e\x21\x98\x45\x9b\xdc\xb3\x8a\x84\xd3\xa1\xef\x91\x45\x71\xe4\xf6\xb4\x97\x67\x49\x2a\xf0\xe8\x0b\x22\x46\x3a\xf7\xc0\x0f\x82\xb4\x54\x8c\x32\xf1\xa5\xd9\xe5\xe6\xee\x0c\x36\xcd\xda\x38\x5d\x25\x41\xd6\xad\x4f\x3a\xf4\xd3\x60\x25\xde\xea\x88\x77\xe1\x70\xed\x88\xb6\x42\x3f\x14\xb6\x57\x20\x71\x13\x05\x6b\x5b\x79\x37\x82\xfb\x33\x11\x45\x86\x51\xe0\x96\xc9\x81\x52\xe6\xd1\xa0\x64\x0c\xd1\x7b\xb3\x07\x8b\x33\x8c\xf5\x05\x75\xc6\xb4\xdb\x96\xc5\x4c\x04\x9d\xda\xc1\x25\x47\x33\xec\x37\x3a\x43\x6e\x21\x6c\x06\xbc\x21\x18\xfe\x36\x31\x99\xf8\xd3\x0a\xe9\x45\x39\xe8\x5f\x42\x12\x75\x79\x0e\xbb\x37\x1d\x77\xb6\x6d\x5f\x58\x40\x4a\x21\x87\x4a\xaa\x47\x20\x92\xd6\x29\xe3\x9c\xa0\xc7\x55\xe7\x22\x2f\x6a\x9d\x8a\x0b\x88\xbf\x30\x01\xef\x0b\xb1\xe4\xd9\x10\x1a\x89\xbc\x40\x06\x42\x81\xe7\x62\x5c\x25\x16\x4d\x1d\xd9\xd0\xa2\x22\x60\x6e\x42\xc1\x68\xef\xaf\xb4\xad\x92\x79\x2a\xe5\x2e\x8e\x1d\xfa\x34\xc9\x84\x1d\xd5\xb0\xa7\x87\xb3\xfa\x62\x04\xe1\xfe\xf4\xd3\x19\x2b\xa8\x09\xe0\x21\x6e\xe1\xea\x8a\x83\x2e\xe9\xd0\xb6\xf7\x25\x1e\xa1\xad\x1d\x5d\x25\x41\x8d\x6e\xff\x02\x9d\x7b\x6f\x53\x41\xa2\x03\x21\xeb\x1b\xca\x81\x3f\x3e\x86\x3f\xfb\xfc\xd0\x0b\xbc\x69\x21\x3a\x17\xcb\xf6\xc0\x35\x9b\x83\x33\xc5\x23\x54\x83\x3a\x31\x09\x21\x3d\x9f\xec\x3a\x51\xeb\x39\xd1\x5f\xb1\xd1\x3f\x7f\x34\xd5\xd3\xfc\x11\xb5\x55\x32\x69\x96\x1f\x4c\x43\x97\x89\xb3\x97\xfb\x76\x6b\x6c\x1b\x0a\xbc\x87\x6e\xe6\x72\x2c\x4b\xe5\x9c\x23\x9e\x1a\x29\x4d\xe5\xb0\xd9\x39\x76\x45\xf9\xdd\xa9\x64\x7a\x3e\x61\x67\x94\x9d\x2e\x1c\x2f\xe6\xa8\xc0\xd8\xe6\xe8\x28\x6e\x83\xb0\x4e\x5e\xda\xfa\x89\x95\x39\x3c\x6a\x5f\x68\x21\x71\x0f\xb8\xf3\xa6\xde\xf8\x7b\x86\x9a\xf1\x95\xa6\xba\x56\x2b\xf2\xdb\xef\xc9\xff\x0e\x00\x53\x6a\xf7\x4b\xe7\x74\x00\x00")
 
 func chartSeederCrdTemplatesMetalHarvesterhciIo_inventoriesYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -243,12 +311,32 @@ func chartSeederCrdTemplatesMetalHarvesterhciIo_inventoriesYaml() (*asset, error
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "chart/seeder-crd/templates/metal.harvesterhci.io_inventories.yaml", size: 15797, mode: os.FileMode(420), modTime: time.Unix(1774588771, 0)}
+	info := bindataFileInfo{name: "chart/seeder-crd/templates/metal.harvesterhci.io_inventories.yaml", size: 29927, mode: os.FileMode(436), modTime: time.Unix(1792439149, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _chartSeederCrdTemplatesMetalHarvesterhciIo_inventoryclassesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x4b\x8f\xdb\x36\x10\xbe\xfb\x57\x0c\xd0\x6b\x6c\x37\x09\x0a\x04\xba\x6d\x9d\xa2\x58\x34\x09\xd2\x6c\x9b\xfb\x98\x1a\x5b\x93\xa5\x48\x85\x43\x79\xeb\xa6\xf9\xef\xc5\xe8\x65\x59\x96\x2d\xef\x22\x8f\xcd\x45\x1c\x6a\x1e\xdf\x7c\xfc\x86\xf2\x7c\x3e\x9f\x61\xc1\x1f\x29\x08\x7b\x97\x00\x16\x4c\xff\x44\x72\xfa\x24\x8b\xfb\x57\xb2\x60\xbf\xdc\x3d\x9f\xdd\xb3\x4b\x13\x58\x95\x12\x7d\xfe\x81\xc4\x97\xc1\xd0\x6b\xda\xb0\xe3\xc8\xde\xcd\x72\x8a\x98\x62\xc4\x64\x06\x80\xce\xf9\x88\xba\x2c\xfa\x08\xf0\xe5\xeb\x0c\xc0\x61\x4e\x09\xb0\xdb\x91\x8b\x3e\xec\x8d\x45\x11\x92\x85\xbe\x68\x17\x19\x86\x1d\x49\xa4\x90\x19\x5e\xb0\x9f\x49\x41\x46\xdf\xdd\x06\x5f\x16\x09\x8c\x6f\xaa\x7d\x36\x31\xea\xfc\x6e\x5b\xf7\x2b\x75\x5f\x19\x2c\x4b\xfc\x63\xc4\xf8\x86\x25\x56\x1b\x0a\x5b\x06\xb4\xa7\xa9\x55\x46\x61\xb7\x2d\x2d\x86\xa1\x79\x06\x20\xc6\x17\x94\xc0\xca\x96\x9a\xf8\x0c\x60\x57\x63\x58\x25\x34\x07\x4c\xd3\x0a\x1a\xb4\xef\x03\xbb\x48\x61\xe5\x6d\x99\xb7\x90\xcc\xe1\x93\x78\xf7\x1e\x63\x96\xc0\x42\x22\xc6\x52\x16\x6d\x04\x6e\x62\xb7\x98\xdd\x9e\xac\xc7\xbd\x46\x56\xaf\x5b\x0a\x67\xfd\xe1\x0e\xd9\xe2\xda\xd2\x91\xb7\x9b\xc1\xea\xa9\xaf\x3a\xea\xee\x39\xda\x22\xc3\xe7\xd5\x36\x31\x19\xe5\x55\x73\xf5\xc9\x17\xe4\x6e\xde\xdf\x7e\x7c\x79\x77\xb4\x0c\x90\x92\x98\xc0\x85\x96\x3d\x84\xbb\x6e\xa5\x74\x30\x32\x09\x3c\x70\xcc\x20\x66\x04\x82\x39\x41\x86\x21\x7d\xc0\x40\x50\x04\xbf\x61\x4b\xcf\x3a\xb7\x4a\xa9\x14\x2c\xe7\x1c\x05\x1e\x32\x36\x59\x55\x8b\x14\x68\x48\xc0\xa0\x03\xb4\xd6\x1b\x8c\xa4\xde\xf2\xee\xbd\x22\xf8\x82\x42\xe4\x96\x23\x8d\xaf\x03\xdb\x7b\xab\x83\xe4\xff\x9b\x1f\xd9\x00\xb4\xde\xba\xbf\x90\x2a\xed\x49\x34\x56\xdb\x73\x4a\x1b\x88\xc0\x6f\x20\x66\x2c\x10\xa8\x08\x24\xe4\xea\x83\xa0\xcb\xe8\xc0\xaf\x3f\x91\x89\x8b\x81\xeb\x3b\x0a\xea\x06\x24\xf3\xa5\x4d\xc1\x78\xb7\xa3\x10\x21\x90\xf1\x5b\xc7\xff\x76\xbe\x05\xa2\xaf\x82\x5a\x8c\x24\xb1\xea\x59\x70\x68\x61\x87\xb6\xa4\x67\x0a\xd2\xc0\x73\x8e\x7b\x08\xa4\x31\xa1\x74\x3d\x7f\xd5\x0b\x32\xcc\xe3\xad\x0f\x04\xec\x36\x3e\x81\x2c\xc6\x42\x92\xe5\x72\xcb\xb1\xd5\x00\xe3\xf3\xbc\x74\x1c\xf7\x4b\xe3\x5d\x0c\xbc\x2e\xa3\x0f\xb2\x4c\x69\x47\x76\x29\xbc\x9d\x63\x30\x19\x47\x32\xb1\x0c\xb4\xc4\x82\xe7\x55\x21\x4e\xcb\x97\x45\x9e\xfe\x14\x1a\xd5\x68\x49\xdc\xa7\x9f\xc4\xc0\x6e\xdb\x33\x54\xc7\xf9\x11\xed\xd1\x13\x0e\x2c\x80\x8d\xab\x1a\x93\x43\x17\x74\x49\xa1\xfb\xf0\xdb\xdd\x5f\xd0\x66\x52\x77\xaa\x6e\xca\x61\xab\x9c\xeb\x8f\xa2\xc9\x6e\x43\xa1\x7e\x6f\x13\x7c\x5e\xb5\x83\x5c\x5a\x78\x76\xb1\x7a\x30\x96\xc9\x45\x90\x72\x5d\xb1\x35\xd0\xe7\x92\x24\x6a\xeb\x86\x6e\x57\x95\x4e\xc2\x9a\xa0\x2c\x52\x8c\x94\x0e\x37\xdc\x3a\x58\x61\x4e\x76\x85\x42\x3f\xb8\x57\xda\x15\x99\x6b\x13\xae\xea\x56\x5f\xfd\x0f\xff\xea\xcd\x35\xbc\x3d\x43\xab\xed\x67\x5a\x7b\x2c\x1b\x77\x05\x99\xc6\xbe\x26\x6d\xef\x89\x4a\x28\xed\x15\xf9\x23\x8f\xcd\x68\xa8\x05\x42\xc5\xe1\x81\x52\x3d\x3d\x9d\x4e\xb4\x32\xb4\xd7\x93\x59\xf7\xad\x1d\x18\x97\xd5\x43\xff\x1a\x87\xef\xba\x10\xc3\x0d\x83\x92\x6e\x86\xfb\x8f\x35\xeb\x8a\x5c\x1a\x40\x3d\x98\x7a\xda\xc8\x02\x6e\xac\x3d\x2a\x32\x50\x57\x28\x6f\x40\x99\xa5\x40\xf3\x86\x69\xa8\x0a\x00\x1c\x29\x3f\xa9\xea\x42\x7f\xfb\x46\x0c\x01\xf7\x03\x5b\x4a\x1b\x2c\x6d\xfc\xb3\xf4\x11\x27\xb0\x78\xdd\xdb\x0a\x58\x14\x56\x07\x41\xf4\xfd\x52\x74\x2c\xf8\x32\x02\xc2\x67\x75\x38\x26\x6c\x6d\x17\x4c\xad\xae\x2c\x55\xc1\xd5\x84\xb8\xa6\xfe\x9c\x1d\xe7\x65\x9e\xc0\xcf\x27\xa6\xd3\x71\x38\x5e\xc8\xec\x11\xd0\x55\x65\xc8\x04\x30\x15\x22\x52\x4f\xb9\x8a\x05\xae\xcc\xd7\x14\x94\x9f\x2d\x41\x14\xaa\x3e\x45\x3a\x0a\x8d\xc1\xd3\x23\x0b\xb0\x03\x3c\x20\x7c\x3d\x1d\xce\x1f\x81\xfa\xaf\x4a\x76\xdc\x74\x11\xe4\x69\xa8\x07\x87\xf8\x5c\x8c\x8b\x7c\x85\x4a\x7d\x39\xd0\x60\x92\xd4\xff\xe7\x35\xd4\xa3\x96\xf3\x50\x9d\x55\xb5\xa9\x23\xd2\xa4\x92\x93\x8b\x53\x4c\xf8\xd0\xdb\x0a\x7a\x21\x32\x19\x99\x7b\x4a\x01\xb7\xc8\x4e\xea\x39\xd3\xe9\x60\xca\x62\xfc\x8e\xc2\x08\xcd\x01\x76\x8c\x10\x28\xdd\xb0\x64\xe0\xdd\xb5\x4a\x73\xb9\xed\xa6\x28\x57\x3e\x9c\xa3\x44\x23\x05\x09\xbc\x9a\x3d\xa5\xe7\x29\xcb\xfd\xef\xfc\xeb\x84\xef\x17\xbf\x8c\x73\xea\x08\xc6\xd7\xb5\x2b\xbd\x15\x68\xa9\x0d\x1d\xc1\x60\x81\x86\x63\x07\x81\xc5\xb0\xd5\xdb\x94\x46\x7e\x52\xca\x96\xdd\xfd\x5d\x41\x94\xbe\x5d\x17\x92\x4c\xe7\xf5\xa6\xbf\x7f\x98\x9d\x3a\x03\x51\x6b\x9b\x5f\x8e\x0e\xb7\x15\x19\x46\x5d\x43\x95\x59\xd8\xa0\x69\x46\x61\xa3\x85\x2d\x67\x26\xb5\xf0\x9a\x12\x73\xca\x7d\xd8\x4f\xf7\xe5\xe5\x8b\x27\xb8\x3f\x7b\xa0\xce\x18\xea\xaf\x9b\x64\x76\x1d\x63\xbb\x8f\xa0\x64\x76\xb1\x2b\xdd\x67\x51\xdb\x91\x83\xfc\x06\xc2\x74\x7f\x56\x84\x4f\xdc\x42\xf3\x89\xa2\x27\x57\xc7\x50\x27\xd2\xe0\x03\x7c\x2e\x31\xa0\x5e\x47\x47\x3a\x71\x09\xa6\x5e\xf4\x89\x42\xda\xfb\x13\x53\x47\xae\xe9\x49\xf2\xa8\x5c\x9c\x77\x2b\xef\x36\x3e\xe4\xec\xb6\x13\xd9\xbc\xeb\xef\xad\xbe\xc5\xe5\x6c\x12\xf0\x90\x79\x99\x10\xb4\x83\xec\x79\xaa\x89\x9e\x13\xd5\x8a\xd8\x57\xd8\x6f\x37\xe2\x74\x14\x8c\x5b\x26\xa7\xcf\xf7\x9e\x5f\xea\xfd\x47\x8d\xaf\x52\x70\x4b\x13\xbd\xfe\x5b\xf7\x5c\xcf\xb9\xc7\xdc\x5e\x08\xfb\xdf\xfc\xdf\xae\xbb\x5d\x0a\xe3\xe6\xcb\xe7\xe0\x8a\x3b\xd0\x11\x3e\x6f\xf4\xbe\xd1\xe2\x53\xdd\x09\x5b\x3c\xba\xca\xfa\x12\x7e\xc6\x25\x80\x50\xd4\x2b\xee\xd9\xfb\xef\x93\x4b\xe9\xd2\xf8\x2e\x74\xed\xb0\x1e\xb5\x76\xb1\xbf\x15\x67\xc7\x93\x99\x1f\x06\xc2\x60\xbd\x47\xd3\xd9\x64\xf0\x93\x45\xd1\xdf\x08\xd2\x04\x62\x28\xeb\x4b\xa3\x44\x1f\xf4\xc8\xf4\x56\xca\x75\xf7\x13\x48\x9b\x94\x44\x8c\xa5\x24\xf0\xe5\xeb\xec\xff\x01\x00\x20\x44\xd3\xc7\x89\x15\x00\x00")
+
+func chartSeederCrdTemplatesMetalHarvesterhciIo_inventoryclassesYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_chartSeederCrdTemplatesMetalHarvesterhciIo_inventoryclassesYaml,
+		"chart/seeder-crd/templates/metal.harvesterhci.io_inventoryclasses.yaml",
+	)
+}
+
+func chartSeederCrdTemplatesMetalHarvesterhciIo_inventoryclassesYaml() (*asset, error) {
+	bytes, err := chartSeederCrdTemplatesMetalHarvesterhciIo_inventoryclassesYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "chart/seeder-crd/templates/metal.harvesterhci.io_inventoryclasses.yaml", size: 5513, mode: os.FileMode(420), modTime: time.Unix(1792439149, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _chartSeederCrdTemplatesMetalHarvesterhciIo_inventorytemplatesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x18\x4d\x6f\xdb\xb8\xf2\xee\x5f\x31\xc0\x7b\x87\xf8\xf5\xc9\x4e\x9a\x4b\xeb\x4b\x51\x78\x8b\x22\xe8\xa6\x1b\x24\x45\x2f\x69\x77\x41\x53\x63\x69\x1a\x89\x54\xc9\xa1\x52\xb7\xe9\x7f\x5f\x90\x94\x1c\xc5\x96\x6c\x6f\x8a\x16\xcb\x4b\xa2\x99\xe1\x7c\x7f\xd1\x49\x92\x8c\x44\x45\xef\xd1\x58\xd2\x6a\x06\xa2\x22\xfc\xc2\xa8\xfc\x97\x9d\xdc\x3c\xb3\x13\xd2\xd3\xfa\x64\x74\x43\x2a\x9d\xc1\xdc\x59\xd6\xe5\x25\x5a\xed\x8c\xc4\xdf\x70\x49\x8a\x98\xb4\x1a\x95\xc8\x22\x15\x2c\x66\x23\x00\xa1\x94\x66\xe1\xc1\xd6\x7f\x02\x7c\xfb\x3e\x02\x50\xa2\xc4\x19\x90\xaa\x51\xb1\x36\x2b\xc6\xb2\x2a\x04\xa3\x9d\xf8\xab\xc5\x24\x17\xa6\x46\xcb\x68\x72\x49\x13\xd2\x23\x5b\xa1\xf4\xb7\x33\xa3\x5d\x35\x83\x7e\xa2\xc8\xb5\x91\x12\x35\x3c\x6b\x05\xbc\x6b\x04\x04\x5c\x41\x96\xdf\xf4\xe3\x7f\x27\xcb\x81\xa6\x2a\x9c\x11\x45\x9f\x8a\x01\x6d\x49\x65\xae\x10\xa6\x87\x60\x04\x60\xa5\xae\x70\x06\x6f\xbd\x3a\x95\x90\x98\x8e\x00\xea\xe8\xd3\xa0\x5e\x02\x22\x4d\x83\xab\x44\x71\x61\x48\x31\x9a\xb9\x2e\x5c\xd9\xba\x28\x81\x4f\x56\xab\x0b\xc1\xf9\x0c\x26\x96\x05\x3b\xdb\xfc\x09\xe8\xd6\x7d\x5b\xda\x5f\x75\x69\x78\xe5\x75\xb0\x6c\x48\x65\x83\x5c\x4b\xb4\x56\x64\xf8\x80\xed\x25\x0a\xab\xd5\x10\x97\x48\x53\x9f\x88\xa2\xca\xc5\x49\x74\x86\xcc\xb1\x14\xb3\xe6\x86\xae\x50\xbd\xbc\x38\x7b\x7f\x7a\xf5\x00\x0c\x90\xa2\x95\x86\x2a\x0e\x99\xb5\xa5\x3b\x90\x05\xce\x11\xe2\x2d\x58\x6a\x13\x3e\xb7\xe9\x5e\x5e\x9c\xad\x59\x56\x46\x57\x68\x98\xda\xa8\xc7\xd3\xc9\xe0\x0e\x74\x43\x81\xbb\xe4\x01\x0e\x3c\xdf\xe6\x16\xa4\x3e\x95\x31\xea\xd3\xc4\x0d\xd3\xc6\x4c\xd0\x4b\xe0\x9c\x2c\x18\xac\x0c\x5a\x54\x31\xb9\x3d\x58\x28\xd0\x8b\x4f\x28\x79\xb2\xc1\xfa\x0a\x8d\x67\x03\x36\xd7\xae\x48\x41\x6a\x55\xa3\x61\x30\x28\x75\xa6\xe8\xeb\x9a\xb7\x05\xd6\x41\x68\x48\x34\x86\x90\x19\x4a\x14\x50\x8b\xc2\xe1\xff\x41\xa8\x74\x83\x73\x29\x56\x60\xd0\xcb\x04\xa7\x3a\xfc\xc2\x05\xbb\xa9\xc7\xb9\x36\x08\xa4\x96\x7a\x06\x39\x73\x65\x67\xd3\x69\x46\xdc\xd6\xb5\xd4\x65\xe9\x14\xf1\x6a\x2a\xb5\x62\x43\x0b\xc7\xda\xd8\x69\x8a\x35\x16\x53\x4b\x59\x22\x8c\xcc\x89\x51\xb2\x33\x38\x15\x15\x25\xc1\x10\x15\x6a\x7b\x52\xa6\xff\x31\x4d\x27\xb0\x0f\xc4\x6e\x65\x50\x3c\xa1\x40\xff\x41\x78\x7c\xc1\xfa\x1c\x11\x0d\xab\x68\xe2\x7d\x14\x3c\xc8\xbb\xee\xf2\xd5\xd5\x3b\x68\x35\x89\x91\x8a\x41\xb9\x27\xdd\xf2\x4b\x1b\x1f\xef\x4d\x52\x4b\x34\xf1\xde\xd2\xe8\x32\xf0\x44\x95\x56\x9a\x14\x87\x0f\x59\x10\x2a\x06\xeb\x16\x25\xb1\x4f\x83\xcf\x0e\x2d\xfb\xd0\x6d\xb2\x9d\x87\xde\x07\x0b\x04\x57\xa5\x82\x31\xdd\x24\x38\x53\x30\x17\x25\x16\x73\x61\xf1\x17\xc7\xca\x47\xc5\x26\x3e\x08\x07\x45\xab\xdb\xd1\x37\x89\xa3\x7b\x3b\x88\xb6\x5b\xdf\x9f\xfe\x3a\xf5\x47\x1a\x4c\xbd\x5a\xa2\xd8\x42\xed\xcb\x88\x18\x39\x69\x90\x2f\x71\x89\x06\x95\xec\xa4\x83\x4f\x94\x88\x84\x35\x76\x02\x67\x0c\xb9\xb0\x80\x4a\xbb\x2c\x0f\xde\x35\x65\x2c\x5f\xd6\x60\x90\x0d\x61\x8d\x60\xc3\xbd\x1e\x69\xa4\x40\xa8\x55\x1c\x33\xbe\xaf\x6f\x91\x0c\xdb\x09\x6d\xeb\xec\x81\x6f\x18\xea\xc9\x7c\xa6\x3b\x45\x9f\x1d\xc2\x2d\x71\xee\x05\xdf\x8b\x8d\xca\xb6\x26\x8b\x5e\x8e\xd0\x58\xb1\x2e\x85\xcd\xfc\x8a\x67\x20\xdc\x5d\x8d\x83\xc8\x03\xd5\x8e\xea\x75\xdb\x67\x84\x34\x36\xdc\xe6\x24\xf3\x08\x1e\x72\x71\x2b\x15\x4a\x67\x63\xe9\x04\x2f\x3c\x42\xfb\x81\xe4\x8c\xe7\x4b\x72\xe3\x16\x68\x14\x32\xda\xa4\x14\x55\x12\xa9\x05\xeb\x92\xe4\x06\x75\x5d\x5e\x6d\x25\x34\xec\x0d\xb6\xd4\x4e\xf1\x90\xdb\x96\xc2\x15\x3c\x83\x93\x5e\x74\x4c\x4a\xbf\x59\xf0\xe9\xd3\x1d\x76\xfb\xe9\x90\xa1\xe9\x93\x5d\xb9\x3d\x92\x9f\xfd\x24\xc9\x29\xd9\x9b\x5e\x77\x00\x10\x63\x39\x80\xda\xe7\xcb\x86\xb7\xa1\x1a\xcd\x30\xbe\x63\x5e\x4d\x86\xc3\x46\x38\x74\x50\xb9\x72\x17\xa7\x64\x3f\x8b\x04\xac\xe0\xa1\xe2\x6b\x08\xa4\xa5\x1d\x04\x7b\x8a\x2f\x1e\x4b\x5f\x07\xaa\x2f\x1e\xa1\x56\x7f\x2c\x77\x9b\xb2\x2f\x68\x9b\x94\x7b\x34\x02\xa8\x04\xfb\xc5\x64\x06\x7f\x1e\x7d\x78\x72\x97\x8c\x5f\x1c\x1d\x5d\x1f\x27\xcf\x3f\x3e\x39\xfa\x30\x09\xff\xfc\x6f\xfc\x62\x7c\xd7\x7e\x3c\x19\x8f\x8f\x8e\xae\xdf\x9c\xbf\x7e\x77\xf1\xea\x23\x8d\xef\xae\x95\x2b\x6f\xe2\xd7\xdd\xd1\x35\xbe\xfa\x78\x20\x93\xf1\xf8\xc5\x7f\x77\x28\xf5\xa0\xa4\x49\x71\xa2\x4d\x12\x2d\x99\x01\x1b\xb7\xdd\xac\xdb\x63\x59\x1b\x91\xe1\xbc\x10\x76\x47\xf6\x1d\x14\x2e\xbf\x0c\x90\xc1\x74\x88\x4d\xd2\x24\xf1\x20\xda\x47\x7b\x18\xd9\x51\x74\x80\x68\x67\xd3\xbb\x27\x10\xc6\x88\x55\xef\x80\xcb\x0c\x5a\x1b\x24\xbc\xdd\x31\xad\x9a\x32\x53\x19\xa9\x2f\x8f\x99\x2b\x25\x96\xda\xac\xfa\xb9\xef\x48\xe8\x43\x52\xf9\x80\x24\x5e\xeb\x7f\xfa\xf4\x75\x7f\x7d\xfe\xcb\x12\xfc\x11\xa9\xbd\x77\x72\x37\x2e\x68\xfe\x79\xd4\x72\x80\x7c\xab\xcd\xa3\xda\xfd\xfe\xc5\x2e\x9e\xb7\x51\xc4\x5c\xab\x25\x65\x20\x8a\x42\xdf\x5a\x70\xd6\xef\xea\xac\x9b\x3d\x03\xde\x9f\x37\x64\x01\xe8\x57\x06\x8b\x69\x58\x39\x40\x91\x8c\x8f\xa8\xa5\x90\x68\x81\xd4\xa0\x24\xbf\x94\x38\x95\xa2\x29\x56\xfe\x25\xe1\xfd\xed\x27\x00\xd4\xe5\x0f\x4c\x2c\x45\xf2\x5c\xa7\x58\xfc\xaa\x99\x85\x27\xc7\xc7\xc7\xfb\x29\x86\x5b\xa1\x27\xa1\x6c\xb1\x13\xaf\xf0\xe9\xcd\x5f\x95\xdc\x35\xd8\x12\xa8\xa4\x1a\x5c\xef\x22\x85\xe1\xe2\xd9\xc9\xe9\xf3\x1f\x9b\xc1\x07\xcd\xab\xba\x6c\xf2\xe3\xa7\x37\xf7\x36\xde\x83\x04\x6b\x55\x7e\x46\xfb\x1e\xd6\x2f\x89\xdb\x68\x1f\xbc\x72\x3d\xd0\xb0\xc5\xf5\xc0\x37\xc7\x43\x0f\x49\xec\xec\x3d\x88\xe1\x47\x53\xb2\xee\x24\x87\xef\xf0\xfd\xb6\x26\xdd\xe7\xe4\x06\x26\x6e\xf1\x07\x3d\x5f\xc3\x4f\x63\x87\x3e\x60\x9b\x5f\xd0\xb6\x9d\xbe\x23\xa3\xfa\x24\xec\xbc\xd2\xab\xe9\x16\xd0\xa2\xa9\x31\xed\x8c\x84\x66\x61\xe8\x42\xdc\x62\xfd\x2b\x4d\x2b\xbf\xd1\x06\xbe\x7d\x1f\xfd\x1d\x00\x00\xff\xff\x0b\xf1\x56\x83\x00\x16\x00\x00")
+var _chartSeederCrdTemplatesMetalHarvesterhciIo_inventorytemplatesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\xdf\x6f\xdb\xb6\x13\x7f\xd7\x5f\x71\xc0\xf7\xfb\x10\xaf\x93\x93\x34\x2f\xad\x5e\x8a\x2e\x2b\x8a\xa0\x4b\x17\x24\x45\x5f\xd2\x6e\x60\xa4\xb3\x75\x8d\x44\xaa\xbc\xa3\x53\xb7\xe9\xff\x3e\x90\x94\x1d\xc7\x96\x64\x2f\x59\x07\x2c\x14\x10\x93\xf7\xe1\xdd\xf1\x7e\xf1\xa4\x34\x4d\x13\xd5\xd0\x7b\xb4\x4c\x46\x67\xa0\x1a\xc2\x2f\x82\xda\xcf\x78\x7c\xfd\x8c\xc7\x64\xf6\x67\x87\xc9\x35\xe9\x22\x83\x63\xc7\x62\xea\x73\x64\xe3\x6c\x8e\xbf\xe2\x84\x34\x09\x19\x9d\xd4\x28\xaa\x50\xa2\xb2\x04\x40\x69\x6d\x44\xf9\x65\xf6\x53\x80\x6f\xdf\x13\x00\xad\x6a\xcc\x80\xf4\x0c\xb5\x18\x3b\x17\xac\x9b\x4a\x09\xf2\xd8\x6f\xad\xc6\xa5\xb2\x33\x64\x41\x5b\xe6\x34\x26\x93\x70\x83\xb9\xdf\x3d\xb5\xc6\x35\x19\x74\x83\x22\xd7\x56\x4a\xd4\xf0\x64\x21\xe0\x5d\x2b\x20\xd0\x2a\x62\x79\xd3\x4d\xff\x8d\x58\x02\xa6\xa9\x9c\x55\x55\x97\x8a\x81\xcc\xa4\xa7\xae\x52\xb6\x03\x90\x00\x70\x6e\x1a\xcc\xe0\xad\xaa\x91\x1b\x95\x63\x91\x00\xcc\xa2\x4d\x83\x7a\x29\xa8\xa2\x08\xa6\x52\xd5\x99\x25\x2d\x68\x8f\x4d\xe5\xea\x85\x89\x52\xf8\xc4\x46\x9f\x29\x29\x33\x18\xb3\x28\x71\xdc\xfe\x0b\xc2\x17\xe6\xdb\xd0\xfe\x62\x15\x23\x73\xaf\x03\x8b\x25\x3d\xed\xe5\x5a\x23\xb3\x9a\xe2\x3d\xb6\xe7\xa8\xd8\xe8\x3e\x2e\x51\xf4\xec\x50\x55\x4d\xa9\x0e\x03\x8a\xf3\x12\xeb\xe0\x6c\x3f\x33\x0d\xea\x97\x67\x27\xef\x8f\x2e\xee\x2d\x03\x14\xc8\xb9\xa5\xc6\x1f\xbb\x43\x77\x20\x06\x29\x11\xe2\x2e\x98\x18\x1b\xa6\x9b\xb8\x97\x67\x27\x4b\x96\x8d\x35\x0d\x5a\xa1\x85\xd7\xe3\x58\x89\xe0\x95\xd5\x35\x05\x6e\xd3\x7b\x34\x00\xaf\x73\xdc\x05\x85\x0f\x65\x8c\xfa\xb4\x7e\xc3\xa2\x3d\x26\x98\x09\x48\x49\x0c\x16\x1b\x8b\x8c\x3a\x06\xb7\x5f\x56\x1a\xcc\xd5\x27\xcc\x65\xbc\xc6\xfa\x02\xad\x67\x03\x5c\x1a\x57\x15\x90\x1b\x3d\x43\x2b\x60\x31\x37\x53\x4d\x5f\x97\xbc\x19\xc4\x04\xa1\x3e\x14\x59\x20\x44\x86\x56\x15\xcc\x54\xe5\xf0\x67\x50\xba\x58\xe3\x5c\xab\x39\x58\xf4\x32\xc1\xe9\x15\x7e\x61\x03\xaf\xeb\x71\x6a\x2c\x02\xe9\x89\xc9\xa0\x14\x69\x38\xdb\xdf\x9f\x92\x2c\xf2\x3a\x37\x75\xed\x34\xc9\x7c\x3f\x37\x5a\x2c\x5d\x39\x31\x96\xf7\x0b\x9c\x61\xb5\xcf\x34\x4d\x95\xcd\x4b\x12\xcc\xc5\x59\xdc\x57\x0d\xa5\xe1\x20\xda\x1f\x9f\xc7\x75\xf1\x3f\xdb\x56\x82\x45\x04\xf6\x44\x50\x7c\x42\x82\xfe\x0d\xf7\xf8\x84\xf5\x31\xa2\x5a\x56\xd1\x26\x77\x5e\xf0\x4b\xde\x74\xe7\xaf\x2e\xde\xc1\x42\x93\xe8\xa9\xe8\x94\x3b\x28\xf7\xf9\xc7\x5b\x93\xf4\x04\x7d\xe8\x11\xc3\xc4\x9a\x3a\xb8\x03\x75\xd1\x18\xd2\x12\x26\x79\x45\xa8\x05\xd8\x5d\xd5\x24\x3e\x0c\x3e\x3b\x64\xf1\xae\x5b\x67\x7b\x1c\x6a\x1f\x5c\x21\xb8\xa6\x50\x82\xc5\x3a\xe0\x44\xc3\xb1\xaa\xb1\x3a\x56\x8c\xff\xb2\xaf\xbc\x57\x38\xf5\x4e\xd8\xc9\x5b\xab\x15\xfd\xee\x2f\x82\xa3\x79\x57\x08\x8b\x6a\x0d\x30\x9c\xa7\x7e\xe4\x16\x0b\xaf\x96\xaa\x36\x48\xdb\x22\xc2\x8f\x0b\xcc\x2d\xca\x39\x4e\xd0\xa2\xce\x57\xc2\xc1\x07\x4a\x24\xc2\x92\x3a\x86\x13\x81\x52\x31\xa0\x36\x6e\x5a\x06\xeb\xda\x3a\xa6\xaf\x18\xb0\x28\x96\x70\x86\xc0\x61\x5f\x87\x34\xd2\xa0\xf4\x3c\x94\xc9\x50\xd7\x37\x20\xfd\xe7\x5c\x96\xce\x8e\xf5\xb5\x83\x7a\x98\x8f\x74\xa7\xe9\xb3\x43\xb8\x21\x29\xbd\xe0\x3b\xb1\xbe\x4a\xd8\xe5\x91\x55\x27\x47\x68\x4f\xb1\x4c\x85\xf5\xf8\x1a\x74\xf7\x62\x2c\x45\xee\xa8\x76\xc0\xde\x2b\x9f\x71\xa5\x3d\xc3\x4d\x49\x79\x19\x97\xfb\x4c\xbc\x90\x0a\xb5\xe3\x98\x3a\xc1\x0a\x0f\xd0\xbe\x27\x38\xe3\xf3\x25\xbd\x76\x57\x68\x35\x0a\x72\x5a\xab\x26\x8d\x68\x25\xa6\xa6\x7c\x0d\x3d\xab\x2f\x36\x02\x7a\xbb\xb3\x73\xe3\xb4\x74\x11\xbc\xd9\x26\xca\x55\x92\xc1\x61\x27\x39\x06\xa5\xef\x2c\xe4\xe8\x69\x27\x22\x2a\xeb\x6f\x87\x29\xda\x0e\x44\xde\xb8\x2d\x92\x9f\xfd\x20\xc9\x05\xf1\x75\xa7\x39\x00\x48\xb0\xee\x21\x6d\xb3\xe5\x1d\xef\x5f\xdc\x00\x60\xe5\x7c\x33\xb2\x42\x66\x00\x89\xda\xd5\x43\x9c\xd2\xed\x2c\x52\x60\x25\x6a\x18\x90\x33\x0d\x00\xb6\x64\x5f\x9b\xc7\xf4\xb5\xa7\x6a\xb4\xdd\x8e\x9e\xff\x3e\x19\x3e\xca\x36\xaf\xad\x23\xb7\x68\x04\xd0\x28\xf1\x9d\x49\x06\x7f\xec\x7d\x78\x72\x9b\x8e\x5e\xec\xed\x5d\x1e\xa4\xcf\x3f\x3e\xd9\xfb\x30\x0e\x3f\x7e\x1a\xbd\x18\xdd\x2e\x26\x4f\x46\xa3\xbd\xbd\xcb\x37\xa7\xaf\xdf\x9d\xbd\xfa\x48\xa3\xdb\x4b\xed\xea\xeb\x38\xbb\xdd\xbb\xc4\x57\x1f\x77\x64\x32\x1a\xbd\xf8\xff\x80\x52\xf7\x72\x9a\xb4\xa4\xc6\xa6\xf1\x24\x19\x88\x75\x98\x74\xec\x09\x0f\x8b\xb1\x6a\x8a\xc7\x95\x62\xce\x1e\xe7\x2e\xdf\x0d\x90\xc5\xb5\xce\xe6\x6e\xa4\x8b\x28\xee\xa5\x33\x7d\xed\xd3\x34\xbd\xa7\x69\x0f\x68\xb0\xec\xdd\x01\x94\xb5\x6a\xde\x41\x27\x3d\xb5\xc8\x1c\x6c\xf1\x76\xe0\xbe\x6a\xf3\x4c\x4f\x49\x7f\x49\x1e\x60\xac\x1a\x6b\x63\xe7\xdd\xdc\x07\x22\x7a\x97\x58\xde\x21\x8a\x97\xfa\x1f\x3d\x7d\x4d\xc9\x7f\x20\xc2\x1f\x10\xdb\x5b\xef\xee\xd6\x04\xed\x8f\x87\x38\x51\xa3\xdc\x18\xfb\xa0\x82\xbf\xbd\xb5\x8b\xe3\x6d\x14\x71\x6c\xf4\x84\xa6\xa0\xaa\xca\xdc\x30\x38\xf6\x6f\x53\x62\xda\x4e\x03\xde\x9f\xb6\xb0\xb0\xe8\x9b\x06\xc6\x22\x34\x4e\xa0\x29\x0f\xd7\x95\x9d\xa8\x1c\x19\x48\x27\x1d\x42\xc2\xe3\xdb\x12\xa7\x0b\xb4\xd5\xdc\xbf\x4b\x78\x7b\xfb\x2b\x00\x66\xf5\x23\xee\x2c\x4d\xf9\xa9\x29\xb0\xea\x47\xfc\xb3\x97\x16\x1e\x1e\x1c\x1c\x6c\x47\xf4\x55\x18\x3f\x52\xa0\xe9\xd5\x20\x5d\xe3\xd3\xeb\x3f\x9b\x7c\xe8\x66\x4b\xa1\xc9\x75\x6f\x83\xe7\x9f\x14\xac\x54\xcf\x0e\x8f\x9e\x3f\xee\x12\xde\x9a\xea\xfe\x99\xd5\x6d\x7c\x64\x8f\xe3\xb4\xbd\xba\x2f\xfc\xdd\x0b\x58\xaa\xf2\x23\xca\x77\xbf\x7e\x69\xec\x47\xbb\xd6\x1b\xd7\xb1\xea\x6f\xa9\xcd\x1b\x26\xdd\xb8\x1e\x3a\x20\xb1\xb2\x77\x10\x96\x05\xa9\x8b\xd6\xe6\x6f\xb2\xb3\x3d\xba\xcf\x9a\xae\xbe\x50\xae\x51\x62\x1f\x9f\xec\xc0\x3d\x7e\x1c\xcb\x92\xdd\xb2\xbd\xfd\x86\x96\xf5\xe8\xde\x19\x51\x5d\x12\x06\xb7\x74\x6a\xba\xb1\xc8\xfe\x43\x46\xb1\x72\x25\xb4\x0d\xc3\xea\x8a\xbb\x5a\xbc\x12\x2e\xe5\xb3\x28\x71\x9c\xc1\xb7\xef\xc9\x5f\x03\x00\x3b\x8d\xd2\xd7\x02\x16\x00\x00")
 
 func chartSeederCrdTemplatesMetalHarvesterhciIo_inventorytemplatesYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -263,12 +351,12 @@ func chartSeederCrdTemplatesMetalHarvesterhciIo_inventorytemplatesYaml() (*asset
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "chart/seeder-crd/templates/metal.harvesterhci.io_inventorytemplates.yaml", size: 5632, mode: os.FileMode(420), modTime: time.Unix(1777254817, 0)}
+	info := bindataFileInfo{name: "chart/seeder-crd/templates/metal.harvesterhci.io_inventorytemplates.yaml", size: 5634, mode: os.FileMode(436), modTime: time.Unix(1792439149, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _chartSeederCrdTemplatesMetalHarvesterhciIo_nestedclustersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x1a\xcb\x72\xdb\x38\xf2\xae\xaf\xe8\xaa\xdd\x83\xb5\x59\xca\x76\x7c\xc9\xe8\x92\x4a\x29\xa9\x29\x57\xc6\x1e\x97\xed\xcd\xc5\x93\xdd\x82\xc8\x16\x89\x31\x09\x70\xf0\xa0\xa3\x89\xf3\xef\x5b\x78\x50\xa2\x28\x3e\x20\xdb\x95\xa4\x2f\xb6\x80\x46\xa3\xdf\xdd\x00\x18\x45\xd1\x84\x94\xf4\x13\x0a\x49\x39\x9b\x03\x29\x29\x7e\x51\xc8\xcc\x2f\x39\xbb\x7f\x23\x67\x94\x1f\x57\xa7\x93\x7b\xca\x92\x39\x2c\xb4\x54\xbc\xb8\x46\xc9\xb5\x88\xf1\x3d\xae\x28\xa3\x8a\x72\x36\x29\x50\x91\x84\x28\x32\x9f\x00\x10\xc6\xb8\x22\x66\x58\x9a\x9f\x00\x5f\xbf\x4d\x00\x18\x29\x70\x0e\x0c\xa5\xc2\x24\xce\xb5\x54\x28\xe4\xcc\x2c\xcb\x67\x19\x11\x95\x19\x17\x59\x4c\x67\x94\x4f\x64\x89\xb1\x59\x99\x0a\xae\xcb\x39\x74\x23\x39\x8a\x7e\x07\xc7\xdd\xa5\x25\xbe\x70\xc4\xed\x78\x4e\xa5\xfa\xb8\x3f\xf7\x1b\x95\xca\xce\x97\xb9\x16\x24\x6f\xb3\x65\xa7\x24\x65\xa9\xce\x89\x68\x4d\x4e\x00\x64\xcc\x4b\x9c\xc3\xa5\xd9\xbe\x24\x31\x26\x13\x80\xca\xe9\xcf\xb2\x13\x01\x49\x12\xab\x16\x92\x5f\x09\xca\x14\x8a\x05\xcf\x75\x51\xab\x23\x82\x3f\x25\x67\x57\x44\x65\x73\x98\x49\x45\x94\x96\xfe\x8f\x9d\xae\x55\xe5\x79\xbd\x69\xce\xa8\xb5\xd9\x59\x2a\x41\x59\xda\x4b\x4b\xf1\x7b\x64\x5d\xa4\x6e\x1b\x13\x41\x94\xbc\xcc\xef\x92\x44\xa0\xec\xe4\x6e\x77\x6a\x8f\xa8\xc3\xad\x4e\x49\x5e\x66\xe4\xd4\xe9\x35\xce\xb0\x20\x73\xbf\x82\x97\xc8\xde\x5d\x9d\x7f\x3a\xbb\xd9\x19\x06\x48\x50\xc6\x82\x96\xca\x3a\xa5\xdf\x0c\xa8\x04\x95\x21\x38\x5c\x58\x71\x61\x7f\xd6\x66\x83\x77\x57\xe7\x9b\xf5\xa5\xe0\x25\x0a\x45\x6b\x0f\x71\xd0\xf0\xf4\xc6\x68\x6b\xb7\xc7\x68\x67\x0e\x0c\x5d\xbf\x0a\x12\xe3\xf2\xe8\xd8\xf0\x36\xc7\xc4\xcb\x04\x7c\x05\x2a\xa3\x12\x04\x96\x02\x25\x32\x17\x04\x66\x98\x30\xe0\xcb\x3f\x31\x56\xb3\x16\xe9\x1b\x14\x86\x0c\xc8\x8c\xeb\x3c\x81\x98\xb3\x0a\x85\x02\x81\x31\x4f\x19\xfd\x7b\x43\x5b\x82\xe2\x76\xd3\x9c\x28\x94\x0a\xac\x57\x31\x92\x43\x45\x72\x8d\xff\x06\xc2\x92\x16\xe5\x82\xac\x41\xa0\xd9\x13\x34\x6b\xd0\xb3\x0b\x64\x9b\x8f\x0b\x2e\x10\x28\x5b\xf1\x39\x64\x4a\x95\x72\x7e\x7c\x9c\x52\x55\xc7\x7f\xcc\x8b\x42\x33\xaa\xd6\xc7\x31\x67\x4a\xd0\xa5\x56\x5c\xc8\xe3\x04\x2b\xcc\x8f\x25\x4d\x23\x22\xe2\x8c\x2a\x8c\x95\x16\x78\x4c\x4a\x1a\x59\x41\x98\xcd\x01\xb3\x22\xf9\x87\xf0\x19\x43\xee\x6c\xbb\xe7\x2e\x0e\x6c\x30\x1f\x60\x1e\x13\xe0\xc6\x35\x88\x27\xe5\x44\xdc\x5a\xc1\x0c\x19\xd5\x5d\x7f\xb8\xb9\x85\x9a\x13\x67\x29\x67\x94\x2d\xea\x9e\x5e\x6a\xfb\x18\x6d\x52\xb6\x42\xe1\xd6\xad\x04\x2f\x2c\x4d\x64\x49\xc9\x29\x53\xde\x11\x29\x32\x05\x52\x2f\x0b\xaa\x8c\x1b\xfc\xa5\x51\x2a\x63\xba\x36\xd9\x85\xcd\x91\xb0\x44\xd0\x65\x42\x14\x26\x6d\x84\x73\x06\x0b\x52\x60\xbe\x20\x12\xbf\xb3\xad\x8c\x55\x64\x64\x8c\x10\x64\xad\x66\xe6\x6f\x23\x3b\xf5\x36\x26\xea\xcc\x1e\x6a\xda\x9d\xac\x7d\x53\x62\xbc\x13\x80\x26\x4b\xa1\x09\x2f\xcd\x12\x14\xf9\xda\x18\xba\x4e\x15\x7b\x5b\x1b\x48\x91\xa1\x50\x98\xc0\x72\x6d\x09\xb8\xcc\x5e\x27\x10\xb0\x0a\xe3\x79\xee\x8b\x47\x0d\xdd\xa9\xc4\x80\x5f\xb8\xe0\x6c\x45\xd3\xf6\xe4\xd0\x42\x03\x4b\xce\x92\xdf\xcb\x46\x99\x6c\x43\xb3\x8a\x0c\x11\x82\x7e\xe3\xb4\x11\x3a\xb5\x62\x25\xb1\x22\xfc\xe7\xfa\xb7\xee\x0d\x46\xc8\xc7\xb6\x2d\xb8\x12\xbc\xa2\x26\x23\x52\x96\xde\x62\x51\x9a\x54\xf5\x24\x72\xb6\xb0\xbb\xc0\xeb\x5e\x4f\x15\x16\xcf\x55\x05\x11\x82\xac\x3b\xe6\xa5\xcc\x3e\xe2\xfa\x47\x6c\xac\x04\x92\xe2\xbc\x20\x29\x5e\xf0\x64\x50\x73\x4b\xce\x73\x24\xac\x03\xa3\xca\x09\x3b\x7f\xdf\xbd\x36\xc1\x15\xd1\xb9\x9a\xc3\x69\xe7\x74\x41\x19\x2d\x74\xd1\x37\xed\x76\x36\x75\x27\x6d\xc5\x87\x83\x07\x5a\xe2\x7b\x2a\xef\x7b\xd4\x33\xcc\xf8\x80\x77\x52\xa3\x90\x4e\xc7\x1c\xd0\x37\xb5\x19\x8d\x8b\x75\xed\x87\x7d\x21\xda\x6b\xd0\xe1\xbc\xe4\xe0\xbc\x7b\x97\x9d\x1c\xb5\xe1\x04\x94\x47\xea\x8e\x75\x96\x00\x1a\x4c\x4d\xf2\x7c\x0d\x29\x9a\x4c\x65\x4a\x7d\x8b\x88\x53\x91\xb4\xf8\x2e\x66\xb5\x40\x53\xfa\xe2\x46\xd7\xdb\x06\x2d\xeb\x12\x98\x7a\xb2\x49\x83\xa4\x21\x45\x5c\x1b\x07\x25\xe7\x39\x08\x5c\xa1\x40\xd6\xae\xd6\x0e\x86\x73\x1a\xd4\x94\xae\x38\xcf\xaf\x6b\x3a\x7d\x01\x33\x46\x0b\xea\x0e\xb2\x77\x36\x20\xe8\x6a\x22\xb6\x55\x7f\x26\x25\x53\xd1\xa9\xc0\xa4\x8f\x4c\x64\x77\x1a\x9c\xb4\x6c\x0c\x26\x90\xde\x1c\xdd\xe1\xd6\x37\x7b\xf5\x74\x0b\x21\xda\x8d\x05\x26\xa6\xf4\x93\x7c\x00\x29\x2c\x18\x6a\xb8\xc1\x58\xa0\xda\xd8\xbe\xd1\x5b\x01\xf1\x93\xb0\x99\x9d\xc1\xb9\x82\x8c\x48\x40\xc6\x75\x9a\xd9\xae\x46\x14\xae\x6d\x56\x1c\x04\x2a\x41\xb1\x42\x90\x76\xdd\xe0\xbe\x94\x01\x61\xeb\x51\x1d\x87\x6a\x06\x02\x7c\x6f\x4f\x35\x66\x81\xe9\x44\x35\xa3\x7f\x69\x84\x07\xaa\x32\xc3\xd6\x96\x29\x27\x94\x17\x7e\x84\x32\x98\x86\xd6\xe9\xab\x6e\x5a\xdb\x9d\x60\x1b\x82\x82\x01\x42\x03\xa2\x53\x3c\x27\xc6\x4e\x17\x66\x47\xbc\xac\x0f\x19\x8d\xb3\x51\xc1\xec\x32\x27\x9a\x55\x59\xa1\xa5\x6b\x86\xad\xde\x5e\x48\xca\xd1\x68\x72\xf0\x25\xba\xd7\x4b\x14\x0c\x15\xca\xa8\x20\x65\xe4\xab\xb4\xe2\x05\x8d\x7b\xd7\x55\xc5\x50\xe8\xc1\x01\x4e\x16\x73\xcd\xd4\xb8\x19\x06\x4b\xf7\x16\x5c\xf8\xd8\x3a\x7d\xf6\x3a\x48\x8f\xfd\x15\xbd\xc1\x63\xa9\x83\x39\x7c\xf3\x43\x38\x4c\xfa\x3b\x8f\x2d\x0c\x76\x6f\x5b\x08\xb5\x9c\xdf\x59\xd0\x0a\x45\x08\x66\x43\x49\x15\x15\xca\xde\x61\x8d\x03\x32\x5d\x84\x51\x8f\x0e\x21\x1b\x81\x24\x8a\x84\xa2\xc6\x92\x06\xa1\x06\x27\x20\x07\x92\xfe\x3d\x9a\x81\x1c\x10\xb6\xfe\x7d\x15\xaa\x86\x70\xb7\x69\xaf\x09\xe6\x1c\xa0\x24\x4a\xa1\x60\x73\xf8\xef\xd1\x1f\xaf\x1e\xa3\xe9\xdb\xa3\xa3\xbb\x93\xe8\x97\xcf\xaf\x8e\xfe\x98\xd9\x7f\xfe\x35\x7d\x3b\x7d\xac\x7f\xbc\x9a\x4e\x8f\x8e\xee\x3e\x5e\xfc\x7a\x7b\xf5\xe1\x33\x9d\x3e\xde\x31\x5d\xdc\xbb\x5f\x8f\x47\x77\xf8\xe1\x73\x20\x91\xe9\xf4\xed\x3f\x83\xd8\xdb\x49\x6b\x94\xa9\x88\x8b\xc8\x49\x37\x07\x25\xf4\x78\xf1\x31\x07\x12\x2e\x48\x8a\x8b\x9c\xc8\xa0\x48\x38\xd0\xfc\x63\xcd\xd4\x16\x22\x1f\x64\x01\x88\xc6\xa3\x42\xd0\x1a\xa2\x8d\x57\xab\xb0\x42\x02\xa3\x27\xbc\x2d\x50\x96\x9a\x2e\xd9\xee\x7f\x19\xd4\x65\xf8\xc4\xc1\x52\xca\xbe\xbc\x54\x17\x50\x60\xc1\xc5\x7a\x6c\xef\xa0\xd0\x3b\x2c\xe8\x0e\x0a\xb7\x8d\xec\x67\xaf\x7f\x1d\xcb\x42\x3f\x6d\x50\x3e\x2b\x1c\x0f\xe8\xd6\xbc\xaa\xfc\x3f\x2f\xd6\x2e\xa2\x7a\xe0\xe2\xc5\x0a\xec\x21\xc7\x09\x07\x97\x8e\x01\x7f\xbe\x26\x79\xce\x1f\x24\x68\x89\xc2\xde\x8b\xbb\x6e\x14\x3e\x5d\x78\x34\x3b\x68\xda\x49\x89\x89\x6d\x4c\x81\xd1\xd8\x5d\x99\xaf\x48\x8c\x12\x68\xd7\xd5\x49\x1b\x4c\x93\xda\xb8\x58\x34\xf6\x33\xf5\x15\xaa\xe2\x85\x3b\x08\x46\xe3\x0b\x9e\x60\xfe\x73\xf4\x10\x78\x7a\x72\x72\x72\x08\x6e\x48\x29\x31\xc8\x34\x5d\x06\x62\x32\x7c\x7d\xff\xbf\x32\x0e\x6b\x39\x22\x28\x63\x36\x72\x36\xdc\xe2\x0a\x95\xbf\x39\x3d\xfb\xe5\xe5\xfb\xa9\x03\xfb\x87\xaa\xf0\xbe\xfa\x83\x0b\x6b\xed\x7b\x01\xa8\x1b\x96\xbf\x7f\xc1\x0c\x91\x28\x72\x27\xa9\x61\x8c\x52\x0f\xce\xdb\x53\xc4\x20\x46\xbb\x70\x0f\x22\xbb\xfa\x3a\x88\x12\x72\x5d\x11\x6d\xf2\xef\xf3\x4e\xbb\xe3\xb7\x57\x8d\xeb\xa0\x5e\x1c\x77\xf2\x7d\xea\x0d\xd6\xd0\x95\xca\x88\x93\x0f\xb1\x1f\x75\xde\x3b\x76\x22\x76\xde\xa1\x75\x62\xf6\xdc\xe5\x0d\xca\xd8\xef\xcf\x55\xd7\xbb\xf3\x88\xd8\x15\x2d\x9f\xf6\xb6\x14\x7e\x0b\x3b\x5e\xaa\x86\x6f\xc1\x02\x32\x53\x40\xfb\x32\x4a\x65\xd8\x77\x07\xee\x5d\xc7\x42\x6c\xc4\x63\xa5\x22\x8a\xc6\xfe\x2b\x87\x27\x3c\x66\xf5\xf3\x1d\xe8\xb2\xbd\xfc\x75\x53\x8e\x76\xdf\x24\x5b\x73\xf5\x5b\x4a\x7b\xb8\xfb\x1d\xa3\x85\xe5\x1d\xb8\x3d\x5a\xbb\xe8\x24\x80\x6b\xf7\x59\xc9\xc0\x03\xf0\xce\x37\x2f\x3b\xb7\x8c\x7c\x69\x5f\x04\x93\xed\xa3\xef\xa2\xe3\xc5\x63\xf4\xa9\xb6\xd7\x92\x03\x56\xec\xe2\x7a\x64\x89\xfd\x04\xe7\x80\x15\x9d\xfa\xda\x1b\x74\x1a\x68\x1c\x18\xfc\x31\xb6\x39\xa2\x97\x9b\x6f\x2d\xea\xfd\x3d\xff\xf0\xf5\xdb\xe4\xff\x01\x00\x00\xff\xff\xbd\xca\x5a\x2b\xee\x25\x00\x00")
+var _chartSeederCrdTemplatesMetalHarvesterhciIo_nestedclustersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3d\x5d\x93\xdb\x36\x92\xef\xfc\x15\x5d\x75\xf7\x60\x5f\x24\xd9\x4e\x52\x5b\x59\xbd\xa4\x9c\xb1\x2f\x37\x1b\x8f\x3d\x35\x33\xf1\x3d\x64\x73\x5b\x10\xd9\x92\x10\x91\x00\x03\x80\x1a\xcb\xeb\xfd\xef\x57\x8d\x0f\x92\x92\x48\x90\xd2\x8c\xbd\x77\xb5\x19\xaa\x2a\x96\xd0\x68\xf4\x17\x1a\x8d\x46\x13\x99\x4e\xa7\x09\x2b\xf9\x7b\x54\x9a\x4b\x31\x07\x56\x72\xfc\x60\x50\xd0\x37\x3d\xdb\x7c\xa7\x67\x5c\x3e\xdb\xbe\x48\x36\x5c\x64\x73\xb8\xa8\xb4\x91\xc5\x0d\x6a\x59\xa9\x14\x5f\xe1\x92\x0b\x6e\xb8\x14\x49\x81\x86\x65\xcc\xb0\x79\x02\xc0\x84\x90\x86\xd1\xcf\x9a\xbe\x02\xfc\xfd\x1f\x09\x80\x60\x05\xce\x41\xa0\x36\x98\xa5\x79\xa5\x0d\x2a\x3d\xa3\x6e\xf9\x6c\xcd\xd4\x96\x7e\x57\xeb\x94\xcf\xb8\x4c\x74\x89\x29\xf5\x5c\x29\x59\x95\x73\xe8\x06\x72\x18\xfd\x08\x8e\xba\xb7\xd4\x9e\x5d\x38\xe4\xf6\xf7\x9c\x6b\xf3\xd3\x71\xdb\x1b\xae\x8d\x6d\x2f\xf3\x4a\xb1\xfc\x90\x2c\xdb\xa4\xb9\x58\x55\x39\x53\x07\x8d\x09\x80\x4e\x65\x89\x73\x78\xcb\x0a\xd4\x25\x4b\x31\x4b\x00\xb6\x4e\x7e\x96\x9c\x29\xb0\x2c\xb3\x62\x61\xf9\xb5\xe2\xc2\xa0\xba\x90\x79\x55\x04\x71\x4c\xe1\x37\x2d\xc5\x35\x33\xeb\x39\xcc\xb4\x61\xa6\xd2\xfe\x3f\x76\xe0\x20\x2a\x4f\xeb\x6d\xbb\xc5\xec\x68\x64\x6d\x14\x17\xab\x5e\x5c\x46\x6e\x50\x74\xa1\xba\x6b\x35\x8c\xc2\xe4\x79\x7e\x99\x65\x0a\xb5\xee\x42\xb9\xdf\x74\x84\xd4\xc1\x6e\x5f\xb0\xbc\x5c\xb3\x17\xf6\x27\x9d\xae\xb1\xb0\x76\x42\xdf\x64\x89\xe2\xe5\xf5\xe5\xfb\x6f\x6e\xf7\x7e\x06\xc8\x50\xa7\x8a\x97\x24\xc5\x7a\x30\xe0\x1a\xcc\x1a\xc1\xc1\xc2\x52\x2a\xfb\xd5\x53\xa9\xe1\xe5\xf5\x65\xdd\xbf\x54\xb2\x44\x65\x78\xb0\x10\xf7\xb4\x2c\xbd\xf5\xeb\xc1\x68\x9f\xa6\x7b\x6d\x40\x78\x7d\x2f\xc8\xc8\xe4\xd1\x91\xe1\x75\x8e\x99\xe7\x09\xe4\x12\xcc\x9a\x6b\x50\x58\x2a\xd4\x28\xdc\x24\xa0\x9f\x99\x00\xb9\xf8\x0d\x53\x33\x3b\x40\x7d\x8b\x8a\xd0\x80\x5e\xcb\x2a\xcf\x20\x95\x62\x8b\xca\x80\xc2\x54\xae\x04\xff\x58\xe3\xd6\x60\xa4\x1d\x34\x67\x06\xb5\x01\x6b\x55\x82\xe5\xb0\x65\x79\x85\x13\x60\x22\x3b\xc0\x5c\xb0\x1d\x28\xa4\x31\xa1\x12\x2d\x7c\xb6\x83\x3e\xa4\xe3\x4a\x2a\x04\x2e\x96\x72\x0e\x6b\x63\x4a\x3d\x7f\xf6\x6c\xc5\x4d\x98\xff\xa9\x2c\x8a\x4a\x70\xb3\x7b\x96\x4a\x61\x14\x5f\x54\x46\x2a\xfd\x2c\xc3\x2d\xe6\xcf\x34\x5f\x4d\x99\x4a\xd7\xdc\x60\x6a\x2a\x85\xcf\x58\xc9\xa7\x96\x11\x41\xec\xeb\x59\x91\xfd\x9b\xf2\x1e\x23\x18\x4a\x8f\xb9\xb8\x8f\x9d\xcc\x27\xa8\x87\x26\x38\x99\x06\xf3\xa8\x9c\x4c\x1a\x2d\xd0\x4f\x24\xba\x9b\xd7\xb7\x77\x10\x28\x71\x9a\x72\x4a\x69\x40\x75\x9f\x7e\x48\x9a\x5c\x2c\x91\x2c\x8e\x6b\x58\x2a\x59\x58\x75\xa0\xc8\x4a\xc9\x85\xf1\x86\xc8\x51\x18\xd0\xd5\xa2\xe0\x86\xcc\xe0\xf7\x0a\xb5\x21\xd5\x1d\xa2\xbd\xb0\x3e\x12\x16\x08\x55\x99\x31\x83\xd9\x21\xc0\xa5\x80\x0b\x56\x60\x7e\xc1\x34\x7e\x61\x5d\x91\x56\xf4\x94\x94\x30\x4a\x5b\x6d\xcf\xdf\xfc\x39\x60\x27\xde\x56\x43\xf0\xec\x63\x55\xbb\xe7\xb5\x6f\x4b\x4c\xf7\x26\x20\x79\x29\xa4\xe9\x55\x89\x0c\x55\xbe\x23\x45\x07\x57\x71\x34\x34\x7d\x56\x28\x50\x19\xcc\x60\xb1\xb3\x08\x9c\x67\x0f\x0e\x84\x66\x9f\x51\x32\xcf\xfd\xe2\x11\x77\x25\xf4\xf8\x8e\x17\x52\x2c\xf9\xea\xb0\x31\xd6\x91\x1e\xb6\x34\xa8\x2e\x85\x36\x2c\xcf\x2f\x64\x51\x30\x91\x75\xc2\x1d\xc8\xe8\x65\x47\x37\x60\x0a\x41\x55\x02\xb8\x00\x06\xe9\x5a\x49\x69\x48\x2e\xc4\x23\x77\x23\xe0\xa1\x7f\x08\x8f\xd4\x13\x47\x0a\x68\xc4\xcc\x49\x61\xc9\x57\x95\xf2\x42\x5e\x10\xae\x5c\xb2\xec\x40\x2a\xe1\xe1\x06\x8b\x1e\xba\x7b\x6d\xa6\x79\x1c\x00\x53\x8a\xed\x3a\xda\x17\x52\x64\xef\xca\x56\x24\x71\xf8\xd7\x5e\x68\x63\xb2\x3e\x81\x96\x4e\xc3\xa1\x8f\x93\xcb\xcf\x37\x6f\xe6\xc9\x19\xe8\x53\x1b\x39\x5d\x2b\xb9\xe5\xb4\xfc\x70\xb1\xba\xc3\xa2\x24\x6f\x7e\x16\xba\x0c\x69\x9e\x73\x7d\xbc\x94\x75\x1a\xcd\xd1\xc4\x0a\xcf\xab\x16\x1e\xd0\x8c\xa2\xb9\x8f\xa8\x81\x5b\x8f\x20\x15\x2d\x21\x85\xdc\x62\xd6\x78\x3c\x6f\xf3\x13\x90\x0a\x96\x0a\x31\x83\xfb\x35\x8a\x76\x13\xf9\xe2\x0c\x73\x34\x98\x4d\x7a\x86\x5d\xe0\xd2\x2e\x38\x86\x60\x15\x9a\x4a\x09\xcc\xc2\x12\x57\x4a\x99\x77\xf6\x8b\xcf\x27\x7a\x0a\x99\xf5\xc8\x93\x3e\x19\x2e\x59\x95\x9b\x39\x08\x29\x30\x02\x35\x46\x70\xf4\x5c\xc9\x0c\xe1\x5e\xaa\xcd\x32\x97\xf7\x50\x7e\x70\x93\xc5\x4d\x1b\xc3\xc5\x06\xd5\x02\xf3\x1c\xd6\x52\x6e\x40\x6a\x5a\xa3\x69\x92\xd2\x42\x55\x77\xba\xe7\x25\x79\x2d\x96\xe7\x90\x71\xbd\xd1\x13\x50\x98\x2d\xb9\x5e\x37\xab\x07\x8b\x50\xa0\x31\xad\x14\x02\x2a\xa6\xad\x23\xb4\x78\x14\xdf\xa2\x86\x2d\x67\x96\x90\x1f\xae\x2e\x6c\x78\x60\x99\xf6\xb2\x6e\x2b\xb8\x25\x74\xb8\xe7\x66\x2d\x2b\x13\xa8\xb2\x14\xf5\x8e\x8e\xa2\x2a\xfa\x65\x3d\x8d\x0b\x79\x5a\x8b\x20\x02\xe2\x45\xd1\x0b\x31\x30\x47\xe8\x43\xcb\xba\xf9\xe1\xf2\xdd\xed\x7c\x9c\xbe\x6f\x02\x3c\x05\x0a\x68\x34\x2c\xb8\xd4\xa0\xd1\x18\x2e\x56\x21\x08\xe3\x2a\x98\x92\x4e\x3a\x10\xfa\x87\x14\x10\x94\x29\x45\x8a\x4e\xc1\xb0\x66\x5b\x84\x05\xa2\x20\x29\x63\x36\xc0\xdc\x42\xca\x1c\x99\x48\x3a\x00\x08\x86\x17\x28\x2b\x33\xc2\xe2\xbf\x5e\x8f\xe3\xff\xce\x61\xf4\x2b\xc2\xfd\x9a\xa7\xeb\x96\xad\xb8\xef\x6b\xa6\xc1\x45\x2f\xf5\x6e\xa2\xeb\x09\xde\x24\xa3\x39\xfe\x7b\xc5\x14\xa3\x50\x2c\xc2\xf1\x52\xaa\x82\x99\x39\x64\x95\xb2\x81\xf3\xf9\x7a\x1f\xf0\xe4\xbf\x49\x2e\x7e\x60\x26\x5d\xdf\xf2\x8f\x3d\xee\x62\x9c\x13\xf8\x4b\x1b\x11\xe4\xdc\x86\x7d\x34\xe9\x44\x55\x2c\x50\xd1\x8c\xa4\xb1\x40\xc8\x0c\xc9\xcf\x91\x7b\xc0\x8c\x82\x77\xb9\xb7\x36\x2b\x60\xc6\xda\xc8\x0c\xfe\xd2\xc0\xd3\xa2\xbe\xc6\x3c\x83\x4a\x18\xde\xed\x11\xc1\xa2\x49\x15\x52\x20\x44\xa3\xd0\x5e\x05\x98\xd0\xf7\xa8\x68\x5d\x17\x19\x20\x4b\xd7\xb0\x20\x22\xe1\x9e\x11\x7d\x61\xdb\x54\x2a\xdc\x72\x59\x69\xdf\x68\x24\xa4\xb2\x28\xc9\x6d\x07\xba\xac\x16\x66\x49\xe7\xb8\xf0\x1c\x58\x66\xf9\x25\x9f\xd3\xe2\xd2\x73\xd2\xd9\xab\xe0\x82\x17\x55\x31\x87\xe7\x9d\xcd\x4e\x6d\xb4\xb5\x59\x75\x06\x1b\x1b\x54\x02\xf3\x97\x6a\xa5\x47\x28\xed\xa7\x1a\xd8\x46\x47\xac\x2c\x51\x64\xcd\x22\xe3\x70\x11\xcb\x14\x41\x41\xce\x7b\x9d\xd5\x61\x1c\xe5\xf8\xfc\xe2\xb1\x50\xc1\x3e\xf8\xa8\x8f\x8b\xd5\x5b\x22\xe1\x21\x96\x7b\x75\x84\xad\xdb\x7c\x2d\xaf\x81\x77\xbb\x52\x39\xf5\x4e\x48\x8e\x6c\x2b\x79\x06\x9a\x19\x3b\x61\xfd\x2e\x8b\x17\x6c\x85\xa0\xed\xbe\xa9\x67\x70\x12\x78\x55\xe6\x5c\x6c\x66\x70\x83\x05\xe3\x82\x30\x7b\xf3\x51\x18\x9f\x28\x3a\x7c\xb1\xd6\xa9\x6b\xa3\x9d\xc1\x73\xf2\xb0\x6c\x91\xfb\xc0\xd5\xf2\xf3\x39\xcc\x90\x92\x1a\x8e\x3f\x3d\xff\xe2\x66\x20\x4c\x79\x1b\x1b\x7b\x4f\xfd\x6f\xef\xae\xc3\x06\x96\xe6\x80\x55\xad\x29\xbd\x72\x34\x54\xba\xb5\x15\xfa\xa7\x18\x75\xd9\x8a\x87\xaf\x7a\x63\xb7\x7a\x15\x2b\x3f\xe0\x30\xd3\xbd\x36\xdf\x0e\xbe\x69\xb0\xb0\xdf\xd3\xb0\x96\xf7\xde\xfc\xc8\x43\x5b\xeb\xa9\xd3\x8d\x8d\xed\xcd\x6c\x88\x47\x71\x19\xa7\xcd\x91\xeb\x60\x24\xed\xe2\xa5\x0b\x80\x35\x2b\xfa\xbc\xc8\x9b\xaf\x41\xe3\xaa\xa0\xfc\x00\xd3\xa0\x0b\xc4\x09\xdc\xaf\x79\x8e\xb0\xe5\xca\x54\x2c\xbf\xc2\x8c\x33\x28\x64\x25\xc8\xa7\x0a\xb8\xbc\x7d\xd7\x8e\xe1\xac\x2f\x17\x88\x19\x0d\x0c\xaf\xfe\xeb\xe2\x3a\x39\x2d\x28\x9b\xf6\x4a\x6f\xba\x47\x42\x72\x86\x9a\x4b\x25\x3f\xec\xe6\xc3\xaa\xb9\x26\x38\xe0\x1d\x96\x67\xd9\x6b\xef\x21\x8c\x04\x45\x4b\x57\x27\x52\x00\xca\x51\x2b\xc1\x72\x10\x68\xee\xa5\xda\xe8\x33\x37\x0c\x94\xec\xba\xee\xa7\x7e\x04\xef\x01\x8b\x7e\x38\x1a\x21\x07\x70\x44\xa7\xe2\xc8\x41\x86\xa6\xe4\x60\xf4\xa4\x98\x48\xd7\xa8\x6e\x70\xc5\xb5\x21\xc7\x3f\x6a\x07\x7a\x73\xdc\x0b\x78\x51\x4a\x65\xf4\x9e\xde\xad\xcb\xf7\x43\x74\x62\x05\xbb\xfe\x84\xad\x63\x25\x44\x1f\xab\xc3\xca\xf7\x63\x52\x22\xbf\x0f\xe4\x80\x0b\x9f\x5d\xa2\x1e\x75\x7e\xc5\x32\xd1\xca\x22\x71\x11\xe8\xef\xdb\xfd\xd2\xe3\x42\x69\xef\xd9\xea\xec\x6e\xc0\x21\xc2\xd9\x82\x9d\x17\xa2\xdf\xad\x8c\x52\x39\x17\x6e\x9f\x78\xbb\xe1\xe5\xdd\x9b\xdb\xf7\xa8\xf8\x72\x37\x92\xe3\xcb\xae\xbe\xa0\x37\xbc\xd4\x74\xe8\xc1\x97\x3c\xad\xb3\xdc\x66\xdd\x4f\x66\x6d\x37\x7e\xf5\x81\x94\x74\x63\x7b\xe3\xc3\xf6\x40\x74\xa8\x71\x8b\xa9\x42\x73\x83\xcb\x91\x5c\xdd\xed\x75\xf2\xc9\x63\xfb\xd5\xee\x81\x81\xd5\xd4\xb2\x92\xf7\xa2\xf4\x83\x53\xd6\x8d\x2c\xc1\x7d\xd9\x60\xf7\xac\x1a\x67\x92\xf5\xa1\x49\xa4\xfd\x80\x17\x02\x27\x0e\x2a\xc1\x7f\xaf\xd0\xd2\x6f\xd3\x80\x8d\x0d\xd1\x8c\xc2\x25\x2a\xec\x8b\xcb\x9b\xbf\x5a\x0c\x21\x4f\xde\x17\xfe\x8f\x36\xbe\x3a\x62\xa2\xa3\xb2\x13\xd9\x72\xe4\xef\xa5\x7c\xed\x2f\x9e\x47\x3b\x87\xa2\x18\xdd\xfe\xc8\xb3\x44\x54\x40\x51\x69\xda\xb9\x7a\x69\x3d\x02\x77\x03\xde\xd2\x7d\x3e\x4c\x37\xd5\x82\x76\x1c\x06\xf5\xb4\x60\xe5\xd4\xfb\x60\x23\x0b\x9e\xf6\xf4\xaa\x54\x3e\x4f\x46\x89\xea\xe7\x9b\x37\xc1\x1b\xed\x4f\xb2\xe4\x6c\xce\x7c\x94\x93\xcd\x3b\x5b\xa7\x07\xb3\xae\x07\xa8\x52\x79\x72\x86\xc8\x94\x5b\x59\x76\x57\x5c\x29\x39\x2a\xce\xbd\xd9\xef\xd1\xa4\xb0\xe9\x5f\x86\x71\x81\xca\x6e\xfc\xca\x2a\xcf\xdd\x06\xa5\x3b\x5e\x00\x12\x63\x55\x6a\xa3\x90\x15\x81\x0e\x8e\xfe\xbc\xa7\x70\xf4\x24\x27\xaf\xcc\x11\x5a\xf7\x8c\xdb\x0d\x50\x9f\x29\xe9\x16\xf5\x3d\x98\xc1\xb2\xa4\x3d\x4f\xfe\xa4\xf1\x90\x81\x9d\x25\x3f\x39\xdf\x25\xa5\x0a\x33\x3a\x20\x62\xb9\xae\x55\xde\x0f\x7d\xc0\xef\x45\x47\xe7\x63\x87\x4b\xb6\x5b\x69\x54\xd1\xa5\x2e\xec\x1d\x4b\xa6\xf5\xbd\x54\x19\x6c\x70\xe7\x63\x49\xda\x8d\x56\x66\x4d\x54\xd2\x8a\xd2\x20\x75\x32\x8d\xa0\x1c\xe7\x93\xc7\x78\xe5\xcf\xe9\x97\x4f\xf6\xcc\x23\xe6\x78\x78\x6a\x82\x4e\x66\xef\x11\xfc\xf3\xc3\x3c\xf4\x68\x2e\x47\x79\xe9\xf3\xfc\x34\x34\x13\x76\x9e\x8c\x14\xde\xeb\x7a\x8a\x87\x7d\x79\xa5\x72\x1d\x9c\xb8\xb3\xd9\x09\x18\xc5\x23\xe9\x52\xfa\x70\x01\x52\xf5\x9d\xcc\x8d\xf0\x4c\x67\xc8\xb0\x7f\xdf\x70\x46\xa8\x79\x7e\xb0\x19\x41\x09\x2d\x21\x8e\x0a\x33\xc7\x05\x9a\xcd\xc2\x34\x9a\x9f\xe0\xeb\x43\xe1\xca\x91\x67\x9e\x80\xae\xd2\x35\xb0\xbe\xe5\xc8\xe3\x94\xe9\x06\x95\x2b\x7a\x7a\x80\x0e\xe3\x6b\x3a\x9d\xc9\xd4\x86\xdc\x0b\x11\x08\x4f\xce\x9c\x66\x71\x1b\xd2\x7a\xfd\x13\xee\x7a\xac\x35\x6a\xc8\x83\xec\x0f\x0c\x6c\x57\xfc\x4b\x5a\x45\xfb\xd3\x50\x43\x46\xa2\x77\x3a\x35\xf9\x97\x38\xa9\xde\x33\xb2\x5b\x37\xac\xcd\x72\xfb\xac\x76\xc9\x14\x2b\xd0\xd6\x44\x69\x34\x21\x3b\xd5\x9f\xb7\x1e\x50\x5b\x3b\x3d\x34\x1f\x26\xa8\x37\x01\xf7\xbe\x85\xe7\xa8\x98\x83\xf2\x5d\x36\xfb\x85\xd9\x5e\xde\xcb\x9e\x2e\x57\x54\x8d\xb7\x9f\x29\x6b\xa7\x0e\xed\xb9\x6f\xdf\x4a\x71\xe7\x91\xbb\xe4\x99\x91\x4d\x8e\xaf\xce\xec\xb9\x70\xa1\x4e\xf9\xcd\x7c\xc3\xcc\xc5\x91\x7f\xab\x54\x4e\x47\x80\xb4\x5e\xcf\x2e\x42\x15\xc0\xc4\x6f\xe1\x6d\xa4\xdd\x37\x7f\x69\x94\x12\x95\x4d\x2e\x36\xf8\x7d\x7c\xda\x9c\xa9\xfb\xca\x8b\x30\xfd\x92\xf3\xe2\x14\xae\x65\x6f\x75\xc2\x78\x25\xd1\x73\x79\xfb\x8e\xf6\x14\x14\xa5\x09\xc0\x0f\x65\xce\x53\x6e\x80\xc4\x20\x15\x30\x58\x49\x30\xbe\x78\x21\x48\x81\x72\x31\x74\xbe\xa2\x30\x6b\x18\xb6\x52\x9d\x39\x64\x93\x3d\xd1\xcd\x28\x83\x12\x4b\x8f\x58\x00\x1b\x4c\x4c\x60\xe6\x8b\x0c\x27\x30\x7b\x8b\xa6\x60\x7a\x33\x81\xd9\x8f\xcc\xe0\x3d\xdb\x4d\x60\x76\xf5\xf2\xa2\x01\x78\x9f\x33\x71\xf9\x6a\x02\xb3\x50\xb0\x47\xf1\xe2\xec\xa5\x4a\xd7\xb1\x40\xe2\xd5\x41\x1a\x86\x6c\x91\x51\x7a\x82\xa5\x75\x1d\x8d\x4f\xcd\xf4\x62\x19\xe9\x81\xfa\x27\x99\xa5\x7c\x20\x01\xfe\x22\x7e\x9e\xf1\xe2\xac\xf3\x0c\x3a\x87\x7e\x45\x67\xd3\xe7\xb9\xbd\x7b\xc5\x0d\xfe\x27\xcf\x51\x8f\xf0\x0d\xff\x5d\x03\x5b\x7f\x45\x7d\x0d\x55\x8f\xc8\xc6\x43\xb9\x7a\x92\x7a\xb2\x74\xe2\x04\x5a\x51\xc3\xf4\xcd\x4e\x5f\x2e\xba\x69\x22\xa4\x0c\x96\x3c\x7f\x08\x61\x23\x48\x1b\xb7\xe9\xa0\xcd\x1f\x8a\xc8\xe1\xfe\x08\x9b\xa3\x0f\x8a\x54\x66\x5c\xac\xe6\xbd\x10\x07\xf2\x78\xed\x3b\xd4\x96\xef\x08\x89\x74\x8f\x97\x82\x50\x64\xb1\xf8\xd3\xb7\xf1\x76\xa6\x71\x00\x64\xf5\x71\xa0\x99\x97\x03\x00\x5f\x8d\x1a\x85\x97\xe3\xe0\xbe\x1a\xe2\x69\xf5\x91\x97\x03\x40\xa3\x14\x28\xef\x05\xaa\xd1\xda\x7b\x47\xd0\x41\x75\x64\xcb\x93\x90\x9f\xa4\xfd\xf5\x7c\xa5\x64\x55\x52\xe9\x74\xc1\xcc\x43\x09\x2b\xa9\x58\x7c\x2c\x5d\x54\xef\x1e\xe2\x60\xb6\xd0\x32\xaf\x0c\x5a\x0c\x6d\x5a\x1f\x4c\x11\x2a\x5f\xcc\xa6\xc7\x13\xd6\xf4\x69\x93\x42\x52\x93\xa9\xa1\x23\x26\xff\x36\x43\x6c\xbd\x82\x10\xc8\xc3\xf3\x3f\x7d\xfb\xed\xe7\x8e\xd3\xbd\x6b\xe8\x6d\x27\xb1\xf6\x34\x0e\xac\x42\xf1\x40\x39\xd2\xd9\x66\xa0\x3a\xc3\x8f\x08\xc7\x75\x65\x51\xa8\x85\xec\xab\xa4\xed\x75\xe6\x63\x62\x9a\xcb\xee\x51\xf6\xa2\xcf\xa6\xc6\x29\x44\x36\x9d\xa8\x28\x9a\x40\x82\xac\x58\x9e\xef\x60\x85\x54\x50\xcc\xcc\x11\x12\x27\x22\x0a\x9f\xb2\x26\x19\x49\x8b\x4b\x2c\x8a\x70\x41\x2e\xd9\xdf\xca\xa3\xcd\x5a\x28\x09\x15\x73\x71\x8e\x2b\xd6\xab\x53\x47\x3a\x39\x7d\x81\xf1\x98\xae\xa5\xcc\x6f\x02\x9e\xf9\x03\x16\xab\xa1\xec\xd8\x08\xb3\x1f\x99\x88\x7a\x94\x09\x14\x49\x33\x4e\x1b\x32\xce\x9d\x42\xfc\xd0\xe0\x6e\x8f\xca\xde\x4f\x93\x6e\x2b\x01\x3b\xda\xad\x45\x03\x7c\x80\x3a\x17\xeb\x74\xdf\x7a\x05\x02\x98\x6f\x84\xba\x75\x06\x97\xc6\x96\xfd\xa1\x90\xd5\x8a\x2a\x02\xdd\x02\x42\x01\xb6\x3d\x5c\xa2\x4c\xd5\x36\xa4\xf1\xa2\xe3\x52\x0a\x54\xec\x06\x65\x3c\x56\x32\x63\x6c\xef\x8f\xcc\xec\xbf\x6c\x66\x76\x5b\xc4\xa6\xde\x29\x46\x96\x52\x6a\x22\x0e\x32\xb8\x4f\x3b\x2e\x75\xe5\xc2\x7c\xf3\xf5\x00\xec\xd0\xf6\xad\xf9\x4b\xcb\x6a\x34\x85\xdf\xfd\x53\x28\xcc\xfa\xb7\x99\x23\x16\xfb\xf3\x34\xd7\x8c\xfc\x43\x35\x0a\xb4\x25\x25\xca\x38\x45\xd3\xae\xe3\xf7\x40\xcd\xdf\xf4\x14\xb4\x53\xaa\xaf\x64\xc9\x20\x9c\x03\x4d\x35\x4f\x06\xe1\xc6\xcf\xcd\xf0\xa7\x7b\xeb\xa4\x0f\x1f\x26\x76\xef\x22\x45\x18\xed\x67\x7a\x82\xdd\x1c\xf6\x19\x4d\x39\xd0\x16\x83\x4a\xc5\xe6\xf0\x3f\x4f\xfe\xfa\xd5\xa7\xe9\xd3\xef\x9f\x3c\xf9\xe5\xf9\xf4\xcf\xbf\x7e\xf5\xe4\xaf\x33\xfb\x8f\xff\x78\xfa\xfd\xd3\x4f\xe1\xcb\x57\x4f\x9f\x3e\x79\xf2\xcb\x4f\x57\x3f\xde\x5d\xbf\xfe\x95\x3f\xfd\xf4\x8b\xa8\x8a\x8d\xfb\xf6\xe9\xc9\x2f\xf8\xfa\xd7\x91\x48\x9e\x3e\xfd\xfe\xdf\x47\x91\xb7\xe7\xd7\xb8\x30\x53\xa9\xa6\x8e\xbb\x39\x18\x55\x0d\xaf\x3e\x94\xbc\x96\x8a\xad\xf0\x22\x67\x5a\xcf\x1f\x5f\xfd\x43\xd1\x54\xf3\x37\x0d\xb3\x6c\x04\x24\x99\xd4\x18\xb0\x16\x6f\x83\xe0\x23\x97\x92\xa1\x5d\x4e\xfb\x8f\x8b\x15\x45\xdc\x76\xfc\x78\x9d\xd8\x81\xe7\x10\x2b\x2e\x3e\x24\x8f\xa4\x86\x02\x0b\x19\x3f\x76\x1a\x3d\xf7\x4e\x9b\x75\x27\xcd\xb7\x9a\xf7\x6f\xbe\xfe\x91\x27\xff\x4f\x67\xe5\x83\xe6\xe3\x09\xf1\x9a\x17\x95\xff\xc7\x63\x19\x4a\xa8\x86\x7d\xa4\x25\xf6\x94\x0d\x45\x78\x61\xd7\x12\xe0\x77\xd8\x2c\xcf\xe5\xbd\xad\xd0\x50\x36\xb7\xee\xe2\x51\x78\x7f\xe5\xc1\x42\xf9\xb4\x2d\xe1\xa0\xd0\x14\x04\x4f\xed\x82\xa0\x96\x2c\xb5\xef\x21\x8e\x18\x93\xa2\xdb\xd6\x1b\xc0\xa4\x3f\x5a\x60\x61\x5b\x3c\x72\x0c\x21\x78\x4a\xa7\x83\x91\xb2\xac\x4e\x1d\x7f\xa6\x20\x02\x5f\x3c\x7f\xfe\x3c\x19\x04\x6c\x60\x87\xfd\x2d\x3d\x53\xe0\xab\xc5\x48\x48\x81\x5f\x6f\xfe\x56\xa6\xe3\x62\x8e\x29\x94\xa9\x40\x33\x12\x56\x99\xfc\xbb\x17\xdf\xfc\xf9\xf1\x03\xaa\x13\x1c\x1a\x7d\xb6\x85\xb7\xd5\xf9\xe3\x63\x3f\x65\x65\x0d\xb6\x37\x02\xb4\x26\xf9\xcb\x2f\x98\x63\x38\x9a\xba\xbd\x54\x1c\xa2\xac\xa2\xed\xf1\x37\x53\x69\x8c\xc3\x85\x3b\x0a\xec\xd6\xd7\x28\x48\xed\xda\xe3\x50\xde\xaf\x25\x0f\x92\xf9\x90\x14\xa7\xed\x84\x50\x2f\x8c\xdb\xfb\x26\x67\x52\x11\x4b\xaa\x0c\x18\x79\x8c\xfc\x69\x67\xe6\xb1\x13\xb0\x33\x8b\x96\x9c\x90\xcd\x8b\xf2\xd8\x6f\xcf\xfe\x5a\x97\x79\x72\x02\xdb\x5b\x5e\x9e\x79\x09\xc4\xe8\x3c\xec\xf0\x52\x15\xcf\x83\x0d\x28\x6d\x64\xf8\x32\x88\x25\x6e\xbb\x91\xcc\xeb\xd0\x14\x1b\xb0\x58\xba\x0b\x84\xa7\xbe\x10\x60\x9e\x9c\x4c\x7b\x3f\xdd\x23\x4d\xb6\x97\xbe\x6e\xcc\xd3\x70\x14\xe0\xec\xe6\xa0\x2d\x9c\xa6\x24\x03\x53\xa2\xb3\xb3\x37\xe0\xc3\x5f\x79\xd9\x01\xdd\x43\x35\x49\xf3\x30\x59\xd2\xf5\x22\x8c\xbb\x9c\x6a\x2f\xcf\x28\x17\xb6\x22\x26\x6b\x6e\x67\xf1\xb0\xc9\x38\x6b\x66\x59\xd6\x79\x7a\xb7\x37\xfc\x4b\x0b\x44\x49\xeb\xfa\x05\x22\x85\xba\xca\x6d\xb5\x06\x2b\x4b\x77\x17\x0c\xbd\x3f\x16\xc4\x4c\x4a\x3c\x90\x49\x34\x10\xee\xe2\xd6\x8e\xea\x59\x1e\x18\xba\xb3\x52\xc2\x33\x17\x8a\x0a\x3c\x65\xc9\xe9\x73\x3d\x67\xda\xbc\x52\x7c\x69\x2e\xa4\x52\x98\x9a\x2e\xb3\xed\x60\xe3\xcd\x51\xb7\x70\x20\x4b\x08\xed\x6d\x02\xde\x14\x34\x44\x6a\xa6\xeb\xdb\x10\xd2\x35\x13\x2b\xfb\x3e\x7b\x9b\x1f\xb8\x47\xfb\x0e\xef\x94\x84\xd1\x5f\xc0\x3a\xe8\x49\x88\xa6\x9f\xdd\xed\x48\xf3\x73\x71\x14\xa8\x35\x5b\xe1\xd9\xfd\x1f\xb0\x0a\x42\x3d\x13\x7e\x74\xa7\x79\xbd\xef\xc9\x1d\x68\xe9\xdd\x51\xb7\xa0\x25\x7f\x2c\xd8\xbc\x6d\xd5\x63\xd4\xe1\x21\x09\x42\x5c\x0b\xad\x34\x6f\x6f\xa1\xc2\x70\xc2\xa0\x5c\x33\x7d\xae\x9c\xfa\x1d\x6f\x64\xb9\x98\xba\x21\x3b\x5a\x7a\xdd\x70\x7c\xd1\xf7\xb6\xdb\xbb\x7e\x44\x98\x70\xb7\x2f\xd0\x3b\xf4\x37\xc8\xb2\x8e\x2c\xcd\x9e\x76\x2f\xf6\xa1\x49\xb5\x54\x5a\x68\xdf\x69\x8c\xdd\xe5\x70\x84\x95\xf2\x3d\x59\xfb\xe2\x85\x94\x09\xda\x4b\xdb\x5b\x19\x4c\x87\xc2\x63\x75\x5c\x84\xe1\x06\x0b\xb9\xed\x3c\x61\xdc\x63\xe0\x6d\x0b\x74\xcf\x01\x97\x4a\xda\x90\xbb\xb9\x36\x60\x81\xe4\x85\xfd\x0d\x42\x47\x58\xe1\xe8\x4e\xa1\x33\xbd\x73\x4f\x62\xa2\x45\xa8\xf7\xd9\x46\xb1\x74\x43\x87\xf2\x23\xee\x37\x9a\xc1\xdb\xfa\x3e\x82\x54\xaa\x4c\xd2\x05\x45\x24\xf1\x4c\xd1\x1b\x39\xd9\x04\x72\x29\x56\x6b\xa9\x04\x09\x21\xe7\x69\x4f\x0d\x37\xf5\xc7\x2d\x27\x1f\x5d\xbf\xe3\xdc\x64\x98\x9c\x9e\x5b\xf7\x25\xd5\x30\x05\x4b\xd7\x5c\xf8\xb6\xe6\x8e\x26\xcc\xf6\xef\x4e\x2a\x25\x79\xdb\x0c\xe4\x72\xd9\x71\x07\xa0\x57\xfc\x5e\x69\x82\xbd\xbd\x0e\x31\x3b\x63\xd9\xa9\x91\x0c\x84\xaa\xc3\x98\x86\x7c\xeb\xc0\x94\x3b\x29\x64\x1d\x85\x29\xe6\x85\xa2\x9e\x68\x4c\xe8\x3a\xe0\x95\xfe\xcf\x2c\x76\x32\xc3\x58\x8e\x7b\x10\xc1\xe7\x5b\x06\x8e\x2d\xef\x4b\x2c\x0a\x34\x39\x6f\xb0\xcc\x59\x8a\x74\x71\xc3\x90\x67\xec\xf4\x43\x6f\x0f\x70\x04\x27\xa4\x4b\xf2\x0c\x35\x5f\xd6\x89\xb0\x94\xfc\xe5\x92\xf1\xfa\x96\x99\x89\xbb\xfb\x27\x1c\xe0\x53\x0f\x6d\xb3\xaa\xf4\x3e\x4b\x66\x7f\xe6\x36\x11\x60\x76\x49\xe7\xcb\x8c\x04\xd1\x42\x78\xa6\x87\x3d\x60\xa2\x0e\x81\xc9\x31\xea\x3d\x2a\x20\x65\xaa\xf7\xad\x25\x49\xef\x7d\x5b\xa7\xcf\x02\x51\x8d\x00\x7c\x50\xec\xe4\xd2\x48\x83\x9b\x33\x1c\x95\xdf\xb0\x75\x37\x0e\x9a\x22\x78\xe2\xea\xba\xb1\x3f\xbc\xdc\x23\x79\xb9\xb5\xd4\x26\x26\x90\x41\x16\xac\x75\xfc\xa1\x97\xc7\xd5\xcb\xa8\x7c\x5d\x67\xdb\xc1\x3c\xe9\x84\x09\x3a\xef\x6c\xdc\xd7\x67\x72\x22\xe9\xfd\x9e\xdb\xbf\x06\x7f\x69\xef\xe6\x98\x27\x51\xe7\x76\xd3\x86\xed\x8d\x68\xdd\x5d\x25\xa1\x40\xb3\x2f\x60\x85\xf8\xfd\x25\x71\xc3\xf4\x48\xfb\x5f\x02\x39\xce\x48\x5c\xbe\x0a\x4e\x3e\x72\x0d\x49\x72\x86\x55\x0e\xc6\x23\x03\xfd\xa3\xb1\xc8\x40\xdf\x48\x18\x11\xed\xd9\x6f\xc7\x7d\x91\x41\xc4\xba\xba\x32\x60\x03\x14\x84\xca\x61\x9b\x99\x99\x27\x51\xfd\x75\xc6\x0a\x21\xab\x67\x11\xd8\xbb\xeb\xb5\x2f\x47\xc7\x3c\xd3\xe1\x40\xb1\x63\xb3\x52\x17\x2d\xc3\xfd\x5a\x6a\xf4\x17\x60\x0b\x69\xf7\x28\xa8\xa0\xa0\x1b\xfe\x5c\x7e\xae\x63\xd8\xd0\x79\x56\x13\xe0\x33\x3b\x6e\xf7\x23\x64\x9d\x44\xa0\xcc\x15\x7e\xe0\x9a\x2e\xc3\x0c\x63\xeb\xf1\x31\x45\x54\x7b\xfd\x93\xd9\xde\x35\x31\x4f\x4e\xc0\x66\x64\x29\x73\xb9\xda\xbd\xe7\x32\x6f\xff\x8f\x08\x7a\xd5\x71\x77\xd4\x61\xcf\x11\x84\xd7\x7f\xe8\x4d\xb7\x4c\xd2\x0b\x01\x54\x95\xc5\xf5\x72\x97\x74\x6f\xb7\x02\x01\xa0\x4b\x85\xcc\xd6\x80\xd3\xad\x47\x9d\xaf\xd6\x3e\xae\xb8\x3a\x4d\xfa\xe8\x47\x97\x61\x6a\x55\x34\xf8\x3a\x9b\xf6\x2f\xd5\x22\xd4\xb3\xd6\xd4\x69\xc3\x4c\xa5\xe7\xf0\xf7\x7f\x24\xff\x3b\x00\xdb\xcc\xd6\x07\x38\x62\x00\x00")
 
 func chartSeederCrdTemplatesMetalHarvesterhciIo_nestedclustersYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -283,12 +371,92 @@ func chartSeederCrdTemplatesMetalHarvesterhciIo_nestedclustersYaml() (*asset, er
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "chart/seeder-crd/templates/metal.harvesterhci.io_nestedclusters.yaml", size: 9710, mode: os.FileMode(420), modTime: time.Unix(1777254817, 0)}
+	info := bindataFileInfo{name: "chart/seeder-crd/templates/metal.harvesterhci.io_nestedclusters.yaml", size: 25144, mode: os.FileMode(436), modTime: time.Unix(1792439149, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _chartSeederCrdTemplatesMetalHarvesterhciIo_referencegrantsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x4d\x8f\x1b\x37\x0c\xbd\xfb\x57\x10\xe8\x75\x6d\x63\xd1\x4b\x31\xb7\xd4\x69\x8b\x45\x9b\x22\xd8\x0d\x72\x97\x35\xb4\x87\x8d\x46\x9a\x92\x1c\x6f\xb7\x1f\xff\xbd\xa0\x34\xfe\x1a\x7b\xb2\x9b\x45\x51\xc4\xe3\x8b\x28\xe9\x91\x7a\x7c\xa4\x34\x9f\xcf\x67\xae\xa3\x8f\xc8\x42\x29\x56\xe0\x3a\xc2\x3f\x14\xa3\x8d\x64\xf1\xe9\x3b\x59\x50\x5a\xee\x6e\x67\x9f\x28\xd6\x15\xac\x7a\xd1\xd4\xde\xa3\xa4\x9e\x3d\xbe\xc5\x0d\x45\x52\x4a\x71\xd6\xa2\xba\xda\xa9\xab\x66\x00\x2e\xc6\xa4\xce\xcc\x62\x43\x80\xbf\xfe\x99\x01\x44\xd7\x62\x05\x8c\x1b\x64\x8c\x1e\xb7\xec\xa2\xca\xc2\xf6\x85\x45\xe3\x78\x87\xa2\xc8\x8d\xa7\x05\xa5\x99\x74\xe8\x6d\xeb\x96\x53\xdf\x55\x70\x7d\x51\x81\x1c\x5c\x94\xf0\xee\xf7\xe8\x3f\x19\x7a\x9e\x08\x24\xfa\xf3\x95\xc9\x5f\x48\xca\x82\x2e\xf4\xec\xc2\x45\x64\x79\x4e\x28\x6e\xfb\xe0\x78\x3c\x3b\x03\x10\x9f\x3a\xac\xe0\x57\xd7\xa2\x74\xce\x63\x3d\x03\xd8\x15\x12\x73\x48\xf3\xe1\xc0\xbb\x5b\x17\xba\xc6\xdd\x16\x3c\xdf\x60\x9b\x39\xb2\x51\xea\x30\xbe\x79\x7f\xf7\xf1\xdb\x87\x33\x33\x40\x8d\xe2\x99\x3a\x63\xb0\x82\xbf\xe7\x07\x3b\x8c\xce\x00\x2e\x84\xf4\x28\xe0\x43\x6f\xe4\xc9\x0d\x50\xdc\x61\xd4\xc4\x4f\x37\xb0\x6e\x3d\xd4\x24\x3e\xed\x90\x09\x05\x5c\xac\xc1\xd5\x35\xa3\x08\x74\x29\x05\x01\x8a\x90\xb4\x41\xce\x91\xe6\x43\x08\x68\x3a\x1e\xf5\xc4\xef\xd9\xc6\x13\x37\x19\x55\xd0\x33\x6a\xc6\xd3\x06\x8f\x68\x90\x36\xd9\xb0\xa7\xac\x7c\x1d\xa7\x0e\x59\x69\x9f\xb9\xc1\xc1\x51\x82\x27\xd6\xcf\x51\x61\x9f\xb1\x57\x38\x87\xda\xb4\x88\x92\x1d\x0e\x79\xc0\x7a\x20\xbc\x04\x42\x02\x8c\x1d\xa3\x60\x2c\xea\x34\xb3\x8b\x90\xd6\xbf\xa1\xd7\xc5\x08\xfa\x01\xd9\x60\x40\x9a\xd4\x87\x1a\x7c\x8a\x3b\x64\x05\x46\x9f\xb6\x91\xfe\x3c\x60\x67\xca\xcc\x69\x70\x8a\xa2\x40\x51\x91\xa3\x0b\xb0\x73\xa1\xc7\x1b\x23\x68\x84\xdc\xba\x27\x60\x34\x9f\xd0\xc7\x13\xbc\xbc\x41\xc6\x71\xbc\x4b\x8c\x40\x71\x93\x2a\x68\x54\x3b\xa9\x96\xcb\x2d\xe9\xbe\x30\x7d\x6a\xdb\x3e\x92\x3e\x2d\x7d\x8a\xca\xb4\xee\x35\xb1\x2c\x6b\xdc\x61\x58\x0a\x6d\xe7\x8e\x7d\x43\x8a\x5e\x7b\xc6\xa5\xeb\x68\x9e\x0f\x12\xed\xf8\xb2\x68\xeb\x6f\x78\x28\x65\x39\x73\xab\x4f\xa6\x6d\x51\xa6\xb8\x3d\x99\xc8\x45\xf6\x05\xe9\xb1\xba\x03\x12\x70\x03\x54\xe1\xe4\x98\x05\x33\x19\x75\xf7\x3f\x3c\x7c\x80\x7d\x24\x25\x53\x25\x29\xc7\xa5\x32\x95\x1f\x63\x93\xe2\x06\xb9\xec\xdb\x70\x6a\xb3\x06\x30\xd6\x5d\xa2\xa8\x79\xe0\x03\x61\x54\x90\x7e\xdd\x92\x9a\x0c\x7e\xef\x51\xd4\x52\x37\x86\x5d\xe5\xe6\x05\x6b\x84\xbe\xab\x9d\x62\x3d\x5e\x70\x17\x61\xe5\x5a\x0c\x2b\x27\xf8\x3f\xe7\xca\xb2\x22\x73\x4b\xc2\x8b\xb2\x75\xda\x92\x8f\xbf\xb2\xb8\xd0\x7b\x32\xb1\xef\xb8\x2f\x4d\xed\x79\x23\x7a\xe8\xd0\xef\x9b\x51\x81\x7e\xbe\xbb\x9c\x2e\x9c\x6e\x1b\x8b\x29\xbf\x19\x6c\x04\x21\xae\x3d\xc5\x71\x8c\xe0\xc2\xa3\x7b\x92\x12\x1b\x9e\x57\xe2\xf5\x46\x64\x9f\x89\x68\x6c\x1b\x11\xf2\xa3\xe9\xcc\xee\x96\xd2\x71\x72\x52\xac\xd6\x8f\xee\xc5\xda\xcb\x3e\xc0\xc7\x86\x7c\x73\x81\x08\xe0\x5d\xfc\x52\x46\x2e\x50\x48\xb1\xbd\x38\xc2\xe7\x0f\x38\x55\xce\xc7\x1f\xc6\xfe\x0a\x05\xe5\x3f\x87\x55\xb9\x70\x26\xe7\xef\xf6\xf7\xc3\xe4\x8a\xef\xdf\xad\xde\x0e\x97\xd3\xf4\xa2\x37\xe5\xd6\x79\x9f\x52\x98\x58\x33\x21\xfd\xe3\x77\xe0\xb0\x7a\x1d\x82\xb5\x0a\x62\xbc\xca\xd3\x3c\x33\x78\x75\xe2\xe0\xf6\xca\xec\x44\x05\x0e\x45\x4b\xf1\x2e\xe7\x13\x6e\x2f\xe6\xca\x46\xc7\xec\xc6\x94\x69\x7a\x46\xae\x1f\xd2\x85\x58\xd3\xe6\x39\xbd\x5d\x40\xda\x83\xcc\x9e\x1d\x59\xcd\x59\xbb\x6b\x3c\xca\xb7\xfe\x1a\x94\xf9\xbc\x64\x5e\xa2\xce\x87\xfc\xa2\x79\x9d\x62\x86\x97\xee\xc4\xe6\xb3\xac\xd8\xe3\x11\x02\xe5\x3b\xe9\x50\xdf\xd6\xd7\x5c\x7e\x79\x86\x7d\x47\xc8\xaf\x08\xeb\x61\x13\xa0\x70\xc8\xe4\xd0\x28\x4c\x97\x97\xf9\x01\xda\x80\x5d\x6e\xd6\xeb\x69\x43\x58\xbf\xee\x80\xaf\x2a\x89\xff\x5c\xf4\xd7\xa3\x98\xe7\xde\x3d\x32\x69\x9a\x3d\x1b\xca\x85\x51\xec\x7d\x51\x57\xa0\xdc\x63\x31\x68\x62\xb7\xc5\x0a\x94\x7b\x9c\xfd\x3b\x00\xd7\x11\x75\x80\x37\x0d\x00\x00")
+
+func chartSeederCrdTemplatesMetalHarvesterhciIo_referencegrantsYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_chartSeederCrdTemplatesMetalHarvesterhciIo_referencegrantsYaml,
+		"chart/seeder-crd/templates/metal.harvesterhci.io_referencegrants.yaml",
+	)
+}
+
+func chartSeederCrdTemplatesMetalHarvesterhciIo_referencegrantsYaml() (*asset, error) {
+	bytes, err := chartSeederCrdTemplatesMetalHarvesterhciIo_referencegrantsYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "chart/seeder-crd/templates/metal.harvesterhci.io_referencegrants.yaml", size: 3383, mode: os.FileMode(420), modTime: time.Unix(1792439149, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _chartSeederCrdTemplatesMetalHarvesterhciIo_seederclustersYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x3b\x5b\x73\xdb\x36\x97\xef\xfc\x15\x67\x66\x1f\x3f\x49\x49\xda\x4e\x67\x87\x6f\xa9\xd3\xdd\x75\x1b\x27\x1e\xcb\xcd\x3e\xee\x40\xe4\x91\x88\x0a\x04\x58\x5c\x24\x3b\xdd\xfe\xf7\x9d\x83\x0b\x45\x49\x24\x45\xcb\x4d\xd7\xd4\x83\x45\x00\x07\xe7\x7e\x03\x34\x9f\xcf\x33\xd6\xf0\x2f\xa8\x0d\x57\x32\x07\xd6\x70\x7c\xb2\x28\xe9\x9b\x59\x6c\xff\xdd\x2c\xb8\x7a\xb3\x7b\x97\x6d\xb9\x2c\x73\xb8\x71\xc6\xaa\xfa\x01\x8d\x72\xba\xc0\x0f\xb8\xe6\x92\x5b\xae\x64\x56\xa3\x65\x25\xb3\x2c\xcf\x00\x98\x94\xca\x32\x7a\x6d\xe8\x2b\xc0\x9f\x7f\x65\x00\x82\xad\x50\xc4\x17\x85\x70\xc6\xa2\x5e\x3c\xcd\xdb\x0d\x56\x68\xd9\xbb\x1c\x76\xef\x98\x68\x2a\xf6\x2e\x03\x90\xac\xc6\x1c\x0c\x62\x89\x3a\x2e\x30\x0b\xda\x47\x2c\x2a\xa6\x77\x48\x10\xaa\x82\x2f\xb8\xca\x4c\x83\x05\x41\xde\x68\xe5\x9a\x1c\xfa\x27\x05\x88\x11\x83\x40\xce\xd2\x03\xbf\x09\xc0\xfd\x7b\xc1\x8d\xfd\xf5\x7c\xec\x23\x37\xd6\x8f\x37\xc2\x69\x26\x4e\xd1\xf2\x43\x86\xcb\x8d\x13\x4c\x9f\x0c\x66\x00\xa6\x50\x0d\xe6\xf0\x89\xd5\x68\x1a\x56\x60\x99\x01\xec\x02\xc3\x3d\x3a\x73\x60\x65\xe9\xf9\xc8\xc4\xbd\xe6\xd2\xa2\xbe\x51\xc2\xd5\x89\x7f\x73\xf8\xdd\x28\x79\xcf\x6c\x95\xc3\xc2\x58\x66\x9d\x59\x68\x64\xe5\xb3\xdf\x37\x71\xea\xa1\xf3\xc6\x3e\xd3\x86\x2b\xa5\x04\x32\xd9\x03\xa3\xc1\x62\x51\x28\x69\xb5\x12\xf7\x82\x49\xfc\x59\x96\x8d\xe2\xd2\x2e\x2a\x65\xec\x11\xd4\x34\x72\x04\xd8\x58\xcd\xe5\x26\x3b\xcc\xea\x88\x8d\xc8\xad\xb0\xf6\x9a\x40\x13\x54\x83\xf2\xfd\xfd\xed\x97\xef\x97\x47\xaf\x01\x4a\x34\x85\xe6\x0d\x91\x7d\xc2\x6d\xe0\x06\x6c\x85\x10\x56\xc0\x5a\xe9\xf0\xf5\x68\xce\xfb\xfb\xdb\x16\x54\xa3\x55\x83\xda\xf2\x24\xdd\xf0\x74\xd4\xba\xf3\xf6\x64\xe3\xff\x9d\x1f\x8d\x01\x10\xae\x61\x15\x94\xa4\xdf\x18\x70\x89\xf2\xc2\x32\x92\x07\x6a\x0d\xb6\xe2\x06\x34\x36\x1a\x0d\xca\xa0\xf1\xf4\x9a\x49\x50\xab\xdf\xb1\xb0\x8b\x13\xd0\x4b\xd4\x04\x06\x4c\xa5\x9c\x28\xa1\x50\x72\x87\xda\x82\xc6\x42\x6d\x24\xff\xda\xc2\x36\x60\x95\xdf\x54\x30\x8b\xc6\x82\xd7\x08\xc9\x04\xec\x98\x70\x38\x03\x26\xcb\x13\xc8\x35\x7b\x06\x8d\xb4\x27\x38\xd9\x81\xe7\x17\x98\x53\x3c\xee\x94\x46\xe0\x72\xad\x72\xa8\xac\x6d\x4c\xfe\xe6\xcd\x86\xdb\x64\xec\x85\xaa\x6b\x27\xb9\x7d\x7e\xe3\x35\x84\xaf\x9c\x55\xda\xbc\x29\x71\x87\xe2\x8d\xe1\x9b\x39\xd3\x45\xc5\x2d\x16\xd6\x69\x7c\xc3\x1a\x3e\xf7\x84\x48\x22\xdf\x2c\xea\xf2\xdf\x74\x74\x0f\xe6\x68\xdb\x33\xcd\x09\x1f\x6f\x88\x2f\x10\x0f\x19\x27\xe9\x07\x8b\xa0\x02\x4f\x0e\x52\xa0\x57\xc4\xba\x87\x9f\x97\x8f\x90\x30\x09\x92\x0a\x42\x39\x4c\x35\x43\xf2\x21\x6e\x72\xb9\x46\x52\x3b\x6e\x60\xad\x55\xed\xc5\x81\xd1\x16\xfc\x97\x42\x70\x94\x16\x8c\x5b\xd5\xdc\x92\x1a\xfc\xe1\xd0\x58\x12\xdd\x29\xd8\x1b\xef\x10\x61\x85\xe0\x9a\x92\x59\x2c\x4f\x27\xdc\x4a\xb8\x61\x35\x8a\x1b\x66\xf0\x1f\x96\x15\x49\xc5\xcc\x49\x08\x93\xa4\xd5\x75\xf3\x87\xbf\x30\x39\xb0\xb7\x33\x90\xbc\xf2\x54\xd1\x1e\xd9\xf7\xb2\xc1\x22\xf9\x81\xe8\x49\x29\x36\x11\x2b\x34\x33\x56\x3b\x4f\x52\x1a\x5a\xc0\x63\x85\x60\xd0\x5a\x2e\x37\x06\x98\x46\x70\x06\x4b\xb2\xa3\x0d\x4a\xd4\xcc\xa2\x17\x5a\xf0\xcc\x10\xf7\x38\xd9\x9f\x26\xd4\xac\xa8\xbc\xcd\xab\xf5\xd9\xd6\xed\xff\x1a\xa1\xd1\x6a\xc7\xa3\x43\xe0\xd2\xaa\x23\x50\xfd\xee\xa8\x13\xf8\x6e\x94\x5c\xf3\xcd\xe9\xe0\xd8\x42\x7a\xd8\xda\xa2\xbe\x95\xc6\x32\x21\x6e\x54\x5d\x33\x59\xf6\xce\x3b\xe1\xf3\xfb\x9e\x65\x9e\x43\xda\x49\xe0\x12\x18\x14\x95\x56\xca\x26\x92\x79\xd8\x01\x4f\x7d\x4c\x7a\x94\x99\x05\x54\x62\x9c\x23\x3f\xb6\xe6\x1b\xa7\xa3\xa7\x5c\x11\x2c\xa1\x58\x79\xc6\xe0\xf0\xe1\x16\xeb\x01\xbc\x07\xf5\xee\xf0\x84\x09\x4c\x6b\xf6\x9c\x9d\x0f\xaf\x94\x2c\x3f\x37\x9d\xd4\xe3\xf4\xaf\x1b\x68\xc7\x78\xfd\x02\x5c\xce\xf4\x3e\x3d\x81\x2f\xbf\x3d\x7c\xcc\xb3\x2b\xc0\x17\x3e\xd5\xba\x4f\x7a\xc6\xe5\xe6\x11\xeb\x86\x22\xc2\x55\xe0\x4a\x24\x5f\xc1\xcd\x79\x38\xec\x55\x9a\x33\xe3\x4c\xcf\x87\x0e\x1c\x30\x8c\xd2\xbf\xaf\x68\x80\x7b\xaf\xa2\x34\x85\xa1\x5a\xed\xb0\x3c\x78\xcd\xa8\xf3\x33\x50\x1a\xd6\x1a\xb1\x84\x7d\x85\xf2\xc8\xba\xb8\x81\x12\x05\x5a\x2c\x67\x03\xdb\xae\x70\xed\x83\x96\x25\x9f\xa0\xd1\x3a\x2d\x83\x79\x13\x98\x46\x29\xd1\xbb\x6e\xdc\x9e\xe8\xa9\x55\x39\xc0\x4f\xfa\x94\xb8\x66\x4e\xd8\x1c\xa4\x92\x38\x32\x6b\x0a\xe3\xe8\xb9\x53\x25\xc2\x5e\xe9\xed\x5a\xa8\x3d\x34\x4f\xc1\x58\x82\xd9\x58\x2e\xb7\xa8\x57\x28\x04\x54\x4a\x6d\x41\x19\x8a\xf3\xa0\x9d\xa4\x60\xd7\x2e\xda\xf3\x86\x42\x1c\x13\x02\x4a\x6e\xb6\x66\x06\x1a\xcb\x35\x37\xd5\x21\x02\xb1\x11\x0c\x0c\x16\xe4\x36\x51\x33\x83\x64\xf0\x1e\x8e\xe6\x3b\x34\xb0\xe3\xcc\x23\xf2\xd3\xdd\x8d\x4f\x31\x3c\xd1\x91\xd7\x5d\x01\x77\x98\x0e\x7b\x6e\x2b\xe5\x6c\xc2\xca\x63\x34\xb8\x3b\x4a\x57\x0f\xf3\x7a\x3e\xce\xe4\x79\xcb\x82\x91\x29\x91\x15\x83\x33\x2e\xd8\x08\x7d\x28\x35\xb0\x3f\xdd\x7e\x5e\xe6\xd3\xe4\xfd\x90\xe6\x53\xb2\x81\xd6\xc0\x8a\x2b\x73\x88\x44\x81\x59\x5c\x27\x55\x32\x59\x0f\xc0\xf8\x90\x00\x92\x30\x95\x2c\x30\x08\x18\x2a\xb6\x43\x58\x21\x4a\xe2\x32\x96\x17\x88\xeb\xe6\xfc\xe7\x7f\x96\xd7\xa8\x9c\x9d\xa0\xf1\xdf\x55\xd3\xe8\x7f\x0c\x10\x63\x44\xd8\x57\xbc\xa8\x3a\xba\x12\xbe\x57\xcc\x40\xc8\x80\x70\x08\x31\x7a\x92\x37\x29\xc9\xc6\xff\x70\x4c\x33\x4a\xe7\x46\x28\x5e\x2b\x5d\x33\x9b\x43\xe9\xb4\x4f\xbe\xaf\x97\xfb\x05\x4f\xfe\xbb\xe2\xf2\x27\x66\x8b\x6a\xc9\xbf\x0e\xb8\x8b\x69\x4e\xe0\x97\x2e\x20\x10\xdc\xa7\x8e\x64\x74\xd2\xd5\x2b\xd4\x64\x91\xb4\x17\x48\x55\x22\xf9\x39\x72\x0f\x31\xc3\x38\x8a\xcd\x1a\x98\xf5\x3a\xb2\x80\x5f\x0e\xf3\x29\xa8\x57\x28\x4a\x70\xd2\xf2\x7e\x8f\x08\x1e\x4c\xa1\x91\x32\x22\xda\x85\xea\x1d\x60\xd2\xec\x51\x53\x5c\x97\x25\x20\x2b\x2a\x58\x11\x92\xb0\x67\x84\x5f\xaa\xbf\x1a\x8d\x3b\xae\x9c\x89\x83\x56\x41\xa1\xea\x86\xdc\x76\xc2\xcb\x4b\x61\x91\xf5\xee\x0b\x6f\x81\x95\x9e\x5e\xf2\x39\x1d\x2a\x23\x25\xbd\xab\x6a\x2e\x79\xed\xea\x1c\xde\xf6\x0e\x07\xb1\x51\x79\xb4\xe9\x4d\x36\xb6\xa8\x25\x8a\xf7\x7a\x63\x26\x08\xed\xd7\x76\xb2\xcf\x8e\x58\xd3\xa0\x2c\x0f\x41\x26\xc0\x22\x92\x29\x83\x02\xc1\x07\x9d\xd5\x69\x1e\x15\xe8\xfc\xc7\x73\xa1\x9a\x3d\xc5\xac\x8f\xcb\xcd\x27\x42\xe1\x35\x9a\x7b\x77\x06\xad\x5f\x7d\x3d\xad\x89\x76\x1f\xa9\x82\x78\x67\xc4\x47\xb6\x53\xbc\x04\xc3\xac\x37\xd8\x58\xa9\xf1\x9a\x6d\x28\x77\xa7\xda\x78\x60\x73\x62\xb8\x6b\x04\x97\xdb\x05\x3c\x60\xcd\xb8\x24\xc8\x51\x7d\x34\x8e\x1b\x8a\x49\x5f\xbc\x76\x9a\x56\x69\x17\xf0\x96\x3c\x2c\x5b\x89\x98\xb8\x7a\x7a\xbe\x85\x1a\x52\x7f\x26\xd0\x67\xf2\x7f\x5c\x0d\xa4\x6d\x96\x63\x7b\x1f\x89\xff\xd3\xe3\x7d\x2a\x82\xc9\x06\xbc\x68\x6d\x13\x85\x63\x42\x4d\xb5\x7a\xf6\xdc\xfa\xff\x51\xea\xb6\xee\xe2\x72\x73\x37\x98\xbb\xb5\x51\xac\x79\xc2\xcb\x44\x0f\xea\x7c\x37\xf9\xa6\xcd\x20\xb6\xcc\x0c\x54\x6a\x1f\xd5\x8f\x3c\xb4\xe7\x47\xdb\x6e\x3c\xe8\xde\xc2\xa7\x78\x94\x97\x71\x2a\x8e\xc2\x02\xab\xa8\x13\xa0\x42\x02\x6c\x58\x3d\xe4\x45\x3e\x7e\x07\x06\x37\x35\xf5\x18\x98\x01\x53\x23\xce\x60\x5f\x71\x81\xb0\xe3\xda\x3a\x26\xee\xb0\xe4\x0c\x6a\xe5\x24\xf9\x54\x09\xb7\xcb\xcf\xdd\x1c\xce\xfb\x72\x89\x58\xd2\xc6\xf0\xe1\xbf\x6e\xee\xb3\x97\x25\x65\xf3\x41\xee\xcd\x8f\x50\xc8\xae\x10\x73\xa3\xd5\xd3\x73\x7e\x59\x34\xf7\x34\x0f\x78\x8f\xe6\x79\xf2\xba\x35\x84\x55\xa0\x29\x74\xf5\x02\x05\xa0\xa6\xb6\x96\x4c\x80\x44\xbb\x57\x7a\x6b\xae\x2c\x18\xa8\x61\x76\x3f\x8c\xfd\x04\xda\x13\x14\xf3\x7a\x30\x52\x5d\x80\x31\x6a\x8a\x13\x37\xb9\x64\x92\x17\xb3\x27\xcd\x64\x51\xa1\x7e\xc0\x0d\x37\x96\x1c\xff\xa4\x0a\xf4\xe1\x7c\x15\xf0\xba\x51\xda\x9a\x23\xb9\x7b\x97\x1f\xb7\xe8\x85\x0a\x3e\xfe\xa4\xd2\xd1\x49\x39\x44\xea\x65\xe1\xc7\x3d\xa9\x91\x3f\x34\xe5\x84\x8a\xd8\x69\xa2\x15\x6d\x7f\xc5\x13\x81\x65\x87\x82\x84\xff\x50\xf5\x4b\x4f\x48\xa5\xa3\x67\x6b\x3b\xc4\x09\x86\x4c\x67\x0b\xde\x2e\xe4\xb0\x5b\x99\x24\x72\x2e\x43\x9d\xb8\xdc\xf2\xe6\xf1\xe3\xf2\x0b\x6a\xbe\x7e\x9e\x48\xf1\x6d\xdf\x5a\x30\x5b\xde\x18\x3a\xf4\xe0\x6b\x5e\xb4\x9d\x72\x5b\x0d\xa3\xd9\xea\x4d\x8c\x3e\x50\x90\x6c\xfc\x6a\x7c\x5d\x0d\xa4\xb6\x28\x97\x58\x68\xb4\x0f\xb8\x9e\x48\xd5\xe3\xd1\xa2\xd8\x80\xf6\x30\x7c\x0d\x0c\xac\xc5\x96\x35\x7c\x10\x64\xdc\x9c\x24\x4e\x9a\x10\xbe\x6c\xb1\xdf\xaa\xa6\xa9\x64\x7b\x06\x33\x32\x7e\x42\x0b\x4d\x27\x0a\x9c\xe4\x7f\x38\xf4\xf8\xfb\x36\xe0\x41\x87\xc8\xa2\x70\x8d\x1a\x87\xf2\xf2\xc3\x5f\xcb\x86\xd4\x6b\x1f\x4a\xff\x27\x2b\x5f\x9b\x31\xd1\x51\xd9\x0b\xc9\x0a\xe8\x77\xcf\x6d\xc2\x9b\x48\xa3\xb7\xa1\x51\x88\x10\xdb\xc4\x9e\x24\xc2\x02\x6a\x67\xa8\x72\x8d\xdc\xfa\x1b\xa8\xbb\xe0\x2d\xc3\xe7\x69\xbe\x75\x2b\xaa\x38\x2c\x9a\x79\xcd\x9a\x79\xf4\xc1\x56\xd5\xbc\x18\x58\xe5\xb4\xc8\xb3\x49\xac\xfa\xed\xe1\x63\xf2\x46\xc7\x46\x96\x5d\x4d\x59\xcc\x72\xca\xbc\x77\x74\x7e\x62\x75\x03\x93\x9c\x16\xd9\x15\x2c\xd3\x21\xb2\x3c\xdf\x71\xad\xd5\xa4\x3c\xf7\xe1\x78\xc5\xa1\x85\x4d\xff\x59\xc6\x25\x6a\x5f\xf8\x35\x4e\x88\x50\xa0\xf4\xe7\x0b\x40\x6c\x74\x8d\xb1\x1a\x59\x9d\xf0\xe0\x18\xcf\x8c\xea\x80\x4f\xf6\xe2\xc8\x3c\x82\xeb\x91\x72\x87\x0d\xda\x73\x29\xd3\xc1\x7e\x00\x32\x78\x92\x4c\xa4\x29\x9e\x56\x9e\x12\xf0\xec\xd1\xcf\xae\x77\x49\x85\xc6\x92\x0e\x99\x98\x30\xad\xc8\x87\x67\x9f\xd0\x7b\xd3\xb3\xf8\xdc\xe1\x92\xee\x3a\x83\x7a\x34\xd4\xa5\xda\xb1\x61\xc6\xec\x95\x2e\x61\x8b\xcf\x31\x97\xa4\x6a\xd4\xd9\x8a\xb0\xa4\x88\x72\x00\x1a\x78\x3a\x02\x72\x9a\x4f\x9e\xe2\x95\xbf\xa5\x5f\x7e\xb1\x67\x9e\x60\xe3\xe9\x69\x11\x7a\x31\x79\x7f\x83\x7f\x7e\x9d\x87\x9e\x4c\xe5\x24\x2f\x7d\x9d\x9f\x86\x83\xc1\xe6\xd9\x44\xe6\xa5\x6b\x18\x87\xba\xdc\x69\xd1\x9e\x52\x06\x9d\x9d\x81\xd5\x7c\xa4\x5d\x4a\x1f\x2e\x41\xe9\xa1\x93\xb9\x09\x9e\xe9\x0a\x1e\x0e\xd7\x0d\x57\xa4\x9a\xd7\x27\x9b\x23\x20\xe3\xd1\xaf\x67\xe2\xa4\x34\x73\x5a\xa2\x79\x08\x4c\x93\xe9\x49\xbe\x3e\x9d\x7c\x9f\x79\xe6\x19\x18\x57\x54\xc0\x86\xc2\x51\x84\xa9\x8a\x2d\xea\x70\xe9\xe9\x15\x32\x1c\x8f\xe9\x74\x26\xd3\x2a\xf2\xe0\x8c\x84\x78\x76\xa5\x99\x8d\xeb\x90\x31\xd5\xaf\xf8\x3c\xa0\xad\xa3\x8a\x7c\x91\xfc\x0b\x1b\xfb\x88\x7f\x4b\x51\x74\xb8\x0d\x75\x49\x49\xcc\xb3\x29\x6c\xba\x13\xf7\x4d\x4f\xaa\x8f\x94\x6c\x19\xb6\xf5\x9e\x24\x76\xb5\x1b\xa6\x59\x8d\x74\x95\x8d\x8e\xae\x52\x77\x6a\xb8\xc5\x77\x41\x6c\xdd\xf6\x50\x7e\x19\xa1\xc1\x06\xdc\x97\x0e\x1c\x7f\x21\xa4\x1b\x39\xa8\xdf\xe5\xbb\x5f\x58\x1e\xf5\xbd\xfc\xe9\xb2\xa3\xdb\x78\xc7\x9d\xb2\x6e\xeb\xd0\x9f\xfb\x0e\x45\x8a\xc7\x08\x3c\x34\xcf\xac\x3a\xf4\xf8\xda\xce\x5e\x48\x17\xda\x96\xdf\x22\x0e\xd0\x9d\xba\x35\xdf\xfc\x8f\xd3\xc2\xf3\xd1\x2a\x58\xdc\xa4\x5b\x00\xb3\x58\xc2\xfb\x4c\x7b\xc8\x7e\x69\x97\x06\xb5\x6f\x2e\x1e\xe0\xc7\xfc\xf4\x70\xa6\x1e\x6f\x5e\x24\xf3\xcb\xae\xcb\x53\xb8\x51\x83\xb7\x13\xa6\x0b\x89\x9e\xdb\xe5\x67\xaa\x29\x28\x4b\x93\x80\x4f\x8d\xe0\x05\xb7\x40\x6c\x50\x1a\x18\x6c\x14\xd8\x78\x79\x21\x71\x81\x7a\x31\x74\xbe\xa2\xb1\x3c\x10\xec\xb9\xba\x08\xc0\x66\x47\xac\x5b\x50\x07\x65\xac\x3d\xe2\x27\xf8\x64\x62\x06\x8b\xf7\x65\xa9\xd1\x98\x19\x2c\x3e\xa1\xad\x99\xd9\xce\x60\xf1\x9f\xcc\xe2\x9e\x3d\xcf\x60\x71\xf7\xfe\xe6\x30\xe1\x8b\x60\xf2\xf6\xc3\x0c\x16\xe9\xd2\x1f\xe5\x8b\x8b\xf7\xba\xa8\xc6\x12\x89\x0f\x27\x6d\x18\x52\x17\x46\xed\x09\x56\xb4\xf7\x68\x62\x6b\x66\x10\xca\x44\x0f\x34\x6c\x64\x1e\xf3\x0b\x0d\xf0\x77\xe3\xe7\x19\xef\xae\x3a\xcf\xa0\x73\xe8\x0f\x74\x36\x7d\x9d\xdb\xdb\x6b\x6e\xf1\x3f\xb8\x40\x33\xc1\x37\xfc\x77\x3b\xd9\xfb\x2b\x5a\x6b\xe9\xf6\x88\x3a\x78\xa8\x70\x9f\xa4\x35\x96\x5e\x98\x40\x11\x35\x99\x6f\xf9\xf2\x70\xd1\x8f\x13\x01\x65\xb0\xe6\xe2\x35\x88\x4d\x40\x6d\x5a\xd1\x41\xc5\x1f\xca\x91\xc3\xfd\x09\x3a\x47\x1f\x94\x85\x2a\xb9\xdc\xe4\x83\x33\x4e\xf8\xf1\x73\x5c\xd0\x6a\x7e\x40\x64\x64\xf9\xf8\x55\x10\xca\x2c\x56\x3f\xfe\x30\x3e\xce\x0c\x5e\x98\xb2\xf9\x7a\x61\x98\x37\x17\x26\xfc\x6b\xd2\x2e\xbc\x99\x36\xef\x5f\x97\x68\xda\x7c\xe5\xcd\x85\x49\x93\x04\xa8\xf6\x12\xf5\x64\xe9\x7d\xa6\xd9\x49\x74\xa4\xcb\xb3\xd4\x9f\xa4\xfa\x3a\xf7\x77\xea\xe9\x0e\x76\xcd\xec\x6b\x11\x6b\xe8\xfa\xf9\x54\xbc\xe8\xbe\x7b\xca\x83\xd9\xca\x28\xe1\x2c\x7a\x08\x5d\x5c\x5f\x8d\x11\xea\x78\x99\xcd\x4c\x47\xec\xb0\xa6\x8b\x0a\x71\x4d\x15\x96\x8e\x98\xe2\xcf\x1f\xc6\xe2\x15\xa4\x44\x1e\xde\xfe\xf8\xc3\x0f\xdf\x3a\x4f\x8f\xae\x61\x70\x9c\xd8\x3a\x30\x78\x21\x0a\x8d\x27\xca\x23\x8b\xfb\x7e\x7e\x90\x67\xa3\x8c\xbf\xe9\x59\x42\x1a\x12\x53\xad\xee\x79\xc8\x8e\x37\xe9\xd8\xe7\x0c\xa6\x77\xb8\x4c\x08\x45\x35\x5e\x99\xbd\xcc\xd5\xd2\x0f\x24\xf2\x11\x3e\x0c\x0a\x8a\x0e\x7d\xf2\x6c\xec\x1a\x13\x97\xf6\xfb\xef\xae\x08\xc8\x23\x4c\xf6\x6d\xbe\xde\x1c\x6f\x04\xdb\xf8\x53\x87\x97\xad\xe1\xcd\x95\x97\x9a\x43\x26\x76\xaf\x94\x78\x48\xad\xad\xbe\x79\x97\xe0\x5c\xee\xb8\x8d\x60\xff\x82\xc6\xd6\x45\x28\xe3\xc6\x38\x87\xc1\x86\xe5\xfc\xb0\x7d\xef\xf8\x88\x98\xe9\x43\xbf\x0b\xe2\x45\x4c\x6c\xf3\xec\xc5\xb8\x0f\xe3\x3d\xef\x15\x52\x36\x19\xbf\x7e\xc8\xf3\xf4\x93\x9a\xd3\xb7\x49\x93\xb2\x09\xc0\x89\x68\x77\xa2\x0f\xc3\x7a\x12\x9d\xc3\xb2\x67\xd1\xb9\xaf\xe9\xce\x4d\x61\x28\x6c\x97\x9c\x7e\xfa\x65\x41\xd9\x5e\x84\x1f\xc8\xf8\x47\xf8\xbe\x66\x5c\x38\x8d\x77\x68\x0c\xdb\x60\x7e\xc5\xd2\x07\x64\xe6\x85\xc6\xea\x7f\x3b\x76\x81\x7e\xff\x6b\xb2\xe4\x5c\xbd\x27\x3d\x75\xaf\x5d\x27\x9a\x6e\x63\x9c\xc1\x6c\xfd\x3c\x34\xe4\xe8\xdb\x86\x11\x41\xde\x4a\xb5\x97\xd9\xf4\xea\x61\x48\x8f\xba\x3f\x85\x1b\xd1\x96\xb3\x97\xbe\x10\x2f\x73\xb0\xda\x05\x8b\x34\x56\x69\x92\x42\xe7\x8d\x5b\xa5\xee\x79\xab\x30\xc6\x32\xeb\x4c\x0e\x7f\xfe\x95\xfd\xdf\x00\x70\x58\xc5\xec\x3d\x39\x00\x00")
+
+func chartSeederCrdTemplatesMetalHarvesterhciIo_seederclustersYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_chartSeederCrdTemplatesMetalHarvesterhciIo_seederclustersYaml,
+		"chart/seeder-crd/templates/metal.harvesterhci.io_seederclusters.yaml",
+	)
+}
+
+func chartSeederCrdTemplatesMetalHarvesterhciIo_seederclustersYaml() (*asset, error) {
+	bytes, err := chartSeederCrdTemplatesMetalHarvesterhciIo_seederclustersYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "chart/seeder-crd/templates/metal.harvesterhci.io_seederclusters.yaml", size: 14653, mode: os.FileMode(420), modTime: time.Unix(1792439149, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _chartSeederCrdTemplatesMetalHarvesterhciIo_seedermachinesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x58\x4d\x6f\xe3\xcc\x0d\xbe\xfb\x57\x10\xe9\xa1\x97\xc8\xc1\xa2\x97\xc2\xb7\x20\xbb\x07\xa3\xef\x2e\x82\x64\xb1\x77\x5a\xa2\xad\x79\x33\x9a\x99\x0e\x47\xde\xb8\xdb\xfd\xef\x05\x67\x24\x59\x92\x25\xc5\xd9\x2e\x7a\xa8\x15\x20\xd0\x7c\x90\x1c\x3e\x24\x1f\x6a\xb2\x2c\x5b\xa1\x53\xdf\xc8\xb3\xb2\x66\x03\xe8\x14\xbd\x06\x32\xf2\xc6\xeb\x97\xbf\xf3\x5a\xd9\xbb\xe3\x87\xd5\x8b\x32\xc5\x06\x1e\x6a\x0e\xb6\x7a\x22\xb6\xb5\xcf\xe9\x23\xed\x95\x51\x41\x59\xb3\xaa\x28\x60\x81\x01\x37\x2b\x00\x34\xc6\x06\x94\x61\x96\x57\x80\x1f\x3f\x57\x00\x1a\x77\xa4\x9b\x81\x5c\xd7\x1c\xc8\xaf\x5f\xb3\x4e\xc1\x8e\x02\x7e\xd8\xc0\xf1\x03\x6a\x57\xe2\x87\x15\x80\xc1\x8a\x36\xc0\x44\x05\xf9\x0a\xf3\x52\x19\xe2\xb5\xe8\xd1\xeb\x12\xfd\x91\x44\x42\x99\xab\xb5\xb2\x2b\x76\x94\x8b\xe4\x83\xb7\xb5\xdb\xc0\xf4\xa2\x24\xb1\xb1\x20\x1d\xe7\x39\x0a\xff\x9c\x84\xc7\x71\xad\x38\xfc\xe3\x72\xee\x0f\xc5\x21\xce\x3b\x5d\x7b\xd4\x63\xb3\xe2\x14\x2b\x73\xa8\x35\xfa\xd1\xe4\x0a\x80\x73\xeb\x68\x03\x5f\xb0\x22\x76\x98\x53\xb1\x02\x38\x26\x87\x47\x73\x32\xc0\xa2\x88\x7e\x44\xfd\xe8\x95\x09\xe4\x1f\xac\xae\xab\xd6\x7f\x19\xfc\xc9\xd6\x3c\x62\x28\x37\xb0\xe6\x80\xa1\xe6\xb5\x27\x2c\x4e\x51\x6f\xeb\xa9\xa7\xde\x48\x38\x89\xc2\x9d\xb5\x9a\xd0\x4c\xc8\x70\x94\xaf\x9d\xb7\x47\x55\x90\xdf\x7e\x1c\x88\x79\x1c\x0f\x27\x59\x1c\xbc\x32\x87\x59\x73\x94\x39\x92\x09\xd6\x9f\x9e\x68\x4f\x9e\x4c\x4e\x6b\xb1\x6a\x20\x79\xdb\xae\x99\x13\x2c\x3b\x06\x21\x20\xae\x2b\xa9\x8a\x51\x25\x0b\xac\x23\x73\xff\xb8\xfd\xf6\xb7\xe7\xc1\x30\x40\x41\x9c\x7b\xe5\xc4\x85\x23\xe4\x40\x31\x84\x92\x20\xed\x80\xbd\xf5\xe9\x75\xb0\xe6\xfe\x71\xdb\x89\x72\xde\x3a\xf2\x41\xb5\x91\x92\x9e\x5e\x8a\xf4\x46\x47\x8a\xff\x9d\x0d\xe6\x00\xc4\xd6\xb4\x0b\x0a\xc9\x15\x4a\xb6\x34\xd8\x53\xd1\x1c\x0f\xec\x1e\x42\xa9\x18\x3c\x39\x4f\x4c\x26\x65\x8f\x0c\xa3\x01\xbb\xfb\x93\xf2\xb0\x1e\x89\x7e\x26\x2f\x62\x80\x4b\x5b\xeb\x02\x72\x6b\x8e\xe4\x03\x78\xca\xed\xc1\xa8\x7f\x75\xb2\x19\x82\x8d\x4a\x35\x06\xe2\x00\x31\xba\x0c\x6a\x38\xa2\xae\xe9\x16\xd0\x14\x23\xc9\x15\x9e\xc0\x93\xe8\x84\xda\xf4\xe4\xc5\x0d\x3c\xb6\xe3\xb3\xf5\x04\xca\xec\xed\x06\xca\x10\x1c\x6f\xee\xee\x0e\x2a\xb4\x85\x23\xb7\x55\x55\x1b\x15\x4e\x77\xb9\x35\xc1\xab\x5d\x1d\xac\xe7\xbb\x82\x8e\xa4\xef\x58\x1d\x32\xf4\x79\xa9\x02\xe5\xa1\xf6\x74\x87\x4e\x65\xf1\x20\x46\x8e\xcf\xeb\xaa\xf8\x8b\x6f\x4a\x0d\x0f\xd4\x5e\x44\x4e\xfa\x8b\x49\xfd\x0e\x78\x24\xd1\x25\x3e\xb0\x11\x95\x7c\x72\x46\x41\x86\xc4\x75\x4f\x9f\x9e\xbf\x42\x6b\x49\x42\x2a\x81\x72\x5e\xca\x73\xf8\x88\x37\x95\xd9\x93\x84\x9d\x62\xd8\x7b\x5b\x45\x38\xc8\x14\xce\x2a\x13\xe2\x4b\xae\x15\x99\x00\x5c\xef\x2a\x15\x24\x0c\xfe\x59\x13\x07\x81\x6e\x2c\xf6\x21\x16\x57\xd8\x11\xd4\xae\xc0\x40\xc5\x78\xc1\xd6\xc0\x03\x56\xa4\x1f\x90\xe9\x7f\x8c\x95\xa0\xc2\x99\x80\x70\x15\x5a\x7d\xca\x38\xff\xd2\xe2\xe4\xde\xde\x44\x5b\xe1\xaf\x85\x76\x90\xdf\xcf\x8e\xf2\xb6\x0e\x34\xd4\x23\x3c\x27\xae\xf0\xc8\xc1\xd7\xf1\x48\xd0\x14\xec\x35\xdc\xc3\xde\x13\x41\x57\xd3\xa0\xc2\x20\x53\x29\x1a\x98\x34\xe5\xc1\x7a\x91\x98\x6b\x54\x15\x8d\x33\x08\x4d\x21\x15\x9d\x8a\x36\xf7\x12\x21\x74\xba\xed\xbe\x57\x83\x1e\xd2\xe0\x40\xc4\x74\x0d\x92\x07\x8b\xc2\x13\xf3\xa3\xb5\xba\xab\xb4\xe3\x35\x23\xdf\xdc\x4f\x6c\x69\xbd\xd1\x88\x03\x67\xad\x8e\x36\x19\x5b\x74\xa3\x17\x62\x41\xb6\xa1\xd6\x36\x97\xc8\x8b\xb1\x7c\xb1\x66\xde\xf4\xae\xc2\x4f\x8c\xcf\x06\x49\xfb\x98\x96\x3b\x7f\x61\xb7\xa4\x93\xf2\x34\x2a\x0d\xf2\x97\x41\x8f\xa4\xce\xbf\xec\xac\xee\x62\x6e\x26\x3e\xe5\xaf\x8b\x97\xe7\x26\x42\xde\x00\x66\x3b\x5e\x9f\xe2\xac\x21\x89\x73\xf4\x7d\x2f\x55\x5e\x42\x8e\x06\x76\x97\xb6\x42\x1b\x83\xb0\x3b\xc5\x7d\xe7\xb6\xe3\x3d\xc0\x44\xcd\x9f\x5e\x85\x7e\xba\xb6\x04\x60\xd1\xfc\xf1\x96\x18\x1c\xb1\x81\x12\xe2\x8a\xed\xde\x39\x57\x1a\x0c\xaa\xa9\x52\xd9\xfe\xbe\x96\x34\x58\x07\xe8\x09\xee\xbf\x7c\xbc\x2c\x72\x8d\xbf\x03\x55\x33\x86\xbe\x55\x1e\xce\xbf\xfb\x05\x4b\x1b\x6e\x68\x67\x42\x89\x41\x88\x36\xa0\x32\x9c\xb8\x82\x6f\x01\xe1\x85\x4e\x91\x47\x23\x59\x3b\xf2\xd8\x2e\x9e\x55\xea\x29\xb2\x71\xc4\xeb\x85\x4e\x71\xf3\x34\xbd\x5e\x87\x5e\x43\x7f\x74\x9a\x9f\x1c\x79\x44\xb4\x36\x35\x20\x9d\x5f\x06\xc4\xe6\x61\x89\x43\xe7\xb4\x6a\xfa\xdb\xb9\xe7\x92\xa4\xae\xce\xcc\xf6\x69\xbd\x76\xb5\xf9\x0b\x80\xf6\xe5\xf5\xf8\x39\xe1\xf4\x57\x21\x57\x1d\xbb\x2b\x2e\x95\x93\xfa\x2c\x00\xc7\x88\x5d\x06\x20\x3d\xdf\x50\xab\xa2\x13\x9f\x22\x74\x6b\x6e\xe1\x8b\x0d\xf2\xef\xd3\xab\x12\xda\x16\x38\x3f\x5a\xe2\x2f\x36\xc4\x91\xff\xda\x3f\xc9\xb4\xdf\xe5\x9d\x24\x2d\x06\xb7\x01\xf4\x1e\x4f\x72\xfc\x7e\x0b\xc4\x6b\xd8\x26\x8e\xea\x3c\xa9\x18\xb6\x06\xac\x6f\x8e\xba\xa8\x40\x36\x36\x4a\x92\xf8\xaa\xe6\xd8\xb3\x18\x6b\x32\xaa\x5c\x38\x4d\xca\x6f\xbc\x67\xfd\xc0\x79\xbf\xa8\xaa\x51\xf3\x55\x9a\xb5\x34\x93\xfa\x6b\x2d\xdf\x5f\x50\xd4\xf1\xb0\xb1\xf1\xc3\x40\x07\x95\x2f\x6a\xa9\xc8\x1f\x08\x9c\x14\xbc\x25\x2c\x17\x0b\xd2\x3b\xe0\x6e\x97\x45\xbb\x17\x56\xbd\x66\x2f\xf5\x8e\xbc\xa1\x40\x9c\x49\xe1\xcd\x9a\x7d\xc1\x56\xb3\x27\x9a\x67\xc3\x96\xfc\x5e\xe8\xb4\x9a\x98\x88\x7c\xd9\xe2\x35\xb3\x60\x81\x1d\xaf\x3b\xd8\xbb\x8f\x14\x59\xe8\x8f\xde\xdd\xc2\xf8\xe9\x7f\x5a\xbf\x55\x43\xdf\x44\xe7\xba\x34\xeb\xd9\x14\xb3\x0c\x2a\x74\x92\x62\x3f\x84\x29\x62\x62\xfc\x04\x87\xca\xb3\xb4\x9a\x72\x6d\xa0\x69\x30\xa7\x4c\xc3\xe4\x9d\x98\x59\x45\x4e\x14\x08\xa2\x47\xd4\xc2\x58\x52\xd0\x0c\x90\x4e\xfc\x65\xf7\x17\xc4\x7e\x0b\xdf\x4b\xcb\x24\xc5\x10\xf6\x8a\x74\x21\x02\x6e\x5e\xe8\x74\x73\x2b\x4a\x67\x14\xf5\xd3\xf4\x66\x6b\x6e\x12\xdf\x5d\x24\x5f\x47\x8e\xd6\xe8\x13\xdc\xc4\xb9\x9b\xf5\xbb\x89\x7d\x31\x8a\x16\x27\x07\xe1\x53\xa1\x5b\x8a\x9e\xf3\xfd\xc7\x66\xb5\x88\xf3\xf9\x46\x44\x8e\x1f\xd9\x42\x5a\x68\x34\xbd\x2f\x84\xd9\x6f\x81\x85\xa0\x9a\xce\xc4\x0c\x70\xa2\x61\x5f\x5d\xe1\x82\x74\x15\xb3\x59\x5d\xd7\x37\x34\x5a\xa6\x92\x61\xb6\x8e\xbd\xd5\x85\x34\x32\xa7\x27\x17\x3c\xd1\x3e\x71\xc1\xcc\xe6\x01\x24\xcd\x87\x5d\xf3\x69\xf3\xf5\xe4\x08\xf6\x56\x6b\xfb\x7d\xf8\x59\x23\xf2\x18\xec\x7e\x46\x64\x77\x11\x19\xbf\x06\x9b\xc6\x99\x7f\xcd\xf8\x69\x30\x47\x90\x4e\xce\x89\xe0\x89\x89\xc5\x38\x9f\x2f\xa3\x7b\x54\xba\xf6\xf4\x99\x98\xf1\x30\xe1\xcc\x85\x63\x34\x5b\x9f\x08\xd9\x9a\x77\xed\xec\xf2\xa0\x8b\xd7\xcd\x6a\x11\xbf\xed\xc5\x86\xb6\x1b\x3d\xa7\xd4\x6f\xf9\xae\xf9\xbf\xfe\xe0\x8c\x77\xc0\x6f\x78\x3a\xde\x0a\x0f\xea\x56\x77\x2f\x0e\x25\x32\xec\x88\xa4\x8e\x71\x40\xad\xa9\x00\x6b\x66\x58\xa0\x45\xa3\xc3\xe7\x62\xcd\xe5\x9d\xf3\xb2\x3f\x32\xe8\xdf\x61\x2f\x1c\xf6\x62\x90\xe5\x6e\xad\xd8\x40\xf0\x75\x32\x95\x83\xf5\x12\xed\xbd\x91\x7a\xd7\x5d\x1d\xb6\x6a\x39\x60\xa8\x79\x03\x3f\x7e\xae\xfe\x33\x00\x83\x8e\xd2\xf3\xf6\x18\x00\x00")
+
+func chartSeederCrdTemplatesMetalHarvesterhciIo_seedermachinesYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_chartSeederCrdTemplatesMetalHarvesterhciIo_seedermachinesYaml,
+		"chart/seeder-crd/templates/metal.harvesterhci.io_seedermachines.yaml",
+	)
+}
+
+func chartSeederCrdTemplatesMetalHarvesterhciIo_seedermachinesYaml() (*asset, error) {
+	bytes, err := chartSeederCrdTemplatesMetalHarvesterhciIo_seedermachinesYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "chart/seeder-crd/templates/metal.harvesterhci.io_seedermachines.yaml", size: 6390, mode: os.FileMode(420), modTime: time.Unix(1792439149, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _chartSeederCrdTemplatesMetalHarvesterhciIo_seedermachinetemplatesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\xcd\x6e\xe3\x36\x10\xbe\xeb\x29\x06\xe9\xa1\x97\xc8\x41\xd0\x4b\xe1\x5b\x90\xec\xc1\xe8\x6e\x10\x6c\x82\xbd\x8f\xc5\xb1\xc5\x9a\x22\x59\x92\xf2\x46\xdd\xee\xbb\x17\x43\x4a\xb2\xec\x48\xb1\x92\xdd\x36\x3c\x04\x26\x87\xf3\x3f\xdf\x0c\x95\xe7\x79\x86\x56\x7e\x21\xe7\xa5\xd1\x4b\x40\x2b\xe9\x39\x90\xe6\x5f\x7e\xb1\xfb\xdd\x2f\xa4\xb9\xda\x5f\x67\x3b\xa9\xc5\x12\x6e\x6b\x1f\x4c\xf5\x99\xbc\xa9\x5d\x41\x77\xb4\x91\x5a\x06\x69\x74\x56\x51\x40\x81\x01\x97\x19\x00\x6a\x6d\x02\xf2\xb6\xe7\x9f\x00\xdf\xbe\x67\x00\x0a\xd7\xa4\xda\x8d\x42\xd5\x3e\x90\x5b\x3c\xe7\xbd\x80\x35\x05\xbc\x5e\xc2\xfe\x1a\x95\x2d\xf1\x3a\x03\xd0\x58\xd1\x12\x3c\x91\x20\x57\x61\x51\x4a\x4d\x81\x2a\xab\x30\x90\x5f\xb0\x3c\xb5\x28\xd1\xed\x89\x39\x95\x85\x5c\x48\x93\x79\x4b\x05\x4b\xd8\x3a\x53\xdb\x25\x8c\x13\x25\xce\xad\x26\xc9\xac\xc7\x28\xe4\x53\x12\xf2\xd4\x0a\x89\xe7\x4a\xfa\xf0\xc7\x34\xcd\x47\xe9\x43\xa4\xb3\xaa\x76\xa8\xa6\xd4\x8d\x24\x5e\xea\x6d\xad\xd0\x4d\x10\x65\x00\xbe\x30\x96\x96\x70\x8f\x15\x79\x8b\x05\x89\x0c\x60\x9f\x02\x13\xd5\xcd\x5b\x9f\x0c\x9c\xc4\x97\x4a\xaa\xa2\xdf\x59\x88\xb1\xa4\x6f\x1e\x56\x5f\x7e\x7b\x3c\xda\x06\x10\xe4\x0b\x27\x2d\x47\x65\xc2\x16\x90\x1e\x42\x49\x90\x6e\xc2\xc6\xb8\xf4\x73\x8c\xb6\x67\x0b\x70\xf3\xb0\xea\x7f\x59\x67\x2c\xb9\x20\x3b\xef\xa6\x35\x48\xaf\xc1\xee\x89\x4a\xff\xe4\x47\x67\x91\x6f\x7b\x0b\x04\xe7\x19\x25\xed\x5a\x7f\x90\x68\x0d\x07\xb3\x81\x50\x4a\x0f\x8e\xac\x23\x4f\x3a\x65\x1e\x6f\xa3\x06\xb3\xfe\x93\x8a\xb0\x38\x61\xfd\x48\x8e\xd9\x80\x2f\x4d\xad\x04\x14\x46\xef\xc9\x05\x70\x54\x98\xad\x96\x7f\xf7\xbc\x3d\x04\x13\x85\xb2\xc9\x3e\x80\xd4\x81\x9c\x46\x05\x7b\x54\x35\x5d\x02\x6a\x71\xc2\xb9\xc2\x06\x1c\xb1\x4c\xa8\xf5\x80\x5f\xbc\xe0\x4f\xf5\xf8\x64\x1c\x81\xd4\x1b\xb3\x84\x32\x04\xeb\x97\x57\x57\x5b\x19\xba\xa2\x2b\x4c\x55\xd5\x5a\x86\xe6\xaa\x30\x3a\x38\xb9\xae\x83\x71\xfe\x4a\xd0\x9e\xd4\x95\x97\xdb\x1c\x5d\x51\xca\x40\x45\xa8\x1d\x5d\xa1\x95\x79\x34\x44\xb3\xf9\x7e\x51\x89\x5f\x5c\x5b\xa6\xfe\x48\x6c\x68\x38\xc7\x7c\x70\x52\x6f\x07\x07\xb1\x10\xde\x10\x1e\x2e\x0a\xce\x18\x6c\x59\x25\x9f\x1c\xa2\xc0\x5b\xec\xba\xcf\x1f\x1e\x9f\xa0\xd3\x24\x45\x2a\x05\xe5\x40\xea\xa7\xe2\xc3\xde\x94\x7a\x43\x9c\x88\xd2\xc3\xc6\x99\x2a\x86\x83\xb4\xb0\x46\xea\x10\x7f\x14\x4a\x92\x0e\xe0\xeb\x75\x25\x03\xa7\xc1\x5f\x35\xf9\xc0\xa1\x3b\x65\x7b\x1b\x81\x09\xd6\x04\xb5\x15\x18\x48\x9c\x12\xac\x34\xdc\x62\x45\xea\x16\x3d\xfd\xcf\xb1\xe2\xa8\xf8\x9c\x83\x30\x2b\x5a\x43\xb8\x3d\xfc\x25\xe2\xe4\xde\xc1\x41\x87\x8a\x13\xa1\x1d\x2d\xf0\x47\x4b\x05\x87\xb7\xf6\x24\x60\xdd\x74\x88\xcd\xed\x81\x8b\xa2\x70\x74\x8c\x02\xbc\x8e\x18\xf9\x08\x20\x2d\xc6\x81\x20\xab\x4c\x53\x91\x0e\x9e\xab\x86\x4b\x2e\x38\xa3\xc0\x2a\xd4\x2d\x3c\xbe\x8e\x21\xbc\x3a\xa4\x3c\xdd\x7f\xed\xce\xb8\xfd\xa3\x7e\x78\x91\xe2\xa3\x76\x75\x8e\x49\xa9\x77\x70\x8a\xd4\x1b\x87\x3e\xb8\x3a\x86\xb8\x33\x7c\x01\x37\xb0\x71\xc4\x55\xbe\x27\x1d\x8c\x6b\xa0\xc2\xc0\x47\xa9\x3a\x3c\x29\x2a\x82\x71\xcc\xb1\x50\x28\x2b\x12\xd9\xa8\x0e\xd1\x6b\x28\x04\x89\x0e\x93\x52\x13\xe9\x75\x30\x9b\x01\x5a\xdf\xa6\xcd\x51\x56\xaf\xfb\x8a\x17\x0a\xe1\xc8\xfb\x07\x63\xd4\x67\xda\x90\x23\x5d\x8c\xf8\x7c\xd4\x87\x37\x23\x57\x3b\x6f\xb5\x6c\xc1\x1a\xa3\x58\xd7\x49\x8e\x00\xda\x88\x03\x3d\x83\x8c\x52\xa6\xe0\x8a\x8d\x18\x30\x79\xf1\xbc\x69\xbc\x62\x0f\x7d\xe5\x7c\xb2\xe8\x4e\x97\xee\xfa\xf4\x4f\xe0\xc6\xb0\x25\x1d\x9d\x40\xf0\x70\xa5\xe6\x7f\xe6\x38\xaa\x33\x49\x33\x81\x0f\xc3\xd5\xe7\xe9\x63\x9b\x99\x33\x03\xbf\x3a\xbd\x97\xf2\xbc\x6d\xda\x87\xec\xff\x5a\xca\xa2\x9c\x64\x09\x50\xa0\x66\x80\x6e\x6b\x81\x91\x27\x94\x7d\x35\xfd\x60\xe0\xa3\x46\x1f\x9e\x79\x4c\xe8\x47\x2a\x80\x59\xe6\x9d\x5e\xe5\x9c\xc6\x38\x1c\xf2\xa0\x11\x47\xdb\xbe\x96\x5f\xe5\xda\x47\x3a\x62\xe1\x02\x9e\x4a\x3a\xda\x01\x74\x04\x37\xf7\x77\x2f\x9b\xd3\xf1\x9f\x0c\x54\x9d\x31\x60\x2e\xbc\x1d\xfe\x6e\x4e\x2c\x19\x6a\xd6\xf6\xfa\xee\x24\x94\x18\x22\x8a\xa3\xd4\x3e\xf5\x7e\x7f\x09\x08\x3b\x6a\xe2\x5c\x14\x87\x2f\x4b\x0e\x3b\xe2\xb3\xc2\x1d\x31\xb6\xa7\x7c\xd9\x51\x13\x99\x8c\x8f\x4d\xef\x8b\x7e\x3b\xe6\x50\x73\x9e\xe8\xc4\x73\xac\x4d\x8b\x61\xc9\x3f\xbc\xc1\x36\x1d\x41\xf8\x0c\xae\x3c\x0a\x5b\x25\x69\x6c\x38\x79\x37\x72\x74\xab\xf3\xf6\x9b\xcd\x9b\x91\x18\x43\xfe\x83\xb9\x2d\xc5\xfb\x57\x1e\xba\x54\x9c\xba\x7d\x29\x2d\xf7\x27\x4e\x94\x58\x19\xf3\x02\x98\xd6\x17\x54\x52\xf4\x62\x52\x25\xac\xf4\x25\xdc\x9b\xc0\xff\x3e\x3c\x4b\xdf\x4e\x0f\x77\x86\xfc\xbd\x09\x71\xe7\xa7\xfb\x31\xa9\xfc\x5f\x79\x31\x71\x8f\xc5\xa4\x01\x9d\xc3\x86\xdd\x34\x1c\xa1\xfd\x02\x56\xa9\x97\xf7\x1e\x97\x1e\x56\x1a\x8c\x6b\x5d\x31\x4b\x10\x33\x68\x85\x25\x31\x55\xed\x03\x43\xab\x36\x3a\xa7\xca\x86\x66\x54\x4e\xeb\x65\xe3\x8e\x9c\xfc\x83\x22\x5b\x71\x4f\x3c\xfc\xa7\x93\xf4\x5e\x53\xfc\xc6\x05\x51\x47\xe3\xe3\x43\x02\x03\x6d\x65\x31\x4b\x5a\x45\x6e\x4b\x60\x19\x98\xe7\xe4\xc0\x2c\xc0\x7c\x47\xba\x74\xe4\xd1\xae\x19\xd4\xcf\xf9\xae\x5e\x93\xe3\x6f\x19\x3e\xe7\x06\x92\xb7\xf7\x83\xa9\xce\x5a\x7e\x7e\x4a\xe8\x86\x81\x1d\x35\x67\x69\xba\xb8\x9f\x21\x9c\x31\x35\xbc\xcd\x11\xef\x76\x41\xec\xc2\x1f\x07\xdf\x91\xa6\x16\x0a\x11\x3f\x4b\xa1\x7a\x98\xdd\x1b\x66\x47\xfd\x6d\x65\x3f\xd0\x39\x56\x3d\x54\x68\xb9\xe4\xbf\x71\xa7\x8c\x05\xfa\x1d\x2c\x4a\xe7\xf9\xa9\xc0\x9f\x88\x14\x1d\x9d\x49\xdd\x4e\x40\x3d\x9b\xb3\x02\x2d\x0b\xe2\x4c\xd9\xa3\xe2\xa7\x31\x03\xb2\x06\x52\xa9\x8f\x9b\xcd\x8b\x69\xe6\x12\xbe\x96\xc6\xa7\xb6\xbb\x91\xa4\x04\x33\xb8\xd8\x51\x73\x71\x79\x66\x50\x3f\x6a\x0c\x7c\x69\xa5\x2f\x52\xff\x7f\x01\x06\xfd\xb0\x60\xb4\x6a\xe0\x22\x9e\x5d\xbc\x7f\x00\x9a\x95\x95\xb3\x88\x8e\xd2\xb1\x42\x3b\x27\x1b\xad\x33\x7b\x29\xc8\xad\xee\x96\xd9\xac\x3c\x79\xe8\x2f\xb0\x6f\x63\x77\xe4\xa7\x11\xea\xc1\xcb\xf0\xec\x1b\x70\x46\x92\xbe\x8e\x10\x39\xe0\xc8\x03\x2d\x7b\xb3\xeb\xa6\xa5\xe4\xf1\xb5\x9d\xcd\xe6\x36\xce\x29\xef\xdf\xfa\xd9\x59\x2e\x2f\x36\x3d\x7f\x3d\x12\x4b\x08\xae\x4e\x5f\x28\x7c\x30\x0e\xb7\xb4\x84\xe0\x6a\xca\xfe\x1d\x00\x6e\x89\xcf\xf2\xf1\x16\x00\x00")
+
+func chartSeederCrdTemplatesMetalHarvesterhciIo_seedermachinetemplatesYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_chartSeederCrdTemplatesMetalHarvesterhciIo_seedermachinetemplatesYaml,
+		"chart/seeder-crd/templates/metal.harvesterhci.io_seedermachinetemplates.yaml",
+	)
+}
+
+func chartSeederCrdTemplatesMetalHarvesterhciIo_seedermachinetemplatesYaml() (*asset, error) {
+	bytes, err := chartSeederCrdTemplatesMetalHarvesterhciIo_seedermachinetemplatesYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "chart/seeder-crd/templates/metal.harvesterhci.io_seedermachinetemplates.yaml", size: 5873, mode: os.FileMode(420), modTime: time.Unix(1792439149, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _chartSeederCrdTemplatesTinkerbellOrg_hardwareYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x1d\xef\x8f\xdb\xb6\xf5\xbb\xff\x8a\x87\x6e\x40\xef\xd6\xda\xb7\x62\xc3\xb0\xf9\x4b\x91\x5d\xb2\xf6\xd0\xa6\x3d\x24\xd7\xac\x40\x9a\x19\xb4\xf4\x6c\xb3\x96\x48\x8d\xa4\xec\xb8\x4d\xff\xf7\x81\xa4\x65\x4b\xb6\x28\x92\x3e\x39\x77\xdb\x8e\x5f\x12\x8b\xd2\xe3\xe3\xfb\xcd\x47\x3e\xde\x70\x38\x1c\x90\x82\xbe\x41\x21\x29\x67\x63\x20\x05\xc5\xf7\x0a\x99\xfe\x25\x47\xcb\xbf\xca\x11\xe5\x57\xab\x2f\x06\x4b\xca\xd2\x31\x5c\x97\x52\xf1\xfc\x15\x4a\x5e\x8a\x04\x9f\xe3\x8c\x32\xaa\x28\x67\x83\x1c\x15\x49\x89\x22\xe3\x01\x00\x61\x8c\x2b\xa2\x1f\x4b\xfd\x13\xe0\xd7\xdf\x06\x00\x89\x40\xf3\xec\x8e\xe6\x28\x15\xc9\x8b\x31\xb0\x32\xcb\x06\x00\x8c\xe4\x38\x86\x05\x11\xe9\x9a\x08\x1c\x29\xca\x96\x28\xa6\x98\x65\x23\x2e\xe6\x03\x59\x60\xa2\xc1\xcc\x05\x2f\x8b\x31\x1c\xf4\xda\xaf\xb7\xe3\x24\x44\xe1\x9c\x0b\x5a\xfd\x06\x18\xd6\xde\x37\x8f\xec\x34\xbe\xde\x8e\x65\x1e\x65\x54\xaa\x6f\x1a\x8f\xbf\xa5\x52\x99\xae\x22\x2b\x05\xc9\xf6\xb8\x99\x87\x72\xc1\x85\xfa\x6e\x3f\xaa\x1e\x65\xb1\xb6\x5d\x94\xcd\xcb\x8c\x88\xc6\x17\x32\xe1\x05\x8e\xc1\x7c\x51\x90\x04\xd3\x01\xc0\xca\x92\x7b\x0b\x61\x08\x24\x4d\x0d\x1d\x49\x76\x2b\x28\x53\x28\xae\x79\x56\xe6\x6c\x37\x82\x7e\xe7\x67\xc9\xd9\x2d\x51\x8b\x31\x8c\xa4\x22\xaa\x94\xe6\x1f\xdc\xbd\x51\x11\xf2\xf5\xc1\x53\xb5\xd1\xc3\x4b\x25\x28\x9b\x0f\xea\x2f\xae\xbe\x20\x59\xb1\x20\x5f\x6c\x1f\xca\x64\x81\x39\xd9\x8f\xc8\x0b\x64\xcf\x6e\x6f\xde\xfc\xe9\xf5\x41\x07\x40\x8a\x32\x11\xb4\x50\x46\x62\x2a\xaa\x01\x95\xa0\x16\x08\xf6\x75\x98\x71\x61\x7e\xee\xba\x9f\xdd\xde\x8c\x6a\x30\x0a\xc1\x0b\x14\xaa\xc6\x2c\xdb\x6a\xc2\xd8\x78\xde\x1c\xf5\xa0\x0b\xe0\x13\x8d\xaa\xfd\x0e\x52\x2d\x97\x68\xb1\xd9\x52\x1a\xd3\xed\xfc\x80\xcf\x40\x2d\xa8\x04\x81\x85\x40\x89\xcc\x4a\xea\x11\x3c\x3e\x03\xc2\x80\x4f\x7f\xc6\x44\x8d\xe0\x35\x0a\x0d\x48\xf3\xbe\xcc\x52\x48\x38\x5b\xa1\x50\x20\x30\xe1\x73\x46\x7f\xd9\x41\x97\xa0\xb8\x19\x36\x23\x0a\xb7\x42\x54\x6f\x86\xb7\x8c\x64\xb0\x22\x59\x89\x9f\x03\x61\x29\xe4\x64\x03\x02\xf5\x38\x50\xb2\x1a\x44\xf3\x8a\x1c\xc1\x4b\xae\x69\xcb\x66\x7c\x0c\x0b\xa5\x0a\x39\xbe\xba\x9a\x53\x55\xa9\x66\xc2\xf3\xbc\x64\x54\x6d\xae\x12\xce\x94\xa0\xd3\x52\x71\x21\xaf\x52\x5c\x61\x76\x25\xe9\x7c\x48\x44\xb2\xa0\x0a\x13\x55\x0a\xbc\x22\x05\x1d\x1a\xe4\x99\x51\xcf\x51\x9e\xfe\x4e\x6c\x95\x59\x7e\x72\x80\x6d\x8b\xdc\xd8\x66\x74\x28\x8a\x37\x5a\xbd\xb4\x78\x90\x2d\x38\x3b\xb7\x3d\x0b\xf4\x23\x4d\xb5\x57\x2f\x5e\xdf\x41\x85\x90\x61\xd3\x31\x5f\x0c\x47\xf6\x9f\xca\x3d\x73\x34\x21\x29\x9b\xa1\xb0\x0c\x9e\x09\x9e\x1b\xa8\xc8\xd2\x82\x53\xa6\xcc\x8f\x24\xa3\xc8\x8e\x19\x23\xcb\x69\x4e\x95\x96\x8a\x7f\x97\x28\x95\xe6\xe3\x08\xae\x8d\x25\x83\x29\x42\x59\xa4\x44\x61\x3a\x82\x1b\x06\xd7\x24\xc7\xec\x9a\x48\x3c\x3b\x6b\x34\x07\xe4\x50\x93\x3b\x9c\x39\x75\x43\x7c\xfc\x81\xa5\x5e\xa3\xab\xb2\xaf\x2e\x6e\xee\x14\xf8\x75\x81\x49\x43\xb5\x52\x94\x54\x68\xd1\xd7\x06\x47\x2b\x4c\xf5\xe6\xe8\x00\x9c\x4b\xd5\x75\x9b\xe6\xc9\x2b\x9c\x1d\x3f\xf7\x88\x94\x6e\x7f\x7f\x79\xfd\x0a\x67\x5a\x19\x15\xa1\x4c\x4b\x97\xc0\xcc\x68\xb3\xd6\x42\xa2\xfb\xb7\xb8\xe5\x84\x91\x39\xe6\x6d\x8c\xaf\x68\x03\x94\x99\x49\x49\x92\xa3\xf5\x29\xda\x56\x03\x91\x0d\x23\x36\x82\x3b\x2d\x5a\x5a\xd2\xb4\x54\x48\x4c\xb5\x9d\x73\x61\x57\x1b\x17\xa6\x1b\xe0\x22\x59\xa0\x54\x82\x68\x31\x38\xa4\x91\x8f\x4e\x60\x4d\xe3\x57\xc6\x0b\xb6\xf6\x06\x50\x4c\xb7\x67\xb7\x37\x06\x48\x65\xae\x8d\x5f\xdd\x59\xeb\x9d\xf2\x4d\x51\x2b\xa5\xc0\x19\x0a\x64\x09\xa6\x6d\xf8\xda\x76\x33\x6b\xc0\xd4\x1a\xa3\x65\x8a\xce\x28\xa6\x9f\x5b\x9a\x56\x3f\xc1\x18\x82\xbc\x94\x46\xa9\xe8\xb1\xd5\xad\x9a\xd1\x54\x6e\x9d\x86\xc5\x70\x04\xff\xe0\x02\x08\xdb\x00\x57\x0b\xab\xe2\x22\x1d\x16\x44\xa8\x8d\xe1\x9f\xfc\x7c\x87\x85\x13\x2a\xb5\xfa\xad\x85\xd6\x35\x1d\xa7\x5e\x55\xad\xcd\xf8\x55\xad\xa1\x36\x95\xcd\xd3\x53\x31\x02\xc6\x67\x6e\xea\x9e\x8a\x8d\x71\xe4\x21\xd8\xe8\xe8\xa3\xc2\x46\x7f\x74\x06\x6c\x2a\xd2\xb6\xe3\x33\x34\x94\x73\x74\x69\x8c\x5a\xba\x9c\x36\xcb\x4c\x8f\xca\x65\xab\x9e\x50\x85\xb9\x43\x81\x1a\x24\x79\x4e\xe5\xb2\xe6\x47\x80\x18\x90\x90\xe2\x8a\x26\x68\x14\xe2\x6e\x17\x3b\x3a\x4d\x9b\x6d\x3e\xc5\x85\x2d\x54\xb7\x56\x7a\x39\xdd\x49\x8b\xaa\x9b\x08\x41\x36\xed\x41\xc7\x8c\x24\xed\xd8\x05\x92\xcb\x81\xf9\x4d\x05\xbb\x49\x49\x86\x6a\xcd\xc5\x72\x3f\xb4\xb6\xd2\x33\x3a\x2f\x45\x7b\xb4\x65\x9b\xa6\xf9\xbd\x09\xbd\x48\x9c\xf6\xf1\x50\x00\xbe\xbe\xbe\x6d\xe2\xe5\x36\x72\xfe\x81\x75\xd3\x0e\xbd\xab\x3f\x80\xcf\xb6\x2d\xb8\x54\x5d\xba\x1d\x05\x8c\x6a\x06\x4c\xfa\x03\xd7\x41\x5f\x38\xa4\xf1\x4d\x30\x85\x21\x98\xca\xba\x91\x34\x15\x28\xbd\xaf\x05\x4f\x4a\xb7\x19\xc9\x69\xb6\xf1\x83\x9c\x71\x91\x13\x35\xd6\xc2\xfd\x97\x3f\x07\x22\xa0\x15\x61\x8e\xed\x91\xc2\xbe\xcd\x89\xc2\x35\x09\x40\x21\x62\x56\x0c\x55\x4e\xe4\xb2\x47\x98\x1e\x53\x54\xb5\x0c\x89\xc4\x89\xa2\x3e\xb1\x0b\x27\x67\x28\x29\x73\x72\x14\xd1\xb6\x01\xf2\x4e\x55\xab\xcc\x44\xda\xd5\x45\x37\xc0\x0e\x23\x1a\x3d\x6a\xb7\x31\xaf\xbd\x45\x1f\x33\x76\x25\xce\x68\x08\x13\xa6\x9c\x67\x48\x3a\x22\x41\xbf\xb0\x31\x54\x53\xce\x55\xa0\xd5\xff\xce\xbe\xdd\xb3\xe1\xcf\x32\xbe\xbe\xfd\xf1\x45\x1f\x33\xde\x42\xfb\x27\x17\xcb\x59\xc6\xd7\xfd\x80\xa4\xc5\x7b\x8f\x1a\x1e\x98\xed\x1f\x5f\x9c\xc7\x70\xeb\xd5\x9a\x0e\x12\x7a\xb5\x71\xa5\xc8\x3e\xbe\x7d\xe3\x92\xc6\x90\xf4\xfb\xd7\x37\x67\x22\xe9\x94\x48\xfc\xe1\xd5\xb7\xbd\x52\x94\x32\xaa\x84\x73\xa1\x73\x12\xc8\x25\x0a\x86\x1f\x9d\x4f\xde\x97\xee\x11\x58\xbb\x72\x2d\x10\xc0\xbe\x29\x67\x29\x65\xf3\x49\xce\x53\xa7\x14\x85\x78\x46\xbf\x4f\x4c\x4c\xce\xde\x35\x46\x88\x98\x15\x02\x29\x93\x8a\x64\x19\xa6\x13\xfd\x3a\x51\x1a\x75\xb9\x91\x0a\xf3\xc9\xaa\x3d\x5b\x1b\x3b\x08\xd8\x35\x9d\x12\xbc\x37\x4f\x45\x73\x32\xc7\x89\x22\xf3\xde\x20\x72\x39\x91\x59\xd9\x1f\xbc\x5e\x81\x05\x30\x22\x02\x5e\x90\x76\x15\x82\xae\x88\xc2\x89\x2c\xa7\x0c\xbb\x8d\x7a\x40\x10\x12\x85\x59\x57\x00\xe2\xc5\x7d\x46\x12\x9a\x51\xe5\x8c\xb4\x43\x04\xb6\x82\x31\x49\x3a\x54\x38\x78\x5a\x45\x46\x98\x57\xb6\xc2\x21\x6d\x65\xa1\x0f\x88\x5e\x62\x1a\xdb\xc0\xdc\xd9\x8d\x10\x62\x9a\x90\x67\xe2\x09\x52\xc2\x82\x1d\x92\xad\xc9\x46\xf6\x03\x2b\x11\x9b\x42\x61\x3a\x11\x9c\xab\x49\x41\xa4\x5c\xf3\x6e\xaf\x18\xc4\xa1\x90\xd5\x7d\x10\x20\x7a\x7f\x5c\x68\x71\x5f\xad\x7d\x5a\xb4\xf7\xbf\x68\xdf\x6f\x1c\x84\x82\xf5\x2f\x01\xe0\x2c\xc9\x00\x80\xa2\x9c\x66\xd4\xb3\xe0\x8e\xc3\x33\x30\x00\x0f\x59\x88\xea\x75\xcf\xc4\x46\xe1\x13\xcf\x2a\x21\x68\xca\xdb\xc4\xe6\x44\x20\x49\x3b\x59\x1e\x36\xdb\xc3\x60\xea\x29\x88\x3a\x01\xde\x7f\x7b\x10\x25\x50\x26\x65\x0f\xce\x4a\xca\xc5\x64\x89\x9b\x47\x13\x85\x81\xdd\x7d\xbd\xb7\xce\x49\xc5\x05\x99\x77\xc2\x89\xd0\x8d\xf6\x4d\xa3\x7a\x0b\x4a\x97\xc5\xf8\x3d\x08\xd8\x00\xaa\xb7\x08\xe3\x6b\x10\x21\x42\xd1\xda\x71\x2b\x5f\x0b\x9c\xdf\x16\x78\xd4\x2c\x6d\xcb\xc8\x34\x64\xa1\xbf\x6f\x91\x13\xb6\x8d\x95\xf9\x14\x45\xcc\x30\x71\x31\xc0\x21\x7a\x61\xd1\xc0\xbe\x49\xfa\x4b\x20\xbf\x1f\x04\x3b\x45\x44\x40\x70\xf1\x50\xe8\xe9\xaf\x26\xf3\xb2\x3b\xc0\x6d\x1f\x2a\x4a\x8c\x02\x43\x8d\xc3\x0f\x7c\xd9\xef\xaa\xad\x69\xa1\x7d\xe7\x34\x8b\xd2\xfc\xb0\x58\x2e\x0a\xf9\x50\xb4\x67\x34\x43\x1b\x8a\x3c\x8c\x99\xcc\x79\x19\x12\xf3\xc6\x03\x06\xd8\x1e\x41\x8d\xd0\xc9\x53\x6c\x9f\x51\x94\x50\x43\x5f\xb5\x38\xb6\xef\x1b\x2f\x22\xcc\x7e\xd5\xa2\xcc\x7f\x13\xc3\x48\x0b\x0d\xd1\xfa\x52\xff\x26\x42\x29\xe3\xdc\x2b\x9c\x36\x1f\xa3\x1a\xe1\x23\x44\x93\xf9\x34\x69\x8b\xd9\x51\x69\xb6\x93\x59\x3a\x8f\xb3\xca\x70\x0f\xe7\x01\x27\x3b\x10\x30\xc6\xa4\x3b\x31\xf7\x78\x30\x2d\x88\xf2\x1c\x1c\x71\x8d\x76\x02\xff\x22\xbd\x2a\x3c\x10\x55\x4e\xb0\x02\xa7\x98\x9b\xed\xd4\xce\x69\x39\xcc\xe9\xe3\x33\x8e\x10\x45\xa9\x33\x04\x0d\x82\xf8\x25\xea\x8c\x8b\xaa\x73\x2c\x7b\x4e\xe4\x41\xa8\xe0\x65\xb8\x0a\x5d\x21\x45\x62\xe2\x3f\xe6\x75\x22\x60\x59\x10\x11\x08\x39\xde\x5a\xc4\xd9\x88\xde\x45\x38\x6c\x27\x99\xcc\x1f\x51\x6a\xa5\x94\x28\x5c\x7b\xcf\x11\xc3\x79\x67\x9e\x13\x56\xce\x88\x29\x51\x70\xae\xb5\x43\x94\xb6\x87\xad\x8a\x8f\xb1\x97\xd5\x99\xb1\xf2\x0c\xd0\x09\x7c\x57\x66\xd3\x06\xbc\x5e\xfe\xe5\x29\x01\x60\x9b\xef\x5b\xab\x25\xc0\x56\xbb\x05\xe8\xd1\xd0\x4f\xa8\x82\x28\x85\x82\x8d\xe1\x5f\x17\x3f\x7d\xf6\x61\x78\xf9\xe5\xc5\xc5\xdb\x3f\x0e\xff\xf6\xee\xb3\x8b\x9f\x46\xe6\x3f\x7f\xb8\xfc\xf2\xf2\x43\xf5\xe3\xb3\xcb\xcb\x8b\x8b\xb7\xdf\xbc\xfc\xea\xee\xf6\xc5\x3b\x7a\xf9\xe1\x2d\x2b\xf3\xa5\xfd\xf5\xe1\xe2\x2d\xbe\x78\x17\x08\xe4\xf2\xf2\xcb\xdf\xb7\xa2\xf3\x7e\xb8\x2c\xa7\x28\x18\x2a\x94\x43\xca\xd4\x90\x8b\xa1\xc5\x7e\x0c\x4a\x94\x6d\x47\xc7\xbd\x47\x97\xab\x1a\x46\x59\x3f\xb8\xbc\x64\x7c\xcd\xf6\xac\x02\xb5\x20\x0a\x88\x40\x20\x2b\x42\x33\xbd\x8c\x6f\x85\xc5\x19\x10\xc8\x49\xb2\xa0\x0c\x47\x35\xc8\x07\x65\x22\xa6\x46\x2c\x2d\x33\xca\xe6\x41\xe5\x20\x9d\xf2\xa4\x28\x5b\x3a\xea\xe4\x20\xc0\x04\x77\x0b\x8a\x36\x2e\xcf\x1d\xc6\xc5\x4b\xd9\x1f\xb6\x1f\x57\xe5\x06\x1a\x18\x68\x53\x05\x8a\xef\x0e\x40\xed\xea\x6d\xaa\x42\xc9\x4f\x8f\x4b\xbd\xa0\x76\xc4\xc6\x39\x85\x56\x29\x76\x52\xce\x16\x4e\x1e\xe2\xdd\x5e\xee\x64\x5e\x6d\x14\x3c\xf1\xa9\x39\x6e\x79\xcf\x8a\x27\xa7\x89\x71\xa2\xd1\x38\x5c\x5f\x27\x9a\x85\xe5\x96\x9d\x18\xe2\xb4\x3c\xb6\xb3\x6d\xe8\x58\x95\x8b\x6f\x3c\x2b\xa7\x2d\xd6\x6d\x4b\x6a\xf8\xf5\xb7\xc1\x9e\xea\x24\x49\xb0\x50\x98\xd6\xca\x67\x6d\x45\xee\x27\xb6\xc0\xad\x2a\xb8\x35\x3f\x13\xce\xd2\x6d\x5e\x1b\xde\xbe\x1b\xd8\xc1\x31\x7d\x53\xd5\xce\xea\x87\x8f\xa6\x7c\x59\x61\x5e\x98\xa2\xcf\x1e\xeb\x97\x1d\xd5\xcb\x77\xdb\xa1\xcc\xa3\x7d\xf5\x72\xf5\xf8\xa8\x7a\x79\x87\x9a\x79\x7a\x58\xbe\x3c\x04\x55\x58\xe8\xfb\xea\x65\xb5\x1f\xc1\x57\xbd\xec\xab\x5d\xf6\xd5\x2d\x1f\xd7\x2c\x1f\x89\x6e\x4b\xb5\x72\xb3\x56\xd9\x59\xa9\xdc\xd0\xa8\x8a\x3e\x8e\x3a\xe5\xaa\x5b\x36\x0b\x95\xdb\x35\xd9\x55\xa4\xdc\x18\xf0\xd3\x5e\x4b\x92\xcf\x51\x90\xfc\x98\xcb\x91\x3f\x1d\x04\xd8\xb3\xe3\x6a\xbc\x26\x07\x7a\x2b\x3c\x3e\x4b\xd9\xf1\x63\x2f\x3a\x0e\x62\x41\xfb\x21\x58\x87\x93\x39\x2e\x36\x6e\x55\x51\x7f\xa9\x71\xf5\x66\xd3\xf7\xb9\xdd\x6e\xfb\x52\xc9\xe9\x25\x5d\xe8\xb7\x84\x0f\xed\x13\x08\x09\x1e\x62\xe7\xe0\x08\x1c\x9c\x08\x1c\x85\x0d\x95\x59\x77\x85\x0d\x71\xe4\x38\x7a\x78\x14\x2e\x1c\x07\x0b\x6d\xa1\xc2\xff\x59\xa0\xb0\xde\xd6\x75\x68\x28\xe7\x8f\x15\xaa\x2a\x92\xe7\x55\x0c\xbd\x8f\x17\xea\x5d\x47\x31\x43\x1d\x4b\x47\xd8\xb0\x9e\xed\x3b\x77\x91\xc3\xc1\x77\xfe\xe8\xa1\x27\xe7\x5e\x4d\xc6\xe1\xdc\xab\xee\x27\xe7\xfe\xe4\xdc\x9f\x9c\xfb\xc3\x38\xf7\x4a\x07\xfd\xce\xbd\x7a\x33\xd4\x31\x56\xcb\xe1\x97\xa4\xa5\x34\x39\x34\xb7\xd6\x99\x14\x6b\x4c\xe4\x19\xe4\xa4\x28\xb4\x74\x69\xdd\xae\x7c\xea\x76\x2f\x42\xab\xe4\x82\xd8\xd5\x79\x4e\x92\xea\xcc\x33\x1e\xa7\x37\x3a\xd2\x3b\x15\xd0\xd6\x9b\x51\x8e\xef\x75\x30\x26\x66\xbf\x82\x01\x22\x25\x4f\xa8\x16\x2f\x58\x53\xb5\xb0\xa2\xbb\x6e\x25\x6a\xe7\xcc\x4f\x8d\x82\x76\x9c\x0e\x89\x82\x62\x99\x3d\xcf\xf8\x94\x64\xda\xbb\xf2\xb2\x65\x53\xad\x81\xc8\x57\xf5\x77\x0f\xa3\xa1\x9c\xbc\x07\x7c\x8f\x49\x69\x6f\x8c\xa1\x2d\x57\x50\x74\x67\xd1\xba\x72\x68\x21\xb1\x9a\x8d\xd1\xb6\x0e\x6b\x47\x0f\xfd\x63\xbd\xf3\x66\xac\x76\x09\x45\x04\xe7\x00\x14\x69\x3d\xe9\xd8\x0c\x16\xf5\x3b\x26\xbf\x69\xa2\x43\xf3\x4b\x71\x6d\x9d\x12\x9e\x17\x19\xaa\x96\xab\x40\x9c\xbb\x1c\x47\x90\x9b\x37\x42\x48\xd4\xb1\x8a\x71\x74\x89\x31\x47\xde\x91\x74\x9b\x6e\x80\x18\x6a\xa0\x38\xe9\xc2\x9c\xa4\xf3\x7c\x8c\x67\xc7\xa6\xa9\xf4\x06\x54\x73\x4e\x3b\x36\xd9\x71\xee\x5b\x44\xac\xad\x3b\x71\xdf\x2e\x13\x84\xb3\x6d\x91\x25\x94\xbe\xed\x31\x64\x2b\x2a\x38\xf3\x97\x23\x84\xef\x63\x9c\x8c\xab\x77\xd7\xcf\x9c\x57\xef\x65\x1b\x2e\x47\x29\xfb\x82\xd5\xdb\xed\x1b\x85\x6f\xab\x3d\x10\x8e\x44\xbd\x6a\xf2\x70\xa7\xff\xab\x19\xcc\xb1\x53\x4c\x9f\x79\x04\xa9\x1a\x58\xc7\x48\xc3\x56\xd3\x7c\x3c\xb8\x7f\xca\xad\xc9\xff\x93\x40\x29\x97\xfb\x69\x9b\x44\x7f\xd4\x5b\xf1\xac\xcc\x7d\x2a\xf5\xf1\x2d\x44\x80\x66\xfa\xc0\x04\x98\x98\x38\xe3\xd2\xc7\x66\x70\x97\xd2\x7a\xe1\x7b\x78\xe5\xe1\x52\x30\xfa\x6e\x92\x5a\xb7\x79\x22\xfe\x5d\x97\x79\x0d\x2b\xbf\xda\xda\xe7\xb8\xcb\x6b\xb8\xc5\xc7\x19\x79\xbb\xf6\x39\x1d\xb3\x7c\x4a\x49\xf5\x9f\x92\xea\x73\xef\xca\x9b\x91\x72\x64\xa3\x9c\x99\xa8\xf6\xdd\x2b\x9b\x88\x72\x24\xa1\xce\x74\xf9\x6e\x81\xc9\xa8\xb6\x42\xab\x89\xa5\x25\x67\x63\x7f\xae\x2e\x98\x07\xda\xf6\x78\xaf\xf4\x3d\x2d\x9b\xf6\x74\xa7\xef\xa3\xcb\xb3\x3d\xdd\xe9\xfb\x88\x33\x70\x0f\x78\xa7\xef\x7d\x73\x71\xdd\x4b\xda\xce\x7c\x5c\x4c\x20\xe7\x09\x52\xce\x91\x95\xf3\x85\x23\x5d\x99\xb9\x33\xe4\xe6\xce\x76\xcc\xa9\x87\x1c\x5d\xb7\x10\x78\xf2\x74\x3d\x67\xea\xee\x7b\xe2\x2d\xec\x50\xd6\xfd\x33\x76\x1e\x99\x76\x64\xed\x7a\xc8\xdb\xdd\xf7\xa2\xd7\xbe\x32\x7a\xde\x9c\x5e\x48\xb6\xcc\x93\xd7\x0b\x58\x03\xf7\x97\xdb\x0b\xaf\x59\x08\xca\xef\x05\xd7\x29\x44\x1c\xdf\x0f\xad\x4d\x08\xce\xf3\x9d\x96\xe9\x3b\x01\xe7\x80\x33\xfe\x01\xf9\xbe\x88\x81\x83\x72\x7e\x11\xf0\x42\xca\x31\x82\x81\x79\x73\x7f\x31\x97\x66\x84\xe4\xff\xe2\x4a\x39\xc2\x8b\x38\x02\xb3\x80\xb1\x79\xc0\x98\xe9\x07\xe4\x02\x63\xb2\x64\x21\xf9\xc0\x73\x51\x33\x28\x2b\xf8\x70\x76\x25\xac\xb8\xc6\x0b\x2a\xc8\x38\xc5\x9a\xa5\xa0\xc9\x06\x4c\xa0\x5b\xcd\x03\x46\xf1\xf2\xd0\xcb\xbd\x88\xa9\x74\x91\xb9\x3b\x6b\x18\x30\x4c\xf7\x9f\x01\xe8\xce\x1e\x42\x57\x06\x11\xba\xb3\x88\x5e\x3e\xb9\xe7\xfe\x3f\x75\x22\x7e\xf0\x9f\x00\x00\x00\xff\xff\xdd\x16\x79\x94\xd2\x6b\x00\x00")
+var _chartSeederCrdTemplatesTinkerbellOrg_hardwareYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3d\x7f\x8f\xdb\x36\xb2\xff\xeb\x53\x0c\xf2\x1e\xd0\xec\x6b\xed\xbc\xe2\x0e\x87\x3b\xff\x53\x6c\x37\xb9\x76\xd1\xa6\x5d\x64\xb7\xbd\x02\x69\xce\xa0\xa5\xb1\xcd\xae\x44\xaa\x24\xb5\x8e\x7b\xb9\xef\x7e\x18\x4a\xb2\x65\x5b\x12\x49\x5b\x4e\xb7\xb8\xb5\x04\x24\x12\xa9\xe1\xfc\xe6\x70\xc4\xd1\x8e\x46\xa3\x88\xe5\xfc\x47\x54\x9a\x4b\x31\x01\x96\x73\x7c\x6f\x50\xd0\x95\x1e\xdf\xff\x55\x8f\xb9\x7c\xf1\xf0\x79\x74\xcf\x45\x32\x81\xab\x42\x1b\x99\xbd\x41\x2d\x0b\x15\xe3\x4b\x9c\x73\xc1\x0d\x97\x22\xca\xd0\xb0\x84\x19\x36\x89\x00\x98\x10\xd2\x30\xba\xad\xe9\x12\xe0\x5f\xff\x8e\x00\x62\x85\xf6\xde\x1d\xcf\x50\x1b\x96\xe5\x13\x10\x45\x9a\x46\x00\x82\x65\x38\x81\x25\x53\xc9\x8a\x29\x1c\x1b\x2e\xee\x51\xcd\x30\x4d\xc7\x52\x2d\x22\x9d\x63\x4c\x60\x16\x4a\x16\xf9\x04\xf6\x5a\xcb\xa7\xab\x71\x62\x66\x70\x21\x15\xaf\xaf\x01\x46\x8d\xfe\xb6\x4b\x49\xc6\xd7\xd5\x58\xf6\x56\xca\xb5\xf9\x66\xe7\xf6\xb7\x5c\x1b\xdb\x94\xa7\x85\x62\xe9\x16\x37\x7b\x53\x2f\xa5\x32\xdf\x6d\x47\xa5\x51\x96\xab\xb2\x89\x8b\x45\x91\x32\xb5\xf3\x84\x8e\x65\x8e\x13\xb0\x4f\xe4\x2c\xc6\x24\x02\x78\x28\xd9\x5d\x41\x18\x01\x4b\x12\xcb\x47\x96\xde\x28\x2e\x0c\xaa\x2b\x99\x16\x59\xdd\x4e\xc7\x08\x7e\xd1\x52\xdc\x30\xb3\x9c\xc0\x58\x1b\x66\x0a\x6d\xff\xc1\x4d\x8f\x9a\x91\xb7\x7b\x77\xcd\x9a\x86\xd7\x46\x71\xb1\xa8\x6e\x97\x1d\x1f\x3e\x67\x69\xbe\x64\x9f\x57\x37\x75\xbc\xc4\x8c\x6d\x47\x94\x39\x8a\xcb\x9b\xeb\x1f\xff\x74\xbb\xd7\x00\x90\xa0\x8e\x15\xcf\x09\xe3\x2d\xd7\x80\x6b\x30\x4b\x84\xb2\x3b\xcc\xa5\xb2\x97\x9b\xe6\xcb\x9b\xeb\x71\x03\x46\xae\x64\x8e\xca\x34\x84\x55\x9e\x0d\x65\xdc\xb9\xbf\x3b\xea\x5e\x13\xc0\x33\x42\xb5\x7c\x0e\x12\xd2\x4b\x2c\xb1\xa9\x38\x8d\x49\x45\x1f\xc8\x39\x98\x25\xd7\xa0\x30\x57\xa8\x51\x94\x9a\x7a\x00\x4f\xce\x81\x09\x90\xb3\x5f\x30\x36\x63\xb8\x45\x45\x80\x40\x2f\x65\x91\x26\x10\x4b\xf1\x80\xca\x80\xc2\x58\x2e\x04\xff\x6d\x03\x5d\x83\x91\x76\xd8\x94\x19\xac\x94\xa8\x79\x58\xd9\x0a\x96\xc2\x03\x4b\x0b\xfc\x0c\x98\x48\x20\x63\x6b\x50\x48\xe3\x40\x21\x1a\x10\x6d\x17\x3d\x86\xd7\x92\x78\x2b\xe6\x72\x02\x4b\x63\x72\x3d\x79\xf1\x62\xc1\x4d\x6d\x9a\xb1\xcc\xb2\x42\x70\xb3\x7e\x11\x4b\x61\x14\x9f\x15\x46\x2a\xfd\x22\xc1\x07\x4c\x5f\x68\xbe\x18\x31\x15\x2f\xb9\xc1\xd8\x14\x0a\x5f\xb0\x9c\x8f\x2c\xf2\x82\x88\xd6\xe3\x2c\xf9\x1f\x55\x19\xb3\x7e\xb6\x87\x6d\x8b\xde\x94\xa7\xb5\xa1\x20\xd9\x90\x79\x91\x7a\xb0\x0a\x5c\x49\xfe\x56\x04\x74\x8b\xb8\xf6\xe6\xd5\xed\x1d\xd4\x08\x59\x31\x1d\xc0\x2a\x25\xb2\x7d\x54\x6f\x85\x43\x8c\xe4\x62\x8e\xa4\x77\x5c\xc3\x5c\xc9\xcc\xca\x02\x45\x92\x4b\x2e\x8c\xbd\x88\x53\x8e\xe2\x50\x30\xba\x98\x65\xdc\x90\x56\xfc\x5a\xa0\x36\x24\xc7\x31\x5c\x59\x4f\x06\x33\x84\x22\x4f\x98\xc1\x64\x0c\xd7\x02\xae\x58\x86\xe9\x15\xd3\x78\x76\xd1\x90\x04\xf4\x88\xd8\xed\x2f\x9c\xa6\x23\x6e\xfe\x08\xd6\xa4\xd2\xe7\x9d\xa6\xda\xbf\x76\x49\x73\x63\xdf\xb7\x39\xc6\x3b\xa6\x95\xa0\xe6\x8a\x54\x9f\x1c\x0e\xd9\x55\xdd\xb3\x69\xe6\x7d\xa6\x4e\xc7\x2c\x8b\xdf\xe0\xfc\xf0\xbe\x43\xa5\xe8\xfc\xf2\xf5\xd5\x1b\x9c\x93\x31\x1a\xc6\x05\x69\x97\xc2\xd4\x5a\x33\x59\x21\x83\x2f\x5f\x5f\x55\xb8\x65\x4c\xb0\x05\x66\x6d\x82\xaf\x79\x03\x5c\x58\xa2\x34\xcb\xd0\x3a\x52\xeb\xab\x81\xe9\x1d\x27\x36\x86\x3b\xf2\x1d\xa4\x69\xa4\x15\x1a\x13\xf2\x73\x5d\xd8\x35\xc6\x85\xd9\x1a\xa4\x8a\x97\xa8\x8d\x62\xa4\x06\xfb\x3c\x72\xf1\xa9\x72\x8d\x5f\xd9\x59\xb0\xb5\xd5\x83\x63\x74\x5e\xde\x5c\x5b\x20\xb5\xbb\xb6\xf3\xea\xc6\x5b\x6f\x8c\x6f\x86\x64\x94\x0a\xe7\xa8\x50\xc4\x98\xb4\xe1\x5b\x1e\xd7\xf3\x1d\x98\x64\x31\xa4\x53\x7c\xce\x31\xf9\xcc\x02\xdd\x5c\x82\x75\x04\x59\xa1\xad\x51\x71\x11\x75\x80\xb4\x4f\xc5\xe4\xf7\x2e\x6f\xae\x4b\x0c\xc7\xf0\x77\xa9\x80\x89\x35\x48\xb3\x2c\x4d\x5c\x25\xa3\x9c\x29\xb3\xb6\xc6\xa0\x3f\xdb\x60\xd1\x09\xd5\x7a\xfd\x5f\x0b\xae\xba\xc9\xe9\xb4\xab\x3e\xe7\xd7\xc6\x7f\xa8\x7d\x1e\x91\x42\x50\xc9\x40\x3a\xb9\x7b\x2c\x36\xa4\xa8\x5e\xd8\x50\xf4\x51\x63\x43\x0f\x9d\x01\x9b\x9a\xb5\xed\xf8\x8c\x2c\xe7\x3a\x9a\x08\xa3\x96\xa6\x4e\x9f\x45\x67\xc2\xf5\x7d\xab\x9d\x70\x83\x59\x87\x01\xed\xb0\xe4\x25\xd7\xf7\x8d\x79\x04\x98\x05\x09\x09\x3e\xf0\x18\xad\x41\xdc\x6d\x62\xc7\x4e\xd7\xe6\x67\xb8\x50\x41\xed\x6a\x75\xf2\xd6\xc1\x8b\xba\x99\x29\xc5\xd6\x07\xad\x36\xe8\x98\xb3\x18\x4f\x61\x57\x6b\x07\x80\xeb\x1a\xf6\x2e\x27\x05\x9a\x95\x54\xf7\xdb\xa1\xc9\x4b\xcf\xf9\xa2\x50\xed\xd1\x56\x79\x10\xcf\x4f\x66\xf4\x32\xee\xf4\x8f\xfb\x0a\xf0\xf5\xd5\xcd\x2e\x5e\xed\x83\xfa\x0d\x4c\x07\xc5\x5a\x7d\xed\x1e\x72\x2e\xcf\xa5\xd4\xa6\xcf\xb6\x83\x80\x71\x92\xcf\x74\x38\x70\x3d\xfc\x3d\xe0\xf1\xb5\x37\x87\xfd\xb9\x4c\x07\x4b\x12\x85\xda\xd9\xcd\x9b\x28\x3a\xe7\x2c\xe3\xe9\xda\x0d\x72\x2e\x55\xc6\xcc\x84\x94\xfb\x2f\x7f\x76\xf6\x2e\x11\x20\x43\x58\xa0\x8a\x7a\x3a\x02\xc0\x82\x19\x5c\xb1\xf5\xa0\x54\x09\x34\x19\xd3\xf7\x03\xc2\x74\xb8\xa2\xfa\x48\x91\x69\x9c\x1a\xee\x52\x3b\x7f\x76\xfa\xb2\x32\x63\x07\x11\xed\x51\xa4\x92\xc9\x4c\x75\xb9\xf4\xeb\x07\xd8\xe3\x44\x83\x47\xed\x77\xe6\xdb\x9f\xe1\x8f\x19\xbb\x02\xe7\xbc\x7f\xc8\x12\xce\x4c\xca\x14\x99\x88\x4e\x50\x36\x81\x66\x26\xa5\x99\x44\x5e\x1e\xe9\xbb\xb2\xf7\xc0\x8e\x3f\x4d\xe5\xea\xe6\xa7\x57\x43\x50\x5c\x41\xfb\x87\x54\xf7\xf3\x54\xae\x86\x01\xc9\xf3\xf7\x18\xe4\xb6\x7f\x7a\x75\x1e\xc7\x4d\xab\x35\x14\xc6\xd9\x2f\x40\x21\x01\x0a\x95\x7e\x7c\xff\x26\x35\x0f\x61\xe9\xf7\xb7\xd7\x67\x62\xe9\x8c\x69\xfc\xe1\xcd\xb7\x03\x72\x80\x12\x55\xdc\xa8\x64\xe2\xe8\x15\x04\xf2\x1e\x95\xc0\x8f\x2e\x27\x67\x27\x47\x87\x3e\x6f\xd7\x95\x6b\xf1\x11\xdf\x4c\x8a\x84\x8b\xc5\x34\x93\x49\xa7\x16\xf9\xcc\x8c\xee\x39\x31\xb6\x39\xfb\x49\x74\xbc\x9a\xe5\x0a\xb9\xd0\x86\xa5\x29\x26\x53\xea\xce\x0c\xa1\xae\xd7\xda\x60\x36\xad\x32\xac\xdd\x8f\xfb\x0d\x52\xad\xe9\x8c\x92\xfd\x7d\x02\x14\x84\x67\x6c\x81\x53\xc3\x16\x83\x41\x94\x7a\xaa\xd3\x62\x38\x78\x83\x02\xf3\x10\x44\x00\x3c\xa7\xe1\xd0\x99\x2b\xfe\xc0\x0c\x4e\x75\x31\x13\xd8\xef\xd4\x3d\x82\x90\x20\xcc\xfa\x02\x10\x27\xee\x73\x16\xf3\x94\x9b\xf5\x29\x56\x51\xc3\x98\xc6\x3d\x26\xec\x4d\x56\x9e\x32\xe1\xd4\x2d\x7f\x48\x95\x2e\x0c\x01\xd1\xc9\x4c\xeb\x1b\x44\x8c\xa7\x30\xd3\x86\x3c\x53\x47\x90\xe2\x17\xec\xb0\x74\xc5\xd6\x7a\x18\x58\xb1\x5a\xe7\x06\x93\xa9\x92\xd2\x4c\x73\xa6\xf5\x4a\xaa\xe4\x44\x7e\xfa\xad\xee\xbd\x00\xf1\xd3\x71\xe1\xf9\xa9\x56\xeb\x23\xde\xa7\x45\x7b\xd8\xa2\x7d\xfb\xe2\xc0\x17\xac\x4b\x93\xcf\x95\x0c\x00\xc8\x8b\x59\xca\x63\x5f\x90\x3e\x78\x3a\x3d\x8e\xef\x3c\x40\xea\xfd\x1e\xa7\xe5\xea\x6f\xea\x58\x25\x78\x91\x5c\x25\x36\xa7\x0a\x59\xd2\x2b\x72\x3f\x6a\xf7\x83\xa9\x49\x74\xba\x9d\x3d\x05\x51\x7f\xb0\x20\x4a\xa1\x8e\x8b\x01\x26\x2b\xad\x97\xd3\x7b\x5c\x9f\xea\xcf\x07\x8b\xc2\xa0\x7c\xfb\x3a\x89\x4e\x1c\x4b\x1b\xa9\xd8\x02\x07\xb2\x8d\xf6\x97\x46\x81\x3c\x0a\x19\xd3\xef\x05\x50\x30\x57\xb6\x07\xbd\x04\xe5\x8d\xed\x56\x00\x83\xd0\x77\x0c\x95\x55\xe6\x97\xcd\x30\xf5\xef\x1e\x4c\x70\xe5\x8a\x8b\x6c\x86\x2a\x64\x98\xb0\x18\xe0\xb8\x68\x60\xfb\xd3\xfc\x37\x7c\xc4\xd8\x19\xa6\xcc\xc4\xbb\xfb\xc7\x46\x8f\x9e\x9a\x2e\x8a\xfe\x00\x77\x00\x35\xf2\x0c\x35\xc2\xdc\xde\xf6\xb7\xe2\x39\x25\x20\x66\x69\x90\xe5\xbb\x1c\xfd\x11\xc8\xfb\xa2\x3d\xe7\x29\x96\xa1\xc8\xef\xe3\x26\x33\x59\xf8\xc4\xbc\xc7\x79\x26\xbb\x05\x35\xc0\x26\xc3\x47\xa8\x0c\xc5\xd7\xd1\x1f\x27\xf6\xed\x4f\xe6\x01\x6e\x3f\x50\x6e\x27\x9b\x56\x98\xe2\x1d\xa9\xd7\xc7\x4c\xaf\x47\xd2\x63\x4d\x63\x12\x9d\x8d\xcd\xc7\x69\x5b\xc8\x1b\x95\x93\x59\x50\x2d\x78\xc3\xbc\xf2\x29\x93\xc7\xf1\x13\x08\x1d\x7d\xb9\xf5\xc7\x85\x69\x4e\xfb\xba\x3f\x96\xfc\x8a\x3f\x86\xfc\x8e\xf0\x02\xc7\xb8\x9b\x8a\xb4\xe8\x8c\x6c\xb7\xbb\x8f\xcf\x38\x42\x10\xa7\x02\x3a\xfb\x32\x53\x31\xb7\x46\x79\xba\xc3\x30\x27\x58\x7a\x7d\xaf\xae\x81\x0e\xf9\x48\x19\xf8\x2a\x5e\x4a\x7b\xc0\x27\xd1\x19\x30\x71\x6f\xf3\x3a\x12\xb0\xce\x99\xf2\x84\x1c\xee\x2d\xc2\x7c\xc4\xe0\x2a\xec\x05\xd0\xb0\x45\xaf\xfa\x78\xe8\x97\x27\xcb\x7d\x90\x2e\x34\xaa\xae\x77\xcf\x01\xc3\x39\x29\xcf\x98\x28\xe6\xcc\x96\x28\x74\xae\xb5\x7d\x8c\x96\x27\x27\x62\xea\xce\xf3\x0d\x41\x6e\x6f\xc6\xca\x31\x40\x2f\xf0\x7a\xb3\x75\x2b\x8b\x9a\xe5\x5f\xfd\xac\x64\x62\xfd\x7d\x6b\xb5\x44\x55\xed\xe6\x61\x47\x23\x17\x1d\x36\x1c\xa1\x3a\xa5\x09\xfc\xf3\xf9\xcf\x9f\x7e\x18\x5d\x7c\xf1\xfc\xf9\xdb\xff\x1f\xfd\xed\xdd\xa7\xcf\x7f\x1e\xdb\xff\xfc\xdf\xc5\x17\x17\x1f\xea\x8b\x4f\x2f\x2e\x9e\x3f\x7f\xfb\xcd\xeb\xaf\xee\x6e\x5e\xbd\xe3\x17\x1f\xde\x8a\x22\xbb\x2f\xaf\x3e\x3c\x7f\x8b\xaf\xde\x79\x02\xb9\xb8\xf8\xe2\x7f\x5b\xd1\x79\x3f\xba\x2f\x66\xb4\x7b\xc4\xa0\x1e\x71\x61\x46\x52\x8d\x4a\xec\x27\x60\x54\x81\x51\xff\xd6\x9b\x56\x98\x75\x0d\x63\xa3\x10\x4c\xc3\xbd\x90\x2b\xb1\xd9\x17\x4f\xbb\xe5\x99\x01\xa6\x10\xd8\x03\xe3\x29\x9b\xa5\x6d\x43\x01\x48\x01\x0c\x32\x16\x2f\xb9\xc0\x71\x03\xf2\x5e\x99\x88\xad\x11\x4b\x8a\x94\x0a\x2b\x7c\xca\x41\x7a\xf5\x89\xea\x1a\x3b\xea\xe4\x7c\x5c\x70\xbf\xc3\x25\xe7\xf2\xb2\xc3\xb9\x38\x39\xfb\x43\xf5\x70\x5d\x6e\x40\xc0\x80\x5c\x15\x55\xe5\xd4\x1b\xa0\x36\xf5\x36\x75\xa1\xe4\x27\xba\x15\x58\xbd\xc5\x26\x0a\xb2\xc6\x4e\xce\x91\x79\x17\x07\xa6\xd5\x24\x69\xb3\x01\x9d\xca\x28\x0b\xbd\x53\xf0\x24\x67\x76\x33\xe8\x89\x15\x4f\x9d\x2e\xa6\x13\x8d\x9d\xcd\xf5\x4d\xa6\x95\x88\x8c\x07\x61\x4e\xcb\xed\x92\xda\x1d\x1b\xab\x73\xf1\x3b\xf7\x8a\x59\x8b\x77\xab\x58\x4d\xc5\xbf\x5b\xae\xb3\x38\x46\x7a\xa1\xde\x28\x9f\xa5\xb2\x90\x09\x3c\x7b\xb6\x53\x70\x6b\x2f\x63\xda\x22\x45\x6e\x51\x4f\xe0\xed\x3b\xaa\xa4\x35\x52\x61\x52\x69\x7d\x79\xf3\xd1\x94\x2f\x1b\xcc\x72\x5b\xf4\x39\x60\xfd\x72\x47\xf5\xf2\x5d\x35\xd4\x5e\xf5\x72\x7d\xfb\xa0\x7a\x79\x83\x5a\x6b\xf9\xf2\x08\x4c\x9e\xee\x55\x2f\xd7\x4f\x78\x54\x2f\xbb\x6a\x97\x5d\x75\xcb\x87\x35\xcb\x07\xaa\xdb\x52\xad\xbc\x5b\xab\xdc\x59\xa9\xbc\x63\x51\x35\x7f\x3a\xea\x94\xeb\x66\xbd\x5b\xa8\xdc\x6e\xc9\x5d\x45\xca\x3b\x03\x7e\x32\x68\x49\xf2\x39\x0a\x92\x1f\x73\x39\xf2\x27\x91\x87\x3f\x3b\xac\xc6\xdb\x95\xc0\x60\x85\xc7\x67\x29\x3b\x7e\xec\x45\xc7\x5e\x22\x68\xba\xce\xde\xd9\xa4\xbd\xd8\xb8\xd5\x44\xdd\xa5\xc6\x75\xcf\x71\xe4\x37\xed\x1e\x62\xd8\x43\x52\x37\xfa\x2d\xe1\x43\x3b\x01\x3e\xc1\x43\x28\x0d\x1d\x81\x43\x27\x02\x07\x61\x43\xed\xd6\xbb\xc2\x86\x30\x76\x1c\xdc\x3c\x08\x17\x0e\x83\x85\xb6\x50\xe1\xbf\x2c\x50\x58\x55\x75\x1d\xa4\x91\xe7\x8f\x15\xea\x2a\x92\x97\x75\x0c\xbd\x8d\x17\x9a\x4d\x07\x31\x43\x13\xcb\x8e\xb0\x61\x35\xdf\x36\x6e\x22\x87\xbd\xe7\xdc\xd1\xc3\x40\x93\x7b\x4d\x4c\xc7\xe4\x5e\x37\x3f\x4d\xee\x4f\x93\xfb\xd3\xe4\xfe\xfb\x4c\xee\xb5\x0d\xba\x27\xf7\xba\xa7\xef\xc4\x58\x2f\x87\x5f\xb3\x96\xd2\x64\xdf\xdc\x5a\x07\x07\x5a\x08\xb9\x84\x8c\xe5\x39\x29\x9c\x9c\x6f\xe7\xd4\xea\x5d\x04\x25\x39\x96\xcc\x2e\xdd\x29\x25\x54\xef\x79\xae\x16\x5f\x1e\x5c\xa4\xb3\x06\xda\xfa\x65\x94\x1d\x5c\xbe\xab\xbe\xe5\xd0\x5c\xc1\x00\xd3\x5a\xc6\x9c\x62\x47\x58\x71\xb3\x2c\x55\x77\xd5\xca\xd4\x5e\xca\x8f\x8d\x82\x36\x92\xf6\x89\x82\x42\x85\xbd\x48\xe5\x8c\xa5\xf4\x15\x31\x59\x18\x07\x77\xbe\x6a\xf6\x6d\xd8\xb7\x55\xbb\x8c\xbd\x07\x7c\x8f\x71\x41\x7d\x6d\x35\x6d\x14\x96\x45\xeb\xcb\xa1\xf9\xc4\x6a\xb7\xa6\xb1\x1a\xdd\xf0\x83\x50\xab\x65\x45\x49\xb2\xed\x47\x28\x02\x24\x07\x60\x58\xeb\x4e\xc7\x1d\x04\xee\xa8\x8f\xcd\x6f\xd2\xa0\xf6\x09\x52\xdf\x19\x42\x2c\xb3\x3c\x45\xd3\xf2\x29\x90\xce\xb7\x1c\x07\x90\x9b\xfc\x66\xa0\x91\xbe\xcb\x46\x02\x67\x31\x91\xef\x1e\x89\x8e\xd9\x1a\x98\xe5\x06\xaa\x43\xea\xfb\xb4\xa4\xb2\xfd\xb8\x11\x9d\x01\x78\xd3\xd2\x42\xd1\xa5\x05\xb5\x4b\xd3\x46\x4c\x25\x49\x6d\x18\xfa\xe1\x59\x6f\xe4\xc8\x32\xb6\x3f\xe5\x05\xe2\xec\xd4\x8b\xf0\x37\x4d\x00\x28\x1e\xb8\x92\xc2\x5d\x8e\xe0\xeb\x6b\x4f\xc0\xb5\xd5\x5f\x36\x0f\x5b\xf4\x37\x89\x06\x18\x32\x43\xad\x87\x82\xe5\x7e\x2d\xeb\x09\x28\xe7\xc9\x20\x70\x34\xc6\x52\x24\x0e\xe9\xb8\xde\x22\xf8\xf9\xc2\xe6\xcf\x6e\x3b\xc5\xe4\xd2\xf8\x0d\x4c\x31\xd2\xa8\xd5\x35\x1f\x43\x72\xcb\xbc\x75\x24\x28\xd3\x35\xfd\xb4\x11\x31\x1c\xf7\x1e\x28\xb3\x8a\xfa\xb1\x79\x08\x0f\xcb\x74\x81\xf1\x70\x31\x61\xce\xc5\x83\x40\x27\xda\x7d\x46\xeb\x84\xef\x90\x95\x43\x4a\xde\xe8\x77\xb3\x94\x66\x27\x54\x47\xe2\xdf\xf7\x31\xaf\x51\x3d\xaf\x46\x01\xdf\xf2\x1a\x55\xf8\x44\x81\x72\xe8\xa2\xb2\xf5\xa1\xa7\x94\xd4\x49\x29\x29\x3d\x60\x3e\xca\x99\x91\xea\xc8\x46\x75\x66\xa2\x74\x6b\x1a\x8a\x86\x59\xcd\x3b\x92\x50\x67\xfa\xf8\x6e\x8e\xf1\xb8\xb1\x42\x8b\xf6\x1c\xc6\x66\x1d\x16\x39\xac\xcd\xf5\x6a\xac\xfd\xf5\x58\x07\xb8\x96\x3c\xda\x7e\x26\xad\x27\x97\xb6\x17\xec\x1e\x97\x4d\xeb\x8e\x73\xbb\x32\x6a\xbb\xc3\x3e\x7d\xd3\xf7\xe9\x9b\xbe\x4f\xdf\xf4\xfd\x28\xdf\xf4\x3d\x35\x17\xd7\xbf\xa4\xed\xcd\xc7\x85\x04\x72\x9d\x1c\x39\x5f\x56\xae\x87\xab\xce\xcc\xdc\x19\x72\x73\xbd\x3c\xe8\x96\x7f\xeb\x4a\xa7\x5d\x03\x8e\xcf\xd1\xf5\x2b\x81\x23\x4f\x37\x70\xa6\xce\xbd\xda\xea\x5f\x63\x75\xe4\xeb\x06\xcf\xd8\xf5\xca\xb3\x33\x6b\x37\x40\xde\xae\x77\xc5\xd1\x84\xde\xda\x01\x06\xcb\xe8\x39\x73\x7a\xfd\x5a\xe5\x95\xd7\x73\xae\xae\xf6\xf8\x79\x52\x6e\xcf\x07\xdf\x80\xfc\x9e\x07\xee\x1e\x7a\xe4\xb7\x86\x3a\x62\x11\x1e\xee\xc3\x4f\xc2\xb9\xc3\x0b\x07\xe6\xfb\x02\x06\xf6\xca\xf9\x05\xc0\x73\xe7\xfd\x02\x80\x39\x73\x7f\x01\xb0\xbc\xf2\x7f\x21\x39\x2c\x97\x87\x3d\x22\x0b\x18\x9a\x07\x0c\x21\xbf\x75\x86\x3c\x1a\x9c\x57\x3e\xf0\x5c\xdc\xf4\xca\x0a\xfe\x7e\x7e\xc5\xcb\x92\xdd\xa0\xbc\x9c\x53\xa8\x5b\xf2\x22\xd6\x83\x80\x7e\x33\xf7\x18\xc5\x29\x43\xa7\xf4\x02\x48\xe9\x63\x73\x7f\xd6\xd0\x63\x98\xfe\x3f\x03\xd0\x9f\x3d\xec\xcd\x20\x3a\xb2\x88\x4e\x39\x75\xd3\xde\xf1\x60\xcb\xed\x83\x7c\x62\x5b\x46\xb1\x3d\xa7\xf8\xb1\xb2\x8a\xd1\x7f\x06\x00\xdd\x16\x79\x94\xd2\x6b\x00\x00")
 
 func chartSeederCrdTemplatesTinkerbellOrg_hardwareYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -303,7 +471,7 @@ func chartSeederCrdTemplatesTinkerbellOrg_hardwareYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "chart/seeder-crd/templates/tinkerbell.org_hardware.yaml", size: 27602, mode: os.FileMode(420), modTime: time.Unix(1773987736, 0)}
+	info := bindataFileInfo{name: "chart/seeder-crd/templates/tinkerbell.org_hardware.yaml", size: 27602, mode: os.FileMode(436), modTime: time.Unix(1782200728, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -360,17 +528,25 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"chart/seeder-crd/templates/bmc.tinkerbell.org_baseboardmanagements.yaml":  chartSeederCrdTemplatesBmcTinkerbellOrg_baseboardmanagementsYaml,
-	"chart/seeder-crd/templates/bmc.tinkerbell.org_bmctasks.yaml":              chartSeederCrdTemplatesBmcTinkerbellOrg_bmctasksYaml,
-	"chart/seeder-crd/templates/bmc.tinkerbell.org_jobs.yaml":                  chartSeederCrdTemplatesBmcTinkerbellOrg_jobsYaml,
-	"chart/seeder-crd/templates/bmc.tinkerbell.org_machines.yaml":              chartSeederCrdTemplatesBmcTinkerbellOrg_machinesYaml,
-	"chart/seeder-crd/templates/bmc.tinkerbell.org_tasks.yaml":                 chartSeederCrdTemplatesBmcTinkerbellOrg_tasksYaml,
-	"chart/seeder-crd/templates/metal.harvesterhci.io_addresspools.yaml":       chartSeederCrdTemplatesMetalHarvesterhciIo_addresspoolsYaml,
-	"chart/seeder-crd/templates/metal.harvesterhci.io_clusters.yaml":           chartSeederCrdTemplatesMetalHarvesterhciIo_clustersYaml,
-	"chart/seeder-crd/templates/metal.harvesterhci.io_inventories.yaml":        chartSeederCrdTemplatesMetalHarvesterhciIo_inventoriesYaml,
-	"chart/seeder-crd/templates/metal.harvesterhci.io_inventorytemplates.yaml": chartSeederCrdTemplatesMetalHarvesterhciIo_inventorytemplatesYaml,
-	"chart/seeder-crd/templates/metal.harvesterhci.io_nestedclusters.yaml":     chartSeederCrdTemplatesMetalHarvesterhciIo_nestedclustersYaml,
-	"chart/seeder-crd/templates/tinkerbell.org_hardware.yaml":                  chartSeederCrdTemplatesTinkerbellOrg_hardwareYaml,
+	"chart/seeder-crd/templates/bmc.tinkerbell.org_baseboardmanagements.yaml":      chartSeederCrdTemplatesBmcTinkerbellOrg_baseboardmanagementsYaml,
+	"chart/seeder-crd/templates/bmc.tinkerbell.org_bmctasks.yaml":                  chartSeederCrdTemplatesBmcTinkerbellOrg_bmctasksYaml,
+	"chart/seeder-crd/templates/bmc.tinkerbell.org_jobs.yaml":                      chartSeederCrdTemplatesBmcTinkerbellOrg_jobsYaml,
+	"chart/seeder-crd/templates/bmc.tinkerbell.org_machines.yaml":                  chartSeederCrdTemplatesBmcTinkerbellOrg_machinesYaml,
+	"chart/seeder-crd/templates/bmc.tinkerbell.org_tasks.yaml":                     chartSeederCrdTemplatesBmcTinkerbellOrg_tasksYaml,
+	"chart/seeder-crd/templates/metal.harvesterhci.io_addresspools.yaml":           chartSeederCrdTemplatesMetalHarvesterhciIo_addresspoolsYaml,
+	"chart/seeder-crd/templates/metal.harvesterhci.io_bmcdiscoveries.yaml":         chartSeederCrdTemplatesMetalHarvesterhciIo_bmcdiscoveriesYaml,
+	"chart/seeder-crd/templates/metal.harvesterhci.io_clusteraddons.yaml":          chartSeederCrdTemplatesMetalHarvesterhciIo_clusteraddonsYaml,
+	"chart/seeder-crd/templates/metal.harvesterhci.io_clusters.yaml":               chartSeederCrdTemplatesMetalHarvesterhciIo_clustersYaml,
+	"chart/seeder-crd/templates/metal.harvesterhci.io_clustertemplates.yaml":       chartSeederCrdTemplatesMetalHarvesterhciIo_clustertemplatesYaml,
+	"chart/seeder-crd/templates/metal.harvesterhci.io_inventories.yaml":            chartSeederCrdTemplatesMetalHarvesterhciIo_inventoriesYaml,
+	"chart/seeder-crd/templates/metal.harvesterhci.io_inventoryclasses.yaml":       chartSeederCrdTemplatesMetalHarvesterhciIo_inventoryclassesYaml,
+	"chart/seeder-crd/templates/metal.harvesterhci.io_inventorytemplates.yaml":     chartSeederCrdTemplatesMetalHarvesterhciIo_inventorytemplatesYaml,
+	"chart/seeder-crd/templates/metal.harvesterhci.io_nestedclusters.yaml":         chartSeederCrdTemplatesMetalHarvesterhciIo_nestedclustersYaml,
+	"chart/seeder-crd/templates/metal.harvesterhci.io_referencegrants.yaml":        chartSeederCrdTemplatesMetalHarvesterhciIo_referencegrantsYaml,
+	"chart/seeder-crd/templates/metal.harvesterhci.io_seederclusters.yaml":         chartSeederCrdTemplatesMetalHarvesterhciIo_seederclustersYaml,
+	"chart/seeder-crd/templates/metal.harvesterhci.io_seedermachines.yaml":         chartSeederCrdTemplatesMetalHarvesterhciIo_seedermachinesYaml,
+	"chart/seeder-crd/templates/metal.harvesterhci.io_seedermachinetemplates.yaml": chartSeederCrdTemplatesMetalHarvesterhciIo_seedermachinetemplatesYaml,
+	"chart/seeder-crd/templates/tinkerbell.org_hardware.yaml":                      chartSeederCrdTemplatesTinkerbellOrg_hardwareYaml,
 }
 
 // AssetDir returns the file names below a certain
@@ -417,17 +593,25 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"chart": &bintree{nil, map[string]*bintree{
 		"seeder-crd": &bintree{nil, map[string]*bintree{
 			"templates": &bintree{nil, map[string]*bintree{
-				"bmc.tinkerbell.org_baseboardmanagements.yaml":  &bintree{chartSeederCrdTemplatesBmcTinkerbellOrg_baseboardmanagementsYaml, map[string]*bintree{}},
-				"bmc.tinkerbell.org_bmctasks.yaml":              &bintree{chartSeederCrdTemplatesBmcTinkerbellOrg_bmctasksYaml, map[string]*bintree{}},
-				"bmc.tinkerbell.org_jobs.yaml":                  &bintree{chartSeederCrdTemplatesBmcTinkerbellOrg_jobsYaml, map[string]*bintree{}},
-				"bmc.tinkerbell.org_machines.yaml":              &bintree{chartSeederCrdTemplatesBmcTinkerbellOrg_machinesYaml, map[string]*bintree{}},
-				"bmc.tinkerbell.org_tasks.yaml":                 &bintree{chartSeederCrdTemplatesBmcTinkerbellOrg_tasksYaml, map[string]*bintree{}},
-				"metal.harvesterhci.io_addresspools.yaml":       &bintree{chartSeederCrdTemplatesMetalHarvesterhciIo_addresspoolsYaml, map[string]*bintree{}},
-				"metal.harvesterhci.io_clusters.yaml":           &bintree{chartSeederCrdTemplatesMetalHarvesterhciIo_clustersYaml, map[string]*bintree{}},
-				"metal.harvesterhci.io_inventories.yaml":        &bintree{chartSeederCrdTemplatesMetalHarvesterhciIo_inventoriesYaml, map[string]*bintree{}},
-				"metal.harvesterhci.io_inventorytemplates.yaml": &bintree{chartSeederCrdTemplatesMetalHarvesterhciIo_inventorytemplatesYaml, map[string]*bintree{}},
-				"metal.harvesterhci.io_nestedclusters.yaml":     &bintree{chartSeederCrdTemplatesMetalHarvesterhciIo_nestedclustersYaml, map[string]*bintree{}},
-				"tinkerbell.org_hardware.yaml":                  &bintree{chartSeederCrdTemplatesTinkerbellOrg_hardwareYaml, map[string]*bintree{}},
+				"bmc.tinkerbell.org_baseboardmanagements.yaml":      &bintree{chartSeederCrdTemplatesBmcTinkerbellOrg_baseboardmanagementsYaml, map[string]*bintree{}},
+				"bmc.tinkerbell.org_bmctasks.yaml":                  &bintree{chartSeederCrdTemplatesBmcTinkerbellOrg_bmctasksYaml, map[string]*bintree{}},
+				"bmc.tinkerbell.org_jobs.yaml":                      &bintree{chartSeederCrdTemplatesBmcTinkerbellOrg_jobsYaml, map[string]*bintree{}},
+				"bmc.tinkerbell.org_machines.yaml":                  &bintree{chartSeederCrdTemplatesBmcTinkerbellOrg_machinesYaml, map[string]*bintree{}},
+				"bmc.tinkerbell.org_tasks.yaml":                     &bintree{chartSeederCrdTemplatesBmcTinkerbellOrg_tasksYaml, map[string]*bintree{}},
+				"metal.harvesterhci.io_addresspools.yaml":           &bintree{chartSeederCrdTemplatesMetalHarvesterhciIo_addresspoolsYaml, map[string]*bintree{}},
+				"metal.harvesterhci.io_bmcdiscoveries.yaml":         &bintree{chartSeederCrdTemplatesMetalHarvesterhciIo_bmcdiscoveriesYaml, map[string]*bintree{}},
+				"metal.harvesterhci.io_clusteraddons.yaml":          &bintree{chartSeederCrdTemplatesMetalHarvesterhciIo_clusteraddonsYaml, map[string]*bintree{}},
+				"metal.harvesterhci.io_clusters.yaml":               &bintree{chartSeederCrdTemplatesMetalHarvesterhciIo_clustersYaml, map[string]*bintree{}},
+				"metal.harvesterhci.io_clustertemplates.yaml":       &bintree{chartSeederCrdTemplatesMetalHarvesterhciIo_clustertemplatesYaml, map[string]*bintree{}},
+				"metal.harvesterhci.io_inventories.yaml":            &bintree{chartSeederCrdTemplatesMetalHarvesterhciIo_inventoriesYaml, map[string]*bintree{}},
+				"metal.harvesterhci.io_inventoryclasses.yaml":       &bintree{chartSeederCrdTemplatesMetalHarvesterhciIo_inventoryclassesYaml, map[string]*bintree{}},
+				"metal.harvesterhci.io_inventorytemplates.yaml":     &bintree{chartSeederCrdTemplatesMetalHarvesterhciIo_inventorytemplatesYaml, map[string]*bintree{}},
+				"metal.harvesterhci.io_nestedclusters.yaml":         &bintree{chartSeederCrdTemplatesMetalHarvesterhciIo_nestedclustersYaml, map[string]*bintree{}},
+				"metal.harvesterhci.io_referencegrants.yaml":        &bintree{chartSeederCrdTemplatesMetalHarvesterhciIo_referencegrantsYaml, map[string]*bintree{}},
+				"metal.harvesterhci.io_seederclusters.yaml":         &bintree{chartSeederCrdTemplatesMetalHarvesterhciIo_seederclustersYaml, map[string]*bintree{}},
+				"metal.harvesterhci.io_seedermachines.yaml":         &bintree{chartSeederCrdTemplatesMetalHarvesterhciIo_seedermachinesYaml, map[string]*bintree{}},
+				"metal.harvesterhci.io_seedermachinetemplates.yaml": &bintree{chartSeederCrdTemplatesMetalHarvesterhciIo_seedermachinetemplatesYaml, map[string]*bintree{}},
+				"tinkerbell.org_hardware.yaml":                      &bintree{chartSeederCrdTemplatesTinkerbellOrg_hardwareYaml, map[string]*bintree{}},
 			}},
 		}},
 	}},
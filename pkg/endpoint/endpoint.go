@@ -32,6 +32,7 @@ func NewServer(ctx context.Context, client client.Client, log logr.Logger) *Serv
 	}
 	r := mux.NewRouter()
 	r.HandleFunc("/disable/{namespace}/{name}", s.disableHardware).Methods("PUT")
+	r.HandleFunc("/config/{namespace}/{name}", s.hardwareConfig).Methods("GET")
 	r.PathPrefix("/debug/pprof/").Handler(http.DefaultServeMux)
 	s.route = r
 	return s
@@ -98,6 +99,12 @@ func (s *Server) disableHardware(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// installer calls this endpoint once installation is complete
+	if hwObj.Annotations == nil {
+		hwObj.Annotations = make(map[string]string)
+	}
+	hwObj.Annotations[seederv1alpha1.InstallCompletedAnnotation] = time.Now().UTC().Format(time.RFC3339)
+
 	if err := s.client.Update(s.ctx, hwObj); err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		s.log.Error(err, "error disabling AllowPXE", hwObj.Name, hwObj.Namespace)
@@ -106,3 +113,44 @@ func (s *Server) disableHardware(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusAccepted)
 }
+
+// hardwareConfig serves the harvester config stored in the hw object userdata. This is used by installers
+// booted via virtual media, which can not fetch config from the tinkerbell metadata service
+func (s *Server) hardwareConfig(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	name, ok := vars["name"]
+	if !ok {
+		w.WriteHeader(http.StatusInternalServerError)
+		s.log.Error(fmt.Errorf("error: no hw name specified"), "")
+		return
+	}
+
+	namespace, ok := vars["namespace"]
+	if !ok {
+		w.WriteHeader(http.StatusInternalServerError)
+		s.log.Error(fmt.Errorf("error: no hw namespace specified"), "")
+		return
+	}
+
+	hwObj := &tinkv1alpha1.Hardware{}
+	if err := s.client.Get(s.ctx, types.NamespacedName{Name: name, Namespace: namespace}, hwObj); err != nil {
+		if apierrors.IsNotFound(err) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		s.log.Error(err, "error looking up hw object", name, namespace)
+		return
+	}
+
+	if hwObj.Spec.UserData == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/yaml")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte(*hwObj.Spec.UserData)); err != nil {
+		s.log.Error(err, "error writing config", hwObj.Name, hwObj.Namespace)
+	}
+}
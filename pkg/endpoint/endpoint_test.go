@@ -3,9 +3,12 @@ package endpoint
 import (
 	"context"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
 	"github.com/stretchr/testify/require"
@@ -58,6 +61,10 @@ metadata:
 spec:
   disks:
   - device: /dev/sda
+  userData: |
+    scheme_version: 1
+    install:
+      mode: create
   interfaces:
   - dhcp:
       arch: x86_64
@@ -106,6 +113,16 @@ func TestMain(t *testing.M) {
 		}
 	}()
 
+	// wait for server to start listening before running tests
+	for i := 0; i < 50; i++ {
+		conn, err := net.Dial("tcp", fmt.Sprintf("localhost:%d", seederv1alpha1.DefaultEndpointPort))
+		if err == nil {
+			conn.Close()
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
 	code := t.Run()
 	cancel()
 	os.Exit(code)
@@ -147,7 +164,46 @@ func Test_disableHardware(t *testing.T) {
 				disabled = disabled || *v.Netboot.AllowPXE
 			}
 			assert.False(disabled)
+			assert.NotEmpty(hwObj.Annotations[seederv1alpha1.InstallCompletedAnnotation], "expected installation to be marked complete")
 		}
 	}
 
 }
+
+func Test_hardwareConfig(t *testing.T) {
+	var tests = []struct {
+		name           string
+		namespace      string
+		httpStatusCode int
+		expectedConfig string
+	}{
+		{
+			name:           "hp-153",
+			namespace:      "default",
+			httpStatusCode: 200,
+			expectedConfig: "scheme_version: 1\ninstall:\n  mode: create\n",
+		},
+		{
+			name:           "hp-151",
+			namespace:      "default",
+			httpStatusCode: 404,
+		},
+		{
+			name:           "hp-154",
+			namespace:      "default",
+			httpStatusCode: 404,
+		},
+	}
+	assert := require.New(t)
+	for _, t := range tests {
+		resp, err := http.Get(fmt.Sprintf("http://localhost:%d/config/%s/%s", seederv1alpha1.DefaultEndpointPort, t.namespace, t.name))
+		assert.NoErrorf(err, "error making call for test %s", t.name)
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		assert.NoError(err, "expected no error reading response body")
+		assert.Equal(t.httpStatusCode, resp.StatusCode)
+		if t.expectedConfig != "" {
+			assert.Equal(t.expectedConfig, string(body))
+		}
+	}
+}
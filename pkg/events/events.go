@@ -15,11 +15,16 @@ type EventFetcher struct {
 }
 
 func NewEventFetcher(ctx context.Context, username, password, endpoint string) (*EventFetcher, error) {
+	return NewEventFetcherWithTLS(ctx, username, password, endpoint, false)
+}
+
+// NewEventFetcherWithTLS connects to the endpoint, and only verifies the certificate of the endpoint if verifyTLS is set
+func NewEventFetcherWithTLS(ctx context.Context, username, password, endpoint string, verifyTLS bool) (*EventFetcher, error) {
 	cfg := gofish.ClientConfig{
 		Username: username,
 		Password: password,
 		Endpoint: endpoint,
-		Insecure: true,
+		Insecure: !verifyTLS,
 	}
 
 	apiClient, err := gofish.ConnectContext(ctx, cfg)
@@ -9,7 +9,11 @@ import (
 	"time"
 
 	dockertest "github.com/ory/dockertest/v3"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
 	"github.com/stretchr/testify/require"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
 )
 
 var ef *EventFetcher
@@ -58,6 +62,71 @@ func TestMain(m *testing.M) {
 
 }
 
+func Test_GetSystemInfo(t *testing.T) {
+	assert := require.New(t)
+	info, err := ef.GetSystemInfo()
+	assert.NoError(err, "expected no error during system info call")
+	assert.Equal("amd64", info.Arch, "expected x86-64 processors to map to amd64")
+	assert.Equal("ec:f4:bb:f0:46:54", info.ManagementMACAddress(), "expected first enabled interface to be used")
+}
+
+func Test_ManagementMACAddress(t *testing.T) {
+	assert := require.New(t)
+	info := &SystemInfo{
+		Interfaces: []InterfaceInfo{
+			{Name: "nic1", MACAddress: "aa:bb:cc:dd:ee:01"},
+			{Name: "nic2", MACAddress: "aa:bb:cc:dd:ee:02", Enabled: true},
+			{Name: "nic3", MACAddress: "aa:bb:cc:dd:ee:03", Enabled: true, LinkUp: true},
+		},
+	}
+	assert.Equal("aa:bb:cc:dd:ee:03", info.ManagementMACAddress(), "expected interface with link to be preferred")
+	info.Interfaces[2].LinkUp = false
+	assert.Equal("aa:bb:cc:dd:ee:02", info.ManagementMACAddress(), "expected enabled interface to be preferred")
+	info.Interfaces = info.Interfaces[:1]
+	assert.Equal("aa:bb:cc:dd:ee:01", info.ManagementMACAddress(), "expected first interface as fallback")
+}
+
+func Test_SelectInterface(t *testing.T) {
+	assert := require.New(t)
+	info := &SystemInfo{
+		Interfaces: []InterfaceInfo{
+			{Name: "nic1", MACAddress: "aa:bb:cc:dd:ee:01", LinkUp: true},
+			{Name: "nic2", MACAddress: "aa:bb:cc:dd:ee:02", LinkUp: true, VlanID: 100},
+			{Name: "nic3", MACAddress: "aa:bb:cc:dd:ee:03", LinkUp: true, VlanID: 100, PXEEnabled: true},
+		},
+	}
+	assert.Equal("aa:bb:cc:dd:ee:01", info.SelectInterface(nil), "expected management interface without selector")
+	assert.Equal("aa:bb:cc:dd:ee:02", info.SelectInterface(&seederv1alpha1.InterfaceSelector{Name: "nic2"}), "expected interface matching name")
+	assert.Equal("aa:bb:cc:dd:ee:02", info.SelectInterface(&seederv1alpha1.InterfaceSelector{VlanID: 100}), "expected first interface matching vlan")
+	assert.Equal("aa:bb:cc:dd:ee:03", info.SelectInterface(&seederv1alpha1.InterfaceSelector{VlanID: 100, PXEEnabled: true}), "expected interface matching vlan and pxe")
+	assert.Equal("", info.SelectInterface(&seederv1alpha1.InterfaceSelector{Name: "nic4"}), "expected no interface to match")
+}
+
+func Test_chassisLocation(t *testing.T) {
+	assert := require.New(t)
+	chassis := []*redfish.Chassis{
+		{},
+		{
+			Location: common.Location{
+				PostalAddress: common.PostalAddress{
+					Location: "dc1",
+					Room:     "hall-a",
+				},
+				Placement: common.Placement{
+					Rack:       "r12",
+					RackOffset: 20,
+				},
+			},
+		},
+	}
+	assert.Equal(seederv1alpha1.Location{Datacenter: "dc1", Room: "hall-a", Rack: "r12", RackPosition: 20}, chassisLocation(chassis),
+		"expected location of first chassis reporting one")
+
+	chassis[1].Location.PostalAddress.Building = "building-1"
+	assert.Equal("building-1", chassisLocation(chassis).Datacenter, "expected building to be preferred as datacenter")
+	assert.Equal(seederv1alpha1.Location{}, chassisLocation(chassis[:1]), "expected empty location without chassis location")
+}
+
 func Test_GetInventory(t *testing.T) {
 	assert := require.New(t)
 	_, health, err := ef.GetConfig()
@@ -66,3 +135,10 @@ func Test_GetInventory(t *testing.T) {
 	ef.Close()
 
 }
+
+func Test_worseHealth(t *testing.T) {
+	assert := require.New(t)
+	assert.Equal(common.OKHealth, worseHealth(common.OKHealth, ""), "expected missing health to be ignored")
+	assert.Equal(common.WarningHealth, worseHealth(common.OKHealth, common.WarningHealth))
+	assert.Equal(common.CriticalHealth, worseHealth(common.CriticalHealth, common.WarningHealth))
+}
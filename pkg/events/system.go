@@ -0,0 +1,483 @@
+package events
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+// SystemInfo contains details of the computer system used to pre-fill inventory objects
+type SystemInfo struct {
+	Arch       string
+	Interfaces []InterfaceInfo
+	CPUCores   int
+	MemoryGiB  int
+	Disks      []DiskInfo
+	Location   seederv1alpha1.Location
+	// BIOSVersion and BMCFirmwareVersion are those of the first system and manager reporting one
+	BIOSVersion        string
+	BMCFirmwareVersion string
+	GPUs               []string
+	PCIDevices         []seederv1alpha1.DiscoveredPCIDevice
+}
+
+// DiskInfo contains details of a drive attached to a computer system
+type DiskInfo struct {
+	Name        string
+	CapacityGiB int
+}
+
+// InterfaceInfo contains details of an ethernet interface on a computer system
+type InterfaceInfo struct {
+	Name       string
+	MACAddress string
+	Enabled    bool
+	LinkUp     bool
+	VlanID     int
+	PXEEnabled bool
+	SpeedMbps  int
+}
+
+// ProbeRedfish checks if a redfish service root is available at the endpoint. The service root
+// does not need authentication, so this can be used to discover endpoints before credentials are tried
+func ProbeRedfish(ctx context.Context, endpoint string, timeout time.Duration) bool {
+	httpClient := &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			TLSHandshakeTimeout: timeout,
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: true,
+			},
+		},
+	}
+	apiClient, err := gofish.ConnectContext(ctx, gofish.ClientConfig{
+		Endpoint:   endpoint,
+		Insecure:   true,
+		HTTPClient: httpClient,
+	})
+	if err != nil {
+		return false
+	}
+	return apiClient.Service != nil && apiClient.Service.RedfishVersion != ""
+}
+
+// GetSystemInfo queries the computer systems for ethernet interfaces, processors, memory and drives
+func (ef *EventFetcher) GetSystemInfo() (*SystemInfo, error) {
+	systems, err := ef.client.Service.Systems()
+	if err != nil {
+		return nil, err
+	}
+
+	info := &SystemInfo{}
+	for _, system := range systems {
+		nics, err := system.EthernetInterfaces()
+		if err != nil {
+			return nil, err
+		}
+		pxeAddresses := pxeMACAddresses(system)
+		for _, nic := range nics {
+			mac := normaliseMAC(nic.MACAddress)
+			if mac == "" {
+				mac = normaliseMAC(nic.PermanentMACAddress)
+			}
+			if mac == "" {
+				continue
+			}
+			nicInfo := InterfaceInfo{
+				Name:       nic.ID,
+				MACAddress: mac,
+				Enabled:    nic.Status.State == common.EnabledState,
+				LinkUp:     nic.LinkStatus == redfish.LinkUpLinkStatus,
+				PXEEnabled: pxeAddresses[mac] || pxeAddresses[normaliseMAC(nic.PermanentMACAddress)],
+				SpeedMbps:  nic.SpeedMbps,
+			}
+			if nic.VLAN.VLANEnable {
+				nicInfo.VlanID = int(nic.VLAN.VLANID)
+			}
+			info.Interfaces = append(info.Interfaces, nicInfo)
+		}
+
+		processors, err := system.Processors()
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range processors {
+			if p.ProcessorType == redfish.GPUProcessorType {
+				info.GPUs = append(info.GPUs, strings.TrimSpace(p.Model))
+				continue
+			}
+			info.CPUCores += p.TotalCores
+			if arch := processorArch(p); arch != "" && info.Arch == "" {
+				info.Arch = arch
+			}
+		}
+		info.MemoryGiB += int(system.MemorySummary.TotalSystemMemoryGiB)
+		info.Disks = append(info.Disks, systemDisks(system)...)
+		info.PCIDevices = append(info.PCIDevices, systemPCIDevices(system)...)
+		if info.BIOSVersion == "" {
+			info.BIOSVersion = system.BIOSVersion
+		}
+	}
+
+	managers, err := ef.client.Service.Managers()
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range managers {
+		if m.FirmwareVersion != "" {
+			info.BMCFirmwareVersion = m.FirmwareVersion
+			break
+		}
+	}
+
+	chassis, err := ef.client.Service.Chassis()
+	if err != nil {
+		return nil, err
+	}
+	info.Location = chassisLocation(chassis)
+
+	return info, nil
+}
+
+// chassisLocation returns the location of the first chassis which reports one. The building, or the postal address
+// location if the building is not set, is used as the datacenter
+func chassisLocation(chassis []*redfish.Chassis) seederv1alpha1.Location {
+	for _, c := range chassis {
+		address := c.Location.PostalAddress
+		placement := c.Location.Placement
+		loc := seederv1alpha1.Location{
+			Datacenter:   address.Building,
+			Room:         address.Room,
+			Rack:         placement.Rack,
+			RackPosition: placement.RackOffset,
+		}
+		if loc.Datacenter == "" {
+			loc.Datacenter = address.Location
+		}
+		if loc != (seederv1alpha1.Location{}) {
+			return loc
+		}
+	}
+	return seederv1alpha1.Location{}
+}
+
+// systemDisks returns the drives attached to the storage controllers of the system. Storage is not
+// implemented by all BMCs, so errors are ignored
+func systemDisks(system *redfish.ComputerSystem) []DiskInfo {
+	var disks []DiskInfo
+	storage, err := system.Storage()
+	if err != nil {
+		return disks
+	}
+	for _, s := range storage {
+		drives, err := s.Drives()
+		if err != nil {
+			continue
+		}
+		for _, d := range drives {
+			disks = append(disks, DiskInfo{
+				Name:        d.ID,
+				CapacityGiB: int(d.CapacityBytes >> 30),
+			})
+		}
+	}
+	return disks
+}
+
+// systemPCIDevices returns the pcie devices of the system. PCIe devices are not reported by all BMCs, so errors are
+// ignored
+func systemPCIDevices(system *redfish.ComputerSystem) []seederv1alpha1.DiscoveredPCIDevice {
+	var devices []seederv1alpha1.DiscoveredPCIDevice
+	pcieDevices, err := system.PCIeDevices()
+	if err != nil {
+		return nil
+	}
+	for _, d := range pcieDevices {
+		name := d.Name
+		if name == "" {
+			name = d.ID
+		}
+		devices = append(devices, seederv1alpha1.DiscoveredPCIDevice{
+			Name:            name,
+			Manufacturer:    strings.TrimSpace(d.Manufacturer),
+			Model:           strings.TrimSpace(d.Model),
+			FirmwareVersion: d.FirmwareVersion,
+		})
+	}
+	return devices
+}
+
+// pxeMACAddresses returns the mac addresses of network device functions with PXE boot mode enabled.
+// network device functions are not implemented by all BMCs, so errors are ignored
+func pxeMACAddresses(system *redfish.ComputerSystem) map[string]bool {
+	addresses := make(map[string]bool)
+	networkInterfaces, err := system.NetworkInterfaces()
+	if err != nil {
+		return addresses
+	}
+
+	for _, ni := range networkInterfaces {
+		functions, err := ni.NetworkDeviceFunctions()
+		if err != nil {
+			continue
+		}
+		for _, f := range functions {
+			if f.BootMode != redfish.PXEBootMode {
+				continue
+			}
+			for _, mac := range []string{f.Ethernet.MACAddress, f.Ethernet.PermanentMACAddress} {
+				if mac = normaliseMAC(mac); mac != "" {
+					addresses[mac] = true
+				}
+			}
+		}
+	}
+	return addresses
+}
+
+// normaliseMAC returns the mac address in lower case, or an empty string if the address is invalid or unset
+func normaliseMAC(mac string) string {
+	hwAddr, err := net.ParseMAC(mac)
+	if err != nil {
+		return ""
+	}
+	for _, b := range hwAddr {
+		if b != 0 {
+			return hwAddr.String()
+		}
+	}
+	return ""
+}
+
+// processorArch maps redfish processor details to the arch used in inventory objects
+func processorArch(p *redfish.Processor) string {
+	switch p.InstructionSet {
+	case redfish.X8664InstructionSet:
+		return "amd64"
+	case redfish.ARMA64InstructionSet:
+		return "arm64"
+	}
+
+	switch p.ProcessorArchitecture {
+	case redfish.X86ProcessorArchitecture:
+		return "amd64"
+	case redfish.ARMProcessorArchitecture:
+		return "arm64"
+	}
+	return ""
+}
+
+// ManagementMACAddress returns the mac address of the first interface with a link, falling
+// back to the first enabled interface and then the first interface reported by the system
+func (s *SystemInfo) ManagementMACAddress() string {
+	for _, nic := range s.Interfaces {
+		if nic.LinkUp {
+			return nic.MACAddress
+		}
+	}
+
+	for _, nic := range s.Interfaces {
+		if nic.Enabled {
+			return nic.MACAddress
+		}
+	}
+
+	if len(s.Interfaces) != 0 {
+		return s.Interfaces[0].MACAddress
+	}
+	return ""
+}
+
+// SelectInterface returns the mac address of the first interface matching all criteria in the selector,
+// and falls back to ManagementMACAddress if no selector is specified
+func (s *SystemInfo) SelectInterface(selector *seederv1alpha1.InterfaceSelector) string {
+	if selector == nil {
+		return s.ManagementMACAddress()
+	}
+
+	for _, nic := range s.Interfaces {
+		if selector.Name != "" && nic.Name != selector.Name {
+			continue
+		}
+		if selector.LinkUp && !nic.LinkUp {
+			continue
+		}
+		if selector.VlanID != 0 && nic.VlanID != selector.VlanID {
+			continue
+		}
+		if selector.PXEEnabled && !nic.PXEEnabled {
+			continue
+		}
+		return nic.MACAddress
+	}
+	return ""
+}
+
+// SetPersistentBootDevice sets the boot source override for all subsequent boots of the computer systems
+func (ef *EventFetcher) SetPersistentBootDevice(target redfish.BootSourceOverrideTarget) error {
+	systems, err := ef.client.Service.Systems()
+	if err != nil {
+		return err
+	}
+
+	for _, system := range systems {
+		err := system.SetBoot(redfish.Boot{
+			BootSourceOverrideEnabled: redfish.ContinuousBootSourceOverrideEnabled,
+			BootSourceOverrideTarget:  target,
+		})
+		if err != nil {
+			return fmt.Errorf("error setting boot device on system %s: %w", system.ID, err)
+		}
+	}
+	return nil
+}
+
+// GetSystemHealth returns the worst health rollup reported by the computer systems
+func (ef *EventFetcher) GetSystemHealth() (common.Health, error) {
+	systems, err := ef.client.Service.Systems()
+	if err != nil {
+		return "", err
+	}
+
+	health := common.OKHealth
+	for _, system := range systems {
+		systemHealth := system.Status.HealthRollup
+		if systemHealth == "" {
+			systemHealth = system.Status.Health
+		}
+		health = worseHealth(health, systemHealth)
+	}
+	return health, nil
+}
+
+// worseHealth returns the more severe of two redfish health states
+func worseHealth(a, b common.Health) common.Health {
+	severity := map[common.Health]int{
+		common.OKHealth:       0,
+		common.WarningHealth:  1,
+		common.CriticalHealth: 2,
+	}
+	if severity[b] > severity[a] {
+		return b
+	}
+	return a
+}
+
+// SecureEraseDrives requests a secure erase of all drives attached to the storage controllers of the computer systems
+func (ef *EventFetcher) SecureEraseDrives() (int, error) {
+	systems, err := ef.client.Service.Systems()
+	if err != nil {
+		return 0, err
+	}
+
+	var erased int
+	for _, system := range systems {
+		storage, err := system.Storage()
+		if err != nil {
+			return erased, fmt.Errorf("error querying storage in system %s: %w", system.ID, err)
+		}
+		for _, s := range storage {
+			drives, err := s.Drives()
+			if err != nil {
+				return erased, fmt.Errorf("error querying drives in storage %s: %w", s.ID, err)
+			}
+			for _, d := range drives {
+				if err := d.SecureErase(); err != nil {
+					return erased, fmt.Errorf("error requesting secure erase of drive %s: %w", d.ID, err)
+				}
+				erased++
+			}
+		}
+	}
+	return erased, nil
+}
+
+// SecureEraseProgress returns the number of drives with an erase or sanitize operation still running. An error is
+// returned if the task associated with an operation has failed
+func (ef *EventFetcher) SecureEraseProgress() (int, error) {
+	systems, err := ef.client.Service.Systems()
+	if err != nil {
+		return 0, err
+	}
+
+	var pending int
+	for _, system := range systems {
+		storage, err := system.Storage()
+		if err != nil {
+			return pending, fmt.Errorf("error querying storage in system %s: %w", system.ID, err)
+		}
+		for _, s := range storage {
+			drives, err := s.Drives()
+			if err != nil {
+				return pending, fmt.Errorf("error querying drives in storage %s: %w", s.ID, err)
+			}
+			for _, d := range drives {
+				running, err := ef.eraseRunning(d)
+				if err != nil {
+					return pending, err
+				}
+				if running {
+					pending++
+				}
+			}
+		}
+	}
+	return pending, nil
+}
+
+// eraseRunning checks the operations running on the drive for an erase. Operations with an associated task are
+// running until the task completes, and a task which did not complete successfully fails the erase
+func (ef *EventFetcher) eraseRunning(d *redfish.Drive) (bool, error) {
+	var running bool
+	for _, op := range d.Operations {
+		name := strings.ToLower(op.OperationName)
+		if !strings.Contains(name, "erase") && !strings.Contains(name, "sanitize") {
+			continue
+		}
+		if op.AssociatedTask == "" {
+			running = true
+			continue
+		}
+		task, err := redfish.GetTask(ef.client, op.AssociatedTask)
+		if err != nil {
+			return false, fmt.Errorf("error querying erase task of drive %s: %w", d.ID, err)
+		}
+		switch task.TaskState {
+		case redfish.CompletedTaskState:
+		case redfish.ExceptionTaskState, redfish.KilledTaskState, redfish.CancelledTaskState:
+			return false, fmt.Errorf("erase of drive %s %s", d.ID, strings.ToLower(string(task.TaskState)))
+		default:
+			running = true
+		}
+	}
+	return running, nil
+}
+
+// ResetBIOS resets the bios settings of the computer systems to their defaults. Settings are applied on the next boot
+func (ef *EventFetcher) ResetBIOS() error {
+	systems, err := ef.client.Service.Systems()
+	if err != nil {
+		return err
+	}
+
+	for _, system := range systems {
+		bios, err := system.Bios()
+		if err != nil {
+			return fmt.Errorf("error querying bios of system %s: %w", system.ID, err)
+		}
+		if err := bios.ResetBios(); err != nil {
+			return fmt.Errorf("error resetting bios of system %s: %w", system.ID, err)
+		}
+	}
+	return nil
+}
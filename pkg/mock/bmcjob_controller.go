@@ -92,7 +92,14 @@ func (f *FakeBaseboardJobReconciller) PatchMachinePowerStatus(ctx context.Contex
 	}
 
 	lastAction := j.Spec.Tasks[len(j.Spec.Tasks)-1]
-	machineObj.Status.Power = rufio.PowerState(*lastAction.PowerAction)
+	switch *lastAction.PowerAction {
+	case rufio.PowerSoftOff:
+		machineObj.Status.Power = rufio.Off
+	case rufio.PowerCycle, rufio.PowerReset:
+		machineObj.Status.Power = rufio.On
+	default:
+		machineObj.Status.Power = rufio.PowerState(*lastAction.PowerAction)
+	}
 	err = f.Status().Update(ctx, machineObj)
 	if err != nil {
 		return err
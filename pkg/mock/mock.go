@@ -70,7 +70,7 @@ metadata:
 `
 )
 
-var statusSubResources = []client.Object{&seederv1alpha1.Cluster{}, &seederv1alpha1.Inventory{}, &seederv1alpha1.AddressPool{}, &seederv1alpha1.Cluster{}}
+var statusSubResources = []client.Object{&seederv1alpha1.Cluster{}, &seederv1alpha1.Inventory{}, &seederv1alpha1.AddressPool{}, &seederv1alpha1.Cluster{}, &seederv1alpha1.InventoryClass{}}
 
 func generateObjects() ([]runtime.Object, error) {
 	objs, err := GenerateObjectsFromVar(DefaultObjects)
@@ -0,0 +1,391 @@
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	tinkv1alpha1 "github.com/tinkerbell/tink/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/tink"
+	"github.com/harvester/seeder/pkg/util"
+)
+
+// placeholder values are used in place of addresses and secrets which are only known
+// once seeder is running in a cluster, and keep the rendered output stable between runs
+const (
+	DefaultSeederAddress    = "192.0.2.10"
+	DefaultTinkStackAddress = "192.0.2.20"
+	PlaceholderToken        = "rendered-cluster-token"
+	PlaceholderPassword     = "rendered-node-password"
+)
+
+// Options define the inputs used to render the provisioning artifacts
+type Options struct {
+	Files            []string
+	SeederAddress    string
+	TinkStackAddress string
+}
+
+// Objects are the seeder objects loaded from the input files
+type Objects struct {
+	Clusters     []*seederv1alpha1.Cluster
+	Inventories  map[types.NamespacedName]*seederv1alpha1.Inventory
+	AddressPools map[types.NamespacedName]*seederv1alpha1.AddressPool
+	SeederConfig map[string]*corev1.ConfigMap
+	// Secrets hold the credentials of cluster registry mirrors
+	Secrets map[types.NamespacedName]*corev1.Secret
+}
+
+// NodeArtifacts contains everything seeder generates to provision a single inventory
+type NodeArtifacts struct {
+	Inventory   *seederv1alpha1.Inventory
+	Hardware    *tinkv1alpha1.Hardware
+	Template    *tinkv1alpha1.Template
+	Workflow    *tinkv1alpha1.Workflow
+	IPXEScript  string
+	CloudConfig string
+}
+
+// Run loads the input files, renders the provisioning artifacts and writes them to w
+func Run(w io.Writer, opts Options) error {
+	objs, err := LoadFiles(opts.Files...)
+	if err != nil {
+		return err
+	}
+
+	nodes, err := Render(objs, opts)
+	if err != nil {
+		return err
+	}
+
+	return Write(w, nodes)
+}
+
+// LoadFiles reads multi document yaml files and sorts the Clusters, Inventories, AddressPools, Secrets and
+// seeder-config ConfigMaps in them. Objects of other kinds are ignored
+func LoadFiles(files ...string) (*Objects, error) {
+	objs := &Objects{
+		Inventories:  make(map[types.NamespacedName]*seederv1alpha1.Inventory),
+		AddressPools: make(map[types.NamespacedName]*seederv1alpha1.AddressPool),
+		SeederConfig: make(map[string]*corev1.ConfigMap),
+		Secrets:      make(map[types.NamespacedName]*corev1.Secret),
+	}
+
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("error reading file %s: %v", file, err)
+		}
+		if err := objs.load(content); err != nil {
+			return nil, fmt.Errorf("error loading objects from file %s: %v", file, err)
+		}
+	}
+
+	return objs, nil
+}
+
+func (o *Objects) load(content []byte) error {
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(content)))
+	for {
+		doc, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		typeMeta := &metav1.TypeMeta{}
+		if err := yaml.Unmarshal(doc, typeMeta); err != nil {
+			return err
+		}
+
+		switch typeMeta.Kind {
+		case "Cluster":
+			c := &seederv1alpha1.Cluster{}
+			if err := yaml.Unmarshal(doc, c); err != nil {
+				return err
+			}
+			// apply the same default as the crd
+			if c.Spec.VlanID == 0 {
+				c.Spec.VlanID = 1
+			}
+			o.Clusters = append(o.Clusters, c)
+		case "Inventory":
+			i := &seederv1alpha1.Inventory{}
+			if err := yaml.Unmarshal(doc, i); err != nil {
+				return err
+			}
+			// apply the same default as the crd
+			if i.Spec.Arch == "" {
+				i.Spec.Arch = "amd64"
+			}
+			o.Inventories[types.NamespacedName{Namespace: i.Namespace, Name: i.Name}] = i
+		case "AddressPool":
+			pool := &seederv1alpha1.AddressPool{}
+			if err := yaml.Unmarshal(doc, pool); err != nil {
+				return err
+			}
+			o.AddressPools[types.NamespacedName{Namespace: pool.Namespace, Name: pool.Name}] = pool
+		case "ConfigMap":
+			cm := &corev1.ConfigMap{}
+			if err := yaml.Unmarshal(doc, cm); err != nil {
+				return err
+			}
+			if cm.Name == seederv1alpha1.SeederConfig {
+				o.SeederConfig[cm.Namespace] = cm
+			}
+		case "Secret":
+			secret := &corev1.Secret{}
+			if err := yaml.Unmarshal(doc, secret); err != nil {
+				return err
+			}
+			// stringData is only merged into data by the api server
+			for k, v := range secret.StringData {
+				if secret.Data == nil {
+					secret.Data = make(map[string][]byte)
+				}
+				secret.Data[k] = []byte(v)
+			}
+			o.Secrets[types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}] = secret
+		}
+	}
+}
+
+// Render allocates addresses from the address pools in the same way as the cluster controller
+// and generates the tinkerbell objects, ipxe script and harvester config for each node
+func Render(objs *Objects, opts Options) ([]NodeArtifacts, error) {
+	if len(objs.Clusters) == 0 {
+		return nil, fmt.Errorf("no Cluster objects found in input files")
+	}
+
+	seederAddress := opts.SeederAddress
+	if seederAddress == "" {
+		seederAddress = DefaultSeederAddress
+	}
+	tinkStackAddress := opts.TinkStackAddress
+	if tinkStackAddress == "" {
+		tinkStackAddress = DefaultTinkStackAddress
+	}
+	seederService := loadBalancerService(seederv1alpha1.DefaultSeederDeploymentService, seederAddress)
+	tinkStackService := loadBalancerService(seederv1alpha1.DefaultTinkStackService, tinkStackAddress)
+
+	pools := make(map[types.NamespacedName]*seederv1alpha1.AddressStatus)
+	for key, pool := range objs.AddressPools {
+		status, err := util.GenerateAddressPoolStatus(pool)
+		if err != nil {
+			return nil, fmt.Errorf("error generating status for address pool %s: %v", key, err)
+		}
+		for _, reservedAddress := range pool.Spec.ReservedAddresses {
+			status.AddressAllocation[reservedAddress] = seederv1alpha1.ObjectReferenceWithKind{
+				Kind: seederv1alpha1.KindReserved,
+				ObjectReference: seederv1alpha1.ObjectReference{
+					Name:      "reservedAddress",
+					Namespace: "reserved",
+				},
+			}
+		}
+		pools[key] = status
+	}
+
+	var nodes []NodeArtifacts
+	for _, cObj := range objs.Clusters {
+		c := cObj.DeepCopy()
+		if c.Status.ClusterAddress == "" {
+			vip, err := allocate(pools, c.Spec.AddressPoolReference, c.Spec.StaticAddress, seederv1alpha1.KindCluster, c.Name, c.Namespace)
+			if err != nil {
+				return nil, fmt.Errorf("error allocating vip for cluster %s: %v", c.Name, err)
+			}
+			c.Status.ClusterAddress = vip
+		}
+		if c.Status.ClusterToken == "" {
+			c.Status.ClusterToken = PlaceholderToken
+		}
+
+		registryAuth, err := objs.registryAuth(c)
+		if err != nil {
+			return nil, err
+		}
+
+		for n, nc := range c.Spec.Nodes {
+			iObj, ok := objs.Inventories[types.NamespacedName{Namespace: nc.InventoryReference.Namespace, Name: nc.InventoryReference.Name}]
+			if !ok {
+				return nil, fmt.Errorf("inventory %s in namespace %s referenced by cluster %s not found", nc.InventoryReference.Name, nc.InventoryReference.Namespace, c.Name)
+			}
+			i := iObj.DeepCopy()
+			poolKey := types.NamespacedName{Namespace: nc.AddressPoolReference.Namespace, Name: nc.AddressPoolReference.Name}
+			address, err := allocate(pools, nc.AddressPoolReference, nc.StaticAddress, seederv1alpha1.KindInventory, i.Name, i.Namespace)
+			if err != nil {
+				return nil, fmt.Errorf("error allocating address for inventory %s: %v", i.Name, err)
+			}
+			i.Status.Address = address
+			i.Status.Gateway = objs.AddressPools[poolKey].Spec.Gateway
+			i.Status.Netmask = pools[poolKey].Netmask
+			i.Status.GeneratedPassword = PlaceholderPassword
+			i.Status.Cluster.Namespace = c.Namespace
+			i.Status.Cluster.Name = c.Name
+			util.CreateOrUpdateCondition(i, seederv1alpha1.InventoryAllocatedToCluster,
+				fmt.Sprintf("node assigned to cluster %s", c.Name))
+			if n == 0 {
+				util.CreateOrUpdateCondition(i, seederv1alpha1.HarvesterCreateNode, "Create Mode")
+			} else {
+				util.CreateOrUpdateCondition(i, seederv1alpha1.HarvesterJoinNode, "Join Mode")
+			}
+
+			node, err := renderNode(i, c, objs.SeederConfig[c.Namespace], seederService, tinkStackService, registryAuth)
+			if err != nil {
+				return nil, fmt.Errorf("error rendering inventory %s: %v", i.Name, err)
+			}
+			nodes = append(nodes, *node)
+		}
+	}
+
+	return nodes, nil
+}
+
+// registryAuth reads the credentials of the cluster registry mirrors from the secrets in the input files
+func (o *Objects) registryAuth(c *seederv1alpha1.Cluster) (map[string]util.RegistryAuth, error) {
+	registryAuth := make(map[string]util.RegistryAuth)
+	for _, m := range c.Spec.RegistryMirrors {
+		if m.CredentialsSecretRef == nil {
+			continue
+		}
+		key := types.NamespacedName{Namespace: m.CredentialsSecretRef.Namespace, Name: m.CredentialsSecretRef.Name}
+		if key.Namespace == "" {
+			key.Namespace = c.Namespace
+		}
+		secret, ok := o.Secrets[key]
+		if !ok {
+			return nil, fmt.Errorf("secret %s in namespace %s referenced by cluster %s not found", key.Name, key.Namespace, c.Name)
+		}
+		auth, err := util.RegistryAuthFromSecret(secret)
+		if err != nil {
+			return nil, fmt.Errorf("error reading credentials of registry mirror %s: %v", m.Registry, err)
+		}
+		registryAuth[m.Registry] = auth
+	}
+	return registryAuth, nil
+}
+
+func renderNode(i *seederv1alpha1.Inventory, c *seederv1alpha1.Cluster, seederConfig *corev1.ConfigMap, seederService, tinkStackService *corev1.Service, registryAuth map[string]util.RegistryAuth) (*NodeArtifacts, error) {
+	hw, err := tink.GenerateHWRequest(i, c, seederService, tinkStackService, registryAuth)
+	if err != nil {
+		return nil, err
+	}
+	hw.TypeMeta = metav1.TypeMeta{APIVersion: tinkv1alpha1.GroupVersion.String(), Kind: "Hardware"}
+
+	template, err := tink.GenerateTemplate(tinkStackService.Status.LoadBalancer.Ingress[0].IP, seederConfig, i, c)
+	if err != nil {
+		return nil, err
+	}
+	template.TypeMeta = metav1.TypeMeta{APIVersion: tinkv1alpha1.GroupVersion.String(), Kind: "Template"}
+
+	workflow := tink.GenerateWorkflow(i, c)
+	workflow.TypeMeta = metav1.TypeMeta{APIVersion: tinkv1alpha1.GroupVersion.String(), Kind: "Workflow"}
+
+	node := &NodeArtifacts{
+		Inventory: i,
+		Hardware:  hw,
+		Template:  template,
+		Workflow:  workflow,
+	}
+	if hw.Spec.UserData != nil {
+		node.CloudConfig = *hw.Spec.UserData
+	}
+	if len(hw.Spec.Interfaces) != 0 && hw.Spec.Interfaces[0].Netboot != nil && hw.Spec.Interfaces[0].Netboot.IPXE != nil {
+		node.IPXEScript = hw.Spec.Interfaces[0].Netboot.IPXE.Contents
+	}
+	return node, nil
+}
+
+// allocate reserves an address in the referenced pool for an object
+func allocate(pools map[types.NamespacedName]*seederv1alpha1.AddressStatus, ref seederv1alpha1.ObjectReference, staticAddress, kind, name, namespace string) (string, error) {
+	pool, ok := pools[types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}]
+	if !ok {
+		return "", fmt.Errorf("address pool %s in namespace %s not found", ref.Name, ref.Namespace)
+	}
+
+	address, err := util.AllocateAddress(pool.DeepCopy(), staticAddress)
+	if err != nil {
+		return "", err
+	}
+	pool.AddressAllocation[address] = seederv1alpha1.ObjectReferenceWithKind{
+		Kind: kind,
+		ObjectReference: seederv1alpha1.ObjectReference{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	return address, nil
+}
+
+func loadBalancerService(name, address string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Status: corev1.ServiceStatus{
+			LoadBalancer: corev1.LoadBalancerStatus{
+				Ingress: []corev1.LoadBalancerIngress{
+					{
+						IP: address,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Write prints the rendered artifacts for each node, with a source comment identifying each document
+func Write(w io.Writer, nodes []NodeArtifacts) error {
+	for _, node := range nodes {
+		source := fmt.Sprintf("%s/%s", node.Inventory.Namespace, node.Inventory.Name)
+		objects := []struct {
+			kind string
+			obj  interface{}
+		}{
+			{node.Hardware.Kind, node.Hardware},
+			{node.Template.Kind, node.Template},
+			{node.Workflow.Kind, node.Workflow},
+		}
+		for _, v := range objects {
+			out, err := yaml.Marshal(v.obj)
+			if err != nil {
+				return fmt.Errorf("error marshalling %s for inventory %s: %v", v.kind, source, err)
+			}
+			if err := writeDocument(w, fmt.Sprintf("%s %s", v.kind, source), string(out)); err != nil {
+				return err
+			}
+		}
+
+		if node.IPXEScript != "" {
+			if err := writeDocument(w, fmt.Sprintf("ipxe script %s", source), node.IPXEScript); err != nil {
+				return err
+			}
+		}
+
+		if err := writeDocument(w, fmt.Sprintf("harvester config %s", source), node.CloudConfig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeDocument(w io.Writer, source, content string) error {
+	_, err := fmt.Fprintf(w, "---\n# Source: %s\n%s", source, content)
+	return err
+}
@@ -0,0 +1,91 @@
+package render
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/util"
+)
+
+func Test_Render(t *testing.T) {
+	assert := require.New(t)
+	objs, err := LoadFiles("testdata/cluster.yaml")
+	assert.NoError(err, "expected no error loading testdata")
+	assert.Len(objs.Clusters, 1)
+	assert.Len(objs.Inventories, 2)
+	assert.Len(objs.AddressPools, 2)
+	assert.Len(objs.SeederConfig, 1)
+
+	nodes, err := Render(objs, Options{})
+	assert.NoError(err, "expected no error rendering nodes")
+	assert.Len(nodes, 2)
+
+	createNode := nodes[0]
+	assert.True(util.ConditionExists(createNode.Inventory, seederv1alpha1.HarvesterCreateNode), "expected first node to be create node")
+	assert.Equal("172.16.128.9", createNode.Inventory.Status.Address, "expected reserved address to be skipped")
+	assert.Equal("x86_64", createNode.Hardware.Spec.Interfaces[0].DHCP.Arch)
+	assert.Contains(createNode.IPXEScript, "harvester.install.config_url=http://192.0.2.20:50061/2009-04-04/user-data")
+	assert.Contains(createNode.CloudConfig, "vip: 172.16.128.20")
+	assert.Contains(createNode.CloudConfig, "url: http://192.0.2.10:9090/disable/default/node")
+	assert.Contains(*createNode.Template.Spec.Data, "registry.local/reboot:v1", "expected image override from seeder-config")
+	assert.Equal("node", createNode.Workflow.Spec.TemplateRef)
+
+	joinNode := nodes[1]
+	assert.True(util.ConditionExists(joinNode.Inventory, seederv1alpha1.HarvesterJoinNode), "expected second node to be join node")
+	assert.Equal("172.16.128.10", joinNode.Inventory.Status.Address, "expected next free address to be allocated")
+	assert.Equal("aarch64", joinNode.Hardware.Spec.Interfaces[0].DHCP.Arch)
+	assert.Contains(joinNode.IPXEScript, "harvester-v1.2.0-vmlinuz-arm64")
+	assert.Contains(joinNode.CloudConfig, "https://172.16.128.20:443")
+
+	var output bytes.Buffer
+	assert.NoError(Write(&output, nodes), "expected no error writing rendered output")
+	for _, source := range []string{"Hardware default/node", "Template default/node2", "Workflow default/node", "ipxe script default/node2", "harvester config default/node"} {
+		assert.Contains(output.String(), "# Source: "+source)
+	}
+}
+
+func Test_RenderMissingInventory(t *testing.T) {
+	assert := require.New(t)
+	objs, err := LoadFiles("testdata/cluster.yaml")
+	assert.NoError(err, "expected no error loading testdata")
+	for k, v := range objs.Inventories {
+		if v.Name == "node2" {
+			delete(objs.Inventories, k)
+		}
+	}
+	_, err = Render(objs, Options{SeederAddress: "10.0.0.1", TinkStackAddress: "10.0.0.2"})
+	assert.Error(err, "expected error when inventory is missing")
+}
+
+func Test_RenderRegistryMirrorCredentials(t *testing.T) {
+	assert := require.New(t)
+	objs, err := LoadFiles("testdata/cluster.yaml")
+	assert.NoError(err, "expected no error loading testdata")
+	objs.Clusters[0].Spec.RegistryMirrors = []seederv1alpha1.RegistryMirror{
+		{
+			Registry:             "docker.io",
+			Endpoints:            []string{"https://mirror.example.com"},
+			CredentialsSecretRef: &corev1.SecretReference{Name: "mirror-creds"},
+		},
+	}
+	_, err = Render(objs, Options{})
+	assert.Error(err, "expected error when credentials secret is missing")
+
+	assert.NoError(objs.load([]byte(`apiVersion: v1
+kind: Secret
+metadata:
+  name: mirror-creds
+  namespace: default
+stringData:
+  username: user
+  password: secret
+`)))
+	nodes, err := Render(objs, Options{})
+	assert.NoError(err, "expected no error rendering nodes")
+	assert.Contains(nodes[0].CloudConfig, "containerd-registry")
+	assert.Contains(nodes[0].CloudConfig, `"Username":"user"`)
+}
@@ -0,0 +1,96 @@
+package tink
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"text/template"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+// default artifact templates match the layout of harvester releases
+const (
+	DefaultKernelTemplate   = "{{ .ImageURL }}/{{ .Version }}/harvester-{{ .Version }}-vmlinuz-{{ .Arch }}"
+	DefaultInitrdTemplate   = "{{ .ImageURL }}/{{ .Version }}/harvester-{{ .Version }}-initrd-{{ .Arch }}"
+	DefaultRootFSTemplate   = "{{ .ImageURL }}/{{ .Version }}/harvester-{{ .Version }}-rootfs-{{ .Arch }}.squashfs"
+	DefaultISOTemplate      = "{{ .ImageURL }}/{{ .Version }}/harvester-{{ .Version }}-{{ .Arch }}.iso"
+	DefaultRawImageTemplate = "{{ .ImageURL }}/{{ .Version }}/harvester-{{ .Version }}-{{ .Arch }}.raw.gz"
+)
+
+var checksumRegex = regexp.MustCompile(`^(sha256|sha512):[a-fA-F0-9]+$`)
+
+// Artifacts contains the rendered artifact urls, and the raw image checksum, for a specific cluster and arch
+type Artifacts struct {
+	Kernel   seederv1alpha1.Artifact
+	Initrd   seederv1alpha1.Artifact
+	RootFS   seederv1alpha1.Artifact
+	ISO      seederv1alpha1.Artifact
+	RawImage seederv1alpha1.RawImageArtifact
+}
+
+// InitrdName is the name ipxe assigns to the initrd image, and needs to be passed to the kernel
+func (a *Artifacts) InitrdName() string {
+	u, err := url.Parse(a.Initrd.URL)
+	if err != nil {
+		return path.Base(a.Initrd.URL)
+	}
+	return path.Base(u.Path)
+}
+
+// GenerateArtifacts renders the artifact spec on the cluster for an arch, and falls back to the
+// default harvester release layout for artifacts which have not been defined
+func GenerateArtifacts(c *seederv1alpha1.Cluster, arch string) (*Artifacts, error) {
+	values := struct {
+		ImageURL string
+		Version  string
+		Arch     string
+	}{
+		ImageURL: c.Spec.ImageURL,
+		Version:  c.Spec.HarvesterVersion,
+		Arch:     arch,
+	}
+
+	a := &Artifacts{}
+	rawImage := seederv1alpha1.Artifact{URL: c.Spec.Artifacts.RawImage.URL}
+	artifactList := []struct {
+		name            string
+		spec            seederv1alpha1.Artifact
+		defaultTemplate string
+		result          *seederv1alpha1.Artifact
+	}{
+		{"kernel", c.Spec.Artifacts.Kernel, DefaultKernelTemplate, &a.Kernel},
+		{"initrd", c.Spec.Artifacts.Initrd, DefaultInitrdTemplate, &a.Initrd},
+		{"rootfs", c.Spec.Artifacts.RootFS, DefaultRootFSTemplate, &a.RootFS},
+		{"iso", c.Spec.Artifacts.ISO, DefaultISOTemplate, &a.ISO},
+		{"rawImage", rawImage, DefaultRawImageTemplate, &rawImage},
+	}
+
+	for _, v := range artifactList {
+		tmpl := v.spec.URL
+		if tmpl == "" {
+			tmpl = v.defaultTemplate
+		}
+		t, err := template.New(v.name).Parse(tmpl)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing url template for artifact %s: %v", v.name, err)
+		}
+		var output bytes.Buffer
+		if err := t.Execute(&output, values); err != nil {
+			return nil, fmt.Errorf("error rendering url template for artifact %s: %v", v.name, err)
+		}
+		v.result.URL = output.String()
+	}
+
+	checksum := c.Spec.Artifacts.RawImage.Checksum
+	if checksum != "" && !checksumRegex.MatchString(checksum) {
+		return nil, fmt.Errorf("invalid checksum %s for artifact rawImage, expected format <sha256|sha512>:<digest>", checksum)
+	}
+	a.RawImage = seederv1alpha1.RawImageArtifact{
+		URL:      rawImage.URL,
+		Checksum: checksum,
+	}
+	return a, nil
+}
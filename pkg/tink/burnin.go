@@ -0,0 +1,210 @@
+package tink
+
+import (
+	"fmt"
+
+	tinkv1alpha1 "github.com/tinkerbell/tink/api/v1alpha1"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/util"
+)
+
+const (
+	// override image for the burn in actions can be defined in the seeder-config configmap
+	BurnInImageKey = "burn-in-image"
+)
+
+// listDisks lists all disks, excluding ram, loop and cdrom devices, and fails if no disks are found
+const listDisks = `disks=$(lsblk -dnpo NAME,TYPE -e 1,7,11 | awk '$2 == "disk" {print $1}')
+[ -n "$disks" ] || exit 1
+`
+
+// memoryTestScript runs a single memtester pass
+const memoryTestScript = `apk add --no-cache memtester >/dev/null || exit 1
+memtester %dM 1`
+
+// diskHealthScript fails if smart reports a failing disk. Disks which do not support smart are ignored
+const diskHealthScript = `apk add --no-cache util-linux smartmontools >/dev/null || exit 1
+` + listDisks + `for disk in $disks; do
+  smartctl -H $disk
+  [ $(( $? & 8 )) -eq 0 ] || exit 1
+done`
+
+// diskIOScript runs a read only fio test against each disk, leaving existing data intact
+const diskIOScript = `apk add --no-cache util-linux fio >/dev/null || exit 1
+` + listDisks + `for disk in $disks; do
+  fio --name=burnin --filename=$disk --readonly --direct=1 --rw=randread --bs=4k --iodepth=32 --ioengine=libaio --runtime=%d --time_based || exit 1
+done`
+
+var (
+	DefaultBurnInWorkflow = Workflow{
+		Name:          "harvester-burnin",
+		Version:       "0.1",
+		GlobalTimeout: 36000,
+	}
+
+	DefaultBurnInTask = Task{
+		Name:       "harvester-burnin",
+		WorkerAddr: "{{.device_1}}",
+		Volumes:    []string{"/dev:/dev"},
+	}
+
+	DefaultBurnInAction = Action{
+		Image:   "alpine:3.19",
+		Timeout: 7200,
+	}
+)
+
+// BurnInName is the name of the tinkerbell template and workflow used to burn in an inventory
+func BurnInName(i *seederv1alpha1.Inventory) string {
+	return fmt.Sprintf("%s-burnin", i.Name)
+}
+
+// GenerateBurnInTemplate generates a template which runs a memory test, and checks the health and io of all disks
+func GenerateBurnInTemplate(cm *corev1.ConfigMap, i *seederv1alpha1.Inventory) (*tinkv1alpha1.Template, error) {
+	if i.Spec.Acceptance == nil || i.Spec.Acceptance.BurnIn == nil {
+		return nil, fmt.Errorf("burn in is not configured for inventory %s", i.Name)
+	}
+	burnIn := i.Spec.Acceptance.BurnIn
+
+	image := DefaultBurnInAction.Image
+	if cm != nil {
+		if val, ok := cm.Data[BurnInImageKey]; ok && val != "" {
+			image = val
+		}
+	}
+
+	scripts := []struct {
+		name   string
+		script string
+	}{
+		{name: "memory-test", script: fmt.Sprintf(memoryTestScript, burnIn.MemoryTestMiB)},
+		{name: "disk-health", script: diskHealthScript},
+		{name: "disk-io", script: fmt.Sprintf(diskIOScript, burnIn.DiskTestSeconds)},
+	}
+
+	task := DefaultBurnInTask
+	task.Actions = nil
+	for _, v := range scripts {
+		action := DefaultBurnInAction
+		action.Name = v.name
+		action.Image = image
+		action.Command = []string{"/bin/sh", "-c", v.script}
+		task.Actions = append(task.Actions, action)
+	}
+	workflow := DefaultBurnInWorkflow
+	workflow.Tasks = []Task{task}
+
+	output, err := yaml.Marshal(&workflow)
+	if err != nil {
+		return nil, fmt.Errorf("error generating burn in template data: %v", err)
+	}
+	data := string(output)
+
+	return &tinkv1alpha1.Template{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      BurnInName(i),
+			Namespace: i.Namespace,
+			Labels: map[string]string{
+				seederv1alpha1.BurnInWorkflowLabel: i.Name,
+			},
+		},
+		Spec: tinkv1alpha1.TemplateSpec{
+			Data: &data,
+		},
+	}, nil
+}
+
+// GenerateBurnInWorkflow binds the burn in template to the burn in hardware of the inventory
+func GenerateBurnInWorkflow(i *seederv1alpha1.Inventory) *tinkv1alpha1.Workflow {
+	return &tinkv1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      BurnInName(i),
+			Namespace: i.Namespace,
+			Labels: map[string]string{
+				seederv1alpha1.BurnInWorkflowLabel: i.Name,
+			},
+		},
+		Spec: tinkv1alpha1.WorkflowSpec{
+			TemplateRef: BurnInName(i),
+			HardwareRef: BurnInName(i),
+			HardwareMap: map[string]string{
+				"device_1": util.ManagementMACAddress(i),
+			},
+		},
+	}
+}
+
+// GenerateBurnInHardware generates the hardware used to pxe boot the inventory into the tinkerbell hook os, using the
+// address allocated to the inventory for the burn in
+func GenerateBurnInHardware(i *seederv1alpha1.Inventory) (*tinkv1alpha1.Hardware, error) {
+	macAddress := util.ManagementMACAddress(i)
+	if macAddress == "" {
+		return nil, fmt.Errorf("waiting for management interface mac address to be discovered for inventory %s", i.Name)
+	}
+	arch, err := inventoryArch(i)
+	if err != nil {
+		return nil, err
+	}
+
+	var nameservers []string
+	if i.Spec.Acceptance != nil && i.Spec.Acceptance.BurnIn != nil {
+		nameservers = i.Spec.Acceptance.BurnIn.Nameservers
+	}
+
+	return &tinkv1alpha1.Hardware{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      BurnInName(i),
+			Namespace: i.Namespace,
+			Labels: map[string]string{
+				seederv1alpha1.BurnInWorkflowLabel: i.Name,
+			},
+		},
+		Spec: tinkv1alpha1.HardwareSpec{
+			Interfaces: []tinkv1alpha1.Interface{
+				{
+					Netboot: &tinkv1alpha1.Netboot{
+						AllowPXE:      &[]bool{true}[0],
+						AllowWorkflow: &[]bool{true}[0],
+					},
+					DHCP: &tinkv1alpha1.DHCP{
+						MAC:         macAddress,
+						Hostname:    util.NodeHostname(i),
+						LeaseTime:   defaultLeaseTime,
+						Arch:        hardwareArch(arch),
+						UEFI:        true,
+						NameServers: nameservers,
+						IP: &tinkv1alpha1.IP{
+							Address: i.Status.Address,
+							Netmask: i.Status.Netmask,
+							Gateway: i.Status.Gateway,
+						},
+					},
+				},
+			},
+			Metadata: &tinkv1alpha1.HardwareMetadata{
+				Facility: &tinkv1alpha1.MetadataFacility{
+					FacilityCode: defaultFacilityCode,
+				},
+			},
+		},
+	}, nil
+}
+
+// BurnInResults converts the action status of the burn in workflow to acceptance results
+func BurnInResults(workflow *tinkv1alpha1.Workflow) []seederv1alpha1.AcceptanceResult {
+	var results []seederv1alpha1.AcceptanceResult
+	for _, task := range workflow.Status.Tasks {
+		for _, action := range task.Actions {
+			results = append(results, seederv1alpha1.AcceptanceResult{
+				Check:   action.Name,
+				Passed:  action.Status == tinkv1alpha1.WorkflowStateSuccess,
+				Message: fmt.Sprintf("burn in action %s", action.Status),
+			})
+		}
+	}
+	return results
+}
@@ -0,0 +1,108 @@
+package tink
+
+import (
+	"testing"
+
+	"github.com/rancher/wrangler/v3/pkg/yaml"
+	"github.com/stretchr/testify/require"
+	tinkv1alpha1 "github.com/tinkerbell/tink/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+func Test_GenerateBurnIn(t *testing.T) {
+	assert := require.New(t)
+	i := &seederv1alpha1.Inventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-inventory",
+			Namespace: "harvester-system",
+		},
+		Spec: seederv1alpha1.InventorySpec{
+			ManagementInterfaceMacAddress: "xx:xx:xx:xx:xx",
+			Arch:                          "amd64",
+		},
+		Status: seederv1alpha1.InventoryStatus{
+			PXEBootInterface: seederv1alpha1.PXEBootInterface{
+				Address: "192.168.1.10",
+				Netmask: "255.255.255.0",
+				Gateway: "192.168.1.1",
+			},
+		},
+	}
+
+	_, err := GenerateBurnInTemplate(nil, i)
+	assert.Error(err, "expected error when burn in is not configured")
+
+	i.Spec.Acceptance = &seederv1alpha1.AcceptanceSpec{
+		BurnIn: &seederv1alpha1.BurnInSpec{
+			MemoryTestMiB:   2048,
+			DiskTestSeconds: 60,
+			Nameservers:     []string{"8.8.8.8"},
+		},
+	}
+	cm := &corev1.ConfigMap{
+		Data: map[string]string{
+			BurnInImageKey: "registry.local/burnin:latest",
+		},
+	}
+	template, err := GenerateBurnInTemplate(cm, i)
+	assert.NoError(err)
+	assert.Equal("test-inventory-burnin", template.Name)
+	workflowObj := &Workflow{}
+	assert.NoError(yaml.Unmarshal([]byte(*template.Spec.Data), workflowObj))
+	assert.Len(workflowObj.Tasks, 1, "expected to find 1 task")
+	actions := workflowObj.Tasks[0].Actions
+	assert.Len(actions, 3, "expected to find 3 actions")
+	assert.Equal("memory-test", actions[0].Name)
+	assert.Contains(actions[0].Command[2], "memtester 2048M 1")
+	assert.Contains(actions[2].Command[2], "--runtime=60")
+	for _, v := range actions {
+		assert.Equal("registry.local/burnin:latest", v.Image, "expected image override from configmap")
+	}
+	assert.Equal("alpine:3.19", DefaultBurnInAction.Image, "expected default action to be unchanged")
+
+	hw, err := GenerateBurnInHardware(i)
+	assert.NoError(err)
+	assert.Equal(template.Name, hw.Name)
+	assert.Equal("192.168.1.10", hw.Spec.Interfaces[0].DHCP.IP.Address)
+	assert.Equal([]string{"8.8.8.8"}, hw.Spec.Interfaces[0].DHCP.NameServers)
+	assert.Equal("x86_64", hw.Spec.Interfaces[0].DHCP.Arch)
+	assert.Nil(hw.Spec.Interfaces[0].Netboot.IPXE, "expected hook os to be booted")
+
+	iObj := i.DeepCopy()
+	iObj.Spec.Arch = ""
+	_, err = GenerateBurnInHardware(iObj)
+	assert.Error(err, "expected hardware generation to wait for arch to be discovered")
+	iObj.Status.Hardware.Arch = "arm64"
+	hw, err = GenerateBurnInHardware(iObj)
+	assert.NoError(err)
+	assert.Equal("aarch64", hw.Spec.Interfaces[0].DHCP.Arch, "expected discovered arch to be used")
+
+	workflow := GenerateBurnInWorkflow(i)
+	assert.Equal(template.Name, workflow.Spec.TemplateRef)
+	assert.Equal(hw.Name, workflow.Spec.HardwareRef)
+}
+
+func Test_BurnInResults(t *testing.T) {
+	assert := require.New(t)
+	workflow := &tinkv1alpha1.Workflow{
+		Status: tinkv1alpha1.WorkflowStatus{
+			Tasks: []tinkv1alpha1.Task{
+				{
+					Actions: []tinkv1alpha1.Action{
+						{Name: "memory-test", Status: tinkv1alpha1.WorkflowStateSuccess},
+						{Name: "disk-health", Status: tinkv1alpha1.WorkflowStateFailed},
+						{Name: "disk-io", Status: tinkv1alpha1.WorkflowStatePending},
+					},
+				},
+			},
+		},
+	}
+	results := BurnInResults(workflow)
+	assert.Len(results, 3)
+	assert.True(results[0].Passed)
+	assert.False(results[1].Passed)
+	assert.False(results[2].Passed)
+}
@@ -0,0 +1,123 @@
+package tink
+
+import (
+	"fmt"
+
+	tinkv1alpha1 "github.com/tinkerbell/tink/api/v1alpha1"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/util"
+)
+
+const (
+	// override image for the wipe disks action can be defined in the seeder-config configmap
+	WipeDisksImageKey = "wipe-disks-image"
+)
+
+// wipeDisksScript discards or zeroes all disks, excluding ram, loop and cdrom devices, and removes any
+// remaining filesystem signatures. The action fails if no disks are found
+const wipeDisksScript = `apk add --no-cache util-linux >/dev/null || exit 1
+disks=$(lsblk -dnpo NAME,TYPE -e 1,7,11 | awk '$2 == "disk" {print $1}')
+[ -n "$disks" ] || exit 1
+for disk in $disks; do
+  blkdiscard -f -s $disk || blkdiscard -f $disk || dd if=/dev/zero of=$disk bs=4M conv=fsync status=none
+  wipefs -af $disk || exit 1
+done`
+
+var (
+	DefaultDecommissionWorkflow = Workflow{
+		Name:          "harvester-decommission",
+		Version:       "0.1",
+		GlobalTimeout: 36000,
+	}
+
+	DefaultDecommissionTask = Task{
+		Name:       "harvester-decommission",
+		WorkerAddr: "{{.device_1}}",
+		Volumes:    []string{"/dev:/dev"},
+	}
+
+	DefaultWipeDisksAction = Action{
+		Name:    "wipe-disks",
+		Image:   "alpine:3.19",
+		Timeout: 36000,
+		Command: []string{"/bin/sh", "-c", wipeDisksScript},
+	}
+)
+
+// DecommissionName is the name of the tinkerbell template and workflow used to wipe an inventory
+func DecommissionName(i *seederv1alpha1.Inventory) string {
+	return fmt.Sprintf("%s-decommission", i.Name)
+}
+
+// GenerateDecommissionTemplate generates a template which wipes all disks on the inventory
+func GenerateDecommissionTemplate(cm *corev1.ConfigMap, i *seederv1alpha1.Inventory) (*tinkv1alpha1.Template, error) {
+	action := DefaultWipeDisksAction
+	if cm != nil {
+		if val, ok := cm.Data[WipeDisksImageKey]; ok && val != "" {
+			action.Image = val
+		}
+	}
+
+	task := DefaultDecommissionTask
+	task.Actions = []Action{action}
+	workflow := DefaultDecommissionWorkflow
+	workflow.Tasks = []Task{task}
+
+	output, err := yaml.Marshal(&workflow)
+	if err != nil {
+		return nil, fmt.Errorf("error generating decommission template data: %v", err)
+	}
+	data := string(output)
+
+	return &tinkv1alpha1.Template{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DecommissionName(i),
+			Namespace: i.Namespace,
+			Labels: map[string]string{
+				seederv1alpha1.DecommissionWorkflowLabel: i.Name,
+			},
+		},
+		Spec: tinkv1alpha1.TemplateSpec{
+			Data: &data,
+		},
+	}, nil
+}
+
+// GenerateDecommissionWorkflow binds the decommission template to the hardware of the inventory
+func GenerateDecommissionWorkflow(i *seederv1alpha1.Inventory) *tinkv1alpha1.Workflow {
+	return &tinkv1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DecommissionName(i),
+			Namespace: i.Namespace,
+			Labels: map[string]string{
+				seederv1alpha1.DecommissionWorkflowLabel: i.Name,
+			},
+		},
+		Spec: tinkv1alpha1.WorkflowSpec{
+			TemplateRef: DecommissionName(i),
+			HardwareRef: i.Name,
+			HardwareMap: map[string]string{
+				"device_1": util.ManagementMACAddress(i),
+			},
+		},
+	}
+}
+
+// PrepareHardwareForDecommission allows the hardware to pxe boot the tinkerbell hook os and run workflows.
+// The custom ipxe script used to boot the harvester installer is removed
+func PrepareHardwareForDecommission(hw *tinkv1alpha1.Hardware) {
+	for idx := range hw.Spec.Interfaces {
+		if hw.Spec.Interfaces[idx].Netboot == nil {
+			hw.Spec.Interfaces[idx].Netboot = &tinkv1alpha1.Netboot{}
+		}
+		hw.Spec.Interfaces[idx].Netboot.AllowPXE = &[]bool{true}[0]
+		hw.Spec.Interfaces[idx].Netboot.AllowWorkflow = &[]bool{true}[0]
+		hw.Spec.Interfaces[idx].Netboot.IPXE = nil
+	}
+	delete(hw.Annotations, seederv1alpha1.VirtualMediaURLAnnotation)
+	delete(hw.Annotations, seederv1alpha1.InstallCompletedAnnotation)
+}
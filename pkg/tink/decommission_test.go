@@ -0,0 +1,83 @@
+package tink
+
+import (
+	"testing"
+
+	"github.com/rancher/wrangler/v3/pkg/yaml"
+	"github.com/stretchr/testify/require"
+	tinkv1alpha1 "github.com/tinkerbell/tink/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+func Test_GenerateDecommissionTemplate(t *testing.T) {
+	assert := require.New(t)
+	i := &seederv1alpha1.Inventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-inventory",
+			Namespace: "harvester-system",
+		},
+		Spec: seederv1alpha1.InventorySpec{
+			PrimaryDisk:                   "/dev/sda",
+			ManagementInterfaceMacAddress: "xx:xx:xx:xx:xx",
+		},
+	}
+
+	template, err := GenerateDecommissionTemplate(nil, i)
+	assert.NoError(err, "expected no error during template generation")
+	assert.Equal("test-inventory-decommission", template.Name)
+	assert.Equal(i.Name, template.Labels[seederv1alpha1.DecommissionWorkflowLabel])
+	workflowObj := &Workflow{}
+	assert.NoError(yaml.Unmarshal([]byte(*template.Spec.Data), workflowObj))
+	assert.Len(workflowObj.Tasks, 1, "expected to find 1 task")
+	assert.Len(workflowObj.Tasks[0].Actions, 1, "expected to find 1 action")
+	assert.Equal(DefaultWipeDisksAction.Image, workflowObj.Tasks[0].Actions[0].Image)
+
+	cm := &corev1.ConfigMap{
+		Data: map[string]string{
+			WipeDisksImageKey: "registry.local/wipe:latest",
+		},
+	}
+	template, err = GenerateDecommissionTemplate(cm, i)
+	assert.NoError(err)
+	assert.NoError(yaml.Unmarshal([]byte(*template.Spec.Data), workflowObj))
+	assert.Equal("registry.local/wipe:latest", workflowObj.Tasks[0].Actions[0].Image, "expected image override from configmap")
+	assert.Equal("alpine:3.19", DefaultWipeDisksAction.Image, "expected default action to be unchanged")
+
+	workflow := GenerateDecommissionWorkflow(i)
+	assert.Equal(template.Name, workflow.Spec.TemplateRef)
+	assert.Equal(i.Name, workflow.Spec.HardwareRef)
+	assert.Equal("xx:xx:xx:xx:xx", workflow.Spec.HardwareMap["device_1"])
+}
+
+func Test_PrepareHardwareForDecommission(t *testing.T) {
+	assert := require.New(t)
+	hw := &tinkv1alpha1.Hardware{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				seederv1alpha1.InstallCompletedAnnotation: "2024-01-01T00:00:00Z",
+			},
+		},
+		Spec: tinkv1alpha1.HardwareSpec{
+			Interfaces: []tinkv1alpha1.Interface{
+				{
+					Netboot: &tinkv1alpha1.Netboot{
+						AllowPXE:      &[]bool{false}[0],
+						AllowWorkflow: &[]bool{false}[0],
+						IPXE: &tinkv1alpha1.IPXE{
+							Contents: "#!ipxe",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	PrepareHardwareForDecommission(hw)
+	assert.True(*hw.Spec.Interfaces[0].Netboot.AllowPXE)
+	assert.True(*hw.Spec.Interfaces[0].Netboot.AllowWorkflow)
+	assert.Nil(hw.Spec.Interfaces[0].Netboot.IPXE, "expected custom ipxe script to be removed")
+	assert.NotContains(hw.Annotations, seederv1alpha1.InstallCompletedAnnotation)
+}
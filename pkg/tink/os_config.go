@@ -0,0 +1,167 @@
+package tink
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/harvester/harvester-installer/pkg/config"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/util"
+)
+
+const (
+	httpProxySetting          = "http-proxy"
+	containerdRegistrySetting = "containerd-registry"
+)
+
+// httpProxy is the value of the harvester http-proxy setting
+type httpProxy struct {
+	HTTPProxy  string `json:"httpProxy,omitempty"`
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+	NoProxy    string `json:"noProxy,omitempty"`
+}
+
+// containerdRegistry is the value of the harvester containerd-registry setting, which follows the rke2
+// registries.yaml format
+type containerdRegistry struct {
+	Mirrors map[string]containerdMirror         `json:"Mirrors,omitempty"`
+	Configs map[string]containerdRegistryConfig `json:"Configs,omitempty"`
+}
+
+type containerdMirror struct {
+	Endpoints []string `json:"Endpoints"`
+}
+
+type containerdRegistryConfig struct {
+	Auth *containerdAuth `json:"Auth,omitempty"`
+	TLS  *containerdTLS  `json:"TLS,omitempty"`
+}
+
+type containerdAuth struct {
+	Username string `json:"Username"`
+	Password string `json:"Password"`
+}
+
+type containerdTLS struct {
+	InsecureSkipVerify bool `json:"InsecureSkipVerify"`
+}
+
+// applyOSConfig renders the os settings of the cluster config into the harvester config. Settings from the config
+// url are kept, unless the cluster config sets the same key
+func applyOSConfig(hc *config.HarvesterConfig, cc *seederv1alpha1.ClusterConfig, registryAuth map[string]util.RegistryAuth) error {
+	hc.NTPServers = append(hc.NTPServers, cc.NTPServers...)
+
+	if cc.Proxy != nil {
+		if hc.Environment == nil {
+			hc.Environment = make(map[string]string)
+		}
+		noProxy := strings.Join(cc.Proxy.NoProxy, ",")
+		for k, v := range map[string]string{"HTTP_PROXY": cc.Proxy.HTTPProxy, "HTTPS_PROXY": cc.Proxy.HTTPSProxy, "NO_PROXY": noProxy} {
+			if v != "" {
+				hc.Environment[k] = v
+			}
+		}
+		value, err := json.Marshal(httpProxy{
+			HTTPProxy:  cc.Proxy.HTTPProxy,
+			HTTPSProxy: cc.Proxy.HTTPSProxy,
+			NoProxy:    noProxy,
+		})
+		if err != nil {
+			return err
+		}
+		setSystemSetting(hc, httpProxySetting, string(value))
+	}
+
+	if len(cc.RegistryMirrors) != 0 {
+		value, err := generateContainerdRegistry(cc.RegistryMirrors, registryAuth)
+		if err != nil {
+			return err
+		}
+		setSystemSetting(hc, containerdRegistrySetting, value)
+	}
+
+	if len(cc.Sysctls) != 0 {
+		if hc.Sysctls == nil {
+			hc.Sysctls = make(map[string]string)
+		}
+		for k, v := range cc.Sysctls {
+			hc.Sysctls[k] = v
+		}
+	}
+
+	for _, f := range cc.WriteFiles {
+		hc.WriteFiles = append(hc.WriteFiles, config.File{
+			Path:               f.Path,
+			Content:            f.Content,
+			Owner:              f.Owner,
+			RawFilePermissions: f.Permissions,
+			Encoding:           f.Encoding,
+		})
+	}
+
+	hc.AfterInstallChrootCommands = append(hc.AfterInstallChrootCommands, cc.AfterInstallCommands...)
+	return nil
+}
+
+// generateContainerdRegistry generates the containerd-registry setting for the mirrors. Credentials and tls
+// settings apply to every endpoint of the mirror
+func generateContainerdRegistry(mirrors []seederv1alpha1.RegistryMirror, registryAuth map[string]util.RegistryAuth) (string, error) {
+	registry := containerdRegistry{
+		Mirrors: make(map[string]containerdMirror),
+		Configs: make(map[string]containerdRegistryConfig),
+	}
+	for _, m := range mirrors {
+		registry.Mirrors[m.Registry] = containerdMirror{
+			Endpoints: m.Endpoints,
+		}
+
+		auth, hasAuth := registryAuth[m.Registry]
+		if m.CredentialsSecretRef != nil && !hasAuth {
+			return "", fmt.Errorf("missing credentials for registry mirror %s", m.Registry)
+		}
+		if !hasAuth && !m.InsecureSkipTLSVerify {
+			continue
+		}
+
+		var rc containerdRegistryConfig
+		if hasAuth {
+			rc.Auth = &containerdAuth{
+				Username: auth.Username,
+				Password: auth.Password,
+			}
+		}
+		if m.InsecureSkipTLSVerify {
+			rc.TLS = &containerdTLS{
+				InsecureSkipVerify: true,
+			}
+		}
+		for _, e := range m.Endpoints {
+			u, err := url.Parse(e)
+			if err != nil || u.Host == "" {
+				return "", fmt.Errorf("invalid endpoint %q for registry mirror %s", e, m.Registry)
+			}
+			registry.Configs[u.Host] = rc
+		}
+	}
+
+	value, err := json.Marshal(registry)
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+// kernelCmdline returns the extra kernel command line of the installed os
+func kernelCmdline(hwAddress string, kernelArgs []string) string {
+	return strings.Join(append([]string{fmt.Sprintf("ifname=netboot:%s", hwAddress)}, kernelArgs...), " ")
+}
+
+func setSystemSetting(hc *config.HarvesterConfig, name, value string) {
+	if hc.SystemSettings == nil {
+		hc.SystemSettings = make(map[string]string)
+	}
+	hc.SystemSettings[name] = value
+}
@@ -0,0 +1,94 @@
+package tink
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/harvester/harvester-installer/pkg/config"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/util"
+)
+
+func osClusterConfig() *seederv1alpha1.ClusterConfig {
+	return &seederv1alpha1.ClusterConfig{
+		NTPServers: []string{"0.suse.pool.ntp.org"},
+		Proxy: &seederv1alpha1.ProxyConfig{
+			HTTPProxy:  "http://proxy.example.com:3128",
+			HTTPSProxy: "http://proxy.example.com:3128",
+			NoProxy:    []string{"localhost", "127.0.0.1", "10.0.0.0/8"},
+		},
+		RegistryMirrors: []seederv1alpha1.RegistryMirror{
+			{
+				Registry:             "docker.io",
+				Endpoints:            []string{"https://mirror.example.com:5000"},
+				CredentialsSecretRef: &corev1.SecretReference{Name: "mirror-creds"},
+			},
+			{
+				Registry:              "quay.io",
+				Endpoints:             []string{"https://quay-mirror.example.com"},
+				InsecureSkipTLSVerify: true,
+			},
+		},
+		Sysctls:    map[string]string{"vm.max_map_count": "262144"},
+		KernelArgs: []string{"intel_iommu=on", "iommu=pt"},
+		WriteFiles: []seederv1alpha1.WriteFile{
+			{
+				Path:        "/etc/motd",
+				Content:     "provisioned by seeder",
+				Permissions: "0644",
+			},
+		},
+		AfterInstallCommands: []string{"echo installed > /oem/seeder"},
+	}
+}
+
+func Test_cloudConfigOSSettings(t *testing.T) {
+	assert := require.New(t)
+	registryAuth := map[string]util.RegistryAuth{
+		"docker.io": {Username: "user", Password: "secret"},
+	}
+	cloudConfig, err := generateCloudConfig("", "ab:cd:ef:gh:ij:kl", "create", "192.168.1.100", "token", "password", "192.168.1.101", "255.255.255.0", "192.168.1.1", nil, nil, nil, "http://imagestore/iso/v1.2.1/harvester-v1.2.1-amd64.iso", "http://seeder-endpoint", "sample", "harvester-system", false, false, 1, "/dev/vda", "test", osClusterConfig(), registryAuth)
+	assert.NoError(err)
+	hc := config.NewHarvesterConfig()
+	assert.NoError(yaml.Unmarshal([]byte(cloudConfig), hc))
+
+	assert.Equal([]string{"0.suse.pool.ntp.org"}, hc.NTPServers)
+	assert.Equal("http://proxy.example.com:3128", hc.Environment["HTTPS_PROXY"])
+	assert.Equal("localhost,127.0.0.1,10.0.0.0/8", hc.Environment["NO_PROXY"])
+	assert.JSONEq(`{"httpProxy":"http://proxy.example.com:3128","httpsProxy":"http://proxy.example.com:3128","noProxy":"localhost,127.0.0.1,10.0.0.0/8"}`, hc.SystemSettings[httpProxySetting])
+	assert.Equal("262144", hc.Sysctls["vm.max_map_count"])
+	assert.Len(hc.WriteFiles, 1, "expected write file to be rendered")
+	assert.Equal("0644", hc.WriteFiles[0].RawFilePermissions)
+	assert.Equal([]string{
+		`grub2-editenv /oem/grubenv set extra_cmdline="ifname=netboot:ab:cd:ef:gh:ij:kl intel_iommu=on iommu=pt"`,
+		"echo installed > /oem/seeder",
+	}, hc.AfterInstallChrootCommands, "expected kernel args to be appended and commands to run after the boot loader is configured")
+
+	registry := &containerdRegistry{}
+	assert.NoError(json.Unmarshal([]byte(hc.SystemSettings[containerdRegistrySetting]), registry))
+	assert.Equal([]string{"https://mirror.example.com:5000"}, registry.Mirrors["docker.io"].Endpoints)
+	assert.Equal(&containerdAuth{Username: "user", Password: "secret"}, registry.Configs["mirror.example.com:5000"].Auth)
+	assert.Nil(registry.Configs["mirror.example.com:5000"].TLS, "expected tls verification to be enabled")
+	assert.True(registry.Configs["quay-mirror.example.com"].TLS.InsecureSkipVerify)
+	assert.Nil(registry.Configs["quay-mirror.example.com"].Auth, "expected mirror without credentials to have no auth")
+
+	_, err = generateCloudConfig("", "ab:cd:ef:gh:ij:kl", "create", "192.168.1.100", "token", "password", "192.168.1.101", "255.255.255.0", "192.168.1.1", nil, nil, nil, "http://imagestore/iso/v1.2.1/harvester-v1.2.1-amd64.iso", "http://seeder-endpoint", "sample", "harvester-system", false, false, 1, "/dev/vda", "test", osClusterConfig(), nil)
+	assert.Error(err, "expected missing registry credentials to be reported")
+}
+
+func Test_cloudConfigOSSettingsMergeConfigURL(t *testing.T) {
+	assert := require.New(t)
+	cc := &seederv1alpha1.ClusterConfig{
+		NTPServers: []string{"1.suse.pool.ntp.org"},
+	}
+	cloudConfig, err := generateCloudConfig("file:///testdata/create.yaml", "ab:cd:ef:gh:ij:kl", "create", "192.168.1.100", "token", "password", "192.168.1.101", "255.255.255.0", "192.168.1.1", nil, nil, nil, "http://imagestore/iso/v1.2.1/harvester-v1.2.1-amd64.iso", "http://seeder-endpoint", "sample", "harvester-system", false, false, 1, "/dev/vda", "test", cc, nil)
+	assert.NoError(err)
+	hc := config.NewHarvesterConfig()
+	assert.NoError(yaml.Unmarshal([]byte(cloudConfig), hc))
+	assert.Contains(hc.NTPServers, "1.suse.pool.ntp.org", "expected cluster ntp servers to be appended")
+	assert.Equal([]string{`grub2-editenv /oem/grubenv set extra_cmdline="ifname=netboot:ab:cd:ef:gh:ij:kl"`}, hc.AfterInstallChrootCommands)
+}
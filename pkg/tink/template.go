@@ -37,9 +37,23 @@ func GenerateTemplate(hegelEndpoint string, cm *corev1.ConfigMap, i *seederv1alp
 }
 
 func generateDataTemplate(hegelEndpoint string, cm *corev1.ConfigMap, i *seederv1alpha1.Inventory, c *seederv1alpha1.Cluster) (*string, error) {
+	arch, err := inventoryArch(i)
+	if err != nil {
+		return nil, err
+	}
+	artifacts, err := GenerateArtifacts(c, arch)
+	if err != nil {
+		return nil, fmt.Errorf("error generating artifact urls: %v", err)
+	}
+
 	//set StreamHarvester action environment variables
 	DefaultStreamHarvesterAction.Environment[DestDisk] = i.Spec.PrimaryDisk
-	DefaultStreamHarvesterAction.Environment[ImageURL] = fmt.Sprintf("%s/%s/harvester-%s-%s.raw.gz", c.Spec.ImageURL, c.Spec.HarvesterVersion, c.Spec.HarvesterVersion, i.Spec.Arch)
+	DefaultStreamHarvesterAction.Environment[ImageURL] = artifacts.RawImage.URL
+	if artifacts.RawImage.Checksum != "" {
+		DefaultStreamHarvesterAction.Environment[ImageChecksum] = artifacts.RawImage.Checksum
+	} else {
+		delete(DefaultStreamHarvesterAction.Environment, ImageChecksum)
+	}
 
 	//set ConfigureHarvester action environment variables
 	DefaultConfigureHarvesterAction.Environment[HarvesterDevice] = i.Spec.PrimaryDisk
@@ -18,6 +18,7 @@ func Test_GenerateTemplate(t *testing.T) {
 		},
 		Spec: seederv1alpha1.InventorySpec{
 			PrimaryDisk: "/dev/sda",
+			Arch:        "amd64",
 		},
 	}
 
@@ -44,3 +45,43 @@ func Test_GenerateTemplate(t *testing.T) {
 	assert.Len(workflowObj.Tasks[0].Actions, 3, "expected to find 3 actions to be performed by the template")
 
 }
+
+func Test_GenerateTemplateWithArtifacts(t *testing.T) {
+	assert := require.New(t)
+	i := &seederv1alpha1.Inventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-inventory",
+			Namespace: "harvester-system",
+		},
+		Spec: seederv1alpha1.InventorySpec{
+			PrimaryDisk: "/dev/sda",
+			Arch:        "amd64",
+		},
+	}
+
+	c := &seederv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: "harvester-system",
+		},
+		Spec: seederv1alpha1.ClusterSpec{
+			HarvesterVersion: "v1.2.0",
+			ImageURL:         "http://imagestore",
+			Artifacts: seederv1alpha1.ArtifactSpec{
+				RawImage: seederv1alpha1.RawImageArtifact{
+					URL:      "http://mirror/custom-{{ .Version }}-{{ .Arch }}.raw.gz",
+					Checksum: "sha256:abcd",
+				},
+			},
+		},
+	}
+
+	template, err := GenerateTemplate("192.168.1.100", nil, i, c)
+	assert.NoError(err, "exppected no error during template generation")
+	workflowObj := &Workflow{}
+	err = yaml.Unmarshal([]byte(*template.Spec.Data), workflowObj)
+	assert.NoError(err, "expected no error while unmarshalling template.spec.data")
+	streamAction := workflowObj.Tasks[0].Actions[0]
+	assert.Equal("http://mirror/custom-v1.2.0-amd64.raw.gz", streamAction.Environment[ImageURL], "expected image url to be rendered from artifact template")
+	assert.Equal("sha256:abcd", streamAction.Environment[ImageChecksum], "expected image checksum to be passed to stream action")
+}
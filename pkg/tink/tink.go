@@ -26,8 +26,9 @@ const (
 	defaultMethod       = "PUT"
 )
 
-// GenerateHWRequest will generate the tinkerbell Hardware type object
-func GenerateHWRequest(i *seederv1alpha1.Inventory, c *seederv1alpha1.Cluster, seederDeploymentService *corev1.Service, tinkStackService *corev1.Service) (hw *tinkv1alpha1.Hardware, err error) {
+// GenerateHWRequest will generate the tinkerbell Hardware type object. registryAuth holds the credentials of the
+// cluster registry mirrors, keyed by the upstream registry
+func GenerateHWRequest(i *seederv1alpha1.Inventory, c *seederv1alpha1.Cluster, seederDeploymentService *corev1.Service, tinkStackService *corev1.Service, registryAuth map[string]util.RegistryAuth) (hw *tinkv1alpha1.Hardware, err error) {
 
 	// generate metadata
 	mode := "join"
@@ -43,19 +44,23 @@ func GenerateHWRequest(i *seederv1alpha1.Inventory, c *seederv1alpha1.Cluster, s
 		bondOptions["mode"] = "balance-tlb"
 		bondOptions["miimon"] = "100"
 	}
-	userdata, err := generateCloudConfig(c.Spec.ConfigURL, i.Spec.ManagementInterfaceMacAddress, mode, c.Status.ClusterAddress,
-		c.Status.ClusterToken, i.Status.GeneratedPassword, i.Status.Address, i.Status.Netmask, i.Status.Gateway, c.Spec.Nameservers, c.Spec.SSHKeys, bondOptions, c.Spec.ImageURL, c.Spec.HarvesterVersion, seederDeploymentService.Status.LoadBalancer.Ingress[0].IP, i.Name, i.Namespace, c.Spec.StreamImageMode, c.Spec.WipeDisks, c.Spec.VlanID, i.Spec.Arch, i.Spec.PrimaryDisk, fmt.Sprintf("%s-%s", i.Name, i.Namespace))
-
+	macAddress := util.ManagementMACAddress(i)
+	if macAddress == "" {
+		return nil, fmt.Errorf("waiting for management interface mac address to be discovered for inventory %s", i.Name)
+	}
+	arch, err := inventoryArch(i)
 	if err != nil {
-		return nil, fmt.Errorf("error during HW generation: %v", err)
+		return nil, err
 	}
+	artifacts, err := GenerateArtifacts(c, arch)
+	if err != nil {
+		return nil, fmt.Errorf("error generating artifact urls: %v", err)
+	}
+	userdata, err := generateCloudConfig(c.Spec.ConfigURL, macAddress, mode, c.Status.ClusterAddress,
+		c.Status.ClusterToken, i.Status.GeneratedPassword, i.Status.Address, i.Status.Netmask, i.Status.Gateway, c.Spec.Nameservers, c.Spec.SSHKeys, bondOptions, artifacts.ISO.URL, seederDeploymentService.Status.LoadBalancer.Ingress[0].IP, i.Name, i.Namespace, c.Spec.StreamImageMode, c.Spec.WipeDisks, c.Spec.VlanID, i.Spec.PrimaryDisk, util.NodeHostname(i), &c.Spec.ClusterConfig, registryAuth)
 
-	// work around needed since boots represents amd64 arch as x86_64
-	var hwArch string
-	if i.Spec.Arch == "amd64" {
-		hwArch = "x86_64"
-	} else {
-		hwArch = "aarch64"
+	if err != nil {
+		return nil, fmt.Errorf("error during HW generation: %v", err)
 	}
 
 	hw = &tinkv1alpha1.Hardware{
@@ -72,10 +77,10 @@ func GenerateHWRequest(i *seederv1alpha1.Inventory, c *seederv1alpha1.Cluster, s
 						AllowWorkflow: &[]bool{true}[0],
 					},
 					DHCP: &tinkv1alpha1.DHCP{
-						MAC:       i.Spec.ManagementInterfaceMacAddress,
-						Hostname:  fmt.Sprintf("%s-%s", i.Name, i.Namespace),
+						MAC:       macAddress,
+						Hostname:  util.NodeHostname(i),
 						LeaseTime: defaultLeaseTime,
-						Arch:      hwArch,
+						Arch:      hardwareArch(arch),
 						UEFI:      true,
 						IP: &tinkv1alpha1.IP{
 							Address: i.Status.Address,
@@ -104,10 +109,26 @@ func GenerateHWRequest(i *seederv1alpha1.Inventory, c *seederv1alpha1.Cluster, s
 		},
 	}
 
+	// virtual media mode boots the installer from an ISO mounted via the BMC, and the
+	// installer fetches the harvester config from the seeder endpoint instead of hegel
+	if IsVirtualMediaMode(c) {
+		isoURL, err := GenerateVirtualMediaURL(i, c, seederDeploymentService.Status.LoadBalancer.Ingress[0].IP)
+		if err != nil {
+			return nil, fmt.Errorf("error generating virtual media url for inventory %s: %v", i.Name, err)
+		}
+		hw.Annotations = map[string]string{
+			seederv1alpha1.VirtualMediaURLAnnotation: isoURL,
+		}
+		for i := range hw.Spec.Interfaces {
+			hw.Spec.Interfaces[i].Netboot.AllowPXE = &[]bool{false}[0]
+		}
+		return hw, nil
+	}
+
 	// if not using StreamImage mode then define a custom ipxe url with info needed to provision harvester
 	if !c.Spec.StreamImageMode {
-		customIPXEScript, err := generateIPXEScript(c.Spec.HarvesterVersion, c.Spec.ImageURL, fmt.Sprintf("http://%s:%s/2009-04-04/user-data",
-			tinkStackService.Status.LoadBalancer.Ingress[0].IP, HegelDefaultPort), i.Spec.ManagementInterfaceMacAddress, i.Spec.Arch, c.Spec.VlanID, i.Status.Address, i.Status.Netmask, i.Status.Gateway)
+		customIPXEScript, err := generateIPXEScript(artifacts, fmt.Sprintf("http://%s:%s/2009-04-04/user-data",
+			tinkStackService.Status.LoadBalancer.Ingress[0].IP, HegelDefaultPort), macAddress, c.Spec.VlanID, i.Status.Address, i.Status.Netmask, i.Status.Gateway)
 		if err != nil {
 			return nil, fmt.Errorf("error generating custom ipxe script for inventory %s: %v", i.Name, err)
 		}
@@ -120,6 +141,24 @@ func GenerateHWRequest(i *seederv1alpha1.Inventory, c *seederv1alpha1.Cluster, s
 	return hw, nil
 }
 
+// inventoryArch returns the arch of the inventory. Hardware is not generated until the arch is set on the inventory
+// or discovered, since hardware generated with the wrong arch would boot the wrong artifacts
+func inventoryArch(i *seederv1alpha1.Inventory) (string, error) {
+	arch := util.InventoryArch(i)
+	if arch == "" {
+		return "", fmt.Errorf("waiting for arch to be discovered for inventory %s", i.Name)
+	}
+	return arch, nil
+}
+
+// hardwareArch is a work around needed since boots represents amd64 arch as x86_64
+func hardwareArch(arch string) string {
+	if arch == "amd64" {
+		return "x86_64"
+	}
+	return "aarch64"
+}
+
 // GenerateWorkflow binds the template associated with inventory to the workflow
 // this needs to be done before the ipxe boot is performed to ensure correct workflow is executed on reboot
 func GenerateWorkflow(i *seederv1alpha1.Inventory, c *seederv1alpha1.Cluster) (workflow *tinkv1alpha1.Workflow) {
@@ -132,7 +171,7 @@ func GenerateWorkflow(i *seederv1alpha1.Inventory, c *seederv1alpha1.Cluster) (w
 			TemplateRef: i.Name,
 			HardwareRef: i.Name,
 			HardwareMap: map[string]string{
-				"device_1": i.Spec.ManagementInterfaceMacAddress,
+				"device_1": util.ManagementMACAddress(i),
 			},
 		},
 	}
@@ -144,7 +183,7 @@ func GenerateWorkflow(i *seederv1alpha1.Inventory, c *seederv1alpha1.Cluster) (w
 	return workflow
 }
 
-func generateCloudConfig(configURL, hwAddress, mode, vip, token, password, ip, subnetMask, gateway string, Nameservers, SSHKeys []string, bondOptions map[string]string, imageURL string, harvesterVersion string, webhookURL string, hwName string, hwNamespace string, streamImage bool, wipeDisks bool, vlanID int, arch string, disk string, hostname string) (string, error) {
+func generateCloudConfig(configURL, hwAddress, mode, vip, token, password, ip, subnetMask, gateway string, Nameservers, SSHKeys []string, bondOptions map[string]string, isoURL string, webhookURL string, hwName string, hwNamespace string, streamImage bool, wipeDisks bool, vlanID int, disk string, hostname string, cc *seederv1alpha1.ClusterConfig, registryAuth map[string]util.RegistryAuth) (string, error) {
 	hc := config.NewHarvesterConfig()
 	if configURL != "" {
 		if err := readConfigURL(hc, configURL); err != nil {
@@ -181,7 +220,16 @@ func generateCloudConfig(configURL, hwAddress, mode, vip, token, password, ip, s
 	hc.SSHAuthorizedKeys = append(hc.SSHAuthorizedKeys, SSHKeys...)
 	hc.Hostname = hostname
 
-	hc.AfterInstallChrootCommands = []string{fmt.Sprintf("grub2-editenv /oem/grubenv set extra_cmdline=\"ifname=netboot:%s\"", hwAddress)}
+	var kernelArgs []string
+	if cc != nil {
+		kernelArgs = cc.KernelArgs
+	}
+	hc.AfterInstallChrootCommands = []string{fmt.Sprintf("grub2-editenv /oem/grubenv set extra_cmdline=\"%s\"", kernelCmdline(hwAddress, kernelArgs))}
+	if cc != nil {
+		if err := applyOSConfig(hc, cc, registryAuth); err != nil {
+			return "", err
+		}
+	}
 
 	hc.ManagementInterface.BondOptions = bondOptions
 	hc.WipeAllDisks = hc.WipeAllDisks || wipeDisks
@@ -196,7 +244,7 @@ func generateCloudConfig(configURL, hwAddress, mode, vip, token, password, ip, s
 	// we need to provide ISO URL
 	if !streamImage {
 		//hc.Install.ConfigURL = "" // reset the config url
-		hc.ISOURL = fmt.Sprintf("%s/%s/harvester-%s-%s.iso", imageURL, harvesterVersion, harvesterVersion, arch)
+		hc.ISOURL = isoURL
 		hc.Webhooks = []config.Webhook{
 			{
 				Event:  defaultEvent,
@@ -214,24 +262,26 @@ func generateCloudConfig(configURL, hwAddress, mode, vip, token, password, ip, s
 
 // generateIPXEScript will generate an inline ipxe script similar to https://github.com/harvester/ipxe-examples/blob/main/general/ipxe-create
 // and uses the same for create / join of node
-func generateIPXEScript(harvesterVersion, isoURL, hegelEndpoint, macAddress, arch string, vlanID int, ip string, netmask string, gateway string) (string, error) {
+func generateIPXEScript(artifacts *Artifacts, hegelEndpoint, macAddress string, vlanID int, ip string, netmask string, gateway string) (string, error) {
 
 	ipxeTemplateStruct := struct {
-		Version       string
-		ISOURL        string
+		KernelURL     string
+		InitrdURL     string
+		InitrdName    string
+		RootFSURL     string
 		HegelEndpoint string
 		MacAddress    string
-		Arch          string
 		VlanID        int
 		IP            string
 		Netmask       string
 		Gateway       string
 	}{
-		Version:       harvesterVersion,
-		ISOURL:        isoURL,
+		KernelURL:     artifacts.Kernel.URL,
+		InitrdURL:     artifacts.Initrd.URL,
+		InitrdName:    artifacts.InitrdName(),
+		RootFSURL:     artifacts.RootFS.URL,
 		HegelEndpoint: hegelEndpoint,
 		MacAddress:    macAddress,
-		Arch:          arch,
 		VlanID:        vlanID,
 		IP:            ip,
 		Netmask:       netmask,
@@ -241,13 +291,10 @@ func generateIPXEScript(harvesterVersion, isoURL, hegelEndpoint, macAddress, arc
 	var output bytes.Buffer
 
 	ipxeTemplate := `#!ipxe
-set version {{ .Version }}
-set base {{ .ISOURL}}/{{ .Version }}
-set arch {{ .Arch }}
 dhcp
 iflinkwait -t 5000
-kernel ${base}/harvester-${version}-vmlinuz-${arch} initrd=harvester-${version}-initrd-${arch} {{if gt .VlanID 1}}ip={{ .IP }}::{{ .Gateway }}:{{ .Netmask }}::vlan{{ .VlanID }}:off{{else}}ip={{ .IP }}::{{ .Gateway }}:{{ .Netmask }}::netboot:off{{end}} net.ifnames=1 rd.cos.disable rd.noverifyssl BOOTIF={{ .MacAddress }} ifname=netboot:{{ .MacAddress }} root=live:${base}/harvester-${version}-rootfs-${arch}.squashfs console=tty1 harvester.install.automatic=true boot_cmd='echo include_ping_test=yes >> /etc/conf.d/net-online' harvester.install.config_url={{ .HegelEndpoint }} {{if gt .VlanID 1}}vlan=vlan{{ .VlanID }}:netboot {{end}}
-initrd ${base}/harvester-${version}-initrd-${arch}
+kernel {{ .KernelURL }} initrd={{ .InitrdName }} {{if gt .VlanID 1}}ip={{ .IP }}::{{ .Gateway }}:{{ .Netmask }}::vlan{{ .VlanID }}:off{{else}}ip={{ .IP }}::{{ .Gateway }}:{{ .Netmask }}::netboot:off{{end}} net.ifnames=1 rd.cos.disable rd.noverifyssl BOOTIF={{ .MacAddress }} ifname=netboot:{{ .MacAddress }} root=live:{{ .RootFSURL }} console=tty1 harvester.install.automatic=true boot_cmd='echo include_ping_test=yes >> /etc/conf.d/net-online' harvester.install.config_url={{ .HegelEndpoint }} {{if gt .VlanID 1}}vlan=vlan{{ .VlanID }}:netboot {{end}}
+initrd {{ .InitrdURL }}
 boot
 `
 	ipxeTmpl := template.Must(template.New("IPXE").Parse(ipxeTemplate))
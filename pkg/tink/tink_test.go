@@ -18,7 +18,7 @@ import (
 
 func Test_createModeCloudConfig(t *testing.T) {
 	assert := require.New(t)
-	cloudConfig, err := generateCloudConfig("file:///testdata/create.yaml", "ab:cd:ef:gh:ij:kl", "create", "192.168.1.100", "token", "password", "192.168.1.101", "255.255.255.0", "192.168.1.1", []string{"8.8.8.8"}, []string{"ssh-key 1", "ssh-key 2"}, nil, "http://imagestore/iso", "v1.2.1", "http://seeder-endpoint", "sample", "harvester-system", false, true, 1, "amd64", "/dev/vda", "test")
+	cloudConfig, err := generateCloudConfig("file:///testdata/create.yaml", "ab:cd:ef:gh:ij:kl", "create", "192.168.1.100", "token", "password", "192.168.1.101", "255.255.255.0", "192.168.1.1", []string{"8.8.8.8"}, []string{"ssh-key 1", "ssh-key 2"}, nil, "http://imagestore/iso/v1.2.1/harvester-v1.2.1-amd64.iso", "http://seeder-endpoint", "sample", "harvester-system", false, true, 1, "/dev/vda", "test", nil, nil)
 	assert.NoError(err)
 	hc := config.NewHarvesterConfig()
 	err = yaml.Unmarshal([]byte(cloudConfig), hc)
@@ -42,7 +42,7 @@ func Test_createModeCloudConfig(t *testing.T) {
 
 func Test_joinModeCloudConfig(t *testing.T) {
 	assert := require.New(t)
-	cloudConfig, err := generateCloudConfig("file:///testdata/create.yaml", "ab:cd:ef:gh:ij:kl", "join", "192.168.1.100", "token", "password", "192.168.1.101", "255.255.255.0", "192.168.1.1", []string{"8.8.8.8"}, []string{"ssh-key 1", "ssh-key 2"}, nil, "http://imagestore/iso", "v1.2.1", "http://seeder-endpoint", "sample", "harvester-system", false, true, 1, "amd64", "/dev/vda", "test")
+	cloudConfig, err := generateCloudConfig("file:///testdata/create.yaml", "ab:cd:ef:gh:ij:kl", "join", "192.168.1.100", "token", "password", "192.168.1.101", "255.255.255.0", "192.168.1.1", []string{"8.8.8.8"}, []string{"ssh-key 1", "ssh-key 2"}, nil, "http://imagestore/iso/v1.2.1/harvester-v1.2.1-amd64.iso", "http://seeder-endpoint", "sample", "harvester-system", false, true, 1, "/dev/vda", "test", nil, nil)
 	assert.NoError(err)
 	hc := config.NewHarvesterConfig()
 	err = yaml.Unmarshal([]byte(cloudConfig), hc)
@@ -71,6 +71,7 @@ var (
 		Spec: seederv1alpha1.InventorySpec{
 			PrimaryDisk:                   "/dev/sda",
 			ManagementInterfaceMacAddress: "xx:xx:xx:xx:xx",
+			Arch:                          "amd64",
 			BaseboardManagementSpec: rufio.MachineSpec{
 				Connection: rufio.Connection{
 					Host: "localhost",
@@ -182,7 +183,7 @@ var (
 func Test_GenerateHWRequest(t *testing.T) {
 	assert := require.New(t)
 	util.CreateOrUpdateCondition(i, seederv1alpha1.HarvesterCreateNode, "")
-	hw, err := GenerateHWRequest(i, c, svc, hegelSvc)
+	hw, err := GenerateHWRequest(i, c, svc, hegelSvc, nil)
 	assert.NoError(err, "expected no error during hardware generation")
 	assert.NotNil(hw.Spec.UserData, "expected user data to be set")
 }
@@ -271,15 +272,59 @@ func Test_GenerateWorkflow(t *testing.T) {
 
 func Test_generateIPXEScript(t *testing.T) {
 	assert := require.New(t)
-	_, err := generateIPXEScript("v1.1.3", "http://imagestore/iso", "hegelEndpoint", "ab:cd:ef:gh:ij", "amd64", 1, "192.168.1.101", "255.255.255.0", "192.168.1.1")
+	cObj := c.DeepCopy()
+	cObj.Spec.HarvesterVersion = "v1.1.3"
+	cObj.Spec.ImageURL = "http://imagestore/iso"
+	artifacts, err := GenerateArtifacts(cObj, "amd64")
+	assert.NoError(err, "expect no error during generation of artifacts")
+	script, err := generateIPXEScript(artifacts, "hegelEndpoint", "ab:cd:ef:gh:ij", 1, "192.168.1.101", "255.255.255.0", "192.168.1.1")
 	assert.NoError(err, "expect no error during generation of ipxe script")
+	assert.Contains(script, "kernel http://imagestore/iso/v1.1.3/harvester-v1.1.3-vmlinuz-amd64 initrd=harvester-v1.1.3-initrd-amd64", "expected kernel and initrd name to follow release layout")
+	assert.Contains(script, "root=live:http://imagestore/iso/v1.1.3/harvester-v1.1.3-rootfs-amd64.squashfs", "expected rootfs to follow release layout")
+	assert.Contains(script, "initrd http://imagestore/iso/v1.1.3/harvester-v1.1.3-initrd-amd64", "expected initrd to follow release layout")
+}
+
+func Test_GenerateArtifacts(t *testing.T) {
+	assert := require.New(t)
+	cObj := c.DeepCopy()
+	cObj.Spec.ImageURL = "http://imagestore"
+	cObj.Spec.Artifacts = seederv1alpha1.ArtifactSpec{
+		Kernel: seederv1alpha1.Artifact{
+			URL: "http://mirror/rc/{{ .Version }}/custom-kernel-{{ .Arch }}",
+		},
+		Initrd: seederv1alpha1.Artifact{
+			URL: "http://mirror/rc/initrd.img?token=abc",
+		},
+		RawImage: seederv1alpha1.RawImageArtifact{
+			URL:      "{{ .ImageURL }}/patched/{{ .Version }}.raw.gz",
+			Checksum: "sha512:0123456789",
+		},
+	}
+	artifacts, err := GenerateArtifacts(cObj, "arm64")
+	assert.NoError(err, "expected no error during generation of artifacts")
+	assert.Equal("http://mirror/rc/v1.2.0/custom-kernel-arm64", artifacts.Kernel.URL)
+	assert.Equal("http://mirror/rc/initrd.img?token=abc", artifacts.Initrd.URL)
+	assert.Equal("initrd.img", artifacts.InitrdName(), "expected initrd name to ignore query parameters")
+	assert.Equal("http://imagestore/v1.2.0/harvester-v1.2.0-rootfs-arm64.squashfs", artifacts.RootFS.URL, "expected rootfs to fall back to default layout")
+	assert.Equal("http://imagestore/v1.2.0/harvester-v1.2.0-arm64.iso", artifacts.ISO.URL, "expected iso to fall back to default layout")
+	assert.Equal("http://imagestore/patched/v1.2.0.raw.gz", artifacts.RawImage.URL)
+	assert.Equal("sha512:0123456789", artifacts.RawImage.Checksum)
+
+	cObj.Spec.Artifacts.ISO.URL = "{{ .Unknown }}"
+	_, err = GenerateArtifacts(cObj, "amd64")
+	assert.Error(err, "expected error for template with unknown field")
+
+	cObj.Spec.Artifacts.ISO = seederv1alpha1.Artifact{}
+	cObj.Spec.Artifacts.RawImage.Checksum = "md5:abcd"
+	_, err = GenerateArtifacts(cObj, "amd64")
+	assert.Error(err, "expected error for unsupported checksum algorithm")
 }
 
 func Test_GenerateHardwareRequestV11(t *testing.T) {
 	assert := require.New(t)
 	cObj := c.DeepCopy()
 	cObj.Spec.HarvesterVersion = "v1.1.2"
-	hw, err := GenerateHWRequest(i, cObj, svc, hegelSvc)
+	hw, err := GenerateHWRequest(i, cObj, svc, hegelSvc, nil)
 	assert.NoError(err, "expected no error during hardware generation")
 	assert.NotNil(hw.Spec.UserData, "expected user data to be set")
 	for _, v := range hw.Spec.Interfaces {
@@ -290,7 +335,7 @@ func Test_GenerateHardwareRequestV11(t *testing.T) {
 
 func Test_createModeCloudConfigV11(t *testing.T) {
 	assert := require.New(t)
-	cloudConfig, err := generateCloudConfig("file:///testdata/create.yaml", "ab:cd:ef:gh:ij:kl", "create", "192.168.1.100", "token", "password", "192.168.1.101", "255.255.255.0", "192.168.1.1", []string{"8.8.8.8"}, []string{"ssh-key 1", "ssh-key 2"}, nil, "http://imagestore/iso", "v1.1.2", "http://seeder-endpoint", "sample", "harvester-system", false, true, 1, "amd64", "/dev/vda", "test")
+	cloudConfig, err := generateCloudConfig("file:///testdata/create.yaml", "ab:cd:ef:gh:ij:kl", "create", "192.168.1.100", "token", "password", "192.168.1.101", "255.255.255.0", "192.168.1.1", []string{"8.8.8.8"}, []string{"ssh-key 1", "ssh-key 2"}, nil, "http://imagestore/iso/v1.1.2/harvester-v1.1.2-amd64.iso", "http://seeder-endpoint", "sample", "harvester-system", false, true, 1, "/dev/vda", "test", nil, nil)
 	assert.NoError(err)
 	hc := config.NewHarvesterConfig()
 	err = yaml.Unmarshal([]byte(cloudConfig), hc)
@@ -315,7 +360,7 @@ func Test_createModeCloudConfigV11(t *testing.T) {
 
 func Test_joinModeCloudConfigV11(t *testing.T) {
 	assert := require.New(t)
-	cloudConfig, err := generateCloudConfig("file:///testdata/create.yaml", "ab:cd:ef:gh:ij:kl", "join", "192.168.1.100", "token", "password", "192.168.1.101", "255.255.255.0", "192.168.1.1", []string{"8.8.8.8"}, []string{"ssh-key 1", "ssh-key 2"}, nil, "http://imagestore/iso", "v1.1.2", "http://seeder-endpoint", "sample", "harvester-system", false, true, 1, "amd64", "/dev/vda", "test")
+	cloudConfig, err := generateCloudConfig("file:///testdata/create.yaml", "ab:cd:ef:gh:ij:kl", "join", "192.168.1.100", "token", "password", "192.168.1.101", "255.255.255.0", "192.168.1.1", []string{"8.8.8.8"}, []string{"ssh-key 1", "ssh-key 2"}, nil, "http://imagestore/iso/v1.1.2/harvester-v1.1.2-amd64.iso", "http://seeder-endpoint", "sample", "harvester-system", false, true, 1, "/dev/vda", "test", nil, nil)
 	assert.NoError(err)
 	hc := config.NewHarvesterConfig()
 	err = yaml.Unmarshal([]byte(cloudConfig), hc)
@@ -335,3 +380,36 @@ func Test_joinModeCloudConfigV11(t *testing.T) {
 	assert.True(hc.WipeAllDisks, "expected wipe disks to be set")
 	assert.Contains(hc.WipeDisksList, "/dev/vda", "expected to find /dev/vda in wipeDisksList")
 }
+
+func Test_GenerateVirtualMediaURL(t *testing.T) {
+	assert := require.New(t)
+	cObj := c.DeepCopy()
+	cObj.Spec.ImageURL = "http://imagestore"
+	cObj.Spec.ProvisioningMode = seederv1alpha1.ProvisioningModeVirtualMedia
+	isoURL, err := GenerateVirtualMediaURL(i, cObj, "127.0.0.1")
+	assert.NoError(err, "expected no error during generation of virtual media url")
+	assert.Equal("http://imagestore/v1.2.0/harvester-v1.2.0-amd64.iso", isoURL, "expected iso artifact to be used by default")
+
+	cObj.Spec.VirtualMedia.ISOURL = "http://builder/iso?base={{ .ISOURL }}&config={{ .ConfigURL }}&ip={{ .Address }}"
+	isoURL, err = GenerateVirtualMediaURL(i, cObj, "127.0.0.1")
+	assert.NoError(err, "expected no error during generation of virtual media url")
+	assert.Equal(fmt.Sprintf("http://builder/iso?base=http://imagestore/v1.2.0/harvester-v1.2.0-amd64.iso&config=http://127.0.0.1:9090/config/%s/%s&ip=%s", i.Namespace, i.Name, i.Status.Address), isoURL)
+
+	cObj.Spec.VirtualMedia.ISOURL = "{{ .Unknown }}"
+	_, err = GenerateVirtualMediaURL(i, cObj, "127.0.0.1")
+	assert.Error(err, "expected error for template with unknown field")
+}
+
+func Test_GenerateHWRequestVirtualMedia(t *testing.T) {
+	assert := require.New(t)
+	cObj := c.DeepCopy()
+	cObj.Spec.ProvisioningMode = seederv1alpha1.ProvisioningModeVirtualMedia
+	hw, err := GenerateHWRequest(i, cObj, svc, hegelSvc, nil)
+	assert.NoError(err, "expected no error during hardware generation")
+	assert.NotNil(hw.Spec.UserData, "expected user data to be set")
+	assert.NotEmpty(hw.Annotations[seederv1alpha1.VirtualMediaURLAnnotation], "expected virtual media url annotation to be set")
+	for _, v := range hw.Spec.Interfaces {
+		assert.False(*v.Netboot.AllowPXE, "expected pxe to be disabled")
+		assert.Nil(v.Netboot.IPXE, "expected no ipxe script")
+	}
+}
@@ -38,6 +38,7 @@ const (
 	DestDisk              = "DEST_DISK"
 	HarvesterCloudInitURL = "HARVESTER_STREAMDISK_CLOUDINIT_URL"
 	ImageURL              = "IMG_URL"
+	ImageChecksum         = "IMG_CHECKSUM"
 )
 
 var (
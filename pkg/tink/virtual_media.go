@@ -0,0 +1,72 @@
+package tink
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/util"
+)
+
+// IsVirtualMediaMode returns true if the cluster nodes are provisioned by mounting an ISO via the BMC
+func IsVirtualMediaMode(c *seederv1alpha1.Cluster) bool {
+	return c.Spec.ProvisioningMode == seederv1alpha1.ProvisioningModeVirtualMedia
+}
+
+// GenerateConfigURL returns the url used by the installer to fetch the harvester config of an inventory from the seeder endpoint
+func GenerateConfigURL(seederAddress string, i *seederv1alpha1.Inventory) string {
+	return fmt.Sprintf("http://%s:%d/config/%s/%s", seederAddress, seederv1alpha1.DefaultEndpointPort, i.Namespace, i.Name)
+}
+
+// GenerateVirtualMediaURL renders the virtual media ISO url for an inventory
+func GenerateVirtualMediaURL(i *seederv1alpha1.Inventory, c *seederv1alpha1.Cluster, seederAddress string) (string, error) {
+	arch, err := inventoryArch(i)
+	if err != nil {
+		return "", err
+	}
+	artifacts, err := GenerateArtifacts(c, arch)
+	if err != nil {
+		return "", fmt.Errorf("error generating artifact urls: %v", err)
+	}
+
+	if c.Spec.VirtualMedia.ISOURL == "" {
+		return artifacts.ISO.URL, nil
+	}
+
+	values := struct {
+		ISOURL     string
+		ConfigURL  string
+		Name       string
+		Namespace  string
+		Address    string
+		Netmask    string
+		Gateway    string
+		MACAddress string
+		VlanID     int
+		Version    string
+		Arch       string
+	}{
+		ISOURL:     artifacts.ISO.URL,
+		ConfigURL:  GenerateConfigURL(seederAddress, i),
+		Name:       i.Name,
+		Namespace:  i.Namespace,
+		Address:    i.Status.Address,
+		Netmask:    i.Status.Netmask,
+		Gateway:    i.Status.Gateway,
+		MACAddress: util.ManagementMACAddress(i),
+		VlanID:     c.Spec.VlanID,
+		Version:    c.Spec.HarvesterVersion,
+		Arch:       arch,
+	}
+
+	t, err := template.New("virtualMedia").Parse(c.Spec.VirtualMedia.ISOURL)
+	if err != nil {
+		return "", fmt.Errorf("error parsing virtual media iso url template: %v", err)
+	}
+	var output bytes.Buffer
+	if err := t.Execute(&output, values); err != nil {
+		return "", fmt.Errorf("error rendering virtual media iso url template: %v", err)
+	}
+	return output.String(), nil
+}
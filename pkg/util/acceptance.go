@@ -0,0 +1,62 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+// InventoryAccepted returns true if the inventory has no acceptance checks, or has passed them
+func InventoryAccepted(i *seederv1alpha1.Inventory) bool {
+	return i.Spec.Acceptance == nil || ConditionExists(i, seederv1alpha1.HardwareAccepted)
+}
+
+// CheckHardwareRequirements checks the hardware discovered via redfish against the minimum requirements. Link speed
+// is only checked when specified, as it is not reported by all BMCs
+func CheckHardwareRequirements(i *seederv1alpha1.Inventory, req seederv1alpha1.HardwareRequirements) []seederv1alpha1.AcceptanceResult {
+	hw := i.Status.Hardware
+	results := []seederv1alpha1.AcceptanceResult{
+		minimumResult("cpu-cores", hw.CPUCores, req.CPUCores, ""),
+		minimumResult("memory", hw.MemoryGiB, req.MemoryGiB, "GiB"),
+	}
+
+	var largestDisk int
+	for _, d := range hw.Disks {
+		if d.CapacityGiB > largestDisk {
+			largestDisk = d.CapacityGiB
+		}
+	}
+	results = append(results, minimumResult("disk-size", largestDisk, req.DiskGiB, "GiB"))
+
+	if req.LinkSpeedMbps > 0 {
+		var speed int
+		mac := ManagementMACAddress(i)
+		for _, v := range hw.Interfaces {
+			if strings.EqualFold(v.MACAddress, mac) {
+				speed = v.SpeedMbps
+				break
+			}
+		}
+		results = append(results, minimumResult("link-speed", speed, req.LinkSpeedMbps, "Mbps"))
+	}
+	return results
+}
+
+func minimumResult(check string, found, minimum int, unit string) seederv1alpha1.AcceptanceResult {
+	return seederv1alpha1.AcceptanceResult{
+		Check:   check,
+		Passed:  found >= minimum,
+		Message: fmt.Sprintf("found %d%s, minimum %d%s", found, unit, minimum, unit),
+	}
+}
+
+// AcceptancePassed returns false if any of the acceptance checks failed
+func AcceptancePassed(results []seederv1alpha1.AcceptanceResult) bool {
+	for _, v := range results {
+		if !v.Passed {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,65 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+func Test_CheckHardwareRequirements(t *testing.T) {
+	assert := require.New(t)
+	i := &seederv1alpha1.Inventory{
+		Spec: seederv1alpha1.InventorySpec{
+			ManagementInterfaceMacAddress: "AA:BB:CC:DD:EE:FF",
+		},
+		Status: seederv1alpha1.InventoryStatus{
+			Hardware: seederv1alpha1.DiscoveredHardware{
+				CPUCores:  16,
+				MemoryGiB: 64,
+				Disks: []seederv1alpha1.DiscoveredDisk{
+					{Name: "disk0", CapacityGiB: 120},
+					{Name: "disk1", CapacityGiB: 480},
+				},
+				Interfaces: []seederv1alpha1.DiscoveredInterface{
+					{Name: "nic0", MACAddress: "aa:bb:cc:dd:ee:ff", SpeedMbps: 1000},
+				},
+			},
+		},
+	}
+	req := seederv1alpha1.HardwareRequirements{
+		CPUCores:  8,
+		MemoryGiB: 32,
+		DiskGiB:   250,
+	}
+
+	results := CheckHardwareRequirements(i, req)
+	assert.Len(results, 3, "expected link speed not to be checked by default")
+	assert.True(AcceptancePassed(results), "expected requirements to be met")
+
+	req.LinkSpeedMbps = 10000
+	req.MemoryGiB = 128
+	results = CheckHardwareRequirements(i, req)
+	assert.Len(results, 4)
+	assert.False(AcceptancePassed(results), "expected requirements not to be met")
+	var failed []string
+	for _, v := range results {
+		if !v.Passed {
+			failed = append(failed, v.Check)
+		}
+	}
+	assert.Equal([]string{"memory", "link-speed"}, failed)
+}
+
+func Test_InventoryAccepted(t *testing.T) {
+	assert := require.New(t)
+	i := &seederv1alpha1.Inventory{}
+	assert.True(InventoryAccepted(i), "expected inventory without acceptance checks to be accepted")
+
+	i.Spec.Acceptance = &seederv1alpha1.AcceptanceSpec{}
+	assert.False(InventoryAccepted(i), "expected inventory to wait for acceptance")
+
+	CreateOrUpdateCondition(i, seederv1alpha1.HardwareAccepted, "passed")
+	assert.True(InventoryAccepted(i))
+}
@@ -0,0 +1,101 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+// GenerateClusterFromSeederCluster generates the seeder cluster the machines of a cluster api cluster are
+// provisioned into. Nodes are added to the cluster by the SeederMachine controller
+func GenerateClusterFromSeederCluster(sc *seederv1alpha1.SeederCluster) *seederv1alpha1.Cluster {
+	isController := true
+	return &seederv1alpha1.Cluster{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       seederv1alpha1.ClusterGroupVersionKind.Kind,
+			APIVersion: seederv1alpha1.ClusterGroupVersionKind.GroupVersion().String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      sc.Name,
+			Namespace: sc.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: seederv1alpha1.SeederClusterGroupVersionKind.GroupVersion().String(),
+					Kind:       seederv1alpha1.SeederClusterGroupVersionKind.Kind,
+					Name:       sc.Name,
+					UID:        sc.UID,
+					Controller: &isController,
+				},
+			},
+		},
+		Spec: seederv1alpha1.ClusterSpec{
+			HarvesterVersion: sc.Spec.HarvesterVersion,
+			ImageURL:         sc.Spec.ImageURL,
+			Nodes:            []seederv1alpha1.NodeConfig{},
+			VIPConfig:        sc.Spec.VIPConfig,
+			ClusterConfig:    sc.Spec.ClusterConfig,
+		},
+	}
+}
+
+// CAPIClusterName returns the name of the cluster api cluster owning the object. Cluster api sets the cluster name
+// label on infrastructure machines, and an owner reference to the cluster on infrastructure clusters
+func CAPIClusterName(obj metav1.Object) string {
+	if name, ok := obj.GetLabels()[seederv1alpha1.CAPIClusterNameLabel]; ok {
+		return name
+	}
+	for _, o := range obj.GetOwnerReferences() {
+		gv, err := schema.ParseGroupVersion(o.APIVersion)
+		if err == nil && gv.Group == seederv1alpha1.CAPIGroup && o.Kind == "Cluster" {
+			return o.Name
+		}
+	}
+	return ""
+}
+
+// FindSeederCluster returns the SeederCluster of the cluster api cluster owning the machine
+func FindSeederCluster(ctx context.Context, c client.Client, m *seederv1alpha1.SeederMachine) (*seederv1alpha1.SeederCluster, error) {
+	clusterName := CAPIClusterName(m)
+	if clusterName == "" {
+		return nil, fmt.Errorf("seeder machine %s/%s has no %s label", m.Namespace, m.Name, seederv1alpha1.CAPIClusterNameLabel)
+	}
+
+	scList := &seederv1alpha1.SeederClusterList{}
+	if err := c.List(ctx, scList, client.InNamespace(m.Namespace)); err != nil {
+		return nil, fmt.Errorf("error listing seeder clusters: %w", err)
+	}
+	for i, sc := range scList.Items {
+		if CAPIClusterName(&sc) == clusterName {
+			return &scList.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// SeederMachineProviderID returns the provider id of the node provisioned on the inventory
+func SeederMachineProviderID(i *seederv1alpha1.Inventory) string {
+	return seederv1alpha1.ProviderIDPrefix + NodeHostname(i)
+}
+
+// IsCAPIPaused returns true if cluster api has paused reconciliation of the object
+func IsCAPIPaused(obj metav1.Object) bool {
+	_, ok := obj.GetAnnotations()[seederv1alpha1.CAPIPausedAnnotation]
+	return ok
+}
+
+// InventoryClaimedBySeederMachine returns the name of the seeder machine which claimed the inventory
+func InventoryClaimedBySeederMachine(machines []seederv1alpha1.SeederMachine, i *seederv1alpha1.Inventory) string {
+	for _, m := range machines {
+		ref := m.Status.InventoryReference
+		if ref != nil && ref.Name == i.Name && ref.Namespace == i.Namespace {
+			return strings.Join([]string{m.Namespace, m.Name}, "/")
+		}
+	}
+	return ""
+}
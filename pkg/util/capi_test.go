@@ -0,0 +1,103 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/mock"
+)
+
+func Test_FindSeederCluster(t *testing.T) {
+	assert := require.New(t)
+	sc := &seederv1alpha1.SeederCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "workload-infra",
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: "cluster.x-k8s.io/v1beta1",
+					Kind:       "Cluster",
+					Name:       "workload",
+					UID:        "workload-uid",
+				},
+			},
+		},
+	}
+	c, err := mock.GenerateFakeClient()
+	assert.NoError(err)
+	assert.NoError(c.Create(ctx, sc))
+
+	m := &seederv1alpha1.SeederMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "workload-md-0",
+			Namespace: "default",
+		},
+	}
+	_, err = FindSeederCluster(ctx, c, m)
+	assert.Error(err, "expected machine without cluster name label to be rejected")
+
+	m.Labels = map[string]string{seederv1alpha1.CAPIClusterNameLabel: "other"}
+	found, err := FindSeederCluster(ctx, c, m)
+	assert.NoError(err)
+	assert.Nil(found)
+
+	m.Labels[seederv1alpha1.CAPIClusterNameLabel] = "workload"
+	found, err = FindSeederCluster(ctx, c, m)
+	assert.NoError(err)
+	assert.NotNil(found)
+	assert.Equal(sc.Name, found.Name)
+}
+
+func Test_GenerateClusterFromSeederCluster(t *testing.T) {
+	assert := require.New(t)
+	sc := &seederv1alpha1.SeederCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "workload-infra",
+			Namespace: "default",
+			UID:       "infra-uid",
+		},
+		Spec: seederv1alpha1.SeederClusterSpec{
+			HarvesterVersion: "v1.3.0",
+			VIPConfig: seederv1alpha1.VIPConfig{
+				AddressPoolReference: seederv1alpha1.ObjectReference{
+					Name:      "vip-pool",
+					Namespace: "default",
+				},
+			},
+		},
+	}
+	c := GenerateClusterFromSeederCluster(sc)
+	assert.True(metav1.IsControlledBy(c, sc))
+	assert.Equal("v1.3.0", c.Spec.HarvesterVersion)
+	assert.Equal("vip-pool", c.Spec.AddressPoolReference.Name)
+	assert.NotNil(c.Spec.Nodes, "expected nodes to be an empty list")
+}
+
+func Test_SeederMachineProviderID(t *testing.T) {
+	assert := require.New(t)
+	i := &seederv1alpha1.Inventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "node1",
+			Namespace: "default",
+		},
+	}
+	assert.Equal("rke2://node1-default", SeederMachineProviderID(i))
+
+	m := []seederv1alpha1.SeederMachine{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "workload-md-0",
+				Namespace: "default",
+			},
+			Status: seederv1alpha1.SeederMachineStatus{
+				InventoryReference: &seederv1alpha1.ObjectReference{Name: "node1", Namespace: "default"},
+			},
+		},
+	}
+	assert.Equal("default/workload-md-0", InventoryClaimedBySeederMachine(m, i))
+	i.Name = "node2"
+	assert.Empty(InventoryClaimedBySeederMachine(m, i))
+}
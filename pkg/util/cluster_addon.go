@@ -0,0 +1,183 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+// ClusterAddonManifests returns the manifests in the configmaps of the addon, in the order they are applied
+func ClusterAddonManifests(ctx context.Context, c client.Client, addon *seederv1alpha1.ClusterAddon) ([]string, error) {
+	var manifests []string
+	for _, name := range addon.Spec.ManifestConfigMaps {
+		cm := &corev1.ConfigMap{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: addon.Namespace, Name: name}, cm); err != nil {
+			return nil, fmt.Errorf("error fetching manifests configmap %s/%s: %w", addon.Namespace, name, err)
+		}
+		keys := make([]string, 0, len(cm.Data))
+		for k := range cm.Data {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			manifests = append(manifests, cm.Data[k])
+		}
+	}
+	return manifests, nil
+}
+
+// ParseManifest decodes the objects in a yaml or json manifest, which may contain multiple documents. Empty
+// documents are skipped
+func ParseManifest(manifest string) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+	decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(manifest), 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				return objs, nil
+			}
+			return nil, fmt.Errorf("error decoding manifest: %w", err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		if obj.GetKind() == "" || obj.GetAPIVersion() == "" || obj.GetName() == "" {
+			return nil, fmt.Errorf("manifest object %q is missing apiVersion, kind or name", obj.GetName())
+		}
+		objs = append(objs, obj)
+	}
+}
+
+// ApplyClusterAddon applies the manifests and then the harvester settings of an addon to the target cluster, and
+// returns the number of objects and settings which were created or changed
+func ApplyClusterAddon(ctx context.Context, dc dynamic.Interface, mapper meta.RESTMapper, manifests []string, settings []seederv1alpha1.HarvesterSetting) (int, error) {
+	var changed int
+	for _, m := range manifests {
+		objs, err := ParseManifest(m)
+		if err != nil {
+			return changed, err
+		}
+		for _, obj := range objs {
+			ok, err := ApplyObject(ctx, dc, mapper, obj)
+			if err != nil {
+				return changed, err
+			}
+			if ok {
+				changed++
+			}
+		}
+	}
+
+	for _, s := range settings {
+		ok, err := UpdateHarvesterSetting(ctx, dc, s.Name, s.Value)
+		if err != nil {
+			return changed, err
+		}
+		if ok {
+			changed++
+		}
+	}
+	return changed, nil
+}
+
+// ApplyObject creates the object in the target cluster, or patches the live object if the fields set in the
+// object do not match it, and returns true if the object was created or patched. Namespaced objects without a
+// namespace are created in the default namespace
+func ApplyObject(ctx context.Context, dc dynamic.Interface, mapper meta.RESTMapper, obj *unstructured.Unstructured) (bool, error) {
+	gvk := obj.GroupVersionKind()
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return false, fmt.Errorf("error mapping %s %s: %w", gvk.Kind, obj.GetName(), err)
+	}
+
+	desired := obj.DeepCopy()
+	unstructured.RemoveNestedField(desired.Object, "status")
+	var ri dynamic.ResourceInterface = dc.Resource(mapping.Resource)
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if desired.GetNamespace() == "" {
+			desired.SetNamespace(metav1.NamespaceDefault)
+		}
+		ri = dc.Resource(mapping.Resource).Namespace(desired.GetNamespace())
+	}
+
+	live, err := ri.Get(ctx, desired.GetName(), metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, fmt.Errorf("error fetching %s %s: %w", gvk.Kind, desired.GetName(), err)
+		}
+		if _, err := ri.Create(ctx, desired, metav1.CreateOptions{}); err != nil {
+			return false, fmt.Errorf("error creating %s %s: %w", gvk.Kind, desired.GetName(), err)
+		}
+		return true, nil
+	}
+
+	if fieldsMatch(desired.Object, live.Object) {
+		return false, nil
+	}
+
+	patch, err := json.Marshal(desired.Object)
+	if err != nil {
+		return false, err
+	}
+	if _, err := ri.Patch(ctx, desired.GetName(), types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return false, fmt.Errorf("error patching %s %s: %w", gvk.Kind, desired.GetName(), err)
+	}
+	return true, nil
+}
+
+// fieldsMatch returns true if every field set in desired has the same value in live. Fields only set in live, such
+// as those defaulted by the api server, are ignored
+func fieldsMatch(desired, live interface{}) bool {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range d {
+			lv, ok := l[k]
+			if !ok {
+				if v == nil {
+					continue
+				}
+				return false
+			}
+			if !fieldsMatch(v, lv) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok || len(l) != len(d) {
+			return false
+		}
+		for i := range d {
+			if !fieldsMatch(d[i], l[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		// numbers decoded from yaml and returned by the api server may have different types
+		return reflect.DeepEqual(desired, live) || fmt.Sprint(desired) == fmt.Sprint(live)
+	}
+}
@@ -0,0 +1,129 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/mock"
+)
+
+const addonManifest = `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: backup
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: backup-config
+  namespace: backup
+data:
+  retention: "7"
+---
+`
+
+func Test_ParseManifest(t *testing.T) {
+	assert := require.New(t)
+	objs, err := ParseManifest(addonManifest)
+	assert.NoError(err)
+	assert.Len(objs, 2, "expected empty documents to be skipped")
+	assert.Equal("Namespace", objs[0].GetKind())
+	assert.Equal("backup-config", objs[1].GetName())
+
+	_, err = ParseManifest("apiVersion: v1\nkind: ConfigMap\n")
+	assert.Error(err, "expected object without a name to be rejected")
+}
+
+func Test_ClusterAddonManifests(t *testing.T) {
+	assert := require.New(t)
+	c, err := mock.GenerateFakeClient()
+	assert.NoError(err)
+	for _, cm := range []*corev1.ConfigMap{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "first", Namespace: "default"},
+			Data:       map[string]string{"b.yaml": "b", "a.yaml": "a"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "second", Namespace: "default"},
+			Data:       map[string]string{"c.yaml": "c"},
+		},
+	} {
+		assert.NoError(c.Create(ctx, cm))
+	}
+
+	addon := &seederv1alpha1.ClusterAddon{
+		ObjectMeta: metav1.ObjectMeta{Name: "addon", Namespace: "default"},
+		Spec: seederv1alpha1.ClusterAddonSpec{
+			ManifestConfigMaps: []string{"second", "first"},
+		},
+	}
+	manifests, err := ClusterAddonManifests(ctx, c, addon)
+	assert.NoError(err)
+	assert.Equal([]string{"c", "a", "b"}, manifests, "expected configmaps in order and keys in sorted order")
+
+	addon.Spec.ManifestConfigMaps = append(addon.Spec.ManifestConfigMaps, "missing")
+	_, err = ClusterAddonManifests(ctx, c, addon)
+	assert.Error(err, "expected missing configmap to be reported")
+}
+
+func Test_ApplyClusterAddon(t *testing.T) {
+	assert := require.New(t)
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}, meta.RESTScopeRoot)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+	namespaceGVR := schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	configMapGVR := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+	scheme := runtime.NewScheme()
+	dc := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, map[schema.GroupVersionResource]string{
+		namespaceGVR:        "NamespaceList",
+		configMapGVR:        "ConfigMapList",
+		harvesterSettingGVR: "SettingList",
+	})
+	settings := []seederv1alpha1.HarvesterSetting{
+		{
+			Name:  "backup-target",
+			Value: `{"type":"nfs","endpoint":"nfs://backup.example.com/backups"}`,
+		},
+	}
+
+	changed, err := ApplyClusterAddon(ctx, dc, mapper, []string{addonManifest}, settings)
+	assert.NoError(err)
+	assert.Equal(3, changed, "expected objects and setting to be created")
+
+	changed, err = ApplyClusterAddon(ctx, dc, mapper, []string{addonManifest}, settings)
+	assert.NoError(err)
+	assert.Equal(0, changed, "expected applied addon to be unchanged")
+
+	// simulate changes made in the cluster, and fields defaulted by the api server
+	cm, err := dc.Resource(configMapGVR).Namespace("backup").Get(ctx, "backup-config", metav1.GetOptions{})
+	assert.NoError(err)
+	assert.NoError(unstructured.SetNestedField(cm.Object, "30", "data", "retention"))
+	assert.NoError(unstructured.SetNestedField(cm.Object, "value", "data", "extra"))
+	_, err = dc.Resource(configMapGVR).Namespace("backup").Update(ctx, cm, metav1.UpdateOptions{})
+	assert.NoError(err)
+	_, err = UpdateHarvesterSetting(ctx, dc, "backup-target", "")
+	assert.NoError(err)
+
+	changed, err = ApplyClusterAddon(ctx, dc, mapper, []string{addonManifest}, settings)
+	assert.NoError(err)
+	assert.Equal(2, changed, "expected drifted object and setting to be re-applied")
+
+	cm, err = dc.Resource(configMapGVR).Namespace("backup").Get(ctx, "backup-config", metav1.GetOptions{})
+	assert.NoError(err)
+	retention, _, _ := unstructured.NestedString(cm.Object, "data", "retention")
+	assert.Equal("7", retention, "expected drifted field to be restored")
+
+	_, err = ApplyClusterAddon(ctx, dc, mapper, []string{"apiVersion: v1\nkind: Secret\nmetadata:\n  name: unknown\n"}, nil)
+	assert.Error(err, "expected unknown kind to be rejected")
+}
@@ -0,0 +1,207 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+// clusterTemplateField is a cluster setting which can be defaulted from a cluster template
+type clusterTemplateField struct {
+	path string
+	// inherit copies the template value to the cluster if the cluster value is not set, and returns true if it did
+	inherit func(c *seederv1alpha1.ClusterSpec, t *seederv1alpha1.ClusterTemplateSpec) bool
+	// matches returns true if the cluster value is the same as the template value
+	matches func(c *seederv1alpha1.ClusterSpec, t *seederv1alpha1.ClusterTemplateSpec) bool
+}
+
+// templateField defines a cluster template field. Values which are the zero value, or one of the unset values, are
+// considered not set. The crd defaults are applied before the cluster is defaulted from the template, so fields with
+// a crd default list it as an unset value
+func templateField[T any](path string, field func(*seederv1alpha1.ClusterSpec) *T, templateField func(*seederv1alpha1.ClusterTemplateSpec) *T, unset ...T) clusterTemplateField {
+	isSet := func(v *T) bool {
+		if reflect.ValueOf(*v).IsZero() {
+			return false
+		}
+		for _, u := range unset {
+			if reflect.DeepEqual(*v, u) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return clusterTemplateField{
+		path: path,
+		inherit: func(c *seederv1alpha1.ClusterSpec, t *seederv1alpha1.ClusterTemplateSpec) bool {
+			v, tv := field(c), templateField(t)
+			if isSet(v) || !isSet(tv) {
+				return false
+			}
+			*v = *tv
+			return true
+		},
+		matches: func(c *seederv1alpha1.ClusterSpec, t *seederv1alpha1.ClusterTemplateSpec) bool {
+			return reflect.DeepEqual(*field(c), *templateField(t))
+		},
+	}
+}
+
+var clusterTemplateFields = []clusterTemplateField{
+	templateField("version",
+		func(c *seederv1alpha1.ClusterSpec) *string { return &c.HarvesterVersion },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *string { return &t.HarvesterVersion }),
+	templateField("imageURL",
+		func(c *seederv1alpha1.ClusterSpec) *string { return &c.ImageURL },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *string { return &t.ImageURL }),
+	{
+		path: "vipConfig.addressPoolReference",
+		inherit: func(c *seederv1alpha1.ClusterSpec, t *seederv1alpha1.ClusterTemplateSpec) bool {
+			if c.VIPConfig.AddressPoolReference != (seederv1alpha1.ObjectReference{}) || t.VIPAddressPoolReference == nil {
+				return false
+			}
+			c.VIPConfig.AddressPoolReference = *t.VIPAddressPoolReference
+			return true
+		},
+		matches: func(c *seederv1alpha1.ClusterSpec, t *seederv1alpha1.ClusterTemplateSpec) bool {
+			return t.VIPAddressPoolReference != nil && c.VIPConfig.AddressPoolReference == *t.VIPAddressPoolReference
+		},
+	},
+	templateField("clusterConfig.configURL",
+		func(c *seederv1alpha1.ClusterSpec) *string { return &c.ConfigURL },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *string { return &t.ClusterConfig.ConfigURL }),
+	templateField("clusterConfig.sshKeys",
+		func(c *seederv1alpha1.ClusterSpec) *[]string { return &c.SSHKeys },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *[]string { return &t.ClusterConfig.SSHKeys }),
+	templateField("clusterConfig.nameservers",
+		func(c *seederv1alpha1.ClusterSpec) *[]string { return &c.Nameservers },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *[]string { return &t.ClusterConfig.Nameservers }),
+	templateField("clusterConfig.customProvisioningTemplate",
+		func(c *seederv1alpha1.ClusterSpec) *string { return &c.CustomProvisioningTemplate },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *string {
+			return &t.ClusterConfig.CustomProvisioningTemplate
+		}),
+	templateField("clusterConfig.bondOptions",
+		func(c *seederv1alpha1.ClusterSpec) *map[string]string { return &c.BondOptions },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *map[string]string { return &t.ClusterConfig.BondOptions }),
+	templateField("clusterConfig.vlanID",
+		func(c *seederv1alpha1.ClusterSpec) *int { return &c.VlanID },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *int { return &t.ClusterConfig.VlanID }, 1),
+	templateField("clusterConfig.streamImageMode",
+		func(c *seederv1alpha1.ClusterSpec) *bool { return &c.StreamImageMode },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *bool { return &t.ClusterConfig.StreamImageMode }),
+	templateField("clusterConfig.wipeDisks",
+		func(c *seederv1alpha1.ClusterSpec) *bool { return &c.WipeDisks },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *bool { return &t.ClusterConfig.WipeDisks }),
+	templateField("clusterConfig.provisioningMode",
+		func(c *seederv1alpha1.ClusterSpec) *seederv1alpha1.ProvisioningMode { return &c.ProvisioningMode },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *seederv1alpha1.ProvisioningMode {
+			return &t.ClusterConfig.ProvisioningMode
+		}, seederv1alpha1.ProvisioningModePXE),
+	templateField("clusterConfig.virtualMedia",
+		func(c *seederv1alpha1.ClusterSpec) *seederv1alpha1.VirtualMediaSpec { return &c.VirtualMedia },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *seederv1alpha1.VirtualMediaSpec {
+			return &t.ClusterConfig.VirtualMedia
+		}),
+	templateField("clusterConfig.maxInstallingNodes",
+		func(c *seederv1alpha1.ClusterSpec) *int { return &c.MaxInstallingNodes },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *int { return &t.ClusterConfig.MaxInstallingNodes }),
+	templateField("clusterConfig.joinBatchSize",
+		func(c *seederv1alpha1.ClusterSpec) *int { return &c.JoinBatchSize },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *int { return &t.ClusterConfig.JoinBatchSize }),
+	templateField("clusterConfig.decommission",
+		func(c *seederv1alpha1.ClusterSpec) *seederv1alpha1.DecommissionSpec { return &c.Decommission },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *seederv1alpha1.DecommissionSpec {
+			return &t.ClusterConfig.Decommission
+		},
+		seederv1alpha1.DecommissionSpec{Mode: seederv1alpha1.DecommissionModeNone, Timeout: "2h"}),
+	templateField("clusterConfig.rancherRegistration",
+		func(c *seederv1alpha1.ClusterSpec) **seederv1alpha1.RancherRegistrationSpec {
+			return &c.RancherRegistration
+		},
+		func(t *seederv1alpha1.ClusterTemplateSpec) **seederv1alpha1.RancherRegistrationSpec {
+			return &t.ClusterConfig.RancherRegistration
+		}),
+	templateField("clusterConfig.ntpServers",
+		func(c *seederv1alpha1.ClusterSpec) *[]string { return &c.NTPServers },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *[]string { return &t.ClusterConfig.NTPServers }),
+	templateField("clusterConfig.proxy",
+		func(c *seederv1alpha1.ClusterSpec) **seederv1alpha1.ProxyConfig { return &c.Proxy },
+		func(t *seederv1alpha1.ClusterTemplateSpec) **seederv1alpha1.ProxyConfig {
+			return &t.ClusterConfig.Proxy
+		}),
+	templateField("clusterConfig.registryMirrors",
+		func(c *seederv1alpha1.ClusterSpec) *[]seederv1alpha1.RegistryMirror { return &c.RegistryMirrors },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *[]seederv1alpha1.RegistryMirror {
+			return &t.ClusterConfig.RegistryMirrors
+		}),
+	templateField("clusterConfig.sysctls",
+		func(c *seederv1alpha1.ClusterSpec) *map[string]string { return &c.Sysctls },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *map[string]string { return &t.ClusterConfig.Sysctls }),
+	templateField("clusterConfig.kernelArgs",
+		func(c *seederv1alpha1.ClusterSpec) *[]string { return &c.KernelArgs },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *[]string { return &t.ClusterConfig.KernelArgs }),
+	templateField("clusterConfig.writeFiles",
+		func(c *seederv1alpha1.ClusterSpec) *[]seederv1alpha1.WriteFile { return &c.WriteFiles },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *[]seederv1alpha1.WriteFile {
+			return &t.ClusterConfig.WriteFiles
+		}),
+	templateField("clusterConfig.afterInstallCommands",
+		func(c *seederv1alpha1.ClusterSpec) *[]string { return &c.AfterInstallCommands },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *[]string { return &t.ClusterConfig.AfterInstallCommands }),
+	templateField("addons",
+		func(c *seederv1alpha1.ClusterSpec) *[]string { return &c.Addons },
+		func(t *seederv1alpha1.ClusterTemplateSpec) *[]string { return &t.Addons }),
+}
+
+// ApplyClusterTemplate defaults the settings which are not specified on the cluster to those of the template, and
+// records the defaulted fields and template generation in the cluster annotations
+func ApplyClusterTemplate(c *seederv1alpha1.Cluster, t *seederv1alpha1.ClusterTemplate) []string {
+	var fields []string
+	for _, f := range clusterTemplateFields {
+		if f.inherit(&c.Spec, &t.Spec) {
+			fields = append(fields, f.path)
+		}
+	}
+
+	if c.Annotations == nil {
+		c.Annotations = make(map[string]string)
+	}
+	c.Annotations[seederv1alpha1.ClusterTemplateFieldsAnnotation] = strings.Join(fields, ",")
+	c.Annotations[seederv1alpha1.ClusterTemplateGenerationAnnotation] = strconv.FormatInt(t.Generation, 10)
+	return fields
+}
+
+// ClusterTemplateDrift returns the fields defaulted from the template which no longer match the template, once the
+// template has changed since the cluster was defaulted from it
+func ClusterTemplateDrift(c *seederv1alpha1.Cluster, t *seederv1alpha1.ClusterTemplate) []string {
+	if c.Annotations[seederv1alpha1.ClusterTemplateGenerationAnnotation] == strconv.FormatInt(t.Generation, 10) {
+		return nil
+	}
+
+	inherited := strings.Split(c.Annotations[seederv1alpha1.ClusterTemplateFieldsAnnotation], ",")
+	var drift []string
+	for _, f := range clusterTemplateFields {
+		if slices.Contains(inherited, f.path) && !f.matches(&c.Spec, &t.Spec) {
+			drift = append(drift, f.path)
+		}
+	}
+	return drift
+}
+
+// GetClusterTemplate returns the cluster template referenced by the cluster
+func GetClusterTemplate(ctx context.Context, c client.Client, cluster *seederv1alpha1.Cluster) (*seederv1alpha1.ClusterTemplate, error) {
+	t := &seederv1alpha1.ClusterTemplate{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: cluster.Namespace, Name: cluster.Spec.ClusterTemplateName}, t); err != nil {
+		return nil, fmt.Errorf("error fetching cluster template %s/%s: %w", cluster.Namespace, cluster.Spec.ClusterTemplateName, err)
+	}
+	return t, nil
+}
@@ -0,0 +1,89 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+func clusterTemplate() *seederv1alpha1.ClusterTemplate {
+	return &seederv1alpha1.ClusterTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "standard",
+			Namespace:  "default",
+			Generation: 1,
+		},
+		Spec: seederv1alpha1.ClusterTemplateSpec{
+			HarvesterVersion: "v1.3.0",
+			ImageURL:         "http://images.example.com",
+			VIPAddressPoolReference: &seederv1alpha1.ObjectReference{
+				Name:      "vip-pool",
+				Namespace: "default",
+			},
+			ClusterConfig: seederv1alpha1.ClusterConfig{
+				SSHKeys:          []string{"ssh-ed25519 AAAA"},
+				Nameservers:      []string{"8.8.8.8"},
+				VlanID:           100,
+				ProvisioningMode: seederv1alpha1.ProvisioningModePXE,
+			},
+		},
+	}
+}
+
+func Test_ApplyClusterTemplate(t *testing.T) {
+	assert := require.New(t)
+	cluster := &seederv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster",
+			Namespace: "default",
+		},
+		Spec: seederv1alpha1.ClusterSpec{
+			HarvesterVersion: "v1.4.0",
+			ClusterConfig: seederv1alpha1.ClusterConfig{
+				Nameservers:      []string{"1.1.1.1"},
+				VlanID:           1,
+				ProvisioningMode: seederv1alpha1.ProvisioningModePXE,
+			},
+		},
+	}
+
+	fields := ApplyClusterTemplate(cluster, clusterTemplate())
+	assert.Equal([]string{"imageURL", "vipConfig.addressPoolReference", "clusterConfig.sshKeys", "clusterConfig.vlanID"}, fields)
+	assert.Equal("v1.4.0", cluster.Spec.HarvesterVersion, "expected cluster version to override the template")
+	assert.Equal([]string{"1.1.1.1"}, cluster.Spec.Nameservers, "expected cluster nameservers to override the template")
+	assert.Equal("vip-pool", cluster.Spec.VIPConfig.AddressPoolReference.Name)
+	assert.Equal(100, cluster.Spec.VlanID, "expected crd default to be replaced by the template")
+	assert.Equal("imageURL,vipConfig.addressPoolReference,clusterConfig.sshKeys,clusterConfig.vlanID",
+		cluster.Annotations[seederv1alpha1.ClusterTemplateFieldsAnnotation])
+	assert.Equal("1", cluster.Annotations[seederv1alpha1.ClusterTemplateGenerationAnnotation])
+}
+
+func Test_ClusterTemplateDrift(t *testing.T) {
+	assert := require.New(t)
+	template := clusterTemplate()
+	cluster := &seederv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster",
+			Namespace: "default",
+		},
+		Spec: seederv1alpha1.ClusterSpec{
+			ImageURL: "http://mirror.example.com",
+		},
+	}
+	ApplyClusterTemplate(cluster, template)
+	assert.Empty(ClusterTemplateDrift(cluster, template), "expected no drift without template changes")
+
+	template.Generation = 2
+	template.Spec.HarvesterVersion = "v1.4.0"
+	template.Spec.ImageURL = "http://images2.example.com"
+	template.Spec.ClusterConfig.SSHKeys = append(template.Spec.ClusterConfig.SSHKeys, "ssh-rsa AAAA")
+	assert.Equal([]string{"version", "clusterConfig.sshKeys"}, ClusterTemplateDrift(cluster, template),
+		"expected overridden fields not to be reported as drift")
+
+	cluster.Spec.HarvesterVersion = "v1.4.0"
+	assert.Equal([]string{"clusterConfig.sshKeys"}, ClusterTemplateDrift(cluster, template),
+		"expected drift to be resolved once the cluster matches the template")
+}
@@ -0,0 +1,185 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	typedCore "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+const (
+	LonghornNamespace   = "longhorn-system"
+	mirrorPodAnnotation = "kubernetes.io/config.mirror"
+)
+
+var (
+	longhornNodeGVR = schema.GroupVersionResource{
+		Group:    "longhorn.io",
+		Version:  "v1beta2",
+		Resource: "nodes",
+	}
+	longhornReplicaGVR = schema.GroupVersionResource{
+		Group:    "longhorn.io",
+		Version:  "v1beta2",
+		Resource: "replicas",
+	}
+)
+
+// FindNodeByAddress returns the node in the target cluster with a matching internal ip, falling back to a node
+// with a matching name. A nil node is returned if the node does not exist
+func FindNodeByAddress(ctx context.Context, core typedCore.CoreV1Interface, address, name string) (*corev1.Node, error) {
+	nodeList, err := core.Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing nodes: %w", err)
+	}
+
+	for _, node := range nodeList.Items {
+		for _, nodeAddress := range node.Status.Addresses {
+			if nodeAddress.Type == corev1.NodeInternalIP && nodeAddress.Address == address {
+				return &node, nil
+			}
+		}
+	}
+
+	for _, node := range nodeList.Items {
+		if node.Name == name {
+			return &node, nil
+		}
+	}
+	return nil, nil
+}
+
+// CordonNode marks the node as unschedulable
+func CordonNode(ctx context.Context, core typedCore.CoreV1Interface, node *corev1.Node) error {
+	if node.Spec.Unschedulable {
+		return nil
+	}
+	patch := []byte(`{"spec":{"unschedulable":true}}`)
+	_, err := core.Nodes().Patch(ctx, node.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// DrainNode requests eviction of all pods on the node which are not managed by a DaemonSet, and returns the
+// number of pods still waiting to be evicted. Evictions blocked by a PodDisruptionBudget are retried on the next call
+func DrainNode(ctx context.Context, core typedCore.CoreV1Interface, nodeName string) (int, error) {
+	podList, err := core.Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String(),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error listing pods on node %s: %w", nodeName, err)
+	}
+
+	var remaining int
+	for _, pod := range podList.Items {
+		if !podNeedsEviction(&pod) {
+			continue
+		}
+		remaining++
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		err := core.Pods(pod.Namespace).EvictV1(ctx, &policyv1.Eviction{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pod.Name,
+				Namespace: pod.Namespace,
+			},
+		})
+		if err != nil && !apierrors.IsNotFound(err) && !apierrors.IsTooManyRequests(err) {
+			return remaining, fmt.Errorf("error evicting pod %s/%s: %w", pod.Namespace, pod.Name, err)
+		}
+	}
+	return remaining, nil
+}
+
+// podNeedsEviction ignores completed pods, mirror pods and pods managed by a DaemonSet
+func podNeedsEviction(pod *corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+	if _, ok := pod.Annotations[mirrorPodAnnotation]; ok {
+		return false
+	}
+	for _, owner := range pod.OwnerReferences {
+		if owner.Kind == "DaemonSet" {
+			return false
+		}
+	}
+	return true
+}
+
+// EvictLonghornReplicas disables scheduling and requests eviction of replicas on the longhorn node, and returns
+// the number of replicas still on the node. Replicas of volumes without a healthy replica on another node, such as
+// single replica and detached volumes, are never moved by longhorn. They are not counted, and their volumes are
+// returned instead. Clusters without longhorn have no replicas to evict
+func EvictLonghornReplicas(ctx context.Context, dc dynamic.Interface, nodeName string) (int, []string, error) {
+	lhNode, err := dc.Resource(longhornNodeGVR).Namespace(LonghornNamespace).Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return 0, nil, nil
+		}
+		return 0, nil, fmt.Errorf("error fetching longhorn node %s: %w", nodeName, err)
+	}
+
+	allowScheduling, _, _ := unstructured.NestedBool(lhNode.Object, "spec", "allowScheduling")
+	evictionRequested, _, _ := unstructured.NestedBool(lhNode.Object, "spec", "evictionRequested")
+	if allowScheduling || !evictionRequested {
+		patch := []byte(`{"spec":{"allowScheduling":false,"evictionRequested":true}}`)
+		_, err := dc.Resource(longhornNodeGVR).Namespace(LonghornNamespace).Patch(ctx, nodeName, types.MergePatchType, patch, metav1.PatchOptions{})
+		if err != nil {
+			return 0, nil, fmt.Errorf("error requesting eviction of longhorn node %s: %w", nodeName, err)
+		}
+	}
+
+	replicaList, err := dc.Resource(longhornReplicaGVR).Namespace(LonghornNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return 0, nil, fmt.Errorf("error listing longhorn replicas: %w", err)
+	}
+
+	// volumes with a healthy replica on another node
+	movable := make(map[string]bool)
+	for _, replica := range replicaList.Items {
+		replicaNode, _, _ := unstructured.NestedString(replica.Object, "spec", "nodeID")
+		if replicaNode != nodeName && longhornReplicaHealthy(&replica) {
+			volume, _, _ := unstructured.NestedString(replica.Object, "spec", "volumeName")
+			movable[volume] = true
+		}
+	}
+
+	var remaining int
+	var stuck []string
+	for _, replica := range replicaList.Items {
+		if replicaNode, _, _ := unstructured.NestedString(replica.Object, "spec", "nodeID"); replicaNode != nodeName {
+			continue
+		}
+		volume, _, _ := unstructured.NestedString(replica.Object, "spec", "volumeName")
+		if !movable[volume] {
+			if !slices.Contains(stuck, volume) {
+				stuck = append(stuck, volume)
+			}
+			continue
+		}
+		remaining++
+	}
+	sort.Strings(stuck)
+	return remaining, stuck, nil
+}
+
+// longhornReplicaHealthy returns true if the replica is running and has not failed. Replicas of detached volumes
+// are stopped
+func longhornReplicaHealthy(replica *unstructured.Unstructured) bool {
+	healthyAt, _, _ := unstructured.NestedString(replica.Object, "spec", "healthyAt")
+	failedAt, _, _ := unstructured.NestedString(replica.Object, "spec", "failedAt")
+	state, _, _ := unstructured.NestedString(replica.Object, "status", "currentState")
+	return healthyAt != "" && failedAt == "" && state == "running"
+}
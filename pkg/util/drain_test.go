@@ -0,0 +1,173 @@
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func Test_FindNodeByAddressAndCordon(t *testing.T) {
+	assert := require.New(t)
+	ctx := context.TODO()
+	clientset := fake.NewSimpleClientset(&corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "harvester-abcd",
+		},
+		Status: corev1.NodeStatus{
+			Addresses: []corev1.NodeAddress{
+				{
+					Type:    corev1.NodeInternalIP,
+					Address: "192.168.1.10",
+				},
+			},
+		},
+	})
+
+	node, err := FindNodeByAddress(ctx, clientset.CoreV1(), "192.168.1.10", "node1")
+	assert.NoError(err)
+	assert.NotNil(node, "expected node to be found by address")
+	assert.Equal("harvester-abcd", node.Name)
+
+	missing, err := FindNodeByAddress(ctx, clientset.CoreV1(), "192.168.1.11", "node2")
+	assert.NoError(err)
+	assert.Nil(missing, "expected no node to be found")
+
+	assert.NoError(CordonNode(ctx, clientset.CoreV1(), node))
+	node, err = clientset.CoreV1().Nodes().Get(ctx, node.Name, metav1.GetOptions{})
+	assert.NoError(err)
+	assert.True(node.Spec.Unschedulable, "expected node to be cordoned")
+}
+
+func Test_DrainNode(t *testing.T) {
+	assert := require.New(t)
+	ctx := context.TODO()
+	pods := []runtime.Object{
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "workload",
+				Namespace: "default",
+			},
+			Spec: corev1.PodSpec{
+				NodeName: "harvester-abcd",
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "daemonset",
+				Namespace: "default",
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						Kind: "DaemonSet",
+						Name: "ds",
+					},
+				},
+			},
+			Spec: corev1.PodSpec{
+				NodeName: "harvester-abcd",
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "completed",
+				Namespace: "default",
+			},
+			Spec: corev1.PodSpec{
+				NodeName: "harvester-abcd",
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodSucceeded,
+			},
+		},
+	}
+	clientset := fake.NewSimpleClientset(pods...)
+
+	remaining, err := DrainNode(ctx, clientset.CoreV1(), "harvester-abcd")
+	assert.NoError(err)
+	assert.Equal(1, remaining, "expected only workload pod to need eviction")
+
+	var evictions []string
+	for _, action := range clientset.Actions() {
+		if action.GetVerb() == "create" && action.GetSubresource() == "eviction" {
+			evictions = append(evictions, action.(k8stesting.CreateAction).GetObject().(*policyv1.Eviction).Name)
+		}
+	}
+	assert.Equal([]string{"workload"}, evictions)
+}
+
+func Test_EvictLonghornReplicas(t *testing.T) {
+	assert := require.New(t)
+	ctx := context.TODO()
+	lhNode := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "longhorn.io/v1beta2",
+			"kind":       "Node",
+			"metadata": map[string]interface{}{
+				"name":      "harvester-abcd",
+				"namespace": LonghornNamespace,
+			},
+			"spec": map[string]interface{}{
+				"allowScheduling": true,
+			},
+		},
+	}
+	replica := func(name, volume, nodeID, state string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "longhorn.io/v1beta2",
+				"kind":       "Replica",
+				"metadata": map[string]interface{}{
+					"name":      name,
+					"namespace": LonghornNamespace,
+				},
+				"spec": map[string]interface{}{
+					"nodeID":     nodeID,
+					"volumeName": volume,
+					"healthyAt":  "2024-01-01T00:00:00Z",
+				},
+				"status": map[string]interface{}{
+					"currentState": state,
+				},
+			},
+		}
+	}
+
+	scheme := runtime.NewScheme()
+	dc := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, map[schema.GroupVersionResource]string{
+		longhornNodeGVR:    "NodeList",
+		longhornReplicaGVR: "ReplicaList",
+	}, lhNode,
+		replica("pvc-1-r-1", "pvc-1", "harvester-abcd", "running"),
+		replica("pvc-1-r-2", "pvc-1", "harvester-efgh", "running"),
+		// single replica volume
+		replica("pvc-2-r-1", "pvc-2", "harvester-abcd", "running"),
+		// detached volume
+		replica("pvc-3-r-1", "pvc-3", "harvester-abcd", "stopped"),
+		replica("pvc-3-r-2", "pvc-3", "harvester-efgh", "stopped"),
+	)
+
+	remaining, stuck, err := EvictLonghornReplicas(ctx, dc, "harvester-abcd")
+	assert.NoError(err)
+	assert.Equal(1, remaining, "expected replica of volume with a healthy replica on another node to be reported")
+	assert.Equal([]string{"pvc-2", "pvc-3"}, stuck, "expected volumes which cannot be moved to be reported")
+
+	updated, err := dc.Resource(longhornNodeGVR).Namespace(LonghornNamespace).Get(ctx, "harvester-abcd", metav1.GetOptions{})
+	assert.NoError(err)
+	evictionRequested, _, _ := unstructured.NestedBool(updated.Object, "spec", "evictionRequested")
+	allowScheduling, _, _ := unstructured.NestedBool(updated.Object, "spec", "allowScheduling")
+	assert.True(evictionRequested, "expected eviction to be requested")
+	assert.False(allowScheduling, "expected scheduling to be disabled")
+
+	remaining, _, err = EvictLonghornReplicas(ctx, dc, "harvester-missing")
+	assert.NoError(err)
+	assert.Equal(0, remaining, "expected nodes without longhorn to have no replicas")
+}
@@ -0,0 +1,65 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	// ClusterRegistrationURLSetting is applied by harvester to register the cluster with rancher
+	ClusterRegistrationURLSetting = "cluster-registration-url"
+)
+
+var (
+	harvesterSettingGVR = schema.GroupVersionResource{
+		Group:    "harvesterhci.io",
+		Version:  "v1beta1",
+		Resource: "settings",
+	}
+)
+
+// UpdateHarvesterSetting sets the value of a harvester setting in the target cluster, and returns true if the value
+// was changed. Settings which do not exist are created
+func UpdateHarvesterSetting(ctx context.Context, dc dynamic.Interface, name, value string) (bool, error) {
+	setting, err := dc.Resource(harvesterSettingGVR).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, fmt.Errorf("error fetching harvester setting %s: %w", name, err)
+		}
+		setting = &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": harvesterSettingGVR.GroupVersion().String(),
+				"kind":       "Setting",
+				"metadata": map[string]interface{}{
+					"name": name,
+				},
+				"value": value,
+			},
+		}
+		if _, err := dc.Resource(harvesterSettingGVR).Create(ctx, setting, metav1.CreateOptions{}); err != nil {
+			return false, fmt.Errorf("error creating harvester setting %s: %w", name, err)
+		}
+		return true, nil
+	}
+
+	if current, _, _ := unstructured.NestedString(setting.Object, "value"); current == value {
+		return false, nil
+	}
+
+	patch, err := json.Marshal(map[string]string{"value": value})
+	if err != nil {
+		return false, err
+	}
+	if _, err := dc.Resource(harvesterSettingGVR).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return false, fmt.Errorf("error updating harvester setting %s: %w", name, err)
+	}
+	return true, nil
+}
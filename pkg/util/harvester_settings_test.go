@@ -0,0 +1,55 @@
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func Test_UpdateHarvesterSetting(t *testing.T) {
+	assert := require.New(t)
+	ctx := context.TODO()
+	setting := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "harvesterhci.io/v1beta1",
+			"kind":       "Setting",
+			"metadata": map[string]interface{}{
+				"name": "ntp-servers",
+			},
+			"value": "",
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	dc := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, map[schema.GroupVersionResource]string{
+		harvesterSettingGVR: "SettingList",
+	}, setting)
+
+	changed, err := UpdateHarvesterSetting(ctx, dc, "ntp-servers", `{"ntpServers":["0.suse.pool.ntp.org"]}`)
+	assert.NoError(err)
+	assert.True(changed, "expected existing setting to be updated")
+
+	changed, err = UpdateHarvesterSetting(ctx, dc, "ntp-servers", `{"ntpServers":["0.suse.pool.ntp.org"]}`)
+	assert.NoError(err)
+	assert.False(changed, "expected unchanged setting to be skipped")
+
+	changed, err = UpdateHarvesterSetting(ctx, dc, ClusterRegistrationURLSetting, "https://rancher.example.com/v3/import/abcd.yaml")
+	assert.NoError(err)
+	assert.True(changed, "expected missing setting to be created")
+
+	for name, expected := range map[string]string{
+		"ntp-servers":                 `{"ntpServers":["0.suse.pool.ntp.org"]}`,
+		ClusterRegistrationURLSetting: "https://rancher.example.com/v3/import/abcd.yaml",
+	} {
+		obj, err := dc.Resource(harvesterSettingGVR).Get(ctx, name, metav1.GetOptions{})
+		assert.NoError(err)
+		value, _, _ := unstructured.NestedString(obj.Object, "value")
+		assert.Equal(expected, value, "expected setting %s to be updated", name)
+	}
+}
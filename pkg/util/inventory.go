@@ -3,6 +3,7 @@ package util
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	"context"
 
@@ -11,6 +12,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -128,6 +130,164 @@ func ListInventoryAllocatedtoCluster(ctx context.Context, c client.Client, clust
 	return retItems, nil
 }
 
+// ClusterNodeInventory fetches the inventory of the cluster nodes in the order they are listed in the cluster spec.
+// Nodes whose inventory does not exist are skipped
+func ClusterNodeInventory(ctx context.Context, c client.Client, cluster *seederv1alpha1.Cluster) ([]*seederv1alpha1.Inventory, error) {
+	var nodes []*seederv1alpha1.Inventory
+	for _, n := range cluster.Spec.Nodes {
+		i := &seederv1alpha1.Inventory{}
+		err := c.Get(ctx, types.NamespacedName{Namespace: n.InventoryReference.Namespace, Name: n.InventoryReference.Name}, i)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		nodes = append(nodes, i)
+	}
+	return nodes, nil
+}
+
+// FindSpareInventory returns the first free inventory matching the selector which can replace a failed node.
+// Inventory which is referenced by a cluster, or reports critical hardware health, is not used as a spare. If accept
+// is specified, only inventory it accepts is used
+func FindSpareInventory(ctx context.Context, c client.Client, selector labels.Selector, namespace string, accept func(*seederv1alpha1.Inventory) bool) (*seederv1alpha1.Inventory, error) {
+	items, err := ListInventory(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching inventory list: %v", err)
+	}
+
+	clusterList := &seederv1alpha1.ClusterList{}
+	if err := c.List(ctx, clusterList); err != nil {
+		return nil, fmt.Errorf("error fetching cluster list: %v", err)
+	}
+
+	inUse := make(map[types.NamespacedName]bool)
+	for _, cluster := range clusterList.Items {
+		for _, n := range cluster.Spec.Nodes {
+			inUse[types.NamespacedName{Namespace: n.InventoryReference.Namespace, Name: n.InventoryReference.Name}] = true
+		}
+	}
+
+	for i, v := range items {
+		if !selector.Matches(labels.Set(v.Labels)) || inUse[types.NamespacedName{Namespace: v.Namespace, Name: v.Name}] {
+			continue
+		}
+		if v.Status.Status != seederv1alpha1.InventoryReady || v.Status.Cluster.Name != "" || !v.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if ConditionExists(&v, seederv1alpha1.HardwareHealthCritical) || ConditionExists(&v, seederv1alpha1.Quarantined) || !InventoryAccepted(&v) {
+			continue
+		}
+		granted, err := ReferenceGranted(ctx, c, seederv1alpha1.ReferenceKindCluster, namespace, seederv1alpha1.ReferenceKindInventory,
+			types.NamespacedName{Namespace: v.Namespace, Name: v.Name})
+		if err != nil {
+			return nil, err
+		}
+		if !granted {
+			continue
+		}
+		if v.Spec.InventoryClassName != "" {
+			class := &seederv1alpha1.InventoryClass{}
+			if err := c.Get(ctx, types.NamespacedName{Name: v.Spec.InventoryClassName}, class); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, fmt.Errorf("error fetching inventory class %s: %w", v.Spec.InventoryClassName, err)
+			}
+			if !InventoryClassAllowsNamespace(class, namespace) {
+				continue
+			}
+		}
+		if accept != nil && !accept(&items[i]) {
+			continue
+		}
+		return &items[i], nil
+	}
+	return nil, nil
+}
+
+// NodeHostname returns the hostname of the node provisioned on the inventory
+func NodeHostname(i *seederv1alpha1.Inventory) string {
+	if i.Status.Hostname != "" {
+		return i.Status.Hostname
+	}
+	return fmt.Sprintf("%s-%s", i.Name, i.Namespace)
+}
+
+// FindInventoryWithSameBMC returns the first inventory, other than iObj, which uses the same baseboard host and port
+func FindInventoryWithSameBMC(items []seederv1alpha1.Inventory, iObj *seederv1alpha1.Inventory) *seederv1alpha1.Inventory {
+	for i, v := range items {
+		// ignore self from list
+		if iObj.Name == v.Name && iObj.Namespace == v.Namespace {
+			continue
+		}
+
+		if v.Spec.BaseboardManagementSpec.Connection.Host == iObj.Spec.BaseboardManagementSpec.Connection.Host && v.Spec.BaseboardManagementSpec.Connection.Port == iObj.Spec.BaseboardManagementSpec.Connection.Port {
+			return &items[i]
+		}
+	}
+	return nil
+}
+
+// ManagementMACAddress returns the management interface mac address specified on the inventory, or the one discovered via redfish
+func ManagementMACAddress(i *seederv1alpha1.Inventory) string {
+	if i.Spec.ManagementInterfaceMacAddress != "" {
+		return i.Spec.ManagementInterfaceMacAddress
+	}
+	return i.Status.Hardware.ManagementInterfaceMacAddress
+}
+
+// InventoryArch returns the arch specified on the inventory, or the one discovered via redfish. An empty arch is
+// returned until the arch is set or discovered
+func InventoryArch(i *seederv1alpha1.Inventory) string {
+	if i.Spec.Arch != "" {
+		return i.Spec.Arch
+	}
+	return i.Status.Hardware.Arch
+}
+
+// DesiredMachinePowerState returns the machine power state to be enforced for the inventory. An empty power state
+// is returned when power state is not managed
+func DesiredMachinePowerState(i *seederv1alpha1.Inventory) rufio.PowerState {
+	switch i.Spec.DesiredPowerState {
+	case seederv1alpha1.DesiredPowerStateOn:
+		return rufio.On
+	case seederv1alpha1.DesiredPowerStateOff:
+		return rufio.Off
+	case seederv1alpha1.DesiredPowerStateAuto:
+		// local inventory is the node running seeder, and is never powered off by policy
+		if _, ok := i.Annotations[seederv1alpha1.LocalInventoryAnnotation]; ok {
+			return ""
+		}
+		if ConditionExists(i, seederv1alpha1.InventoryAllocatedToCluster) {
+			return rufio.On
+		}
+		return rufio.Off
+	}
+	return ""
+}
+
+// PowerEnforcementCooldown is the time after an enforcement job completes during which the desired power state is not
+// enforced again, which allows rufio to refresh the machine power state from the bmc
+const PowerEnforcementCooldown = 5 * time.Minute
+
+// PowerEnforcementCooldownRemaining returns the time left until the desired power state can be enforced again, and 0
+// once the cooldown has passed
+func PowerEnforcementCooldownRemaining(i *seederv1alpha1.Inventory, now time.Time) time.Duration {
+	if i.Status.PowerAction.LastEnforcementTime == "" {
+		return 0
+	}
+	last, err := time.Parse(time.RFC3339, i.Status.PowerAction.LastEnforcementTime)
+	if err != nil {
+		return 0
+	}
+	if remaining := last.Add(PowerEnforcementCooldown).Sub(now); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
 // FetchAndUpdateBaseBoard will fetch existing baseboard and trigger an update if needed
 func FetchAndUpdateBaseBoard(ctx context.Context, c client.Client, log logr.Logger, i *seederv1alpha1.Inventory, schema *runtime.Scheme) (*rufio.Machine, error) {
 	// check status of boseboard object
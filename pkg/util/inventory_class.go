@@ -0,0 +1,79 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+// InventoryClassAllowsNamespace returns true if clusters in the namespace can allocate inventory of the class
+func InventoryClassAllowsNamespace(class *seederv1alpha1.InventoryClass, namespace string) bool {
+	return len(class.Spec.AllowedNamespaces) == 0 || slices.Contains(class.Spec.AllowedNamespaces, namespace)
+}
+
+// InventoryClassQuota returns the number of inventories of the class which can be allocated to clusters in the
+// namespace, and false if allocation is not limited
+func InventoryClassQuota(class *seederv1alpha1.InventoryClass, namespace string) (int, bool) {
+	for _, q := range class.Spec.Quotas {
+		if q.Namespace == namespace {
+			return q.Limit, true
+		}
+	}
+	if class.Spec.DefaultQuota != nil {
+		return *class.Spec.DefaultQuota, true
+	}
+	return 0, false
+}
+
+// InventoryClassOf returns the class of the inventory referenced by a cluster node. Missing inventory has no class
+func InventoryClassOf(ctx context.Context, c client.Client, ref seederv1alpha1.ObjectReference) (string, error) {
+	i := &seederv1alpha1.Inventory{}
+	err := c.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, i)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("error fetching inventory %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+	return i.Spec.InventoryClassName, nil
+}
+
+// InventoryClassUsage returns the number of inventories of each class referenced by clusters in the namespace.
+// The nodes of cluster replace those of the stored cluster with the same name, so the usage of a cluster can be
+// checked before it is created or updated
+func InventoryClassUsage(ctx context.Context, c client.Client, namespace string, cluster *seederv1alpha1.Cluster) (map[string]int, error) {
+	clusterList := &seederv1alpha1.ClusterList{}
+	if err := c.List(ctx, clusterList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("error fetching cluster list: %w", err)
+	}
+
+	clusters := []seederv1alpha1.Cluster{}
+	for _, v := range clusterList.Items {
+		if cluster == nil || v.Name != cluster.Name {
+			clusters = append(clusters, v)
+		}
+	}
+	if cluster != nil {
+		clusters = append(clusters, *cluster)
+	}
+
+	usage := make(map[string]int)
+	for _, v := range clusters {
+		for _, n := range v.Spec.Nodes {
+			class, err := InventoryClassOf(ctx, c, n.InventoryReference)
+			if err != nil {
+				return nil, err
+			}
+			if class != "" {
+				usage[class]++
+			}
+		}
+	}
+	return usage, nil
+}
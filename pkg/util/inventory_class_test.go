@@ -0,0 +1,96 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/mock"
+)
+
+func Test_InventoryClassAllowsNamespace(t *testing.T) {
+	assert := require.New(t)
+	class := &seederv1alpha1.InventoryClass{}
+	assert.True(InventoryClassAllowsNamespace(class, "team-a"), "expected all namespaces to be allowed by default")
+
+	class.Spec.AllowedNamespaces = []string{"team-a"}
+	assert.True(InventoryClassAllowsNamespace(class, "team-a"))
+	assert.False(InventoryClassAllowsNamespace(class, "team-b"))
+}
+
+func Test_InventoryClassQuota(t *testing.T) {
+	assert := require.New(t)
+	class := &seederv1alpha1.InventoryClass{
+		Spec: seederv1alpha1.InventoryClassSpec{
+			Quotas: []seederv1alpha1.NamespaceQuota{
+				{
+					Namespace: "team-a",
+					Limit:     3,
+				},
+			},
+		},
+	}
+
+	limit, ok := InventoryClassQuota(class, "team-a")
+	assert.True(ok)
+	assert.Equal(3, limit)
+
+	_, ok = InventoryClassQuota(class, "team-b")
+	assert.False(ok, "expected namespace without quota to be unlimited")
+
+	class.Spec.DefaultQuota = ptr.To(1)
+	limit, ok = InventoryClassQuota(class, "team-b")
+	assert.True(ok)
+	assert.Equal(1, limit, "expected default quota to apply")
+}
+
+func Test_InventoryClassUsage(t *testing.T) {
+	assert := require.New(t)
+	c, err := mock.GenerateFakeClient()
+	assert.NoError(err, "expected no error during generation of fake client")
+
+	for _, name := range []string{"gpu-1", "gpu-2", "gpu-3"} {
+		assert.NoError(c.Create(ctx, &seederv1alpha1.Inventory{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: seederv1alpha1.InventorySpec{
+				InventoryClassName: "gpu",
+			},
+		}))
+	}
+
+	node := func(name string) seederv1alpha1.NodeConfig {
+		return seederv1alpha1.NodeConfig{
+			InventoryReference: seederv1alpha1.ObjectReference{Name: name, Namespace: "default"},
+		}
+	}
+	existing := &seederv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "existing",
+			Namespace: "team-a",
+		},
+		Spec: seederv1alpha1.ClusterSpec{
+			Nodes: []seederv1alpha1.NodeConfig{node("gpu-1"), node("missing")},
+		},
+	}
+	assert.NoError(c.Create(ctx, existing))
+
+	usage, err := InventoryClassUsage(ctx, c, "team-a", nil)
+	assert.NoError(err)
+	assert.Equal(map[string]int{"gpu": 1}, usage)
+
+	usage, err = InventoryClassUsage(ctx, c, "team-b", nil)
+	assert.NoError(err)
+	assert.Empty(usage, "expected no usage in namespace without clusters")
+
+	updated := existing.DeepCopy()
+	updated.Spec.Nodes = append(updated.Spec.Nodes, node("gpu-2"), node("gpu-3"))
+	usage, err = InventoryClassUsage(ctx, c, "team-a", updated)
+	assert.NoError(err)
+	assert.Equal(map[string]int{"gpu": 3}, usage, "expected updated cluster to replace stored cluster")
+}
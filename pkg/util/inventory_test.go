@@ -3,6 +3,7 @@ package util
 import (
 	"context"
 	"testing"
+	"time"
 
 	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
 	"github.com/harvester/seeder/pkg/mock"
@@ -150,3 +151,175 @@ spec:
 	assert.NoError(err, "expected no error while listing inventory")
 	assert.Len(inv, 2, "expected to find 2 inventory objects")
 }
+
+func Test_DesiredMachinePowerState(t *testing.T) {
+	assert := require.New(t)
+	i := &seederv1alpha1.Inventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "node",
+			Namespace: "default",
+		},
+	}
+	assert.Equal(rufio.PowerState(""), DesiredMachinePowerState(i), "expected power state to be unmanaged by default")
+
+	i.Spec.DesiredPowerState = seederv1alpha1.DesiredPowerStateUnmanaged
+	assert.Equal(rufio.PowerState(""), DesiredMachinePowerState(i), "expected power state to be unmanaged")
+
+	i.Spec.DesiredPowerState = seederv1alpha1.DesiredPowerStateOn
+	assert.Equal(rufio.On, DesiredMachinePowerState(i))
+
+	i.Spec.DesiredPowerState = seederv1alpha1.DesiredPowerStateOff
+	assert.Equal(rufio.Off, DesiredMachinePowerState(i))
+
+	i.Spec.DesiredPowerState = seederv1alpha1.DesiredPowerStateAuto
+	assert.Equal(rufio.Off, DesiredMachinePowerState(i), "expected free inventory to be powered off")
+
+	CreateOrUpdateCondition(i, seederv1alpha1.InventoryAllocatedToCluster, "")
+	assert.Equal(rufio.On, DesiredMachinePowerState(i), "expected allocated inventory to be powered on")
+
+	i.Annotations = map[string]string{seederv1alpha1.LocalInventoryAnnotation: "true"}
+	assert.Equal(rufio.PowerState(""), DesiredMachinePowerState(i), "expected local inventory to be unmanaged")
+}
+
+func Test_PowerEnforcementCooldownRemaining(t *testing.T) {
+	assert := require.New(t)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	i := &seederv1alpha1.Inventory{}
+	assert.Zero(PowerEnforcementCooldownRemaining(i, now), "expected no cooldown without an enforcement job")
+
+	i.Status.PowerAction.LastEnforcementTime = now.Add(-time.Minute).Format(time.RFC3339)
+	assert.Equal(PowerEnforcementCooldown-time.Minute, PowerEnforcementCooldownRemaining(i, now))
+
+	i.Status.PowerAction.LastEnforcementTime = now.Add(-PowerEnforcementCooldown).Format(time.RFC3339)
+	assert.Zero(PowerEnforcementCooldownRemaining(i, now), "expected cooldown to have passed")
+}
+
+func Test_FindSpareInventory(t *testing.T) {
+	assert := require.New(t)
+	c, err := mock.GenerateFakeClient()
+	assert.NoError(err, "expected no error during generation of fake client")
+
+	spare := func(name string) *seederv1alpha1.Inventory {
+		return &seederv1alpha1.Inventory{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels: map[string]string{
+					"role": "spare",
+				},
+			},
+		}
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"role": "spare"}})
+	assert.NoError(err)
+
+	// spare which is not ready
+	notReady := spare("spare-not-ready")
+	assert.NoError(c.Create(ctx, notReady))
+
+	// spare with critical hardware health
+	critical := spare("spare-critical")
+	assert.NoError(c.Create(ctx, critical))
+	critical.Status.Status = seederv1alpha1.InventoryReady
+	SetErrorCondition(critical, seederv1alpha1.HardwareHealthCritical, "critical")
+	assert.NoError(c.Status().Update(ctx, critical))
+
+	// spare quarantined after a failed decommission
+	quarantined := spare("spare-quarantined")
+	assert.NoError(c.Create(ctx, quarantined))
+	quarantined.Status.Status = seederv1alpha1.InventoryReady
+	SetErrorCondition(quarantined, seederv1alpha1.Quarantined, "decommission failed")
+	assert.NoError(c.Status().Update(ctx, quarantined))
+
+	// spare referenced by a cluster
+	referenced := spare("spare-referenced")
+	assert.NoError(c.Create(ctx, referenced))
+	referenced.Status.Status = seederv1alpha1.InventoryReady
+	assert.NoError(c.Status().Update(ctx, referenced))
+	assert.NoError(c.Create(ctx, &seederv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster",
+			Namespace: "default",
+		},
+		Spec: seederv1alpha1.ClusterSpec{
+			Nodes: []seederv1alpha1.NodeConfig{
+				{
+					InventoryReference: seederv1alpha1.ObjectReference{Name: referenced.Name, Namespace: referenced.Namespace},
+				},
+			},
+		},
+	}))
+
+	// spare of a class which does not allow the namespace
+	restricted := spare("spare-restricted")
+	restricted.Spec.InventoryClassName = "restricted"
+	assert.NoError(c.Create(ctx, restricted))
+	restricted.Status.Status = seederv1alpha1.InventoryReady
+	assert.NoError(c.Status().Update(ctx, restricted))
+	assert.NoError(c.Create(ctx, &seederv1alpha1.InventoryClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "restricted",
+		},
+		Spec: seederv1alpha1.InventoryClassSpec{
+			AllowedNamespaces: []string{"team-a"},
+		},
+	}))
+
+	i, err := FindSpareInventory(ctx, c, selector, "default", nil)
+	assert.NoError(err)
+	assert.Nil(i, "expected no spare to be available")
+
+	i, err = FindSpareInventory(ctx, c, selector, "team-a", nil)
+	assert.NoError(err)
+	assert.Nil(i, "expected spare in other namespace to need a reference grant")
+
+	assert.NoError(c.Create(ctx, &seederv1alpha1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "team-a",
+			Namespace: "default",
+		},
+		Spec: seederv1alpha1.ReferenceGrantSpec{
+			From: []seederv1alpha1.ReferenceGrantFrom{
+				{
+					Kind:      seederv1alpha1.ReferenceKindCluster,
+					Namespace: "team-a",
+				},
+			},
+			To: []seederv1alpha1.ReferenceGrantTo{
+				{
+					Kind: seederv1alpha1.ReferenceKindInventory,
+				},
+			},
+		},
+	}))
+
+	i, err = FindSpareInventory(ctx, c, selector, "team-a", nil)
+	assert.NoError(err)
+	assert.NotNil(i, "expected spare of class allowing namespace to be found")
+	assert.Equal(restricted.Name, i.Name)
+	assert.NoError(c.Delete(ctx, restricted))
+
+	free := spare("spare-free")
+	assert.NoError(c.Create(ctx, free))
+	free.Status.Status = seederv1alpha1.InventoryReady
+	assert.NoError(c.Status().Update(ctx, free))
+
+	i, err = FindSpareInventory(ctx, c, selector, "default", nil)
+	assert.NoError(err)
+	assert.NotNil(i, "expected free spare to be found")
+	assert.Equal(free.Name, i.Name)
+}
+
+func Test_NodeHostname(t *testing.T) {
+	assert := require.New(t)
+	i := &seederv1alpha1.Inventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "node",
+			Namespace: "default",
+		},
+	}
+	assert.Equal("node-default", NodeHostname(i))
+	i.Status.Hostname = "failed-default"
+	assert.Equal("failed-default", NodeHostname(i), "expected hostname carried over from replaced node")
+}
@@ -0,0 +1,291 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"inet.af/netaddr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+// IPAMProvider allocates and releases the addresses of an address pool. Allocations are always recorded in the
+// address allocation of the pool status, which callers update once the allocation or release succeeds
+type IPAMProvider interface {
+	// Allocate allocates an address to the owner, and returns the address
+	Allocate(ctx context.Context, pool *seederv1alpha1.AddressPool, owner seederv1alpha1.ObjectReferenceWithKind, address string) (string, error)
+	// Release frees the address
+	Release(ctx context.Context, pool *seederv1alpha1.AddressPool, address string) error
+	// Sync reconciles the address allocation with the provider
+	Sync(ctx context.Context, pool *seederv1alpha1.AddressPool) error
+}
+
+// NewIPAMProvider returns the ipam provider configured on the address pool
+func NewIPAMProvider(ctx context.Context, c client.Client, pool *seederv1alpha1.AddressPool) (IPAMProvider, error) {
+	if !IsExternalIPAM(pool) {
+		return &inClusterIPAM{}, nil
+	}
+
+	spec := pool.Spec.IPAM.NetBox
+	if spec == nil {
+		return nil, fmt.Errorf("address pool %s/%s uses the netbox ipam provider, but netbox is not configured", pool.Namespace, pool.Name)
+	}
+	s, err := GetGrantedSecret(ctx, c, seederv1alpha1.ReferenceKindAddressPool, pool.Namespace, spec.TokenSecretRef)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching netbox token secret for address pool %s/%s: %w", pool.Namespace, pool.Name, err)
+	}
+	token, ok := s.Data[seederv1alpha1.NetBoxTokenKey]
+	if !ok {
+		return nil, fmt.Errorf("netbox token secret %s/%s has no %s key", s.Namespace, s.Name, seederv1alpha1.NetBoxTokenKey)
+	}
+	return &netboxIPAM{
+		client: NewNetBoxClient(spec.URL, string(token), spec.InsecureSkipTLSVerify),
+	}, nil
+}
+
+// IsExternalIPAM returns true if the addresses of the pool are reserved with an external ipam provider
+func IsExternalIPAM(pool *seederv1alpha1.AddressPool) bool {
+	return pool.Spec.IPAM != nil && pool.Spec.IPAM.Provider != "" && pool.Spec.IPAM.Provider != seederv1alpha1.IPAMProviderInCluster
+}
+
+// inClusterIPAM only tracks addresses in the address allocation of the pool status
+type inClusterIPAM struct{}
+
+func (i *inClusterIPAM) Allocate(_ context.Context, pool *seederv1alpha1.AddressPool, owner seederv1alpha1.ObjectReferenceWithKind, address string) (string, error) {
+	allocated, err := AllocateAddress(pool.Status.DeepCopy(), address)
+	if err != nil {
+		return "", err
+	}
+	setAddressAllocation(pool, allocated, owner)
+	return allocated, nil
+}
+
+func (i *inClusterIPAM) Release(_ context.Context, pool *seederv1alpha1.AddressPool, address string) error {
+	delete(pool.Status.AddressAllocation, address)
+	return nil
+}
+
+func (i *inClusterIPAM) Sync(_ context.Context, _ *seederv1alpha1.AddressPool) error {
+	return nil
+}
+
+// netboxIPAM reserves allocated addresses as ip addresses in netbox. The reservations are identified by their
+// description, which names the pool and the owner of the address
+type netboxIPAM struct {
+	client *NetBoxClient
+}
+
+func (n *netboxIPAM) Allocate(ctx context.Context, pool *seederv1alpha1.AddressPool, owner seederv1alpha1.ObjectReferenceWithKind, address string) (string, error) {
+	prefixLength, err := poolPrefixLength(pool)
+	if err != nil {
+		return "", err
+	}
+
+	// addresses in use in netbox are recorded as external, and the next free address is tried
+	for {
+		candidate, err := AllocateAddress(pool.Status.DeepCopy(), address)
+		if err != nil {
+			return "", err
+		}
+
+		existing, err := n.client.LookupIPAddress(ctx, candidate)
+		if err != nil {
+			return "", err
+		}
+		var id string
+		var usedByOthers bool
+		for _, v := range existing {
+			if ipamReservationOwnedByPool(pool, v.Description) {
+				id = fmt.Sprint(v.ID)
+			} else {
+				usedByOthers = true
+			}
+		}
+		if usedByOthers {
+			setAddressAllocation(pool, candidate, seederv1alpha1.ObjectReferenceWithKind{Kind: seederv1alpha1.KindExternal})
+			continue
+		}
+
+		// reservations left behind by an allocation which was not recorded are reused
+		if id == "" {
+			id, err = n.client.CreateIPAddress(ctx, fmt.Sprintf("%s/%d", candidate, prefixLength), ipamReservationDescription(pool, owner))
+			if err != nil {
+				return "", err
+			}
+		} else if err := n.client.UpdateIPAddressDescription(ctx, id, ipamReservationDescription(pool, owner)); err != nil {
+			return "", err
+		}
+
+		setAddressAllocation(pool, candidate, owner)
+		if pool.Status.ExternalIDs == nil {
+			pool.Status.ExternalIDs = make(map[string]string)
+		}
+		pool.Status.ExternalIDs[candidate] = id
+		return candidate, nil
+	}
+}
+
+func (n *netboxIPAM) Release(ctx context.Context, pool *seederv1alpha1.AddressPool, address string) error {
+	if id, ok := pool.Status.ExternalIDs[address]; ok {
+		if err := n.client.DeleteIPAddress(ctx, id); err != nil {
+			return err
+		}
+	}
+	delete(pool.Status.ExternalIDs, address)
+	delete(pool.Status.AddressAllocation, address)
+	return nil
+}
+
+// Sync recreates reservations which were removed from netbox, and updates those whose owner has changed.
+// Reservations of the pool for addresses which are not allocated are deleted, and addresses used by others in
+// netbox are recorded as external so they are not allocated
+func (n *netboxIPAM) Sync(ctx context.Context, pool *seederv1alpha1.AddressPool) error {
+	status := pool.Status.DeepCopy()
+	prefixLength, err := poolPrefixLength(pool)
+	if err != nil {
+		return err
+	}
+	ipRange, err := netaddr.ParseIPRange(fmt.Sprintf("%s-%s", status.StartAddress, status.LastAddress))
+	if err != nil {
+		return err
+	}
+
+	addresses, err := n.client.ListIPAddresses(ctx, pool.Spec.CIDR)
+	if err != nil {
+		return err
+	}
+	netboxAddresses := make(map[string][]NetBoxIPAddress)
+	for _, v := range addresses {
+		ip, err := netaddr.ParseIPPrefix(v.Address)
+		if err != nil {
+			return fmt.Errorf("error parsing netbox ip address %s: %w", v.Address, err)
+		}
+		netboxAddresses[ip.IP().String()] = append(netboxAddresses[ip.IP().String()], v)
+	}
+
+	if status.AddressAllocation == nil {
+		status.AddressAllocation = make(map[string]seederv1alpha1.ObjectReferenceWithKind)
+	}
+	externalIDs := make(map[string]string)
+	var conflicts []string
+	for address, owner := range status.AddressAllocation {
+		if owner.Kind == seederv1alpha1.KindExternal {
+			if len(netboxAddresses[address]) == 0 {
+				delete(status.AddressAllocation, address)
+			}
+			continue
+		}
+		if owner.Kind != seederv1alpha1.KindCluster && owner.Kind != seederv1alpha1.KindInventory {
+			continue
+		}
+
+		description := ipamReservationDescription(pool, owner)
+		var reservation *NetBoxIPAddress
+		var usedByOthers bool
+		for i, v := range netboxAddresses[address] {
+			if ipamReservationOwnedByPool(pool, v.Description) {
+				reservation = &netboxAddresses[address][i]
+			} else {
+				usedByOthers = true
+			}
+		}
+
+		switch {
+		case reservation != nil:
+			externalIDs[address] = fmt.Sprint(reservation.ID)
+			if reservation.Description != description {
+				if err := n.client.UpdateIPAddressDescription(ctx, externalIDs[address], description); err != nil {
+					return err
+				}
+			}
+		case usedByOthers:
+			conflicts = append(conflicts, address)
+		default:
+			id, err := n.client.CreateIPAddress(ctx, fmt.Sprintf("%s/%d", address, prefixLength), description)
+			if err != nil {
+				return err
+			}
+			externalIDs[address] = id
+		}
+	}
+
+	for address, v := range netboxAddresses {
+		if _, ok := status.AddressAllocation[address]; ok {
+			continue
+		}
+		var usedByOthers bool
+		for _, a := range v {
+			if !ipamReservationOwnedByPool(pool, a.Description) {
+				usedByOthers = true
+				continue
+			}
+			// reservation of an address which is no longer allocated
+			if err := n.client.DeleteIPAddress(ctx, fmt.Sprint(a.ID)); err != nil {
+				return err
+			}
+		}
+		ip, err := netaddr.ParseIP(address)
+		if err != nil {
+			return err
+		}
+		if usedByOthers && ipRange.Contains(ip) {
+			status.AddressAllocation[address] = seederv1alpha1.ObjectReferenceWithKind{Kind: seederv1alpha1.KindExternal}
+		}
+	}
+
+	status.ExternalIDs = externalIDs
+	sort.Strings(conflicts)
+	status.IPAMSync = &seederv1alpha1.IPAMSyncStatus{
+		LastSynced: time.Now().UTC().Format(time.RFC3339),
+		Conflicts:  conflicts,
+	}
+	pool.Status = *status
+	return nil
+}
+
+func setAddressAllocation(pool *seederv1alpha1.AddressPool, address string, owner seederv1alpha1.ObjectReferenceWithKind) {
+	if pool.Status.AddressAllocation == nil {
+		pool.Status.AddressAllocation = make(map[string]seederv1alpha1.ObjectReferenceWithKind)
+	}
+	pool.Status.AddressAllocation[address] = owner
+}
+
+// poolPrefixLength returns the prefix length of the pool network, which is used for the addresses reserved in the
+// external provider. The netmask of the pool overrides the prefix length of the cidr
+func poolPrefixLength(pool *seederv1alpha1.AddressPool) (int, error) {
+	_, network, err := net.ParseCIDR(pool.Spec.CIDR)
+	if err != nil {
+		return 0, err
+	}
+	if pool.Spec.Netmask == "" {
+		ones, _ := network.Mask.Size()
+		return ones, nil
+	}
+
+	mask := net.ParseIP(pool.Spec.Netmask).To4()
+	if mask == nil {
+		return 0, fmt.Errorf("address pool %s/%s has an invalid netmask %q", pool.Namespace, pool.Name, pool.Spec.Netmask)
+	}
+	ones, bits := net.IPMask(mask).Size()
+	if bits == 0 {
+		return 0, fmt.Errorf("address pool %s/%s has a non canonical netmask %q", pool.Namespace, pool.Name, pool.Spec.Netmask)
+	}
+	return ones, nil
+}
+
+func ipamReservationPrefix(pool *seederv1alpha1.AddressPool) string {
+	return fmt.Sprintf("harvester-seeder %s/%s:", pool.Namespace, pool.Name)
+}
+
+func ipamReservationDescription(pool *seederv1alpha1.AddressPool, owner seederv1alpha1.ObjectReferenceWithKind) string {
+	return fmt.Sprintf("%s %s %s/%s", ipamReservationPrefix(pool), owner.Kind, owner.Namespace, owner.Name)
+}
+
+func ipamReservationOwnedByPool(pool *seederv1alpha1.AddressPool, description string) bool {
+	return strings.HasPrefix(description, ipamReservationPrefix(pool))
+}
@@ -0,0 +1,201 @@
+package util
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/mock"
+)
+
+func ipamTestPool(t *testing.T, ipam *seederv1alpha1.IPAMSpec) *seederv1alpha1.AddressPool {
+	pool := &seederv1alpha1.AddressPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ipam",
+			Namespace: "default",
+		},
+		Spec: seederv1alpha1.AddressSpec{
+			CIDR:    "10.0.0.8/29",
+			Gateway: "10.0.0.14",
+			IPAM:    ipam,
+		},
+	}
+	status, err := GenerateAddressPoolStatus(pool)
+	require.NoError(t, err)
+	pool.Status = *status
+	return pool
+}
+
+func Test_InClusterIPAM(t *testing.T) {
+	assert := require.New(t)
+	c, err := mock.GenerateFakeClient()
+	assert.NoError(err, "expected no error during generation of fake client")
+	pool := ipamTestPool(t, nil)
+	owner := seederv1alpha1.ObjectReferenceWithKind{
+		ObjectReference: seederv1alpha1.ObjectReference{Name: "node1", Namespace: "default"},
+		Kind:            seederv1alpha1.KindInventory,
+	}
+
+	provider, err := NewIPAMProvider(ctx, c, pool)
+	assert.NoError(err)
+	address, err := provider.Allocate(ctx, pool, owner, "")
+	assert.NoError(err)
+	assert.Equal("10.0.0.8", address)
+	assert.Equal(owner, pool.Status.AddressAllocation[address])
+	assert.Empty(pool.Status.ExternalIDs)
+
+	assert.NoError(provider.Release(ctx, pool, address))
+	assert.Empty(pool.Status.AddressAllocation)
+	assert.NoError(provider.Sync(ctx, pool))
+}
+
+func Test_NetBoxIPAM(t *testing.T) {
+	assert := require.New(t)
+	f := newFakeNetBox()
+	// addresses used by others in netbox are skipped
+	f.add("10.0.0.8/29", "network")
+	server := httptest.NewTLSServer(f)
+	defer server.Close()
+
+	c, err := mock.GenerateFakeClient()
+	assert.NoError(err, "expected no error during generation of fake client")
+	assert.NoError(c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "netbox",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			seederv1alpha1.NetBoxTokenKey: []byte("netbox-token"),
+		},
+	}))
+
+	pool := ipamTestPool(t, &seederv1alpha1.IPAMSpec{
+		Provider: seederv1alpha1.IPAMProviderNetBox,
+		NetBox: &seederv1alpha1.NetBoxIPAMSpec{
+			URL:                   server.URL,
+			TokenSecretRef:        corev1.SecretReference{Name: "netbox"},
+			InsecureSkipTLSVerify: true,
+		},
+	})
+	assert.True(IsExternalIPAM(pool))
+	node1 := seederv1alpha1.ObjectReferenceWithKind{
+		ObjectReference: seederv1alpha1.ObjectReference{Name: "node1", Namespace: "default"},
+		Kind:            seederv1alpha1.KindInventory,
+	}
+
+	provider, err := NewIPAMProvider(ctx, c, pool)
+	assert.NoError(err)
+	address, err := provider.Allocate(ctx, pool, node1, "")
+	assert.NoError(err)
+	assert.Equal("10.0.0.9", address)
+	assert.Equal(seederv1alpha1.KindExternal, pool.Status.AddressAllocation["10.0.0.8"].Kind)
+	assert.Equal(node1, pool.Status.AddressAllocation[address])
+	reservations, err := provider.(*netboxIPAM).client.LookupIPAddress(ctx, address)
+	assert.NoError(err)
+	assert.Len(reservations, 1)
+	assert.Equal("10.0.0.9/29", reservations[0].Address)
+	assert.Equal("harvester-seeder default/ipam: inventory default/node1", reservations[0].Description)
+	id := pool.Status.ExternalIDs[address]
+	assert.NotEmpty(id)
+
+	assert.NoError(provider.Release(ctx, pool, address))
+	assert.NotContains(pool.Status.AddressAllocation, address)
+	assert.NotContains(pool.Status.ExternalIDs, address)
+	reservations, err = provider.(*netboxIPAM).client.LookupIPAddress(ctx, address)
+	assert.NoError(err)
+	assert.Empty(reservations, "expected reservation to be deleted on release")
+
+	address, err = provider.Allocate(ctx, pool, node1, "")
+	assert.NoError(err)
+	assert.Equal("10.0.0.9", address)
+	node2 := seederv1alpha1.ObjectReferenceWithKind{
+		ObjectReference: seederv1alpha1.ObjectReference{Name: "node2", Namespace: "default"},
+		Kind:            seederv1alpha1.KindInventory,
+	}
+	_, err = provider.Allocate(ctx, pool, node2, "")
+	assert.NoError(err)
+
+	// drift: the reservation of node1 is removed, node2 is handed over to another inventory, a reservation of
+	// the pool is left behind, and addresses are used by others
+	f.mutex.Lock()
+	for k, v := range f.addresses {
+		if v.Address == "10.0.0.9/29" {
+			delete(f.addresses, k)
+		}
+		if v.Address == "10.0.0.8/29" {
+			delete(f.addresses, k)
+		}
+	}
+	f.mutex.Unlock()
+	pool.Status.AddressAllocation["10.0.0.10"] = seederv1alpha1.ObjectReferenceWithKind{
+		ObjectReference: seederv1alpha1.ObjectReference{Name: "spare", Namespace: "default"},
+		Kind:            seederv1alpha1.KindInventory,
+	}
+	pool.Status.AddressAllocation["10.0.0.11"] = seederv1alpha1.ObjectReferenceWithKind{
+		ObjectReference: seederv1alpha1.ObjectReference{Name: "cluster", Namespace: "default"},
+		Kind:            seederv1alpha1.KindCluster,
+	}
+	pool.Status.AddressAllocation["10.0.0.13"] = seederv1alpha1.ObjectReferenceWithKind{
+		ObjectReference: seederv1alpha1.ObjectReference{Name: "reservedAddress", Namespace: "reserved"},
+		Kind:            seederv1alpha1.KindReserved,
+	}
+	f.add("10.0.0.11/29", "dhcp")
+	f.add("10.0.0.12/29", "harvester-seeder default/ipam: inventory default/node3")
+	f.add("10.0.0.15/29", "bmc")
+	f.add("10.0.1.1/24", "other network")
+
+	assert.NoError(provider.Sync(ctx, pool))
+	assert.Equal(node1, pool.Status.AddressAllocation["10.0.0.9"])
+	assert.NotContains(pool.Status.AddressAllocation, "10.0.0.8", "expected address no longer used by others to be freed")
+	assert.NotContains(pool.Status.AddressAllocation, "10.0.0.12")
+	assert.Equal(seederv1alpha1.KindExternal, pool.Status.AddressAllocation["10.0.0.15"].Kind)
+	assert.Equal([]string{"10.0.0.11"}, pool.Status.IPAMSync.Conflicts)
+	assert.NotEmpty(pool.Status.IPAMSync.LastSynced)
+	assert.NotEqual(id, pool.Status.ExternalIDs["10.0.0.9"], "expected removed reservation to be recreated")
+	assert.NotContains(pool.Status.ExternalIDs, "10.0.0.11")
+	assert.NotContains(pool.Status.ExternalIDs, "10.0.0.13")
+
+	expected := map[string]string{
+		"10.0.0.8":  "",
+		"10.0.0.9":  "harvester-seeder default/ipam: inventory default/node1",
+		"10.0.0.10": "harvester-seeder default/ipam: inventory default/spare",
+		"10.0.0.11": "dhcp",
+		"10.0.0.12": "",
+		"10.0.0.13": "",
+		"10.0.0.15": "bmc",
+	}
+	for address, description := range expected {
+		reservations, err := provider.(*netboxIPAM).client.LookupIPAddress(ctx, address)
+		assert.NoError(err)
+		if description == "" {
+			assert.Empty(reservations, "expected no netbox address for %s", address)
+			continue
+		}
+		assert.Len(reservations, 1, "expected one netbox address for %s", address)
+		assert.Equal(description, reservations[0].Description)
+	}
+}
+
+func Test_NewIPAMProviderErrors(t *testing.T) {
+	assert := require.New(t)
+	c, err := mock.GenerateFakeClient()
+	assert.NoError(err, "expected no error during generation of fake client")
+
+	pool := ipamTestPool(t, &seederv1alpha1.IPAMSpec{Provider: seederv1alpha1.IPAMProviderInCluster})
+	assert.False(IsExternalIPAM(pool))
+
+	pool = ipamTestPool(t, &seederv1alpha1.IPAMSpec{Provider: seederv1alpha1.IPAMProviderNetBox})
+	_, err = NewIPAMProvider(ctx, c, pool)
+	assert.Error(err, "expected netbox provider without netbox config to fail")
+
+	pool.Spec.IPAM.NetBox = &seederv1alpha1.NetBoxIPAMSpec{
+		URL:            "https://netbox.example.com",
+		TokenSecretRef: corev1.SecretReference{Name: "missing"},
+	}
+	_, err = NewIPAMProvider(ctx, c, pool)
+	assert.Error(err, "expected missing token secret to fail")
+}
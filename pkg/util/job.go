@@ -2,6 +2,7 @@ package util
 
 import (
 	"fmt"
+	"time"
 
 	rufio "github.com/tinkerbell/rufio/api/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -9,6 +10,9 @@ import (
 	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
 )
 
+// InventoryJobLabel identifies the inventory a power action job was generated for
+const InventoryJobLabel = "inventory.metal.harvesterhci.io"
+
 // GenerateJob will generate a power action rufio job for an inventory object
 func GenerateJob(name, namespace, powerAction string) *rufio.Job {
 	var tasks []rufio.Action
@@ -19,12 +23,14 @@ func GenerateJob(name, namespace, powerAction string) *rufio.Job {
 		PowerAction: rufio.PowerOn.Ptr(),
 	}
 
-	pxeBoot := rufio.Action{
-		OneTimeBootDeviceAction: &rufio.OneTimeBootDeviceAction{
-			Devices: []rufio.BootDevice{
-				rufio.PXE,
-			},
-		},
+	softPowerOffTask := rufio.Action{
+		PowerAction: rufio.PowerSoftOff.Ptr(),
+	}
+	powerCycleTask := rufio.Action{
+		PowerAction: rufio.PowerCycle.Ptr(),
+	}
+	resetTask := rufio.Action{
+		PowerAction: rufio.PowerReset.Ptr(),
 	}
 
 	switch powerAction {
@@ -33,17 +39,53 @@ func GenerateJob(name, namespace, powerAction string) *rufio.Job {
 	case seederv1alpha1.NodePowerActionShutdown:
 		tasks = append(tasks, powerOffTask)
 	case seederv1alpha1.NodePowerActionReboot:
-		tasks = append(tasks, powerOffTask, pxeBoot, powerOnTask)
+		tasks = append(tasks, powerOffTask, oneTimeBootTask(rufio.PXE), powerOnTask)
+	case seederv1alpha1.NodePowerActionSoftShutdown:
+		tasks = append(tasks, softPowerOffTask)
+	case seederv1alpha1.NodePowerActionPowerCycle:
+		tasks = append(tasks, powerCycleTask)
+	case seederv1alpha1.NodePowerActionWarmReset:
+		tasks = append(tasks, resetTask)
+	case seederv1alpha1.NodePowerActionBootDisk:
+		tasks = append(tasks, powerOffTask, oneTimeBootTask(rufio.Disk), powerOnTask)
+	case seederv1alpha1.NodePowerActionBootBIOS:
+		tasks = append(tasks, powerOffTask, oneTimeBootTask(rufio.BIOS), powerOnTask)
+	case seederv1alpha1.NodePowerActionBootCDROM:
+		tasks = append(tasks, powerOffTask, oneTimeBootTask(rufio.CDROM), powerOnTask)
 	default:
 		return nil
 	}
 
+	return newJob(name, namespace, powerAction, tasks)
+}
+
+// GenerateVirtualMediaJob will generate a rufio job which mounts an ISO via the BMC, and boots from it once
+func GenerateVirtualMediaJob(name, namespace, mediaURL string) *rufio.Job {
+	tasks := []rufio.Action{
+		{
+			PowerAction: rufio.PowerHardOff.Ptr(),
+		},
+		{
+			VirtualMediaAction: &rufio.VirtualMediaAction{
+				MediaURL: mediaURL,
+				Kind:     rufio.VirtualMediaCD,
+			},
+		},
+		oneTimeBootTask(rufio.CDROM),
+		{
+			PowerAction: rufio.PowerOn.Ptr(),
+		},
+	}
+	return newJob(name, namespace, seederv1alpha1.NodePowerActionVirtualMediaBoot, tasks)
+}
+
+func newJob(name, namespace, powerAction string, tasks []rufio.Action) *rufio.Job {
 	return &rufio.Job{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: fmt.Sprintf("%s-%s-", name, powerAction),
 			Namespace:    namespace,
 			Labels: map[string]string{
-				"inventory.metal.harvesterhci.io": name,
+				InventoryJobLabel: name,
 			},
 		},
 		Spec: rufio.JobSpec{
@@ -55,3 +97,81 @@ func GenerateJob(name, namespace, powerAction string) *rufio.Job {
 		},
 	}
 }
+
+func oneTimeBootTask(device rufio.BootDevice) rufio.Action {
+	return rufio.Action{
+		OneTimeBootDeviceAction: &rufio.OneTimeBootDeviceAction{
+			Devices: []rufio.BootDevice{
+				device,
+			},
+		},
+	}
+}
+
+// IsPersistentBootAction returns true for power actions which change the persistent boot device. rufio only
+// supports one time boot devices, so these are performed directly via redfish
+func IsPersistentBootAction(powerAction string) bool {
+	return powerAction == seederv1alpha1.NodePowerActionPersistentBootDisk || powerAction == seederv1alpha1.NodePowerActionPersistentBootPXE
+}
+
+// RecordPowerAction appends a pending power action record for the job to the inventory status, and
+// trims the oldest records to retain the history limit from the inventory spec. A nil job is used for
+// actions performed directly via redfish
+func RecordPowerAction(i *seederv1alpha1.Inventory, j *rufio.Job, action string, requester seederv1alpha1.PowerActionRequester, reason string) {
+	var jobName string
+	if j != nil {
+		jobName = j.Name
+	}
+	i.Status.PowerActionHistory = append(i.Status.PowerActionHistory, seederv1alpha1.PowerActionRecord{
+		Action:        action,
+		Requester:     requester,
+		Reason:        reason,
+		JobName:       jobName,
+		Outcome:       seederv1alpha1.NodeJobPending,
+		SubmittedTime: time.Now().UTC().Format(time.RFC3339),
+	})
+
+	limit := i.Spec.PowerActionHistoryLimit
+	if limit <= 0 {
+		limit = seederv1alpha1.DefaultPowerActionHistoryLimit
+	}
+	if len(i.Status.PowerActionHistory) > limit {
+		i.Status.PowerActionHistory = i.Status.PowerActionHistory[len(i.Status.PowerActionHistory)-limit:]
+	}
+}
+
+// UpdatePowerActionRecord records the outcome of a job in the power action history. A nil job indicates the job
+// was removed before an outcome was recorded. Returns true if the record was updated
+func UpdatePowerActionRecord(i *seederv1alpha1.Inventory, jobName string, j *rufio.Job) bool {
+	for idx := range i.Status.PowerActionHistory {
+		record := &i.Status.PowerActionHistory[idx]
+		if record.JobName != jobName || record.Outcome != seederv1alpha1.NodeJobPending {
+			continue
+		}
+
+		now := time.Now().UTC().Format(time.RFC3339)
+		switch {
+		case j == nil:
+			record.Outcome = seederv1alpha1.NodeJobUnknown
+			record.Message = "job removed before outcome was recorded"
+		case j.HasCondition(rufio.JobFailed, rufio.ConditionTrue):
+			record.Outcome = seederv1alpha1.NodeJobFailed
+			for _, c := range j.Status.Conditions {
+				if c.Type == rufio.JobFailed && c.Status == rufio.ConditionTrue {
+					record.Message = c.Message
+				}
+			}
+		case j.HasCondition(rufio.JobCompleted, rufio.ConditionTrue):
+			record.Outcome = seederv1alpha1.NodeJobComplete
+		default:
+			return false
+		}
+
+		record.CompletionTime = now
+		if j != nil && j.Status.CompletionTime != nil {
+			record.CompletionTime = j.Status.CompletionTime.UTC().Format(time.RFC3339)
+		}
+		return true
+	}
+	return false
+}
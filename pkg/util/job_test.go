@@ -0,0 +1,110 @@
+package util
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	rufio "github.com/tinkerbell/rufio/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+func Test_RecordPowerAction(t *testing.T) {
+	assert := require.New(t)
+	i := &seederv1alpha1.Inventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "node",
+			Namespace: "default",
+		},
+		Spec: seederv1alpha1.InventorySpec{
+			PowerActionHistoryLimit: 3,
+		},
+	}
+
+	for idx := 0; idx < 5; idx++ {
+		j := GenerateJob(i.Name, i.Namespace, seederv1alpha1.NodePowerActionReboot)
+		j.Name = fmt.Sprintf("job-%d", idx)
+		RecordPowerAction(i, j, seederv1alpha1.NodePowerActionReboot, seederv1alpha1.PowerActionRequesterUser, "test")
+	}
+
+	assert.Len(i.Status.PowerActionHistory, 3, "expected history to be trimmed to limit")
+	assert.Equal("job-2", i.Status.PowerActionHistory[0].JobName, "expected oldest records to be removed")
+	assert.Equal("job-4", i.Status.PowerActionHistory[2].JobName)
+	assert.Equal(seederv1alpha1.NodeJobPending, i.Status.PowerActionHistory[2].Outcome)
+	assert.NotEmpty(i.Status.PowerActionHistory[2].SubmittedTime)
+}
+
+func Test_UpdatePowerActionRecord(t *testing.T) {
+	assert := require.New(t)
+	i := &seederv1alpha1.Inventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "node",
+			Namespace: "default",
+		},
+	}
+
+	j := GenerateJob(i.Name, i.Namespace, seederv1alpha1.NodePowerActionShutdown)
+	j.Name = "shutdown"
+	RecordPowerAction(i, j, seederv1alpha1.NodePowerActionShutdown, seederv1alpha1.PowerActionRequesterController, "test")
+	assert.False(UpdatePowerActionRecord(i, j.Name, j), "expected no update for running job")
+
+	j.Status.Conditions = append(j.Status.Conditions, rufio.JobCondition{
+		Type:    rufio.JobFailed,
+		Status:  rufio.ConditionTrue,
+		Message: "bmc unreachable",
+	})
+	assert.True(UpdatePowerActionRecord(i, j.Name, j), "expected failed job to be recorded")
+	assert.Equal(seederv1alpha1.NodeJobFailed, i.Status.PowerActionHistory[0].Outcome)
+	assert.Equal("bmc unreachable", i.Status.PowerActionHistory[0].Message)
+	assert.NotEmpty(i.Status.PowerActionHistory[0].CompletionTime)
+	assert.False(UpdatePowerActionRecord(i, j.Name, j), "expected completed record to not be updated again")
+
+	j.Name = "missing"
+	RecordPowerAction(i, j, seederv1alpha1.NodePowerActionShutdown, seederv1alpha1.PowerActionRequesterController, "test")
+	assert.True(UpdatePowerActionRecord(i, j.Name, nil), "expected missing job to be recorded")
+	assert.Equal(seederv1alpha1.NodeJobUnknown, i.Status.PowerActionHistory[1].Outcome)
+}
+
+func Test_GenerateJob(t *testing.T) {
+	assert := require.New(t)
+	cases := map[string][]rufio.Action{
+		seederv1alpha1.NodePowerActionSoftShutdown: {
+			{PowerAction: rufio.PowerSoftOff.Ptr()},
+		},
+		seederv1alpha1.NodePowerActionPowerCycle: {
+			{PowerAction: rufio.PowerCycle.Ptr()},
+		},
+		seederv1alpha1.NodePowerActionWarmReset: {
+			{PowerAction: rufio.PowerReset.Ptr()},
+		},
+		seederv1alpha1.NodePowerActionBootBIOS: {
+			{PowerAction: rufio.PowerHardOff.Ptr()},
+			{OneTimeBootDeviceAction: &rufio.OneTimeBootDeviceAction{Devices: []rufio.BootDevice{rufio.BIOS}}},
+			{PowerAction: rufio.PowerOn.Ptr()},
+		},
+	}
+
+	for action, tasks := range cases {
+		j := GenerateJob("node", "default", action)
+		assert.NotNil(j, "expected job to be generated for action %s", action)
+		assert.Equal(tasks, j.Spec.Tasks, "expected tasks to match for action %s", action)
+	}
+
+	assert.Nil(GenerateJob("node", "default", seederv1alpha1.NodePowerActionPersistentBootDisk), "expected no job for persistent boot device")
+	assert.True(IsPersistentBootAction(seederv1alpha1.NodePowerActionPersistentBootPXE))
+	assert.False(IsPersistentBootAction(seederv1alpha1.NodePowerActionBootDisk))
+}
+
+func Test_GenerateVirtualMediaJob(t *testing.T) {
+	assert := require.New(t)
+	j := GenerateVirtualMediaJob("node", "default", "http://localhost/harvester.iso")
+	assert.Equal([]rufio.Action{
+		{PowerAction: rufio.PowerHardOff.Ptr()},
+		{VirtualMediaAction: &rufio.VirtualMediaAction{MediaURL: "http://localhost/harvester.iso", Kind: rufio.VirtualMediaCD}},
+		{OneTimeBootDeviceAction: &rufio.OneTimeBootDeviceAction{Devices: []rufio.BootDevice{rufio.CDROM}}},
+		{PowerAction: rufio.PowerOn.Ptr()},
+	}, j.Spec.Tasks)
+	assert.Equal("node", j.Labels[InventoryJobLabel])
+}
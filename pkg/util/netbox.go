@@ -0,0 +1,178 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	netboxIPAddressesPath = "/api/ipam/ip-addresses/"
+	netboxPageSize        = 1000
+	netboxActiveStatus    = "active"
+)
+
+var errNetBoxNotFound = errors.New("not found")
+
+// NetBoxClient manages ip addresses using the netbox ipam rest api
+type NetBoxClient struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+// NetBoxIPAddress is an ip address in netbox. The address includes the prefix length of its network
+type NetBoxIPAddress struct {
+	ID          int    `json:"id,omitempty"`
+	Address     string `json:"address"`
+	Status      string `json:"status,omitempty"`
+	Description string `json:"description"`
+}
+
+// netboxIPAddressResponse is an ip address returned by netbox, which returns the status as an object
+type netboxIPAddressResponse struct {
+	NetBoxIPAddress
+	Status struct {
+		Value string `json:"value"`
+	} `json:"status"`
+}
+
+type netboxCollection[T any] struct {
+	Count   int `json:"count"`
+	Results []T `json:"results"`
+}
+
+// NewNetBoxClient returns a client for the netbox server using the api token
+func NewNetBoxClient(serverURL, token string, insecureSkipTLSVerify bool) *NetBoxClient {
+	return &NetBoxClient{
+		url:   strings.TrimSuffix(serverURL, "/"),
+		token: token,
+		client: &http.Client{
+			Transport: &http.Transport{
+				IdleConnTimeout: 30 * time.Second,
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: insecureSkipTLSVerify,
+				},
+			},
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+// ListIPAddresses returns the ip addresses in the network
+func (n *NetBoxClient) ListIPAddresses(ctx context.Context, cidr string) ([]NetBoxIPAddress, error) {
+	return n.listIPAddresses(ctx, url.Values{"parent": []string{cidr}})
+}
+
+// LookupIPAddress returns the ip addresses matching the address, which is not expected to include a prefix length
+func (n *NetBoxClient) LookupIPAddress(ctx context.Context, address string) ([]NetBoxIPAddress, error) {
+	return n.listIPAddresses(ctx, url.Values{"address": []string{address}})
+}
+
+func (n *NetBoxClient) listIPAddresses(ctx context.Context, query url.Values) ([]NetBoxIPAddress, error) {
+	var addresses []NetBoxIPAddress
+	// netbox may return fewer results than the limit, when it exceeds the max page size of the server
+	query.Set("limit", strconv.Itoa(netboxPageSize))
+	for {
+		query.Set("offset", strconv.Itoa(len(addresses)))
+		page := &netboxCollection[netboxIPAddressResponse]{}
+		if err := n.do(ctx, http.MethodGet, netboxIPAddressesPath+"?"+query.Encode(), nil, page); err != nil {
+			return nil, fmt.Errorf("error listing netbox ip addresses: %w", err)
+		}
+		for _, v := range page.Results {
+			v.NetBoxIPAddress.Status = v.Status.Value
+			addresses = append(addresses, v.NetBoxIPAddress)
+		}
+		if len(page.Results) == 0 || len(addresses) >= page.Count {
+			return addresses, nil
+		}
+	}
+}
+
+// CreateIPAddress creates an active ip address, and returns its id
+func (n *NetBoxClient) CreateIPAddress(ctx context.Context, address, description string) (string, error) {
+	resp := &netboxIPAddressResponse{}
+	req := &NetBoxIPAddress{
+		Address:     address,
+		Status:      netboxActiveStatus,
+		Description: description,
+	}
+	if err := n.do(ctx, http.MethodPost, netboxIPAddressesPath, req, resp); err != nil {
+		return "", fmt.Errorf("error creating netbox ip address %s: %w", address, err)
+	}
+	if resp.ID == 0 {
+		return "", fmt.Errorf("netbox did not return an id for ip address %s", address)
+	}
+	return strconv.Itoa(resp.ID), nil
+}
+
+// UpdateIPAddressDescription changes the description of the ip address
+func (n *NetBoxClient) UpdateIPAddressDescription(ctx context.Context, id, description string) error {
+	req := map[string]string{"description": description}
+	if err := n.do(ctx, http.MethodPatch, netboxIPAddressesPath+url.PathEscape(id)+"/", req, &netboxIPAddressResponse{}); err != nil {
+		return fmt.Errorf("error updating netbox ip address %s: %w", id, err)
+	}
+	return nil
+}
+
+// DeleteIPAddress deletes the ip address. Addresses which no longer exist are ignored
+func (n *NetBoxClient) DeleteIPAddress(ctx context.Context, id string) error {
+	err := n.do(ctx, http.MethodDelete, netboxIPAddressesPath+url.PathEscape(id)+"/", nil, nil)
+	if err != nil && !errors.Is(err, errNetBoxNotFound) {
+		return fmt.Errorf("error deleting netbox ip address %s: %w", id, err)
+	}
+	return nil
+}
+
+func (n *NetBoxClient) do(ctx context.Context, method, path string, body, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		content, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(content)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, n.url+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Token "+n.token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return errNetBoxNotFound
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("expected success status code, got %s", resp.Status)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(content, out)
+}
@@ -0,0 +1,153 @@
+package util
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNetBox implements the subset of the netbox ipam api used to reserve addresses. Pages are limited to two
+// results, to exercise pagination
+type fakeNetBox struct {
+	mutex     sync.Mutex
+	nextID    int
+	addresses map[int]NetBoxIPAddress
+}
+
+func newFakeNetBox() *fakeNetBox {
+	return &fakeNetBox{
+		nextID:    1,
+		addresses: make(map[int]NetBoxIPAddress),
+	}
+}
+
+// add creates an ip address in netbox, and returns its id
+func (f *fakeNetBox) add(address, description string) int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	id := f.nextID
+	f.nextID++
+	f.addresses[id] = NetBoxIPAddress{ID: id, Address: address, Status: netboxActiveStatus, Description: description}
+	return id
+}
+
+func (f *fakeNetBox) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Header.Get("Authorization") != "Token netbox-token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var resp interface{}
+	id, _ := strconv.Atoi(strings.Trim(strings.TrimPrefix(req.URL.Path, netboxIPAddressesPath), "/"))
+	switch {
+	case req.Method == http.MethodGet && req.URL.Path == netboxIPAddressesPath:
+		var matching []NetBoxIPAddress
+		for _, v := range f.addresses {
+			ip, _, _ := net.ParseCIDR(v.Address)
+			if address := req.URL.Query().Get("address"); address != "" && ip.String() != address {
+				continue
+			}
+			if parent := req.URL.Query().Get("parent"); parent != "" {
+				_, network, _ := net.ParseCIDR(parent)
+				if !network.Contains(ip) {
+					continue
+				}
+			}
+			matching = append(matching, v)
+		}
+		sort.Slice(matching, func(i, j int) bool { return matching[i].ID < matching[j].ID })
+		offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+		end := min(offset+2, len(matching))
+		page := map[string]interface{}{"count": len(matching)}
+		var results []map[string]interface{}
+		for _, v := range matching[min(offset, end):end] {
+			results = append(results, map[string]interface{}{
+				"id":          v.ID,
+				"address":     v.Address,
+				"description": v.Description,
+				"status":      map[string]string{"value": v.Status},
+			})
+		}
+		page["results"] = results
+		resp = page
+	case req.Method == http.MethodPost && req.URL.Path == netboxIPAddressesPath:
+		address := &NetBoxIPAddress{}
+		if err := json.NewDecoder(req.Body).Decode(address); err != nil || !strings.Contains(address.Address, "/") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		address.ID = f.nextID
+		f.nextID++
+		f.addresses[address.ID] = *address
+		resp = map[string]interface{}{"id": address.ID, "address": address.Address}
+	case req.Method == http.MethodPatch && f.addresses[id].ID != 0:
+		update := map[string]string{}
+		if err := json.NewDecoder(req.Body).Decode(&update); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		address := f.addresses[id]
+		address.Description = update["description"]
+		f.addresses[id] = address
+		resp = map[string]interface{}{"id": id, "address": address.Address}
+	case req.Method == http.MethodDelete && f.addresses[id].ID != 0:
+		delete(f.addresses, id)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func Test_NetBoxClient(t *testing.T) {
+	assert := require.New(t)
+	f := newFakeNetBox()
+	f.add("10.0.0.1/24", "gateway")
+	f.add("10.0.1.1/24", "other network")
+	server := httptest.NewTLSServer(f)
+	defer server.Close()
+
+	nc := NewNetBoxClient(server.URL+"/", "netbox-token", true)
+	var ids []string
+	for _, v := range []string{"10.0.0.10/24", "10.0.0.11/24", "10.0.0.12/24"} {
+		id, err := nc.CreateIPAddress(ctx, v, "seeder")
+		assert.NoError(err)
+		ids = append(ids, id)
+	}
+
+	addresses, err := nc.ListIPAddresses(ctx, "10.0.0.0/24")
+	assert.NoError(err)
+	assert.Len(addresses, 4, "expected all pages of addresses in the network to be listed")
+	assert.Equal(netboxActiveStatus, addresses[1].Status)
+
+	addresses, err = nc.LookupIPAddress(ctx, "10.0.0.11")
+	assert.NoError(err)
+	assert.Len(addresses, 1)
+	assert.Equal(ids[1], strconv.Itoa(addresses[0].ID))
+
+	assert.NoError(nc.UpdateIPAddressDescription(ctx, ids[1], "updated"))
+	addresses, err = nc.LookupIPAddress(ctx, "10.0.0.11")
+	assert.NoError(err)
+	assert.Equal("updated", addresses[0].Description)
+
+	assert.NoError(nc.DeleteIPAddress(ctx, ids[1]))
+	assert.NoError(nc.DeleteIPAddress(ctx, ids[1]), "expected deleting a missing address to be ignored")
+	addresses, err = nc.LookupIPAddress(ctx, "10.0.0.11")
+	assert.NoError(err)
+	assert.Empty(addresses)
+
+	_, err = NewNetBoxClient(server.URL, "invalid", true).ListIPAddresses(ctx, "10.0.0.0/24")
+	assert.Error(err, "expected request with invalid token to fail")
+}
@@ -0,0 +1,190 @@
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+const (
+	nodeMetadataKeyPrefix = "metal.harvesterhci.io/"
+	// ManagedLabelsAnnotation and ManagedAnnotationsAnnotation record the node labels and annotations applied by
+	// seeder, so they can be removed once they are no longer mapped
+	ManagedLabelsAnnotation      = "metal.harvesterhci.io/managed-labels"
+	ManagedAnnotationsAnnotation = "metal.harvesterhci.io/managed-annotations"
+	maxLabelValueLength          = 63
+)
+
+var invalidLabelValueChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// DefaultNodeMetadata is used for clusters which do not specify a node metadata mapping. The manufacturer, model
+// and serial number labels keep the keys they were published with before the mapping was configurable
+var DefaultNodeMetadata = []seederv1alpha1.NodeMetadataMapping{
+	{Source: seederv1alpha1.NodeMetadataManufacturer, Key: "manufacturer", Target: seederv1alpha1.NodeMetadataLabel},
+	{Source: seederv1alpha1.NodeMetadataModel, Key: "model", Target: seederv1alpha1.NodeMetadataLabel},
+	{Source: seederv1alpha1.NodeMetadataSerialNumber, Key: "serialNumber", Target: seederv1alpha1.NodeMetadataLabel},
+	{Source: seederv1alpha1.NodeMetadataInventoryName, Target: seederv1alpha1.NodeMetadataLabel},
+	{Source: seederv1alpha1.NodeMetadataInventoryNamespace, Target: seederv1alpha1.NodeMetadataLabel},
+	{Source: seederv1alpha1.NodeMetadataBMCAddress},
+	{Source: seederv1alpha1.NodeMetadataBIOSVersion},
+	{Source: seederv1alpha1.NodeMetadataBMCFirmwareVersion},
+	{Source: seederv1alpha1.NodeMetadataGPUs},
+	{Source: seederv1alpha1.NodeMetadataPCIDevices},
+	{Source: seederv1alpha1.NodeMetadataLocation},
+}
+
+// NodeMetadataKey returns the label or annotation key of the mapping
+func NodeMetadataKey(m seederv1alpha1.NodeMetadataMapping) string {
+	if m.Key != "" {
+		return m.Key
+	}
+	return nodeMetadataKeyPrefix + string(m.Source)
+}
+
+// NodeMetadataValue returns the value of the inventory attribute. The manufacturer, model and serial number are
+// those queried via redfish and applied as inventory labels, and lists are comma separated
+func NodeMetadataValue(i *seederv1alpha1.Inventory, source seederv1alpha1.NodeMetadataSource) string {
+	hw := i.Status.Hardware
+	switch source {
+	case seederv1alpha1.NodeMetadataBMCAddress:
+		return i.Spec.BaseboardManagementSpec.Connection.Host
+	case seederv1alpha1.NodeMetadataInventoryName:
+		return i.Name
+	case seederv1alpha1.NodeMetadataInventoryNamespace:
+		return i.Namespace
+	case seederv1alpha1.NodeMetadataManufacturer, seederv1alpha1.NodeMetadataModel, seederv1alpha1.NodeMetadataSerialNumber:
+		return i.Labels[string(source)]
+	case seederv1alpha1.NodeMetadataBIOSVersion:
+		return hw.BIOSVersion
+	case seederv1alpha1.NodeMetadataBMCFirmwareVersion:
+		return hw.BMCFirmwareVersion
+	case seederv1alpha1.NodeMetadataGPUs:
+		return strings.Join(hw.GPUs, ",")
+	case seederv1alpha1.NodeMetadataPCIDevices:
+		var devices []string
+		for _, d := range hw.PCIDevices {
+			name := strings.TrimSpace(fmt.Sprintf("%s %s", d.Manufacturer, d.Model))
+			if name == "" {
+				name = d.Name
+			}
+			devices = append(devices, name)
+		}
+		return strings.Join(devices, ",")
+	case seederv1alpha1.NodeMetadataLocation:
+		return locationValue(InventoryLocation(i))
+	}
+	return ""
+}
+
+func locationValue(loc seederv1alpha1.Location) string {
+	var fields []string
+	add := func(k, v string) {
+		if v != "" {
+			fields = append(fields, fmt.Sprintf("%s=%s", k, v))
+		}
+	}
+	add("datacenter", loc.Datacenter)
+	add("room", loc.Room)
+	add("rack", loc.Rack)
+	if loc.RackPosition > 0 {
+		add("rackPosition", strconv.Itoa(loc.RackPosition))
+	}
+	add("powerDomain", loc.PowerDomain)
+	return strings.Join(fields, ",")
+}
+
+// SanitizeLabelValue replaces characters which are not allowed in label values, and truncates the value to the
+// maximum label value length
+func SanitizeLabelValue(v string) string {
+	v = invalidLabelValueChars.ReplaceAllString(v, "-")
+	if len(v) > maxLabelValueLength {
+		v = v[:maxLabelValueLength]
+	}
+	return strings.Trim(v, "-_.")
+}
+
+// NodeMetadata returns the labels and annotations of the harvester node provisioned on the inventory, including the
+// topology labels. Attributes without a value are not included
+func NodeMetadata(i *seederv1alpha1.Inventory, mappings []seederv1alpha1.NodeMetadataMapping) (map[string]string, map[string]string) {
+	if len(mappings) == 0 {
+		mappings = DefaultNodeMetadata
+	}
+
+	labels := make(map[string]string)
+	annotations := make(map[string]string)
+	for _, m := range mappings {
+		v := NodeMetadataValue(i, m.Source)
+		if m.Target == seederv1alpha1.NodeMetadataLabel {
+			v = SanitizeLabelValue(v)
+			if v != "" {
+				labels[NodeMetadataKey(m)] = v
+			}
+			continue
+		}
+		if v != "" {
+			annotations[NodeMetadataKey(m)] = v
+		}
+	}
+
+	for k, v := range TopologyLabels(InventoryLocation(i)) {
+		if v != "" {
+			labels[k] = v
+		}
+	}
+	return labels, annotations
+}
+
+// SyncNodeMetadata applies the labels and annotations to the node, and removes those previously applied by seeder
+// which are no longer desired. It returns true if the node was changed
+func SyncNodeMetadata(node *corev1.Node, labels, annotations map[string]string) bool {
+	if node.Labels == nil {
+		node.Labels = make(map[string]string)
+	}
+	if node.Annotations == nil {
+		node.Annotations = make(map[string]string)
+	}
+	changed := syncManagedKeys(node.Labels, node.Annotations, ManagedLabelsAnnotation, labels)
+	return syncManagedKeys(node.Annotations, node.Annotations, ManagedAnnotationsAnnotation, annotations) || changed
+}
+
+// syncManagedKeys sets the desired keys, removes keys recorded in the tracking annotation which are no longer desired,
+// and records the desired keys in the tracking annotation
+func syncManagedKeys(current, annotations map[string]string, tracking string, desired map[string]string) bool {
+	var changed bool
+	for _, k := range strings.Split(annotations[tracking], ",") {
+		if _, ok := desired[k]; ok || k == "" {
+			continue
+		}
+		if _, ok := current[k]; ok {
+			delete(current, k)
+			changed = true
+		}
+	}
+
+	keys := make([]string, 0, len(desired))
+	for k, v := range desired {
+		keys = append(keys, k)
+		if current[k] != v {
+			current[k] = v
+			changed = true
+		}
+	}
+	sort.Strings(keys)
+
+	managed := strings.Join(keys, ",")
+	if annotations[tracking] != managed {
+		if managed == "" {
+			delete(annotations, tracking)
+		} else {
+			annotations[tracking] = managed
+		}
+		changed = true
+	}
+	return changed
+}
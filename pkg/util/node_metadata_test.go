@@ -0,0 +1,95 @@
+package util
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+func nodeMetadataInventory() *seederv1alpha1.Inventory {
+	i := topologyInventory("node1", "r1", "")
+	i.Labels = map[string]string{
+		"manufacturer": "Acme",
+		"model":        "Server 9000",
+		"serialNumber": "SN-123",
+	}
+	i.Spec.BaseboardManagementSpec.Connection.Host = "10.0.0.10"
+	i.Status.Hardware.BIOSVersion = "2.1.0"
+	i.Status.Hardware.GPUs = []string{"NVIDIA A100", "NVIDIA A100"}
+	i.Status.Hardware.PCIDevices = []seederv1alpha1.DiscoveredPCIDevice{
+		{Name: "nic0", Manufacturer: "Intel", Model: "E810"},
+		{Name: "raid0"},
+	}
+	return i
+}
+
+func Test_NodeMetadataValue(t *testing.T) {
+	assert := require.New(t)
+	i := nodeMetadataInventory()
+	assert.Equal("10.0.0.10", NodeMetadataValue(i, seederv1alpha1.NodeMetadataBMCAddress))
+	assert.Equal("Server 9000", NodeMetadataValue(i, seederv1alpha1.NodeMetadataModel))
+	assert.Equal("NVIDIA A100,NVIDIA A100", NodeMetadataValue(i, seederv1alpha1.NodeMetadataGPUs))
+	assert.Equal("Intel E810,raid0", NodeMetadataValue(i, seederv1alpha1.NodeMetadataPCIDevices))
+	assert.Equal("rack=r1", NodeMetadataValue(i, seederv1alpha1.NodeMetadataLocation))
+	assert.Empty(NodeMetadataValue(i, seederv1alpha1.NodeMetadataBMCFirmwareVersion))
+}
+
+func Test_SanitizeLabelValue(t *testing.T) {
+	assert := require.New(t)
+	assert.Equal("Server-9000", SanitizeLabelValue("Server 9000"))
+	assert.Equal("Intel-E810-raid0", SanitizeLabelValue("Intel E810, raid0"))
+	assert.Equal("a", SanitizeLabelValue(" a/"), "expected leading and trailing separators to be trimmed")
+	assert.Len(SanitizeLabelValue(strings.Repeat("x", 100)), 63)
+}
+
+func Test_NodeMetadata(t *testing.T) {
+	assert := require.New(t)
+	i := nodeMetadataInventory()
+	labels, annotations := NodeMetadata(i, nil)
+	assert.Equal("Acme", labels["manufacturer"])
+	assert.Equal("Server-9000", labels["model"])
+	assert.Equal("SN-123", labels["serialNumber"])
+	assert.Equal("node1", labels["metal.harvesterhci.io/inventoryName"])
+	assert.Equal("r1", labels[TopologyZoneLabel])
+	assert.NotContains(labels, TopologyRegionLabel, "expected unknown topology labels to be omitted")
+	assert.Equal("10.0.0.10", annotations["metal.harvesterhci.io/bmcAddress"])
+	assert.Equal("2.1.0", annotations["metal.harvesterhci.io/biosVersion"])
+	assert.NotContains(annotations, "metal.harvesterhci.io/bmcFirmwareVersion", "expected empty values to be omitted")
+
+	labels, annotations = NodeMetadata(i, []seederv1alpha1.NodeMetadataMapping{
+		{Source: seederv1alpha1.NodeMetadataGPUs, Key: "example.com/gpus", Target: seederv1alpha1.NodeMetadataLabel},
+	})
+	assert.Equal(map[string]string{"example.com/gpus": "NVIDIA-A100-NVIDIA-A100", TopologyZoneLabel: "r1"}, labels)
+	assert.Empty(annotations)
+}
+
+func Test_SyncNodeMetadata(t *testing.T) {
+	assert := require.New(t)
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node1",
+			Labels: map[string]string{
+				"kubernetes.io/hostname": "node1",
+			},
+		},
+	}
+
+	assert.True(SyncNodeMetadata(node, map[string]string{"manufacturer": "Acme", "model": "Server"},
+		map[string]string{"metal.harvesterhci.io/bmcAddress": "10.0.0.10"}))
+	assert.Equal("manufacturer,model", node.Annotations[ManagedLabelsAnnotation])
+	assert.Equal("metal.harvesterhci.io/bmcAddress", node.Annotations[ManagedAnnotationsAnnotation])
+
+	assert.False(SyncNodeMetadata(node, map[string]string{"manufacturer": "Acme", "model": "Server"},
+		map[string]string{"metal.harvesterhci.io/bmcAddress": "10.0.0.10"}), "expected no change")
+
+	assert.True(SyncNodeMetadata(node, map[string]string{"manufacturer": "Acme"}, nil))
+	assert.NotContains(node.Labels, "model", "expected stale label to be removed")
+	assert.NotContains(node.Annotations, "metal.harvesterhci.io/bmcAddress", "expected stale annotation to be removed")
+	assert.NotContains(node.Annotations, ManagedAnnotationsAnnotation)
+	assert.Equal("node1", node.Labels["kubernetes.io/hostname"], "expected unmanaged labels to be kept")
+}
@@ -0,0 +1,59 @@
+package util
+
+import (
+	"time"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+// QuarantineInventory marks the inventory as quarantined, and records who quarantined it and why. An existing
+// quarantine is kept, so the original source and reason are reported
+func QuarantineInventory(i *seederv1alpha1.Inventory, source seederv1alpha1.QuarantineSource, reason, requestedBy, expiry string) {
+	if ConditionExists(i, seederv1alpha1.Quarantined) && i.Status.Quarantine != nil {
+		return
+	}
+	SetErrorCondition(i, seederv1alpha1.Quarantined, reason)
+	i.Status.Quarantine = &seederv1alpha1.QuarantineStatus{
+		Source:      source,
+		Reason:      reason,
+		RequestedBy: requestedBy,
+		Since:       time.Now().UTC().Format(time.RFC3339),
+		Expiry:      expiry,
+	}
+}
+
+// ReleaseQuarantine returns the inventory to the pool
+func ReleaseQuarantine(i *seederv1alpha1.Inventory) {
+	RemoveCondition(i, seederv1alpha1.Quarantined)
+	i.Status.Quarantine = nil
+}
+
+// QuarantineSourceIs returns true if the inventory was quarantined by the source
+func QuarantineSourceIs(i *seederv1alpha1.Inventory, source seederv1alpha1.QuarantineSource) bool {
+	return ConditionExists(i, seederv1alpha1.Quarantined) && i.Status.Quarantine != nil && i.Status.Quarantine.Source == source
+}
+
+// MaintenanceActive returns true if maintenance is requested on the inventory, and has not expired. Maintenance with
+// an expiry which cannot be parsed does not expire
+func MaintenanceActive(i *seederv1alpha1.Inventory, now time.Time) bool {
+	if i.Spec.Maintenance == nil {
+		return false
+	}
+	return MaintenanceRemaining(i, now) >= 0
+}
+
+// MaintenanceRemaining returns the time left until maintenance expires, and 0 if maintenance does not expire. A negative
+// duration is returned once maintenance has expired
+func MaintenanceRemaining(i *seederv1alpha1.Inventory, now time.Time) time.Duration {
+	if i.Spec.Maintenance == nil || i.Spec.Maintenance.Expiry == "" {
+		return 0
+	}
+	expiry, err := time.Parse(time.RFC3339, i.Spec.Maintenance.Expiry)
+	if err != nil {
+		return 0
+	}
+	if remaining := expiry.Sub(now); remaining > 0 {
+		return remaining
+	}
+	return -1
+}
@@ -0,0 +1,46 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+func Test_QuarantineInventory(t *testing.T) {
+	assert := require.New(t)
+	i := &seederv1alpha1.Inventory{}
+
+	QuarantineInventory(i, seederv1alpha1.QuarantineSourceDecommission, "decommission failed", "", "")
+	assert.True(ConditionExists(i, seederv1alpha1.Quarantined))
+	assert.True(QuarantineSourceIs(i, seederv1alpha1.QuarantineSourceDecommission))
+	assert.Equal("decommission failed", i.Status.Quarantine.Reason)
+	assert.NotEmpty(i.Status.Quarantine.Since)
+
+	QuarantineInventory(i, seederv1alpha1.QuarantineSourceUser, "replacing dimm", "admin", "")
+	assert.True(QuarantineSourceIs(i, seederv1alpha1.QuarantineSourceDecommission), "expected existing quarantine to be kept")
+
+	ReleaseQuarantine(i)
+	assert.False(ConditionExists(i, seederv1alpha1.Quarantined))
+	assert.Nil(i.Status.Quarantine)
+	assert.False(QuarantineSourceIs(i, seederv1alpha1.QuarantineSourceDecommission))
+}
+
+func Test_MaintenanceActive(t *testing.T) {
+	assert := require.New(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	i := &seederv1alpha1.Inventory{}
+	assert.False(MaintenanceActive(i, now), "expected no maintenance by default")
+
+	i.Spec.Maintenance = &seederv1alpha1.MaintenanceSpec{Reason: "replacing dimm"}
+	assert.True(MaintenanceActive(i, now), "expected maintenance without expiry to be active")
+	assert.Equal(time.Duration(0), MaintenanceRemaining(i, now))
+
+	i.Spec.Maintenance.Expiry = "2024-01-01T02:00:00Z"
+	assert.True(MaintenanceActive(i, now))
+	assert.Equal(2*time.Hour, MaintenanceRemaining(i, now))
+
+	assert.False(MaintenanceActive(i, now.Add(3*time.Hour)), "expected maintenance to expire")
+}
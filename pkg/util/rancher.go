@@ -0,0 +1,164 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	rancherClusterActiveState = "active"
+	rancherProviderLabel      = "provider.cattle.io"
+	rancherHarvesterProvider  = "harvester"
+)
+
+// RancherClient imports clusters into rancher using the rancher v3 api
+type RancherClient struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+type rancherCluster struct {
+	ID    string `json:"id,omitempty"`
+	Type  string `json:"type,omitempty"`
+	Name  string `json:"name,omitempty"`
+	State string `json:"state,omitempty"`
+	// Labels mark the cluster as a harvester cluster, so it is listed under virtualization management
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+type rancherRegistrationToken struct {
+	Type        string `json:"type,omitempty"`
+	ClusterID   string `json:"clusterId,omitempty"`
+	ManifestURL string `json:"manifestUrl,omitempty"`
+}
+
+type rancherCollection[T any] struct {
+	Data []T `json:"data"`
+}
+
+// NewRancherClient returns a client for the rancher server using the api token
+func NewRancherClient(serverURL, token string, insecureSkipTLSVerify bool) *RancherClient {
+	return &RancherClient{
+		url:   strings.TrimSuffix(serverURL, "/"),
+		token: token,
+		client: &http.Client{
+			Transport: &http.Transport{
+				IdleConnTimeout: 30 * time.Second,
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: insecureSkipTLSVerify,
+				},
+			},
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+// EnsureImportedCluster returns the id of the imported cluster with the name, and creates it if it does not exist
+func (r *RancherClient) EnsureImportedCluster(ctx context.Context, name string) (string, error) {
+	clusters := &rancherCollection[rancherCluster]{}
+	if err := r.do(ctx, http.MethodGet, "/v3/clusters?name="+url.QueryEscape(name), nil, clusters); err != nil {
+		return "", fmt.Errorf("error looking up rancher cluster %s: %w", name, err)
+	}
+	if len(clusters.Data) != 0 {
+		return clusters.Data[0].ID, nil
+	}
+
+	cluster := &rancherCluster{}
+	req := &rancherCluster{
+		Type: "cluster",
+		Name: name,
+		Labels: map[string]string{
+			rancherProviderLabel: rancherHarvesterProvider,
+		},
+	}
+	if err := r.do(ctx, http.MethodPost, "/v3/clusters", req, cluster); err != nil {
+		return "", fmt.Errorf("error creating rancher cluster %s: %w", name, err)
+	}
+	if cluster.ID == "" {
+		return "", fmt.Errorf("rancher did not return an id for cluster %s", name)
+	}
+	return cluster.ID, nil
+}
+
+// RegistrationManifestURL returns the url of the manifest which registers the cluster with rancher. A registration
+// token is created if the cluster has none, and an empty url is returned until rancher has generated the manifest
+func (r *RancherClient) RegistrationManifestURL(ctx context.Context, clusterID string) (string, error) {
+	tokens := &rancherCollection[rancherRegistrationToken]{}
+	if err := r.do(ctx, http.MethodGet, "/v3/clusterregistrationtokens?clusterId="+url.QueryEscape(clusterID), nil, tokens); err != nil {
+		return "", fmt.Errorf("error looking up registration token of rancher cluster %s: %w", clusterID, err)
+	}
+	for _, t := range tokens.Data {
+		if t.ManifestURL != "" {
+			return t.ManifestURL, nil
+		}
+	}
+	if len(tokens.Data) != 0 {
+		return "", nil
+	}
+
+	token := &rancherRegistrationToken{}
+	req := &rancherRegistrationToken{
+		Type:      "clusterRegistrationToken",
+		ClusterID: clusterID,
+	}
+	if err := r.do(ctx, http.MethodPost, "/v3/clusterregistrationtokens", req, token); err != nil {
+		return "", fmt.Errorf("error creating registration token for rancher cluster %s: %w", clusterID, err)
+	}
+	return token.ManifestURL, nil
+}
+
+// ClusterActive returns true once the cluster agent has connected to rancher and the cluster is active
+func (r *RancherClient) ClusterActive(ctx context.Context, clusterID string) (bool, error) {
+	cluster := &rancherCluster{}
+	if err := r.do(ctx, http.MethodGet, "/v3/clusters/"+url.PathEscape(clusterID), nil, cluster); err != nil {
+		return false, fmt.Errorf("error fetching rancher cluster %s: %w", clusterID, err)
+	}
+	return cluster.State == rancherClusterActiveState, nil
+}
+
+func (r *RancherClient) do(ctx context.Context, method, path string, body, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		content, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(content)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, r.url+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+r.token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("expected success status code, got %s", resp.Status)
+	}
+	return json.Unmarshal(content, out)
+}
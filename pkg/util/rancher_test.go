@@ -0,0 +1,110 @@
+package util
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRancher implements the subset of the rancher v3 api used to import clusters
+type fakeRancher struct {
+	clusters map[string]*rancherCluster
+	tokens   map[string]*rancherRegistrationToken
+}
+
+func (f *fakeRancher) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Header.Get("Authorization") != "Bearer token-abcd:secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var resp interface{}
+	switch {
+	case req.Method == http.MethodGet && req.URL.Path == "/v3/clusters":
+		clusters := &rancherCollection[rancherCluster]{Data: []rancherCluster{}}
+		for _, v := range f.clusters {
+			if v.Name == req.URL.Query().Get("name") {
+				clusters.Data = append(clusters.Data, *v)
+			}
+		}
+		resp = clusters
+	case req.Method == http.MethodPost && req.URL.Path == "/v3/clusters":
+		cluster := &rancherCluster{}
+		if err := json.NewDecoder(req.Body).Decode(cluster); err != nil || cluster.Labels[rancherProviderLabel] != rancherHarvesterProvider {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		cluster.ID = "c-" + cluster.Name
+		cluster.State = "pending"
+		f.clusters[cluster.ID] = cluster
+		resp = cluster
+	case req.Method == http.MethodGet && req.URL.Path == "/v3/clusterregistrationtokens":
+		tokens := &rancherCollection[rancherRegistrationToken]{Data: []rancherRegistrationToken{}}
+		if t, ok := f.tokens[req.URL.Query().Get("clusterId")]; ok {
+			tokens.Data = append(tokens.Data, *t)
+		}
+		resp = tokens
+	case req.Method == http.MethodPost && req.URL.Path == "/v3/clusterregistrationtokens":
+		token := &rancherRegistrationToken{}
+		if err := json.NewDecoder(req.Body).Decode(token); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		token.ManifestURL = "https://rancher.example.com/v3/import/" + token.ClusterID + ".yaml"
+		f.tokens[token.ClusterID] = token
+		resp = token
+	case req.Method == http.MethodGet && f.clusters[strings.TrimPrefix(req.URL.Path, "/v3/clusters/")] != nil:
+		resp = f.clusters[strings.TrimPrefix(req.URL.Path, "/v3/clusters/")]
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func Test_RancherImport(t *testing.T) {
+	assert := require.New(t)
+	f := &fakeRancher{
+		clusters: make(map[string]*rancherCluster),
+		tokens:   make(map[string]*rancherRegistrationToken),
+	}
+	server := httptest.NewTLSServer(f)
+	defer server.Close()
+
+	rc := NewRancherClient(server.URL+"/", "token-abcd:secret", true)
+	id, err := rc.EnsureImportedCluster(ctx, "default-harvester")
+	assert.NoError(err)
+	assert.Equal("c-default-harvester", id, "expected cluster to be created in rancher")
+
+	existing, err := rc.EnsureImportedCluster(ctx, "default-harvester")
+	assert.NoError(err)
+	assert.Equal(id, existing, "expected existing cluster to be reused")
+	assert.Len(f.clusters, 1, "expected only one cluster to be created")
+
+	active, err := rc.ClusterActive(ctx, id)
+	assert.NoError(err)
+	assert.False(active, "expected cluster to be pending until the agent connects")
+
+	manifestURL, err := rc.RegistrationManifestURL(ctx, id)
+	assert.NoError(err)
+	assert.Equal("https://rancher.example.com/v3/import/c-default-harvester.yaml", manifestURL)
+
+	manifestURL, err = rc.RegistrationManifestURL(ctx, id)
+	assert.NoError(err)
+	assert.Equal("https://rancher.example.com/v3/import/c-default-harvester.yaml", manifestURL, "expected existing registration token to be reused")
+
+	f.clusters[id].State = "active"
+	active, err = rc.ClusterActive(ctx, id)
+	assert.NoError(err)
+	assert.True(active, "expected cluster to be active")
+
+	_, err = NewRancherClient(server.URL, "invalid", true).EnsureImportedCluster(ctx, "default-harvester")
+	assert.Error(err, "expected invalid token to be rejected")
+
+	_, err = NewRancherClient(server.URL, "token-abcd:secret", false).EnsureImportedCluster(ctx, "default-harvester")
+	assert.Error(err, "expected untrusted certificate to be rejected")
+}
@@ -0,0 +1,83 @@
+package util
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+// ReferenceGranted returns true if an object of fromKind in fromNamespace can reference the object of toKind. References
+// within a namespace are always allowed, and references to another namespace need a ReferenceGrant in that namespace
+func ReferenceGranted(ctx context.Context, c client.Client, fromKind, fromNamespace, toKind string, to types.NamespacedName) (bool, error) {
+	if to.Namespace == "" || to.Namespace == fromNamespace {
+		return true, nil
+	}
+
+	grantList := &seederv1alpha1.ReferenceGrantList{}
+	if err := c.List(ctx, grantList, client.InNamespace(to.Namespace)); err != nil {
+		return false, fmt.Errorf("error fetching reference grants in namespace %s: %w", to.Namespace, err)
+	}
+
+	for _, g := range grantList.Items {
+		if grantAllows(&g, fromKind, fromNamespace, toKind, to.Name) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func grantAllows(g *seederv1alpha1.ReferenceGrant, fromKind, fromNamespace, toKind, toName string) bool {
+	var fromMatched bool
+	for _, f := range g.Spec.From {
+		if f.Kind == fromKind && f.Namespace == fromNamespace {
+			fromMatched = true
+			break
+		}
+	}
+	if !fromMatched {
+		return false
+	}
+
+	for _, t := range g.Spec.To {
+		if t.Kind == toKind && (t.Name == "" || t.Name == toName) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckReferenceGranted returns an error if the reference to the object of toKind is not granted
+func CheckReferenceGranted(ctx context.Context, c client.Client, fromKind, fromNamespace, toKind string, to types.NamespacedName) error {
+	granted, err := ReferenceGranted(ctx, c, fromKind, fromNamespace, toKind, to)
+	if err != nil {
+		return err
+	}
+	if !granted {
+		return fmt.Errorf("%s in namespace %s is not granted access to %s %s/%s", fromKind, fromNamespace, toKind, to.Namespace, to.Name)
+	}
+	return nil
+}
+
+// GetGrantedSecret fetches a secret referenced by an object of fromKind in fromNamespace, and refuses to read secrets
+// in other namespaces unless the reference is granted
+func GetGrantedSecret(ctx context.Context, c client.Client, fromKind, fromNamespace string, ref corev1.SecretReference) (*corev1.Secret, error) {
+	name := types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}
+	if name.Namespace == "" {
+		name.Namespace = fromNamespace
+	}
+
+	if err := CheckReferenceGranted(ctx, c, fromKind, fromNamespace, seederv1alpha1.ReferenceKindSecret, name); err != nil {
+		return nil, err
+	}
+
+	s := &corev1.Secret{}
+	if err := c.Get(ctx, name, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
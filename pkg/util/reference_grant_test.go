@@ -0,0 +1,149 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/mock"
+)
+
+func Test_ReferenceGranted(t *testing.T) {
+	assert := require.New(t)
+	c, err := mock.GenerateFakeClient()
+	assert.NoError(err, "expected no error during generation of fake client")
+
+	assert.NoError(c.Create(ctx, &seederv1alpha1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "team-a",
+			Namespace: "shared",
+		},
+		Spec: seederv1alpha1.ReferenceGrantSpec{
+			From: []seederv1alpha1.ReferenceGrantFrom{
+				{
+					Kind:      seederv1alpha1.ReferenceKindCluster,
+					Namespace: "team-a",
+				},
+			},
+			To: []seederv1alpha1.ReferenceGrantTo{
+				{
+					Kind: seederv1alpha1.ReferenceKindAddressPool,
+				},
+				{
+					Kind: seederv1alpha1.ReferenceKindInventory,
+					Name: "node1",
+				},
+			},
+		},
+	}))
+
+	tests := []struct {
+		name      string
+		fromKind  string
+		namespace string
+		toKind    string
+		to        types.NamespacedName
+		granted   bool
+	}{
+		{
+			name:      "same namespace",
+			fromKind:  seederv1alpha1.ReferenceKindCluster,
+			namespace: "team-b",
+			toKind:    seederv1alpha1.ReferenceKindAddressPool,
+			to:        types.NamespacedName{Namespace: "team-b", Name: "pool"},
+			granted:   true,
+		},
+		{
+			name:      "any pool granted",
+			fromKind:  seederv1alpha1.ReferenceKindCluster,
+			namespace: "team-a",
+			toKind:    seederv1alpha1.ReferenceKindAddressPool,
+			to:        types.NamespacedName{Namespace: "shared", Name: "pool"},
+			granted:   true,
+		},
+		{
+			name:      "named inventory granted",
+			fromKind:  seederv1alpha1.ReferenceKindCluster,
+			namespace: "team-a",
+			toKind:    seederv1alpha1.ReferenceKindInventory,
+			to:        types.NamespacedName{Namespace: "shared", Name: "node1"},
+			granted:   true,
+		},
+		{
+			name:      "other inventory not granted",
+			fromKind:  seederv1alpha1.ReferenceKindCluster,
+			namespace: "team-a",
+			toKind:    seederv1alpha1.ReferenceKindInventory,
+			to:        types.NamespacedName{Namespace: "shared", Name: "node2"},
+		},
+		{
+			name:      "other namespace not granted",
+			fromKind:  seederv1alpha1.ReferenceKindCluster,
+			namespace: "team-b",
+			toKind:    seederv1alpha1.ReferenceKindAddressPool,
+			to:        types.NamespacedName{Namespace: "shared", Name: "pool"},
+		},
+		{
+			name:      "other kind not granted",
+			fromKind:  seederv1alpha1.ReferenceKindInventory,
+			namespace: "team-a",
+			toKind:    seederv1alpha1.ReferenceKindAddressPool,
+			to:        types.NamespacedName{Namespace: "shared", Name: "pool"},
+		},
+	}
+
+	for _, tt := range tests {
+		granted, err := ReferenceGranted(ctx, c, tt.fromKind, tt.namespace, tt.toKind, tt.to)
+		assert.NoError(err, tt.name)
+		assert.Equal(tt.granted, granted, tt.name)
+	}
+}
+
+func Test_GetGrantedSecret(t *testing.T) {
+	assert := require.New(t)
+	c, err := mock.GenerateFakeClient()
+	assert.NoError(err, "expected no error during generation of fake client")
+
+	assert.NoError(c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bmc",
+			Namespace: "shared",
+		},
+	}))
+	ref := corev1.SecretReference{Name: "bmc", Namespace: "shared"}
+
+	_, err = GetGrantedSecret(ctx, c, seederv1alpha1.ReferenceKindInventory, "team-a", ref)
+	assert.Error(err, "expected secret in other namespace to be refused without a grant")
+
+	s, err := GetGrantedSecret(ctx, c, seederv1alpha1.ReferenceKindInventory, "shared", corev1.SecretReference{Name: "bmc"})
+	assert.NoError(err, "expected secret without namespace to be read from the same namespace")
+	assert.Equal("bmc", s.Name)
+
+	assert.NoError(c.Create(ctx, &seederv1alpha1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bmc",
+			Namespace: "shared",
+		},
+		Spec: seederv1alpha1.ReferenceGrantSpec{
+			From: []seederv1alpha1.ReferenceGrantFrom{
+				{
+					Kind:      seederv1alpha1.ReferenceKindInventory,
+					Namespace: "team-a",
+				},
+			},
+			To: []seederv1alpha1.ReferenceGrantTo{
+				{
+					Kind: seederv1alpha1.ReferenceKindSecret,
+					Name: "bmc",
+				},
+			},
+		},
+	}))
+
+	_, err = GetGrantedSecret(ctx, c, seederv1alpha1.ReferenceKindInventory, "team-a", ref)
+	assert.NoError(err, "expected granted secret to be read")
+}
@@ -0,0 +1,50 @@
+package util
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+// RegistryAuth is the username and password used to authenticate with a registry mirror
+type RegistryAuth struct {
+	Username string
+	Password string
+}
+
+// GetRegistryCredentials reads the credentials of the registry mirrors of the cluster, keyed by the upstream
+// registry. Mirrors without a credentials secret are skipped
+func GetRegistryCredentials(ctx context.Context, c client.Client, cluster *seederv1alpha1.Cluster) (map[string]RegistryAuth, error) {
+	credentials := make(map[string]RegistryAuth)
+	for _, m := range cluster.Spec.RegistryMirrors {
+		if m.CredentialsSecretRef == nil {
+			continue
+		}
+		s, err := GetGrantedSecret(ctx, c, seederv1alpha1.ReferenceKindCluster, cluster.Namespace, *m.CredentialsSecretRef)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching credentials of registry mirror %s: %w", m.Registry, err)
+		}
+		auth, err := RegistryAuthFromSecret(s)
+		if err != nil {
+			return nil, fmt.Errorf("error reading credentials of registry mirror %s: %w", m.Registry, err)
+		}
+		credentials[m.Registry] = auth
+	}
+	return credentials, nil
+}
+
+// RegistryAuthFromSecret reads the username and password keys of a registry credentials secret
+func RegistryAuthFromSecret(s *corev1.Secret) (RegistryAuth, error) {
+	username, password := s.Data[corev1.BasicAuthUsernameKey], s.Data[corev1.BasicAuthPasswordKey]
+	if len(username) == 0 || len(password) == 0 {
+		return RegistryAuth{}, fmt.Errorf("secret %s/%s needs %s and %s keys", s.Namespace, s.Name, corev1.BasicAuthUsernameKey, corev1.BasicAuthPasswordKey)
+	}
+	return RegistryAuth{
+		Username: string(username),
+		Password: string(password),
+	}, nil
+}
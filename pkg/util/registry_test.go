@@ -0,0 +1,60 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/mock"
+)
+
+func Test_GetRegistryCredentials(t *testing.T) {
+	assert := require.New(t)
+	c, err := mock.GenerateFakeClient()
+	assert.NoError(err, "expected no error during generation of fake client")
+	assert.NoError(c.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mirror-creds",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			corev1.BasicAuthUsernameKey: []byte("user"),
+			corev1.BasicAuthPasswordKey: []byte("secret"),
+		},
+	}))
+
+	cluster := &seederv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mirrors",
+			Namespace: "default",
+		},
+		Spec: seederv1alpha1.ClusterSpec{
+			ClusterConfig: seederv1alpha1.ClusterConfig{
+				RegistryMirrors: []seederv1alpha1.RegistryMirror{
+					{
+						Registry:             "docker.io",
+						Endpoints:            []string{"https://mirror.example.com"},
+						CredentialsSecretRef: &corev1.SecretReference{Name: "mirror-creds"},
+					},
+					{
+						Registry:  "quay.io",
+						Endpoints: []string{"https://quay-mirror.example.com"},
+					},
+				},
+			},
+		},
+	}
+	credentials, err := GetRegistryCredentials(ctx, c, cluster)
+	assert.NoError(err)
+	assert.Equal(map[string]RegistryAuth{"docker.io": {Username: "user", Password: "secret"}}, credentials)
+
+	cluster.Spec.RegistryMirrors[1].CredentialsSecretRef = &corev1.SecretReference{Name: "mirror-creds", Namespace: "shared"}
+	_, err = GetRegistryCredentials(ctx, c, cluster)
+	assert.Error(err, "expected secret in other namespace to be rejected without a grant")
+
+	_, err = RegistryAuthFromSecret(&corev1.Secret{Data: map[string][]byte{corev1.BasicAuthUsernameKey: []byte("user")}})
+	assert.Error(err, "expected secret without password to be rejected")
+}
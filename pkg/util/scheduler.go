@@ -0,0 +1,247 @@
+package util
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+
+	rufio "github.com/tinkerbell/rufio/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+const (
+	// BMCSubnetLabel identifies the BMC subnet a power action job was scheduled against
+	BMCSubnetLabel = "subnet.bmc.metal.harvesterhci.io"
+
+	DefaultBMCSubnetPrefixLength = 24
+	bmcIPv6SubnetPrefixLength    = 64
+
+	// submissions are tracked in memory until they are visible in the cache, or this grace period expires
+	schedulerGracePeriod = time.Minute
+	defaultDeferInterval = 10 * time.Second
+)
+
+// DeferredError is returned when an operation has to wait for capacity, and needs to be retried after RequeueAfter
+type DeferredError struct {
+	Reason       string
+	RequeueAfter time.Duration
+}
+
+func (e *DeferredError) Error() string {
+	return fmt.Sprintf("%s, retrying in %s", e.Reason, e.RequeueAfter)
+}
+
+// IsDeferred returns the requeue interval if the error was caused by the scheduler deferring an operation
+func IsDeferred(err error) (time.Duration, bool) {
+	var deferred *DeferredError
+	if errors.As(err, &deferred) {
+		return deferred.RequeueAfter, true
+	}
+	return 0, false
+}
+
+// Scheduler admits BMC power action jobs and node installations across the fleet. It limits the number of jobs
+// running concurrently against BMCs in the same subnet, enforces a minimum spacing between jobs submitted to a subnet
+// to avoid power inrush and BMC overload, and limits the number of nodes installing at once in a cluster.
+// Jobs and installations are counted from the cluster, and recent submissions are tracked in memory until they are
+// visible in the cache
+type Scheduler struct {
+	client.Client
+	// MaxConcurrentJobsPerSubnet limits the number of incomplete jobs per BMC subnet, 0 disables the limit
+	MaxConcurrentJobsPerSubnet int
+	// JobSpacing is the minimum interval between jobs submitted to the same BMC subnet
+	JobSpacing time.Duration
+	// SubnetPrefixLength is used to group IPv4 BMC addresses into subnets. IPv6 addresses are grouped by /64
+	SubnetPrefixLength int
+
+	mutex         sync.Mutex
+	lastSubmitted map[string]time.Time
+	submittedJobs map[string]map[types.NamespacedName]time.Time
+	installing    map[types.NamespacedName]map[types.NamespacedName]time.Time
+}
+
+func NewScheduler(c client.Client, maxConcurrentJobsPerSubnet int, jobSpacing time.Duration, subnetPrefixLength int) *Scheduler {
+	if subnetPrefixLength <= 0 || subnetPrefixLength > 32 {
+		subnetPrefixLength = DefaultBMCSubnetPrefixLength
+	}
+	return &Scheduler{
+		Client:                     c,
+		MaxConcurrentJobsPerSubnet: maxConcurrentJobsPerSubnet,
+		JobSpacing:                 jobSpacing,
+		SubnetPrefixLength:         subnetPrefixLength,
+		lastSubmitted:              make(map[string]time.Time),
+		submittedJobs:              make(map[string]map[types.NamespacedName]time.Time),
+		installing:                 make(map[types.NamespacedName]map[types.NamespacedName]time.Time),
+	}
+}
+
+// SubmitJob creates the job if the BMC subnet of the inventory has capacity, else returns a DeferredError
+func (s *Scheduler) SubmitJob(ctx context.Context, i *seederv1alpha1.Inventory, j *rufio.Job) error {
+	subnet := BMCSubnet(i.Spec.BaseboardManagementSpec.Connection.Host, s.SubnetPrefixLength)
+	label := subnetLabelValue(subnet)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	jobList := &rufio.JobList{}
+	if err := s.List(ctx, jobList, client.MatchingLabels{BMCSubnetLabel: label}); err != nil {
+		return fmt.Errorf("error listing jobs for bmc subnet %s: %w", subnet, err)
+	}
+
+	active := make(map[types.NamespacedName]bool)
+	lastSubmitted := s.lastSubmitted[subnet]
+	for _, job := range jobList.Items {
+		key := types.NamespacedName{Namespace: job.Namespace, Name: job.Name}
+		delete(s.submittedJobs[subnet], key)
+		if job.CreationTimestamp.After(lastSubmitted) {
+			lastSubmitted = job.CreationTimestamp.Time
+		}
+		if !job.HasCondition(rufio.JobCompleted, rufio.ConditionTrue) && !job.HasCondition(rufio.JobFailed, rufio.ConditionTrue) {
+			active[key] = true
+		}
+	}
+
+	for key, submitted := range s.submittedJobs[subnet] {
+		if time.Since(submitted) > schedulerGracePeriod {
+			delete(s.submittedJobs[subnet], key)
+			continue
+		}
+		active[key] = true
+	}
+
+	if s.MaxConcurrentJobsPerSubnet > 0 && len(active) >= s.MaxConcurrentJobsPerSubnet {
+		return &DeferredError{
+			Reason:       fmt.Sprintf("bmc subnet %s has %d jobs in progress", subnet, len(active)),
+			RequeueAfter: s.deferInterval(),
+		}
+	}
+
+	if wait := s.JobSpacing - time.Since(lastSubmitted); wait > 0 {
+		return &DeferredError{
+			Reason:       fmt.Sprintf("bmc subnet %s had a job submitted within %s", subnet, s.JobSpacing),
+			RequeueAfter: wait,
+		}
+	}
+
+	if j.Labels == nil {
+		j.Labels = make(map[string]string)
+	}
+	j.Labels[BMCSubnetLabel] = label
+	if err := s.Create(ctx, j); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	s.lastSubmitted[subnet] = now
+	if s.submittedJobs[subnet] == nil {
+		s.submittedJobs[subnet] = make(map[types.NamespacedName]time.Time)
+	}
+	s.submittedJobs[subnet][types.NamespacedName{Namespace: j.Namespace, Name: j.Name}] = now
+	return nil
+}
+
+// AdmitInstall returns a DeferredError if the cluster already has MaxInstallingNodes nodes installing. Inventories
+// installing have the HarvesterInstalling condition, and ReserveInstall reserves the slot once the installation starts
+func (s *Scheduler) AdmitInstall(ctx context.Context, c *seederv1alpha1.Cluster, i *seederv1alpha1.Inventory) error {
+	if c.Spec.MaxInstallingNodes <= 0 {
+		return nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	clusterKey := types.NamespacedName{Namespace: c.Namespace, Name: c.Name}
+	inventoryKey := types.NamespacedName{Namespace: i.Namespace, Name: i.Name}
+
+	iList := &seederv1alpha1.InventoryList{}
+	if err := s.List(ctx, iList); err != nil {
+		return fmt.Errorf("error listing inventory: %w", err)
+	}
+
+	installing := make(map[types.NamespacedName]bool)
+	for _, v := range iList.Items {
+		if v.Status.Cluster.Name != c.Name || v.Status.Cluster.Namespace != c.Namespace {
+			continue
+		}
+		key := types.NamespacedName{Namespace: v.Namespace, Name: v.Name}
+		if ConditionExists(&v, seederv1alpha1.HarvesterInstalling) {
+			delete(s.installing[clusterKey], key)
+			installing[key] = true
+		}
+	}
+
+	for key, admitted := range s.installing[clusterKey] {
+		if time.Since(admitted) > schedulerGracePeriod {
+			delete(s.installing[clusterKey], key)
+			continue
+		}
+		installing[key] = true
+	}
+
+	if !installing[inventoryKey] && len(installing) >= c.Spec.MaxInstallingNodes {
+		return &DeferredError{
+			Reason:       fmt.Sprintf("cluster %s has %d nodes installing", c.Name, len(installing)),
+			RequeueAfter: defaultDeferInterval,
+		}
+	}
+	return nil
+}
+
+// ReserveInstall reserves an installation slot in the cluster for the inventory, until the HarvesterInstalling
+// condition of the inventory is visible in the cache
+func (s *Scheduler) ReserveInstall(c *seederv1alpha1.Cluster, i *seederv1alpha1.Inventory) {
+	if c.Spec.MaxInstallingNodes <= 0 {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	clusterKey := types.NamespacedName{Namespace: c.Namespace, Name: c.Name}
+	if s.installing[clusterKey] == nil {
+		s.installing[clusterKey] = make(map[types.NamespacedName]time.Time)
+	}
+	s.installing[clusterKey][types.NamespacedName{Namespace: i.Namespace, Name: i.Name}] = time.Now()
+}
+
+func (s *Scheduler) deferInterval() time.Duration {
+	if s.JobSpacing > defaultDeferInterval {
+		return s.JobSpacing
+	}
+	return defaultDeferInterval
+}
+
+// BMCSubnet returns the subnet of a BMC address. Hostnames which are not ip addresses are treated as their own subnet
+func BMCSubnet(host string, prefixLength int) string {
+	addr, err := netip.ParseAddr(strings.Trim(host, "[]"))
+	if err != nil {
+		return strings.ToLower(host)
+	}
+
+	bits := prefixLength
+	if !addr.Is4() {
+		bits = bmcIPv6SubnetPrefixLength
+	}
+	prefix, err := addr.Prefix(bits)
+	if err != nil {
+		return addr.String()
+	}
+	return prefix.String()
+}
+
+// subnetLabelValue converts a subnet to a valid label value
+func subnetLabelValue(subnet string) string {
+	value := strings.Trim(strings.NewReplacer("/", "-", ":", ".").Replace(subnet), "-.")
+	if len(value) > 63 {
+		return fmt.Sprintf("%x", sha256.Sum256([]byte(subnet)))[:63]
+	}
+	return value
+}
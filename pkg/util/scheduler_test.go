@@ -0,0 +1,121 @@
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	rufio "github.com/tinkerbell/rufio/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/mock"
+)
+
+func schedulerInventory(name, bmcAddress string) *seederv1alpha1.Inventory {
+	return &seederv1alpha1.Inventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: seederv1alpha1.InventorySpec{
+			BaseboardManagementSpec: rufio.MachineSpec{
+				Connection: rufio.Connection{
+					Host: bmcAddress,
+				},
+			},
+		},
+	}
+}
+
+func Test_BMCSubnet(t *testing.T) {
+	assert := require.New(t)
+	assert.Equal("192.168.1.0/24", BMCSubnet("192.168.1.10", 24))
+	assert.Equal("192.168.0.0/16", BMCSubnet("192.168.1.10", 16))
+	assert.Equal("fd00:1::/64", BMCSubnet("fd00:1::10", 24))
+	assert.Equal("bmc.example.com", BMCSubnet("BMC.example.com", 24))
+	assert.Equal("fd00.1..-64", subnetLabelValue("fd00:1::/64"))
+}
+
+func Test_SubmitJob(t *testing.T) {
+	assert := require.New(t)
+	c, err := mock.GenerateFakeClient()
+	assert.NoError(err, "expected no error during generation of fake client")
+	ctx := context.TODO()
+	s := NewScheduler(c, 2, 0, 24)
+
+	for _, name := range []string{"node1", "node2"} {
+		err = s.SubmitJob(ctx, schedulerInventory(name, "192.168.1.10"), GenerateJob(name, "default", seederv1alpha1.NodePowerActionReboot))
+		assert.NoError(err, "expected job to be submitted for %s", name)
+	}
+
+	err = s.SubmitJob(ctx, schedulerInventory("node3", "192.168.1.20"), GenerateJob("node3", "default", seederv1alpha1.NodePowerActionReboot))
+	_, ok := IsDeferred(err)
+	assert.True(ok, "expected job to be deferred when subnet is at capacity")
+
+	err = s.SubmitJob(ctx, schedulerInventory("node4", "192.168.2.10"), GenerateJob("node4", "default", seederv1alpha1.NodePowerActionReboot))
+	assert.NoError(err, "expected job in another subnet to be submitted")
+
+	// removing a job frees capacity in the subnet
+	jobList := &rufio.JobList{}
+	assert.NoError(c.List(ctx, jobList, client.MatchingLabels{BMCSubnetLabel: "192.168.1.0-24"}))
+	assert.Len(jobList.Items, 2)
+	assert.NoError(c.Delete(ctx, &jobList.Items[0]))
+	err = s.SubmitJob(ctx, schedulerInventory("node3", "192.168.1.20"), GenerateJob("node3", "default", seederv1alpha1.NodePowerActionReboot))
+	assert.NoError(err, "expected job to be submitted once capacity is available")
+
+	// completed jobs do not count towards the limit
+	s = NewScheduler(c, 1, 0, 24)
+	completed := GenerateJob("node7", "default", seederv1alpha1.NodePowerActionReboot)
+	completed.Labels[BMCSubnetLabel] = "192.168.3.0-24"
+	completed.SetCondition(rufio.JobCompleted, rufio.ConditionTrue)
+	assert.NoError(c.Create(ctx, completed))
+	err = s.SubmitJob(ctx, schedulerInventory("node8", "192.168.3.10"), GenerateJob("node8", "default", seederv1alpha1.NodePowerActionReboot))
+	assert.NoError(err, "expected completed job to be ignored")
+
+	// jobs are spaced within a subnet
+	s = NewScheduler(c, 0, time.Minute, 24)
+	err = s.SubmitJob(ctx, schedulerInventory("node5", "10.0.0.1"), GenerateJob("node5", "default", seederv1alpha1.NodePowerActionShutdown))
+	assert.NoError(err, "expected first job in subnet to be submitted")
+	err = s.SubmitJob(ctx, schedulerInventory("node6", "10.0.0.2"), GenerateJob("node6", "default", seederv1alpha1.NodePowerActionShutdown))
+	requeueAfter, ok := IsDeferred(err)
+	assert.True(ok, "expected job to be deferred by job spacing")
+	assert.True(requeueAfter > 0 && requeueAfter <= time.Minute)
+}
+
+func Test_AdmitInstall(t *testing.T) {
+	assert := require.New(t)
+	c, err := mock.GenerateFakeClient()
+	assert.NoError(err, "expected no error during generation of fake client")
+	ctx := context.TODO()
+	s := NewScheduler(c, 0, 0, 24)
+	cluster := &seederv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "waves",
+			Namespace: "default",
+		},
+	}
+
+	assert.NoError(s.AdmitInstall(ctx, cluster, schedulerInventory("node1", "")), "expected no limit by default")
+
+	cluster.Spec.MaxInstallingNodes = 1
+	installing := schedulerInventory("installing", "")
+	assert.NoError(c.Create(ctx, installing))
+	installing.Status.Cluster = seederv1alpha1.ObjectReference{Name: cluster.Name, Namespace: cluster.Namespace}
+	CreateOrUpdateCondition(installing, seederv1alpha1.HarvesterInstalling, "")
+	assert.NoError(c.Status().Update(ctx, installing))
+
+	_, ok := IsDeferred(s.AdmitInstall(ctx, cluster, schedulerInventory("node2", "")))
+	assert.True(ok, "expected install to be deferred while another node is installing")
+
+	RemoveCondition(installing, seederv1alpha1.HarvesterInstalling)
+	assert.NoError(c.Status().Update(ctx, installing))
+	assert.NoError(s.AdmitInstall(ctx, cluster, schedulerInventory("node2", "")), "expected install to be admitted")
+	assert.NoError(s.AdmitInstall(ctx, cluster, schedulerInventory("node3", "")), "expected admission without a reservation to hold no slot")
+	s.ReserveInstall(cluster, schedulerInventory("node2", ""))
+	assert.NoError(s.AdmitInstall(ctx, cluster, schedulerInventory("node2", "")), "expected reserved node to be admitted again")
+	_, ok = IsDeferred(s.AdmitInstall(ctx, cluster, schedulerInventory("node3", "")))
+	assert.True(ok, "expected reservation to count towards the limit")
+}
@@ -0,0 +1,175 @@
+package util
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+// topology labels applied to harvester nodes
+const (
+	TopologyRegionLabel       = "topology.kubernetes.io/region"
+	TopologyZoneLabel         = "topology.kubernetes.io/zone"
+	TopologyRoomLabel         = "topology.metal.harvesterhci.io/room"
+	TopologyRackPositionLabel = "topology.metal.harvesterhci.io/rack-position"
+	TopologyPowerDomainLabel  = "topology.metal.harvesterhci.io/power-domain"
+)
+
+// managementNodeCount is the number of nodes Harvester promotes to management nodes
+const managementNodeCount = 3
+
+// InventoryLocation returns the location of the inventory. Fields specified on the inventory take precedence over
+// those discovered from the redfish chassis location
+func InventoryLocation(i *seederv1alpha1.Inventory) seederv1alpha1.Location {
+	loc := i.Status.Hardware.Location
+	spec := i.Spec.Location
+	if spec.Datacenter != "" {
+		loc.Datacenter = spec.Datacenter
+	}
+	if spec.Room != "" {
+		loc.Room = spec.Room
+	}
+	if spec.Rack != "" {
+		loc.Rack = spec.Rack
+	}
+	if spec.RackPosition != 0 {
+		loc.RackPosition = spec.RackPosition
+	}
+	if spec.PowerDomain != "" {
+		loc.PowerDomain = spec.PowerDomain
+	}
+	return loc
+}
+
+// TopologyDomain returns the failure domain of the location for the topology key, or an empty string if unknown
+func TopologyDomain(loc seederv1alpha1.Location, key seederv1alpha1.TopologyKey) string {
+	switch key {
+	case seederv1alpha1.TopologyKeyDatacenter:
+		return loc.Datacenter
+	case seederv1alpha1.TopologyKeyRoom:
+		return loc.Room
+	case seederv1alpha1.TopologyKeyRack:
+		return loc.Rack
+	case seederv1alpha1.TopologyKeyPowerDomain:
+		return loc.PowerDomain
+	}
+	return ""
+}
+
+// TopologyLabels returns the topology labels of a node for the location. The datacenter is used as the region and
+// the rack as the zone, and labels for unknown fields have empty values so they can be removed from the node
+func TopologyLabels(loc seederv1alpha1.Location) map[string]string {
+	labels := map[string]string{
+		TopologyRegionLabel:       loc.Datacenter,
+		TopologyZoneLabel:         loc.Rack,
+		TopologyRoomLabel:         loc.Room,
+		TopologyRackPositionLabel: "",
+		TopologyPowerDomainLabel:  loc.PowerDomain,
+	}
+	if loc.RackPosition > 0 {
+		labels[TopologyRackPositionLabel] = strconv.Itoa(loc.RackPosition)
+	}
+	return labels
+}
+
+// TopologyViolation is a failure domain with more nodes than allowed by a spread constraint. Nodes whose failure
+// domain is unknown are reported individually with an empty domain
+type TopologyViolation struct {
+	TopologyKey seederv1alpha1.TopologyKey
+	Scope       seederv1alpha1.TopologySpreadScope
+	Domain      string
+	// Nodes are the namespaced names of the inventory in the failure domain
+	Nodes   []string
+	Maximum int
+}
+
+func (v TopologyViolation) String() string {
+	if v.Domain == "" {
+		return fmt.Sprintf("%s of inventory %s is unknown", v.TopologyKey, strings.Join(v.Nodes, ", "))
+	}
+	return fmt.Sprintf("%s %s has %d nodes (%s), maximum %d", v.TopologyKey, v.Domain, len(v.Nodes), strings.Join(v.Nodes, ", "), v.Maximum)
+}
+
+// Covers returns true if the violation is for the same constraint and failure domain as v, and includes all of its
+// nodes. Removing nodes from a failure domain does not introduce a new violation
+func (v TopologyViolation) Covers(other TopologyViolation) bool {
+	if v.TopologyKey != other.TopologyKey || v.Scope != other.Scope || v.Domain != other.Domain {
+		return false
+	}
+	for _, n := range other.Nodes {
+		if !slices.Contains(v.Nodes, n) {
+			return false
+		}
+	}
+	return true
+}
+
+// TopologySpreadViolations checks the inventory of cluster nodes, in the order they are listed in the cluster spec,
+// against the spread constraints. Only constraints with the actions are checked, and all constraints are checked if
+// no actions are specified. Nodes with an unknown failure domain violate the constraint
+func TopologySpreadViolations(constraints []seederv1alpha1.TopologySpreadConstraint, nodes []*seederv1alpha1.Inventory, actions ...seederv1alpha1.UnsatisfiableConstraintAction) []TopologyViolation {
+	var violations []TopologyViolation
+	for _, c := range constraints {
+		action := c.WhenUnsatisfiable
+		if action == "" {
+			action = seederv1alpha1.DoNotSchedule
+		}
+		if len(actions) != 0 && !slices.Contains(actions, action) {
+			continue
+		}
+
+		maxNodes := c.MaxNodesPerDomain
+		if maxNodes < 1 {
+			maxNodes = 1
+		}
+
+		scope := c.Scope
+		if scope == "" {
+			scope = seederv1alpha1.TopologySpreadScopeManagement
+		}
+		scoped := nodes
+		if scope == seederv1alpha1.TopologySpreadScopeManagement && len(scoped) > managementNodeCount {
+			scoped = scoped[:managementNodeCount]
+		}
+
+		domains := make(map[string][]string)
+		for _, i := range scoped {
+			name := fmt.Sprintf("%s/%s", i.Namespace, i.Name)
+			domain := TopologyDomain(InventoryLocation(i), c.TopologyKey)
+			if domain == "" {
+				violations = append(violations, TopologyViolation{TopologyKey: c.TopologyKey, Scope: scope, Nodes: []string{name}, Maximum: maxNodes})
+				continue
+			}
+			domains[domain] = append(domains[domain], name)
+		}
+
+		var names []string
+		for k := range domains {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		for _, domain := range names {
+			if len(domains[domain]) > maxNodes {
+				violations = append(violations, TopologyViolation{TopologyKey: c.TopologyKey, Scope: scope, Domain: domain, Nodes: domains[domain], Maximum: maxNodes})
+			}
+		}
+	}
+	return violations
+}
+
+// NewTopologyViolations returns the violations of the nodes which are not covered by a violation of the existing nodes,
+// so that changes to a cluster are only rejected for violations they introduce
+func NewTopologyViolations(constraints []seederv1alpha1.TopologySpreadConstraint, existingNodes, nodes []*seederv1alpha1.Inventory, actions ...seederv1alpha1.UnsatisfiableConstraintAction) []TopologyViolation {
+	existing := TopologySpreadViolations(constraints, existingNodes, actions...)
+	var violations []TopologyViolation
+	for _, v := range TopologySpreadViolations(constraints, nodes, actions...) {
+		if !slices.ContainsFunc(existing, func(e TopologyViolation) bool { return e.Covers(v) }) {
+			violations = append(violations, v)
+		}
+	}
+	return violations
+}
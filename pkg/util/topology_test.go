@@ -0,0 +1,103 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+func topologyInventory(name, rack, powerDomain string) *seederv1alpha1.Inventory {
+	return &seederv1alpha1.Inventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: seederv1alpha1.InventorySpec{
+			Location: seederv1alpha1.Location{
+				Rack:        rack,
+				PowerDomain: powerDomain,
+			},
+		},
+	}
+}
+
+func Test_InventoryLocation(t *testing.T) {
+	assert := require.New(t)
+	i := topologyInventory("node1", "r1", "")
+	i.Status.Hardware.Location = seederv1alpha1.Location{
+		Datacenter:   "dc1",
+		Rack:         "r9",
+		RackPosition: 12,
+	}
+	assert.Equal(seederv1alpha1.Location{Datacenter: "dc1", Rack: "r1", RackPosition: 12}, InventoryLocation(i),
+		"expected specified fields to take precedence over discovered location")
+}
+
+func Test_TopologyLabels(t *testing.T) {
+	assert := require.New(t)
+	labels := TopologyLabels(seederv1alpha1.Location{Datacenter: "dc1", Rack: "r1", RackPosition: 12})
+	assert.Equal("dc1", labels[TopologyRegionLabel])
+	assert.Equal("r1", labels[TopologyZoneLabel])
+	assert.Equal("12", labels[TopologyRackPositionLabel])
+	assert.Contains(labels, TopologyPowerDomainLabel, "expected unknown fields to be returned for removal")
+	assert.Empty(labels[TopologyPowerDomainLabel])
+}
+
+func Test_TopologySpreadViolations(t *testing.T) {
+	assert := require.New(t)
+	constraints := []seederv1alpha1.TopologySpreadConstraint{
+		{
+			TopologyKey: seederv1alpha1.TopologyKeyRack,
+		},
+		{
+			TopologyKey:       seederv1alpha1.TopologyKeyPowerDomain,
+			Scope:             seederv1alpha1.TopologySpreadScopeAll,
+			MaxNodesPerDomain: 2,
+			WhenUnsatisfiable: seederv1alpha1.ScheduleAnyway,
+		},
+	}
+
+	nodes := []*seederv1alpha1.Inventory{
+		topologyInventory("node1", "r1", "a"),
+		topologyInventory("node2", "r2", "a"),
+		topologyInventory("node3", "r3", "b"),
+		topologyInventory("node4", "r1", "b"),
+	}
+	assert.Empty(TopologySpreadViolations(constraints, nodes), "expected worker nodes to be ignored by management scope")
+
+	nodes = append(nodes, topologyInventory("node5", "r2", "a"))
+	violations := TopologySpreadViolations(constraints, nodes)
+	assert.Len(violations, 1)
+	assert.Equal("powerDomain a has 3 nodes (default/node1, default/node2, default/node5), maximum 2", violations[0].String())
+	assert.Empty(TopologySpreadViolations(constraints, nodes, seederv1alpha1.DoNotSchedule), "expected ScheduleAnyway constraints to be skipped")
+
+	nodes[1] = topologyInventory("node2", "", "a")
+	violations = TopologySpreadViolations(constraints, nodes, seederv1alpha1.DoNotSchedule)
+	assert.Len(violations, 1)
+	assert.Equal("rack of inventory default/node2 is unknown", violations[0].String())
+}
+
+func Test_NewTopologyViolations(t *testing.T) {
+	assert := require.New(t)
+	constraints := []seederv1alpha1.TopologySpreadConstraint{
+		{
+			TopologyKey: seederv1alpha1.TopologyKeyRack,
+		},
+	}
+
+	existing := []*seederv1alpha1.Inventory{
+		topologyInventory("node1", "r1", ""),
+		topologyInventory("node2", "r1", ""),
+		topologyInventory("node3", "r1", ""),
+	}
+	assert.Empty(NewTopologyViolations(constraints, existing, existing[:2]), "expected removing nodes from a violating rack to be allowed")
+
+	nodes := []*seederv1alpha1.Inventory{existing[0], existing[1], topologyInventory("spare", "r1", "")}
+	assert.Len(NewTopologyViolations(constraints, existing, nodes), 1, "expected node added to a violating rack to be reported")
+
+	nodes[2] = topologyInventory("spare", "r2", "")
+	assert.Empty(NewTopologyViolations(constraints, existing, nodes))
+}
@@ -0,0 +1,84 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	werror "github.com/harvester/webhook/pkg/error"
+	"github.com/harvester/webhook/pkg/server/admission"
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/util"
+)
+
+const (
+	specPath        = "/spec"
+	annotationsPath = "/metadata/annotations"
+)
+
+// ClusterMutator defaults the settings of new clusters from the cluster template they reference
+type ClusterMutator struct {
+	admission.DefaultMutator
+	ctx    context.Context
+	client client.Client
+}
+
+func NewClusterMutator(ctx context.Context, mgr manager.Manager) *ClusterMutator {
+	return &ClusterMutator{
+		ctx:    ctx,
+		client: mgr.GetClient(),
+	}
+}
+
+func (cm *ClusterMutator) Resource() admission.Resource {
+	return admission.Resource{
+		Names:      []string{"clusters"},
+		Scope:      admissionregv1.NamespacedScope,
+		APIGroup:   seederv1alpha1.GroupVersion.Group,
+		APIVersion: seederv1alpha1.GroupVersion.Version,
+		ObjectType: &seederv1alpha1.Cluster{},
+		OperationTypes: []admissionregv1.OperationType{
+			admissionregv1.Create,
+		},
+	}
+}
+
+// Create defaults the cluster from its template. Templates are only applied on creation, and later changes to the
+// template are reported as drift in the cluster status
+func (cm *ClusterMutator) Create(request *admission.Request, newObj runtime.Object) (admission.Patch, error) {
+	cluster, ok := newObj.(*seederv1alpha1.Cluster)
+	if !ok {
+		return nil, werror.NewBadRequest("unable to assert object to Cluster Object")
+	}
+	return cm.clusterTemplatePatch(cluster)
+}
+
+func (cm *ClusterMutator) clusterTemplatePatch(cluster *seederv1alpha1.Cluster) (admission.Patch, error) {
+	if cluster.Spec.ClusterTemplateName == "" {
+		return nil, nil
+	}
+
+	t, err := util.GetClusterTemplate(cm.ctx, cm.client, cluster)
+	if err != nil {
+		return nil, werror.NewBadRequest(fmt.Sprintf("unable to default cluster from template: %v", err))
+	}
+
+	c := cluster.DeepCopy()
+	util.ApplyClusterTemplate(c, t)
+	return admission.Patch{
+		{
+			Op:    admission.PatchOpAdd,
+			Path:  specPath,
+			Value: c.Spec,
+		},
+		{
+			Op:    admission.PatchOpAdd,
+			Path:  annotationsPath,
+			Value: c.Annotations,
+		},
+	}, nil
+}
@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+func Test_clusterTemplatePatch(t *testing.T) {
+	assert := require.New(t)
+	template := &seederv1alpha1.ClusterTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "standard",
+			Namespace: "default",
+		},
+		Spec: seederv1alpha1.ClusterTemplateSpec{
+			HarvesterVersion: "v1.3.0",
+			ClusterConfig: seederv1alpha1.ClusterConfig{
+				Nameservers: []string{"8.8.8.8"},
+			},
+		},
+	}
+	scheme := runtime.NewScheme()
+	assert.NoError(seederv1alpha1.AddToScheme(scheme))
+	cm := &ClusterMutator{
+		ctx:    context.TODO(),
+		client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(template).Build(),
+	}
+
+	cluster := &seederv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "templated",
+			Namespace: "default",
+		},
+	}
+	patch, err := cm.clusterTemplatePatch(cluster)
+	assert.NoError(err)
+	assert.Nil(patch, "expected no patch without a cluster template")
+
+	cluster.Spec.ClusterTemplateName = "standard"
+	patch, err = cm.clusterTemplatePatch(cluster)
+	assert.NoError(err)
+	assert.Len(patch, 2)
+	assert.Equal(specPath, patch[0].Path)
+	spec, ok := patch[0].Value.(seederv1alpha1.ClusterSpec)
+	assert.True(ok)
+	assert.Equal("v1.3.0", spec.HarvesterVersion)
+	assert.Equal([]string{"8.8.8.8"}, spec.Nameservers)
+	assert.Equal(annotationsPath, patch[1].Path)
+	annotations, ok := patch[1].Value.(map[string]string)
+	assert.True(ok)
+	assert.Equal("version,clusterConfig.nameservers", annotations[seederv1alpha1.ClusterTemplateFieldsAnnotation])
+	assert.Empty(cluster.Spec.HarvesterVersion, "expected request object not to be modified")
+
+	cluster.Spec.ClusterTemplateName = "missing"
+	_, err = cm.clusterTemplatePatch(cluster)
+	assert.Error(err, "expected missing cluster template to be rejected")
+}
@@ -3,9 +3,19 @@ package webhook
 import (
 	"context"
 	"fmt"
+	"net"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"time"
 
 	admissionregv1 "k8s.io/api/admissionregistration/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -13,6 +23,13 @@ import (
 	"github.com/harvester/webhook/pkg/server/admission"
 
 	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/tink"
+	"github.com/harvester/seeder/pkg/util"
+)
+
+var (
+	sysctlKeyRegexp       = regexp.MustCompile(`^[a-zA-Z0-9_-]+([./][a-zA-Z0-9_-]+)*$`)
+	filePermissionsRegexp = regexp.MustCompile(`^0?[0-7]{3,4}$`)
 )
 
 type ClusterValidator struct {
@@ -43,20 +60,72 @@ func (cv *ClusterValidator) Resource() admission.Resource {
 }
 
 func (cv *ClusterValidator) Create(request *admission.Request, newObj runtime.Object) error {
-	return cv.validateCluster(newObj)
+	return cv.validateCluster(nil, newObj)
 }
 
 func (cv *ClusterValidator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) error {
-	return cv.validateCluster(newObj)
+	oldCluster, ok := oldObj.(*seederv1alpha1.Cluster)
+	if !ok {
+		return werror.NewBadRequest("unable to assert object to Cluster Object")
+	}
+	return cv.validateCluster(oldCluster, newObj)
 }
 
-func (cv *ClusterValidator) validateCluster(newObj runtime.Object) error {
+func (cv *ClusterValidator) validateCluster(oldCluster *seederv1alpha1.Cluster, newObj runtime.Object) error {
 	cluster, ok := newObj.(*seederv1alpha1.Cluster)
 	if !ok {
 		return werror.NewBadRequest("unable to assert object to Cluster Object")
 	}
 
-	return cv.checkInventoryIsFree(cluster)
+	if err := cv.checkInventoryIsFree(cluster); err != nil {
+		return err
+	}
+
+	if err := cv.checkInventoryNotQuarantined(oldCluster, cluster); err != nil {
+		return err
+	}
+
+	if err := cv.checkInventoryClass(oldCluster, cluster); err != nil {
+		return err
+	}
+
+	if err := cv.checkReferencesGranted(oldCluster, cluster); err != nil {
+		return err
+	}
+
+	if err := cv.checkTopologySpread(oldCluster, cluster); err != nil {
+		return err
+	}
+
+	if err := validateDecommission(cluster); err != nil {
+		return err
+	}
+
+	if err := validateArtifacts(cluster); err != nil {
+		return err
+	}
+
+	if err := validateSparePool(cluster); err != nil {
+		return err
+	}
+
+	if err := validateNodeMetadata(cluster); err != nil {
+		return err
+	}
+
+	if err := validateClusterTemplate(oldCluster, cluster); err != nil {
+		return err
+	}
+
+	if err := validateRancherRegistration(cluster); err != nil {
+		return err
+	}
+
+	if err := validateOSConfig(cluster); err != nil {
+		return err
+	}
+
+	return validateVirtualMedia(cluster)
 }
 
 /* Check that the new cluster doesn't use an inventory object that is already in
@@ -86,6 +155,389 @@ func (cv *ClusterValidator) checkInventoryIsFree(cluster *seederv1alpha1.Cluster
 	return nil
 }
 
+// checkInventoryNotQuarantined rejects inventory added to the cluster which is in maintenance, or was quarantined by
+// a failed decommission, acceptance check or hardware fault. Nodes already part of the cluster are not checked
+func (cv *ClusterValidator) checkInventoryNotQuarantined(oldCluster, cluster *seederv1alpha1.Cluster) error {
+	for _, n := range cluster.Spec.Nodes {
+		if oldCluster != nil && clusterHasInventory(oldCluster, n.InventoryReference) {
+			continue
+		}
+
+		i := &seederv1alpha1.Inventory{}
+		err := cv.client.Get(cv.ctx, types.NamespacedName{Namespace: n.InventoryReference.Namespace, Name: n.InventoryReference.Name}, i)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		if util.ConditionExists(i, seederv1alpha1.Quarantined) {
+			return werror.NewBadRequest(fmt.Sprintf("inventory %s/%s is quarantined, and must be released before it can be used: %s", i.Namespace, i.Name, seederv1alpha1.Quarantined.GetMessage(i)))
+		}
+	}
+	return nil
+}
+
+// checkInventoryClass rejects inventory added to the cluster if its class does not allow the cluster namespace, or the
+// namespace would exceed its quota for the class. Nodes already part of the cluster are not checked, so lowering a
+// quota does not block updates to existing clusters
+func (cv *ClusterValidator) checkInventoryClass(oldCluster, cluster *seederv1alpha1.Cluster) error {
+	added := make(map[string]bool)
+	for _, n := range cluster.Spec.Nodes {
+		if oldCluster != nil && clusterHasInventory(oldCluster, n.InventoryReference) {
+			continue
+		}
+
+		className, err := util.InventoryClassOf(cv.ctx, cv.client, n.InventoryReference)
+		if err != nil {
+			return err
+		}
+		if className == "" {
+			continue
+		}
+
+		class := &seederv1alpha1.InventoryClass{}
+		if err := cv.client.Get(cv.ctx, types.NamespacedName{Name: className}, class); err != nil {
+			if apierrors.IsNotFound(err) {
+				return werror.NewBadRequest(fmt.Sprintf("inventory class %s of inventory %s/%s not found", className, n.InventoryReference.Namespace, n.InventoryReference.Name))
+			}
+			return err
+		}
+
+		if !util.InventoryClassAllowsNamespace(class, cluster.Namespace) {
+			return werror.NewBadRequest(fmt.Sprintf("inventory class %s of inventory %s/%s does not allow namespace %s", className, n.InventoryReference.Namespace, n.InventoryReference.Name, cluster.Namespace))
+		}
+		added[className] = true
+	}
+
+	if len(added) == 0 {
+		return nil
+	}
+
+	usage, err := util.InventoryClassUsage(cv.ctx, cv.client, cluster.Namespace, cluster)
+	if err != nil {
+		return err
+	}
+
+	for className := range added {
+		class := &seederv1alpha1.InventoryClass{}
+		if err := cv.client.Get(cv.ctx, types.NamespacedName{Name: className}, class); err != nil {
+			return err
+		}
+		if limit, ok := util.InventoryClassQuota(class, cluster.Namespace); ok && usage[className] > limit {
+			return werror.NewBadRequest(fmt.Sprintf("namespace %s would allocate %d inventories of class %s, exceeding its quota of %d", cluster.Namespace, usage[className], className, limit))
+		}
+	}
+	return nil
+}
+
+// checkReferencesGranted rejects references to inventory and address pools in other namespaces which are not allowed
+// by a ReferenceGrant. References already part of the cluster are not checked again, so removing a grant does not
+// block updates to existing clusters
+func (cv *ClusterValidator) checkReferencesGranted(oldCluster, cluster *seederv1alpha1.Cluster) error {
+	existing := make(map[objectReference]bool)
+	if oldCluster != nil {
+		for _, v := range clusterReferences(oldCluster) {
+			existing[v] = true
+		}
+	}
+
+	for _, v := range clusterReferences(cluster) {
+		if existing[v] {
+			continue
+		}
+		if err := util.CheckReferenceGranted(cv.ctx, cv.client, seederv1alpha1.ReferenceKindCluster, cluster.Namespace, v.kind, v.name); err != nil {
+			return werror.NewBadRequest(err.Error())
+		}
+	}
+	return nil
+}
+
+// checkTopologySpread rejects changes to cluster nodes which violate a DoNotSchedule topology spread constraint.
+// Violations by the existing nodes are ignored, so nodes can still be removed, and constraints added to a running
+// cluster only apply to later changes. Nodes whose inventory does not exist are not checked
+func (cv *ClusterValidator) checkTopologySpread(oldCluster, cluster *seederv1alpha1.Cluster) error {
+	constraints := cluster.Spec.TopologySpreadConstraints
+	if len(constraints) == 0 {
+		return nil
+	}
+
+	nodes, err := util.ClusterNodeInventory(cv.ctx, cv.client, cluster)
+	if err != nil {
+		return err
+	}
+
+	var oldNodes []*seederv1alpha1.Inventory
+	if oldCluster != nil {
+		oldNodes, err = util.ClusterNodeInventory(cv.ctx, cv.client, oldCluster)
+		if err != nil {
+			return err
+		}
+	}
+
+	var violations []string
+	for _, v := range util.NewTopologyViolations(constraints, oldNodes, nodes, seederv1alpha1.DoNotSchedule) {
+		violations = append(violations, v.String())
+	}
+
+	if len(violations) != 0 {
+		return werror.NewBadRequest(fmt.Sprintf("cluster nodes violate topology spread constraints: %s", strings.Join(violations, ", ")))
+	}
+	return nil
+}
+
+// objectReference identifies an object referenced from another object
+type objectReference struct {
+	kind string
+	name types.NamespacedName
+}
+
+func clusterReferences(cluster *seederv1alpha1.Cluster) []objectReference {
+	refs := []objectReference{
+		{
+			kind: seederv1alpha1.ReferenceKindAddressPool,
+			name: types.NamespacedName{Namespace: cluster.Spec.VIPConfig.AddressPoolReference.Namespace, Name: cluster.Spec.VIPConfig.AddressPoolReference.Name},
+		},
+	}
+	for _, n := range cluster.Spec.Nodes {
+		refs = append(refs, objectReference{
+			kind: seederv1alpha1.ReferenceKindInventory,
+			name: types.NamespacedName{Namespace: n.InventoryReference.Namespace, Name: n.InventoryReference.Name},
+		}, objectReference{
+			kind: seederv1alpha1.ReferenceKindAddressPool,
+			name: types.NamespacedName{Namespace: n.AddressPoolReference.Namespace, Name: n.AddressPoolReference.Name},
+		})
+	}
+	for _, m := range cluster.Spec.RegistryMirrors {
+		if m.CredentialsSecretRef != nil {
+			refs = append(refs, objectReference{
+				kind: seederv1alpha1.ReferenceKindSecret,
+				name: types.NamespacedName{Namespace: m.CredentialsSecretRef.Namespace, Name: m.CredentialsSecretRef.Name},
+			})
+		}
+	}
+	if reg := cluster.Spec.ClusterConfig.RancherRegistration; reg != nil {
+		refs = append(refs, objectReference{
+			kind: seederv1alpha1.ReferenceKindSecret,
+			name: types.NamespacedName{Namespace: reg.TokenSecretRef.Namespace, Name: reg.TokenSecretRef.Name},
+		})
+	}
+	return refs
+}
+
+func clusterHasInventory(cluster *seederv1alpha1.Cluster, ref seederv1alpha1.ObjectReference) bool {
+	for _, n := range cluster.Spec.Nodes {
+		if isSameInventory(n.InventoryReference, ref) {
+			return true
+		}
+	}
+	return false
+}
+
 func isSameInventory(a, b seederv1alpha1.ObjectReference) bool {
 	return a.Name == b.Name && a.Namespace == b.Namespace
 }
+
+// validateRancherRegistration ensures the rancher server url is an http or https url, and the token secret is set
+func validateRancherRegistration(cluster *seederv1alpha1.Cluster) error {
+	reg := cluster.Spec.ClusterConfig.RancherRegistration
+	if reg == nil {
+		return nil
+	}
+
+	if !isHTTPURL(reg.URL) {
+		return werror.NewBadRequest(fmt.Sprintf("rancher registration url %q must be an http or https url", reg.URL))
+	}
+	if reg.TokenSecretRef.Name == "" {
+		return werror.NewBadRequest("rancher registration requires a token secret")
+	}
+	return nil
+}
+
+// validateOSConfig ensures the os settings can be rendered into the harvester config. Kernel args are rendered in a
+// double quoted boot loader variable, so each arg is a single word without quotes
+func validateOSConfig(cluster *seederv1alpha1.Cluster) error {
+	cc := cluster.Spec.ClusterConfig
+	for _, v := range cc.NTPServers {
+		if net.ParseIP(v) == nil && len(validation.IsDNS1123Subdomain(v)) != 0 {
+			return werror.NewBadRequest(fmt.Sprintf("ntp server %q must be an ip address or hostname", v))
+		}
+	}
+
+	if cc.Proxy != nil {
+		for _, v := range []string{cc.Proxy.HTTPProxy, cc.Proxy.HTTPSProxy} {
+			if v != "" && !isHTTPURL(v) {
+				return werror.NewBadRequest(fmt.Sprintf("proxy %q must be an http or https url", v))
+			}
+		}
+		for _, v := range cc.Proxy.NoProxy {
+			if v == "" || strings.ContainsAny(v, ", \t\n") {
+				return werror.NewBadRequest(fmt.Sprintf("invalid no proxy entry %q", v))
+			}
+		}
+	}
+
+	registries := make(map[string]bool)
+	for _, m := range cc.RegistryMirrors {
+		if m.Registry == "" {
+			return werror.NewBadRequest("registry mirror requires a registry")
+		}
+		if registries[m.Registry] {
+			return werror.NewBadRequest(fmt.Sprintf("duplicate registry mirror for %s", m.Registry))
+		}
+		registries[m.Registry] = true
+		if len(m.Endpoints) == 0 {
+			return werror.NewBadRequest(fmt.Sprintf("registry mirror for %s requires an endpoint", m.Registry))
+		}
+		for _, e := range m.Endpoints {
+			if !isHTTPURL(e) {
+				return werror.NewBadRequest(fmt.Sprintf("registry mirror endpoint %q must be an http or https url", e))
+			}
+		}
+		if m.CredentialsSecretRef != nil && m.CredentialsSecretRef.Name == "" {
+			return werror.NewBadRequest(fmt.Sprintf("registry mirror for %s requires a credentials secret name", m.Registry))
+		}
+	}
+
+	for k, v := range cc.Sysctls {
+		if !sysctlKeyRegexp.MatchString(k) {
+			return werror.NewBadRequest(fmt.Sprintf("invalid sysctl %q", k))
+		}
+		if v == "" || strings.ContainsAny(v, "\n") {
+			return werror.NewBadRequest(fmt.Sprintf("invalid value for sysctl %s", k))
+		}
+	}
+
+	for _, v := range cc.KernelArgs {
+		if v == "" || strings.ContainsAny(v, "\"' \t\n") {
+			return werror.NewBadRequest(fmt.Sprintf("invalid kernel arg %q", v))
+		}
+	}
+
+	paths := make(map[string]bool)
+	for _, f := range cc.WriteFiles {
+		if !path.IsAbs(f.Path) || path.Clean(f.Path) != f.Path {
+			return werror.NewBadRequest(fmt.Sprintf("write file path %q must be a clean absolute path", f.Path))
+		}
+		if paths[f.Path] {
+			return werror.NewBadRequest(fmt.Sprintf("duplicate write file %s", f.Path))
+		}
+		paths[f.Path] = true
+		if f.Permissions != "" && !filePermissionsRegexp.MatchString(f.Permissions) {
+			return werror.NewBadRequest(fmt.Sprintf("write file %s permissions %q must be in octal notation", f.Path, f.Permissions))
+		}
+	}
+
+	for _, v := range cc.AfterInstallCommands {
+		if strings.TrimSpace(v) == "" {
+			return werror.NewBadRequest("after install commands cannot be empty")
+		}
+	}
+	return nil
+}
+
+// isHTTPURL returns true if the value is an http or https url with a host
+func isHTTPURL(v string) bool {
+	u, err := url.Parse(v)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// validateArtifacts ensures artifact url templates can be rendered and the raw image checksum is well formed
+func validateArtifacts(cluster *seederv1alpha1.Cluster) error {
+	if _, err := tink.GenerateArtifacts(cluster, "amd64"); err != nil {
+		return werror.NewBadRequest(err.Error())
+	}
+	return nil
+}
+
+// validateVirtualMedia ensures the virtual media iso url template can be rendered. Stream image mode
+// needs the tinkerbell hook os to be booted via pxe, and is not supported with virtual media
+func validateVirtualMedia(cluster *seederv1alpha1.Cluster) error {
+	if !tink.IsVirtualMediaMode(cluster) {
+		return nil
+	}
+
+	if cluster.Spec.StreamImageMode {
+		return werror.NewBadRequest("streamImageMode is not supported with virtualMedia provisioning mode")
+	}
+
+	i := &seederv1alpha1.Inventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "validation",
+			Namespace: cluster.Namespace,
+		},
+		Spec: seederv1alpha1.InventorySpec{
+			Arch: "amd64",
+		},
+	}
+	if _, err := tink.GenerateVirtualMediaURL(i, cluster, seederv1alpha1.DefaultLocalClusterAddress); err != nil {
+		return werror.NewBadRequest(err.Error())
+	}
+	return nil
+}
+
+// validateSparePool ensures the spare pool selector can be parsed
+func validateSparePool(cluster *seederv1alpha1.Cluster) error {
+	if cluster.Spec.SparePool == nil {
+		return nil
+	}
+
+	if _, err := metav1.LabelSelectorAsSelector(&cluster.Spec.SparePool.Selector); err != nil {
+		return werror.NewBadRequest(fmt.Sprintf("invalid spare pool selector: %v", err))
+	}
+	return nil
+}
+
+// validateDecommission ensures the decommission timeout can be parsed. Workflow decommission needs the tinkerbell
+// hook os to be booted via pxe, and is not supported with virtual media
+func validateDecommission(cluster *seederv1alpha1.Cluster) error {
+	if cluster.Spec.Decommission.Timeout != "" {
+		if _, err := time.ParseDuration(cluster.Spec.Decommission.Timeout); err != nil {
+			return werror.NewBadRequest(fmt.Sprintf("invalid decommission timeout: %v", err))
+		}
+	}
+
+	if cluster.Spec.Decommission.Mode == seederv1alpha1.DecommissionModeWorkflow && tink.IsVirtualMediaMode(cluster) {
+		return werror.NewBadRequest("workflow decommission mode is not supported with virtualMedia provisioning mode")
+	}
+	return nil
+}
+
+// validateNodeMetadata ensures node metadata keys are valid and unique, and do not clash with the annotations seeder
+// uses to track the node metadata it manages
+func validateNodeMetadata(cluster *seederv1alpha1.Cluster) error {
+	keys := make(map[seederv1alpha1.NodeMetadataTarget]map[string]bool)
+	for _, m := range cluster.Spec.NodeMetadata {
+		key := util.NodeMetadataKey(m)
+		if errs := validation.IsQualifiedName(key); len(errs) != 0 {
+			return werror.NewBadRequest(fmt.Sprintf("invalid node metadata key %s: %s", key, strings.Join(errs, ", ")))
+		}
+
+		if key == util.ManagedLabelsAnnotation || key == util.ManagedAnnotationsAnnotation {
+			return werror.NewBadRequest(fmt.Sprintf("node metadata key %s is reserved", key))
+		}
+
+		target := m.Target
+		if target == "" {
+			target = seederv1alpha1.NodeMetadataAnnotation
+		}
+		if keys[target] == nil {
+			keys[target] = make(map[string]bool)
+		}
+		if keys[target][key] {
+			return werror.NewBadRequest(fmt.Sprintf("duplicate node metadata %s key %s", target, key))
+		}
+		keys[target][key] = true
+	}
+	return nil
+}
+
+// validateClusterTemplate ensures the cluster template is not changed once the cluster has been defaulted from it.
+// The template reference can be removed to stop drift from being reported
+func validateClusterTemplate(oldCluster, cluster *seederv1alpha1.Cluster) error {
+	if oldCluster == nil || cluster.Spec.ClusterTemplateName == "" || cluster.Spec.ClusterTemplateName == oldCluster.Spec.ClusterTemplateName {
+		return nil
+	}
+	return werror.NewBadRequest(fmt.Sprintf("cluster template cannot be changed to %s after the cluster is created", cluster.Spec.ClusterTemplateName))
+}
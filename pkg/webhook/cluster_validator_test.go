@@ -5,12 +5,14 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/util"
 )
 
 var (
@@ -202,3 +204,544 @@ func Test_checkInventoryIsFree(t *testing.T) {
 		}
 	}
 }
+
+func Test_validateVirtualMedia(t *testing.T) {
+	cases := []struct {
+		Name          string
+		ClusterConfig seederv1alpha1.ClusterConfig
+		ErrorExpected bool
+	}{
+		{
+			Name:          "pxe provisioning mode",
+			ClusterConfig: seederv1alpha1.ClusterConfig{ProvisioningMode: seederv1alpha1.ProvisioningModePXE, StreamImageMode: true},
+			ErrorExpected: false,
+		},
+		{
+			Name:          "virtual media with default iso",
+			ClusterConfig: seederv1alpha1.ClusterConfig{ProvisioningMode: seederv1alpha1.ProvisioningModeVirtualMedia},
+			ErrorExpected: false,
+		},
+		{
+			Name: "virtual media with iso template",
+			ClusterConfig: seederv1alpha1.ClusterConfig{
+				ProvisioningMode: seederv1alpha1.ProvisioningModeVirtualMedia,
+				VirtualMedia:     seederv1alpha1.VirtualMediaSpec{ISOURL: "http://builder/iso?base={{ .ISOURL }}&config={{ .ConfigURL }}"},
+			},
+			ErrorExpected: false,
+		},
+		{
+			Name: "virtual media with invalid iso template",
+			ClusterConfig: seederv1alpha1.ClusterConfig{
+				ProvisioningMode: seederv1alpha1.ProvisioningModeVirtualMedia,
+				VirtualMedia:     seederv1alpha1.VirtualMediaSpec{ISOURL: "http://builder/{{ .Missing }}"},
+			},
+			ErrorExpected: true,
+		},
+		{
+			Name:          "virtual media with stream image mode",
+			ClusterConfig: seederv1alpha1.ClusterConfig{ProvisioningMode: seederv1alpha1.ProvisioningModeVirtualMedia, StreamImageMode: true},
+			ErrorExpected: true,
+		},
+	}
+
+	assert := require.New(t)
+	for _, c := range cases {
+		cluster := &seederv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "virtual-media",
+				Namespace: "default",
+			},
+			Spec: seederv1alpha1.ClusterSpec{
+				HarvesterVersion: "v1.2.0",
+				ImageURL:         "http://localhost/iso",
+				ClusterConfig:    c.ClusterConfig,
+			},
+		}
+		err := validateVirtualMedia(cluster)
+		if c.ErrorExpected {
+			assert.Error(err, c.Name)
+		} else {
+			assert.NoError(err, c.Name)
+		}
+	}
+}
+
+func Test_validateSparePool(t *testing.T) {
+	assert := require.New(t)
+	cluster := &seederv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "spares",
+			Namespace: "default",
+		},
+	}
+	assert.NoError(validateSparePool(cluster), "expected no error without a spare pool")
+
+	cluster.Spec.SparePool = &seederv1alpha1.SparePoolSpec{
+		Selector: metav1.LabelSelector{
+			MatchLabels: map[string]string{"role": "spare"},
+		},
+		AutoReplace: true,
+	}
+	assert.NoError(validateSparePool(cluster), "expected valid selector to be accepted")
+
+	cluster.Spec.SparePool.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{
+		{
+			Key:      "role",
+			Operator: "Unknown",
+		},
+	}
+	assert.Error(validateSparePool(cluster), "expected invalid selector operator to be rejected")
+}
+
+func Test_checkInventoryNotQuarantined(t *testing.T) {
+	assert := require.New(t)
+	quarantined := &seederv1alpha1.Inventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "node1",
+			Namespace: "default",
+		},
+	}
+	util.QuarantineInventory(quarantined, seederv1alpha1.QuarantineSourceDecommission, "decommission failed", "", "")
+	scheme := runtime.NewScheme()
+	assert.NoError(seederv1alpha1.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(quarantined).WithStatusSubresource(quarantined).Build()
+	cv := &ClusterValidator{
+		ctx:    context.TODO(),
+		client: fakeClient,
+	}
+
+	assert.Error(cv.checkInventoryNotQuarantined(nil, cluster1), "expected quarantined inventory to be rejected")
+	assert.NoError(cv.checkInventoryNotQuarantined(cluster1, cluster1), "expected existing nodes to be ignored")
+	assert.NoError(cv.checkInventoryNotQuarantined(nil, cluster2), "expected missing inventory to be ignored")
+
+	failed := &seederv1alpha1.Inventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "node4",
+			Namespace: "non-default",
+		},
+	}
+	util.QuarantineInventory(failed, seederv1alpha1.QuarantineSourceAcceptance, "hardware failed acceptance checks: memory", "", "")
+	assert.NoError(fakeClient.Create(context.TODO(), failed))
+	assert.Error(cv.checkInventoryNotQuarantined(nil, cluster2), "expected inventory which failed acceptance to be rejected")
+}
+
+func Test_checkInventoryClass(t *testing.T) {
+	assert := require.New(t)
+	inventory := func(name, class string) *seederv1alpha1.Inventory {
+		return &seederv1alpha1.Inventory{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: seederv1alpha1.InventorySpec{
+				InventoryClassName: class,
+			},
+		}
+	}
+	cluster := func(name, namespace string, inventory ...string) *seederv1alpha1.Cluster {
+		c := &seederv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+		}
+		for _, v := range inventory {
+			c.Spec.Nodes = append(c.Spec.Nodes, seederv1alpha1.NodeConfig{
+				InventoryReference: seederv1alpha1.ObjectReference{Name: v, Namespace: "default"},
+			})
+		}
+		return c
+	}
+
+	gpu := &seederv1alpha1.InventoryClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "gpu",
+		},
+		Spec: seederv1alpha1.InventoryClassSpec{
+			AllowedNamespaces: []string{"team-a", "team-b"},
+			Quotas: []seederv1alpha1.NamespaceQuota{
+				{
+					Namespace: "team-a",
+					Limit:     2,
+				},
+			},
+		},
+	}
+	existing := cluster("existing", "team-a", "gpu-1")
+	scheme := runtime.NewScheme()
+	assert.NoError(seederv1alpha1.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(gpu, existing, inventory("gpu-1", "gpu"),
+		inventory("gpu-2", "gpu"), inventory("gpu-3", "gpu"), inventory("general", ""), inventory("unknown", "unknown")).Build()
+	cv := &ClusterValidator{
+		ctx:    context.TODO(),
+		client: fakeClient,
+	}
+
+	assert.NoError(cv.checkInventoryClass(nil, cluster("general", "team-c", "general")), "expected inventory without class to be allowed in any namespace")
+	assert.Error(cv.checkInventoryClass(nil, cluster("other-team", "team-c", "gpu-2")), "expected namespace not allowed by class to be rejected")
+	assert.Error(cv.checkInventoryClass(nil, cluster("unknown", "team-a", "unknown")), "expected inventory of missing class to be rejected")
+	assert.NoError(cv.checkInventoryClass(nil, cluster("within-quota", "team-a", "gpu-2")), "expected allocation within quota to be accepted")
+	assert.Error(cv.checkInventoryClass(nil, cluster("over-quota", "team-a", "gpu-2", "gpu-3")), "expected allocation exceeding quota to be rejected")
+	assert.NoError(cv.checkInventoryClass(nil, cluster("unlimited", "team-b", "gpu-2", "gpu-3")), "expected namespace without quota to be unlimited")
+
+	updated := cluster("existing", "team-a", "gpu-1", "gpu-2")
+	assert.NoError(cv.checkInventoryClass(existing, updated), "expected update within quota to be accepted")
+	updated = cluster("existing", "team-a", "gpu-1", "gpu-2", "gpu-3")
+	assert.Error(cv.checkInventoryClass(existing, updated), "expected update exceeding quota to be rejected")
+
+	gpu.Spec.Quotas[0].Limit = 0
+	assert.NoError(fakeClient.Update(context.TODO(), gpu))
+	assert.NoError(cv.checkInventoryClass(existing, existing), "expected existing nodes to be ignored when quota is lowered")
+}
+
+func Test_checkReferencesGranted(t *testing.T) {
+	assert := require.New(t)
+	grant := &seederv1alpha1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "team-a",
+			Namespace: "shared",
+		},
+		Spec: seederv1alpha1.ReferenceGrantSpec{
+			From: []seederv1alpha1.ReferenceGrantFrom{
+				{
+					Kind:      seederv1alpha1.ReferenceKindCluster,
+					Namespace: "team-a",
+				},
+			},
+			To: []seederv1alpha1.ReferenceGrantTo{
+				{
+					Kind: seederv1alpha1.ReferenceKindAddressPool,
+				},
+			},
+		},
+	}
+	scheme := runtime.NewScheme()
+	assert.NoError(seederv1alpha1.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(grant).Build()
+	cv := &ClusterValidator{
+		ctx:    context.TODO(),
+		client: fakeClient,
+	}
+
+	cluster := &seederv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "references",
+			Namespace: "team-a",
+		},
+		Spec: seederv1alpha1.ClusterSpec{
+			Nodes: []seederv1alpha1.NodeConfig{
+				{
+					InventoryReference:   seederv1alpha1.ObjectReference{Name: "node1", Namespace: "team-a"},
+					AddressPoolReference: seederv1alpha1.ObjectReference{Name: "pool", Namespace: "shared"},
+				},
+			},
+			VIPConfig: seederv1alpha1.VIPConfig{
+				AddressPoolReference: seederv1alpha1.ObjectReference{Name: "pool", Namespace: "shared"},
+			},
+		},
+	}
+	assert.NoError(cv.checkReferencesGranted(nil, cluster), "expected granted address pool to be accepted")
+
+	updated := cluster.DeepCopy()
+	updated.Spec.Nodes = append(updated.Spec.Nodes, seederv1alpha1.NodeConfig{
+		InventoryReference:   seederv1alpha1.ObjectReference{Name: "node2", Namespace: "shared"},
+		AddressPoolReference: seederv1alpha1.ObjectReference{Name: "pool", Namespace: "shared"},
+	})
+	assert.Error(cv.checkReferencesGranted(cluster, updated), "expected inventory in other namespace to be rejected without a grant")
+
+	rancher := cluster.DeepCopy()
+	rancher.Spec.ClusterConfig.RancherRegistration = &seederv1alpha1.RancherRegistrationSpec{
+		URL:            "https://rancher.example.com",
+		TokenSecretRef: corev1.SecretReference{Name: "rancher-token"},
+	}
+	assert.NoError(cv.checkReferencesGranted(cluster, rancher), "expected rancher token secret in cluster namespace to be accepted")
+	rancher.Spec.ClusterConfig.RancherRegistration.TokenSecretRef.Namespace = "shared"
+	assert.Error(cv.checkReferencesGranted(cluster, rancher), "expected rancher token secret in other namespace to be rejected without a grant")
+
+	mirrors := cluster.DeepCopy()
+	mirrors.Spec.ClusterConfig.RegistryMirrors = []seederv1alpha1.RegistryMirror{
+		{
+			Registry:             "docker.io",
+			Endpoints:            []string{"https://mirror.example.com"},
+			CredentialsSecretRef: &corev1.SecretReference{Name: "mirror-creds", Namespace: "shared"},
+		},
+	}
+	assert.Error(cv.checkReferencesGranted(cluster, mirrors), "expected registry mirror secret in other namespace to be rejected without a grant")
+
+	assert.NoError(fakeClient.Delete(context.TODO(), grant))
+	assert.NoError(cv.checkReferencesGranted(cluster, cluster), "expected existing references to be ignored when grant is removed")
+	assert.Error(cv.checkReferencesGranted(nil, cluster), "expected address pool in other namespace to be rejected without a grant")
+}
+
+func Test_checkTopologySpread(t *testing.T) {
+	assert := require.New(t)
+	inventory := func(name, rack string) *seederv1alpha1.Inventory {
+		return &seederv1alpha1.Inventory{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: seederv1alpha1.InventorySpec{
+				Location: seederv1alpha1.Location{
+					Rack: rack,
+				},
+			},
+		}
+	}
+	cluster := func(inventory ...string) *seederv1alpha1.Cluster {
+		c := &seederv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "topology",
+				Namespace: "default",
+			},
+			Spec: seederv1alpha1.ClusterSpec{
+				TopologySpreadConstraints: []seederv1alpha1.TopologySpreadConstraint{
+					{
+						TopologyKey: seederv1alpha1.TopologyKeyRack,
+					},
+				},
+			},
+		}
+		for _, v := range inventory {
+			c.Spec.Nodes = append(c.Spec.Nodes, seederv1alpha1.NodeConfig{
+				InventoryReference: seederv1alpha1.ObjectReference{Name: v, Namespace: "default"},
+			})
+		}
+		return c
+	}
+
+	scheme := runtime.NewScheme()
+	assert.NoError(seederv1alpha1.AddToScheme(scheme))
+	cv := &ClusterValidator{
+		ctx: context.TODO(),
+		client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(inventory("node1", "r1"), inventory("node2", "r2"),
+			inventory("node3", "r1"), inventory("node4", "r3"), inventory("node5", "")).Build(),
+	}
+
+	assert.NoError(cv.checkTopologySpread(nil, cluster("node1", "node2", "node4")), "expected nodes in different racks to be accepted")
+	assert.Error(cv.checkTopologySpread(nil, cluster("node1", "node2", "node3")), "expected management nodes sharing a rack to be rejected")
+	assert.Error(cv.checkTopologySpread(nil, cluster("node1", "node5")), "expected node with unknown rack to be rejected")
+	assert.NoError(cv.checkTopologySpread(nil, cluster("node1", "node2", "node4", "node3")), "expected worker nodes to be ignored")
+
+	existing := cluster("node1", "node3", "node5")
+	assert.NoError(cv.checkTopologySpread(existing, cluster("node1", "node3")), "expected removing a node to be accepted with existing violations")
+
+	soft := cluster("node1", "node3")
+	soft.Spec.TopologySpreadConstraints[0].WhenUnsatisfiable = seederv1alpha1.ScheduleAnyway
+	assert.NoError(cv.checkTopologySpread(nil, soft), "expected ScheduleAnyway constraint to be accepted")
+}
+
+func Test_validateDecommission(t *testing.T) {
+	assert := require.New(t)
+	cluster := &seederv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "decommission",
+			Namespace: "default",
+		},
+	}
+	assert.NoError(validateDecommission(cluster), "expected no error without decommission")
+
+	cluster.Spec.Decommission = seederv1alpha1.DecommissionSpec{
+		Mode:    seederv1alpha1.DecommissionModeWorkflow,
+		Timeout: "90m",
+	}
+	assert.NoError(validateDecommission(cluster), "expected workflow decommission to be accepted")
+
+	cluster.Spec.Decommission.Timeout = "two hours"
+	assert.Error(validateDecommission(cluster), "expected invalid timeout to be rejected")
+
+	cluster.Spec.Decommission.Timeout = ""
+	cluster.Spec.ProvisioningMode = seederv1alpha1.ProvisioningModeVirtualMedia
+	assert.Error(validateDecommission(cluster), "expected workflow decommission to be rejected with virtual media")
+
+	cluster.Spec.Decommission.Mode = seederv1alpha1.DecommissionModeRedfish
+	assert.NoError(validateDecommission(cluster), "expected redfish decommission to be accepted with virtual media")
+}
+
+func Test_validateNodeMetadata(t *testing.T) {
+	assert := require.New(t)
+	cluster := &seederv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "node-metadata",
+			Namespace: "default",
+		},
+	}
+	assert.NoError(validateNodeMetadata(cluster), "expected no error with default node metadata")
+
+	cluster.Spec.NodeMetadata = []seederv1alpha1.NodeMetadataMapping{
+		{Source: seederv1alpha1.NodeMetadataBMCAddress, Key: "example.com/bmc", Target: seederv1alpha1.NodeMetadataLabel},
+		{Source: seederv1alpha1.NodeMetadataLocation, Key: "example.com/bmc"},
+		{Source: seederv1alpha1.NodeMetadataGPUs},
+	}
+	assert.NoError(validateNodeMetadata(cluster), "expected same key with different targets to be accepted")
+
+	cluster.Spec.NodeMetadata[1].Target = seederv1alpha1.NodeMetadataLabel
+	assert.Error(validateNodeMetadata(cluster), "expected duplicate label key to be rejected")
+
+	cluster.Spec.NodeMetadata[1].Key = "example.com/invalid key"
+	assert.Error(validateNodeMetadata(cluster), "expected invalid key to be rejected")
+
+	cluster.Spec.NodeMetadata[1].Key = util.ManagedLabelsAnnotation
+	assert.Error(validateNodeMetadata(cluster), "expected reserved key to be rejected")
+}
+
+func Test_validateClusterTemplate(t *testing.T) {
+	assert := require.New(t)
+	oldCluster := &seederv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "templated",
+			Namespace: "default",
+		},
+		Spec: seederv1alpha1.ClusterSpec{
+			ClusterTemplateName: "standard",
+		},
+	}
+	assert.NoError(validateClusterTemplate(nil, oldCluster), "expected cluster template to be accepted on create")
+
+	cluster := oldCluster.DeepCopy()
+	assert.NoError(validateClusterTemplate(oldCluster, cluster), "expected unchanged cluster template to be accepted")
+
+	cluster.Spec.ClusterTemplateName = "large"
+	assert.Error(validateClusterTemplate(oldCluster, cluster), "expected changed cluster template to be rejected")
+
+	cluster.Spec.ClusterTemplateName = ""
+	assert.NoError(validateClusterTemplate(oldCluster, cluster), "expected cluster template to be removable")
+}
+
+func Test_validateRancherRegistration(t *testing.T) {
+	assert := require.New(t)
+	cluster := &seederv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rancher",
+			Namespace: "default",
+		},
+	}
+	assert.NoError(validateRancherRegistration(cluster), "expected no error without rancher registration")
+
+	cluster.Spec.ClusterConfig.RancherRegistration = &seederv1alpha1.RancherRegistrationSpec{
+		URL:            "https://rancher.example.com",
+		TokenSecretRef: corev1.SecretReference{Name: "rancher-token"},
+	}
+	assert.NoError(validateRancherRegistration(cluster), "expected rancher registration to be accepted")
+
+	cluster.Spec.ClusterConfig.RancherRegistration.URL = "rancher.example.com"
+	assert.Error(validateRancherRegistration(cluster), "expected url without scheme to be rejected")
+
+	cluster.Spec.ClusterConfig.RancherRegistration.URL = "https://rancher.example.com"
+	cluster.Spec.ClusterConfig.RancherRegistration.TokenSecretRef.Name = ""
+	assert.Error(validateRancherRegistration(cluster), "expected missing token secret to be rejected")
+}
+
+func Test_validateOSConfig(t *testing.T) {
+	assert := require.New(t)
+	cluster := &seederv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "os-config",
+			Namespace: "default",
+		},
+		Spec: seederv1alpha1.ClusterSpec{
+			ClusterConfig: seederv1alpha1.ClusterConfig{
+				NTPServers: []string{"0.suse.pool.ntp.org", "10.0.0.1"},
+				Proxy: &seederv1alpha1.ProxyConfig{
+					HTTPProxy:  "http://proxy.example.com:3128",
+					HTTPSProxy: "http://proxy.example.com:3128",
+					NoProxy:    []string{"localhost", "10.0.0.0/8", ".svc"},
+				},
+				RegistryMirrors: []seederv1alpha1.RegistryMirror{
+					{
+						Registry:             "docker.io",
+						Endpoints:            []string{"https://mirror.example.com"},
+						CredentialsSecretRef: &corev1.SecretReference{Name: "mirror-creds"},
+					},
+				},
+				Sysctls: map[string]string{
+					"net.ipv4.ip_forward": "1",
+				},
+				KernelArgs: []string{"console=ttyS0,115200", "nomodeset"},
+				WriteFiles: []seederv1alpha1.WriteFile{
+					{
+						Path:        "/etc/motd",
+						Content:     "managed by seeder",
+						Permissions: "0644",
+					},
+				},
+				AfterInstallCommands: []string{"echo done > /tmp/installed"},
+			},
+		},
+	}
+	assert.NoError(validateOSConfig(cluster), "expected os config to be accepted")
+
+	invalid := []struct {
+		name   string
+		modify func(cc *seederv1alpha1.ClusterConfig)
+	}{
+		{
+			name:   "invalid ntp server",
+			modify: func(cc *seederv1alpha1.ClusterConfig) { cc.NTPServers = []string{"ntp server"} },
+		},
+		{
+			name:   "proxy without scheme",
+			modify: func(cc *seederv1alpha1.ClusterConfig) { cc.Proxy.HTTPProxy = "proxy.example.com:3128" },
+		},
+		{
+			name:   "no proxy list in single entry",
+			modify: func(cc *seederv1alpha1.ClusterConfig) { cc.Proxy.NoProxy = []string{"localhost,.svc"} },
+		},
+		{
+			name: "duplicate registry mirror",
+			modify: func(cc *seederv1alpha1.ClusterConfig) {
+				cc.RegistryMirrors = append(cc.RegistryMirrors, seederv1alpha1.RegistryMirror{Registry: "docker.io", Endpoints: []string{"https://other.example.com"}})
+			},
+		},
+		{
+			name:   "registry mirror without endpoint",
+			modify: func(cc *seederv1alpha1.ClusterConfig) { cc.RegistryMirrors[0].Endpoints = nil },
+		},
+		{
+			name:   "invalid sysctl",
+			modify: func(cc *seederv1alpha1.ClusterConfig) { cc.Sysctls["net ipv4"] = "1" },
+		},
+		{
+			name:   "quoted kernel arg",
+			modify: func(cc *seederv1alpha1.ClusterConfig) { cc.KernelArgs = []string{`foo="bar baz"`} },
+		},
+		{
+			name:   "relative write file path",
+			modify: func(cc *seederv1alpha1.ClusterConfig) { cc.WriteFiles[0].Path = "etc/motd" },
+		},
+		{
+			name:   "write file permissions",
+			modify: func(cc *seederv1alpha1.ClusterConfig) { cc.WriteFiles[0].Permissions = "rw-r--r--" },
+		},
+		{
+			name:   "empty after install command",
+			modify: func(cc *seederv1alpha1.ClusterConfig) { cc.AfterInstallCommands = []string{" "} },
+		},
+	}
+	for _, v := range invalid {
+		c := cluster.DeepCopy()
+		v.modify(&c.Spec.ClusterConfig)
+		assert.Error(validateOSConfig(c), "expected %s to be rejected", v.name)
+	}
+}
+
+func Test_validateArtifacts(t *testing.T) {
+	assert := require.New(t)
+	cluster := &seederv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "artifacts",
+			Namespace: "default",
+		},
+		Spec: seederv1alpha1.ClusterSpec{
+			HarvesterVersion: "v1.3.0",
+			ImageURL:         "http://images.example.com",
+			Artifacts: seederv1alpha1.ArtifactSpec{
+				RawImage: seederv1alpha1.RawImageArtifact{Checksum: "sha256:abcd"},
+			},
+		},
+	}
+	assert.NoError(validateArtifacts(cluster), "expected raw image checksum to be accepted")
+
+	cluster.Spec.Artifacts.RawImage.Checksum = "md5:abcd"
+	assert.Error(validateArtifacts(cluster), "expected invalid checksum format to be rejected")
+}
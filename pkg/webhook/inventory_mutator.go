@@ -0,0 +1,80 @@
+package webhook
+
+import (
+	werror "github.com/harvester/webhook/pkg/error"
+	"github.com/harvester/webhook/pkg/server/admission"
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+const maintenanceRequestedByPath = "/spec/maintenance/requestedBy"
+
+// InventoryMutator records the user who requested maintenance on an inventory
+type InventoryMutator struct {
+	admission.DefaultMutator
+}
+
+func NewInventoryMutator() *InventoryMutator {
+	return &InventoryMutator{}
+}
+
+func (im *InventoryMutator) Resource() admission.Resource {
+	return admission.Resource{
+		Names:      []string{"inventories"},
+		Scope:      admissionregv1.NamespacedScope,
+		APIGroup:   seederv1alpha1.GroupVersion.Group,
+		APIVersion: seederv1alpha1.GroupVersion.Version,
+		ObjectType: &seederv1alpha1.Inventory{},
+		OperationTypes: []admissionregv1.OperationType{
+			admissionregv1.Create,
+			admissionregv1.Update,
+		},
+	}
+}
+
+func (im *InventoryMutator) Create(request *admission.Request, newObj runtime.Object) (admission.Patch, error) {
+	iObj, ok := newObj.(*seederv1alpha1.Inventory)
+	if !ok {
+		return nil, werror.NewBadRequest("unable to assert object to Inventory Object")
+	}
+	return maintenancePatch(request.Username(), nil, iObj), nil
+}
+
+func (im *InventoryMutator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) (admission.Patch, error) {
+	oldInventory, ok := oldObj.(*seederv1alpha1.Inventory)
+	if !ok {
+		return nil, werror.NewBadRequest("unable to assert object to Inventory Object")
+	}
+	iObj, ok := newObj.(*seederv1alpha1.Inventory)
+	if !ok {
+		return nil, werror.NewBadRequest("unable to assert object to Inventory Object")
+	}
+	return maintenancePatch(request.Username(), oldInventory, iObj), nil
+}
+
+// maintenancePatch sets requestedBy to the user who requested or changed maintenance. requestedBy cannot be changed
+// on its own, and keeps the user who last requested maintenance
+func maintenancePatch(username string, oldObj, newObj *seederv1alpha1.Inventory) admission.Patch {
+	m := newObj.Spec.Maintenance
+	if m == nil {
+		return nil
+	}
+
+	requestedBy := username
+	if oldObj != nil && oldObj.Spec.Maintenance != nil && oldObj.Spec.Maintenance.Reason == m.Reason && oldObj.Spec.Maintenance.Expiry == m.Expiry {
+		requestedBy = oldObj.Spec.Maintenance.RequestedBy
+	}
+
+	if m.RequestedBy == requestedBy {
+		return nil
+	}
+	return admission.Patch{
+		{
+			Op:    admission.PatchOpAdd,
+			Path:  maintenanceRequestedByPath,
+			Value: requestedBy,
+		},
+	}
+}
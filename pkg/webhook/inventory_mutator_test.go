@@ -0,0 +1,46 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+)
+
+func Test_maintenancePatch(t *testing.T) {
+	assert := require.New(t)
+	iObj := &seederv1alpha1.Inventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "inventory",
+			Namespace: "default",
+		},
+	}
+	assert.Nil(maintenancePatch("admin", nil, iObj), "expected no patch without maintenance")
+
+	iObj.Spec.Maintenance = &seederv1alpha1.MaintenanceSpec{
+		Reason: "replacing dimm",
+	}
+	patch := maintenancePatch("admin", nil, iObj)
+	assert.Len(patch, 1)
+	assert.Equal(maintenanceRequestedByPath, patch[0].Path)
+	assert.Equal("admin", patch[0].Value)
+
+	// unchanged maintenance keeps the original requester
+	oldObj := iObj.DeepCopy()
+	oldObj.Spec.Maintenance.RequestedBy = "admin"
+	newObj := oldObj.DeepCopy()
+	assert.Nil(maintenancePatch("system:serviceaccount:harvester-system:seeder", oldObj, newObj))
+
+	newObj.Spec.Maintenance.RequestedBy = "someone-else"
+	patch = maintenancePatch("someone-else", oldObj, newObj)
+	assert.Len(patch, 1)
+	assert.Equal("admin", patch[0].Value, "expected requester not to be changed on its own")
+
+	// changed maintenance records the new requester
+	newObj.Spec.Maintenance.Reason = "replacing nic"
+	patch = maintenancePatch("operator", oldObj, newObj)
+	assert.Len(patch, 1)
+	assert.Equal("operator", patch[0].Value)
+}
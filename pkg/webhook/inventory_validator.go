@@ -3,16 +3,19 @@ package webhook
 import (
 	"context"
 	"fmt"
+	"time"
 
 	werror "github.com/harvester/webhook/pkg/error"
 	"github.com/harvester/webhook/pkg/server/admission"
 	admissionregv1 "k8s.io/api/admissionregistration/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	seederv1alpha1 "github.com/harvester/seeder/pkg/api/v1alpha1"
+	"github.com/harvester/seeder/pkg/util"
 )
 
 type InventoryValidator struct {
@@ -43,39 +46,137 @@ func (iv *InventoryValidator) Resource() admission.Resource {
 }
 
 func (iv *InventoryValidator) Create(request *admission.Request, newObj runtime.Object) error {
-	return iv.validateInventory(newObj)
+	return iv.validateInventory(nil, newObj)
 }
 
 func (iv *InventoryValidator) Update(request *admission.Request, oldObj runtime.Object, newObj runtime.Object) error {
-	return iv.validateInventory(newObj)
+	oldInventory, ok := oldObj.(*seederv1alpha1.Inventory)
+	if !ok {
+		return werror.NewBadRequest("unable to assert object to Inventory Object")
+	}
+	return iv.validateInventory(oldInventory, newObj)
 }
 
-func (iv *InventoryValidator) validateInventory(newObj runtime.Object) error {
+func (iv *InventoryValidator) validateInventory(oldInventory *seederv1alpha1.Inventory, newObj runtime.Object) error {
 	iObj, ok := newObj.(*seederv1alpha1.Inventory)
 	if !ok {
 		return werror.NewBadRequest("unable to assert object to Inventory Object")
 	}
 
+	if err := validateManagementInterface(iObj); err != nil {
+		return err
+	}
+
+	if err := validateAcceptance(iObj); err != nil {
+		return err
+	}
+
+	if err := iv.validateInventoryClass(oldInventory, iObj); err != nil {
+		return err
+	}
+
+	if err := iv.checkReferencesGranted(oldInventory, iObj); err != nil {
+		return err
+	}
+
 	return iv.identifyDuplicateInventorySpec(iObj)
 }
 
-func (iv *InventoryValidator) identifyDuplicateInventorySpec(iObj *seederv1alpha1.Inventory) error {
-	inventoryList := &seederv1alpha1.InventoryList{}
-	err := iv.client.List(iv.ctx, inventoryList)
-	if err != nil {
+// validateManagementInterface ensures the management interface is either specified or selected, but not both
+func validateManagementInterface(iObj *seederv1alpha1.Inventory) error {
+	if iObj.Spec.ManagementInterfaceMacAddress != "" && iObj.Spec.ManagementInterfaceSelector != nil {
+		return werror.NewBadRequest("only one of managementInterfaceMacAddress and managementInterfaceSelector can be specified")
+	}
+	return nil
+}
+
+// validateAcceptance ensures the burn in address pool is specified, and the burn in timeout can be parsed
+func validateAcceptance(iObj *seederv1alpha1.Inventory) error {
+	if iObj.Spec.Acceptance == nil || iObj.Spec.Acceptance.BurnIn == nil {
+		return nil
+	}
+
+	burnIn := iObj.Spec.Acceptance.BurnIn
+	if burnIn.AddressPoolReference.Name == "" || burnIn.AddressPoolReference.Namespace == "" {
+		return werror.NewBadRequest("burnIn addressPoolReference name and namespace must be specified")
+	}
+
+	if burnIn.Timeout != "" {
+		if _, err := time.ParseDuration(burnIn.Timeout); err != nil {
+			return werror.NewBadRequest(fmt.Sprintf("invalid burn in timeout: %v", err))
+		}
+	}
+	return nil
+}
+
+// validateInventoryClass ensures the inventory class exists, and the class is not changed while the inventory is
+// allocated to a cluster, as the cluster namespace and quota were checked against the original class
+func (iv *InventoryValidator) validateInventoryClass(oldInventory, iObj *seederv1alpha1.Inventory) error {
+	if oldInventory != nil && oldInventory.Spec.InventoryClassName != iObj.Spec.InventoryClassName && iObj.Status.Cluster.Name != "" {
+		return werror.NewBadRequest(fmt.Sprintf("inventory class cannot be changed while inventory is allocated to cluster %s/%s", iObj.Status.Cluster.Namespace, iObj.Status.Cluster.Name))
+	}
+
+	if iObj.Spec.InventoryClassName == "" {
+		return nil
+	}
+
+	class := &seederv1alpha1.InventoryClass{}
+	if err := iv.client.Get(iv.ctx, types.NamespacedName{Name: iObj.Spec.InventoryClassName}, class); err != nil {
+		if apierrors.IsNotFound(err) {
+			return werror.NewBadRequest(fmt.Sprintf("inventory class %s not found", iObj.Spec.InventoryClassName))
+		}
 		return err
 	}
+	return nil
+}
 
-	for _, v := range inventoryList.Items {
+// checkReferencesGranted rejects references to the bmc secret and burn in address pool in other namespaces which are not
+// allowed by a ReferenceGrant. References which have not changed are not checked again
+func (iv *InventoryValidator) checkReferencesGranted(oldInventory, iObj *seederv1alpha1.Inventory) error {
+	existing := make(map[objectReference]bool)
+	if oldInventory != nil {
+		for _, v := range inventoryReferences(oldInventory) {
+			existing[v] = true
+		}
+	}
 
-		// ignore self from list
-		if iObj.Name == v.Name && iObj.Namespace == v.Namespace {
+	for _, v := range inventoryReferences(iObj) {
+		if existing[v] {
 			continue
 		}
-
-		if v.Spec.BaseboardManagementSpec.Connection.Host == iObj.Spec.BaseboardManagementSpec.Connection.Host && v.Spec.BaseboardManagementSpec.Connection.Port == iObj.Spec.BaseboardManagementSpec.Connection.Port {
-			return apierrors.NewBadRequest(fmt.Sprintf("inventory object %s exists with same baseboard host and port", v.Name))
+		if err := util.CheckReferenceGranted(iv.ctx, iv.client, seederv1alpha1.ReferenceKindInventory, iObj.Namespace, v.kind, v.name); err != nil {
+			return werror.NewBadRequest(err.Error())
 		}
 	}
 	return nil
 }
+
+func inventoryReferences(iObj *seederv1alpha1.Inventory) []objectReference {
+	secretRef := iObj.Spec.BaseboardManagementSpec.Connection.AuthSecretRef
+	refs := []objectReference{
+		{
+			kind: seederv1alpha1.ReferenceKindSecret,
+			name: types.NamespacedName{Namespace: secretRef.Namespace, Name: secretRef.Name},
+		},
+	}
+	if iObj.Spec.Acceptance != nil && iObj.Spec.Acceptance.BurnIn != nil {
+		poolRef := iObj.Spec.Acceptance.BurnIn.AddressPoolReference
+		refs = append(refs, objectReference{
+			kind: seederv1alpha1.ReferenceKindAddressPool,
+			name: types.NamespacedName{Namespace: poolRef.Namespace, Name: poolRef.Name},
+		})
+	}
+	return refs
+}
+
+func (iv *InventoryValidator) identifyDuplicateInventorySpec(iObj *seederv1alpha1.Inventory) error {
+	items, err := util.ListInventory(iv.ctx, iv.client)
+	if err != nil {
+		return err
+	}
+
+	if v := util.FindInventoryWithSameBMC(items, iObj); v != nil {
+		return apierrors.NewBadRequest(fmt.Sprintf("inventory object %s exists with same baseboard host and port", v.Name))
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	nadv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	"github.com/stretchr/testify/require"
 	"github.com/tinkerbell/rufio/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -172,6 +173,147 @@ func Test_identifyDuplicateInventorySpec(t *testing.T) {
 	}
 }
 
+func Test_validateManagementInterface(t *testing.T) {
+	assert := require.New(t)
+	iObj := &seederv1alpha1.Inventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "inventory-new",
+			Namespace: "default",
+		},
+		Spec: seederv1alpha1.InventorySpec{
+			ManagementInterfaceMacAddress: "xx:xx:xx:xx:xx",
+		},
+	}
+	assert.NoError(validateManagementInterface(iObj), "expected no error when only mac address is specified")
+
+	iObj.Spec.ManagementInterfaceSelector = &seederv1alpha1.InterfaceSelector{
+		LinkUp: true,
+	}
+	assert.Error(validateManagementInterface(iObj), "expected error when mac address and selector are specified")
+
+	iObj.Spec.ManagementInterfaceMacAddress = ""
+	assert.NoError(validateManagementInterface(iObj), "expected no error when only selector is specified")
+}
+
+func Test_validateAcceptance(t *testing.T) {
+	assert := require.New(t)
+	iObj := &seederv1alpha1.Inventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "inventory-new",
+			Namespace: "default",
+		},
+	}
+	assert.NoError(validateAcceptance(iObj), "expected no error without acceptance")
+
+	iObj.Spec.Acceptance = &seederv1alpha1.AcceptanceSpec{
+		BurnIn: &seederv1alpha1.BurnInSpec{},
+	}
+	assert.Error(validateAcceptance(iObj), "expected error when burn in address pool is missing")
+
+	iObj.Spec.Acceptance.BurnIn.AddressPoolReference = seederv1alpha1.ObjectReference{Name: "burnin", Namespace: "default"}
+	iObj.Spec.Acceptance.BurnIn.Timeout = "4h"
+	assert.NoError(validateAcceptance(iObj), "expected valid burn in to be accepted")
+
+	iObj.Spec.Acceptance.BurnIn.Timeout = "four hours"
+	assert.Error(validateAcceptance(iObj), "expected invalid timeout to be rejected")
+}
+
+func Test_validateInventoryClass(t *testing.T) {
+	assert := require.New(t)
+	class := &seederv1alpha1.InventoryClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "gpu",
+		},
+	}
+	scheme := runtime.NewScheme()
+	assert.NoError(seederv1alpha1.AddToScheme(scheme))
+	iv := &InventoryValidator{
+		ctx:    context.TODO(),
+		client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(class).Build(),
+	}
+
+	iObj := &seederv1alpha1.Inventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "inventory-class",
+			Namespace: "default",
+		},
+	}
+	assert.NoError(iv.validateInventoryClass(nil, iObj), "expected no error without class")
+
+	iObj.Spec.InventoryClassName = "storage"
+	assert.Error(iv.validateInventoryClass(nil, iObj), "expected missing class to be rejected")
+
+	iObj.Spec.InventoryClassName = "gpu"
+	assert.NoError(iv.validateInventoryClass(nil, iObj), "expected existing class to be accepted")
+
+	oldObj := iObj.DeepCopy()
+	oldObj.Spec.InventoryClassName = ""
+	iObj.Status.Cluster = seederv1alpha1.ObjectReference{Name: "cluster", Namespace: "default"}
+	assert.Error(iv.validateInventoryClass(oldObj, iObj), "expected class change to be rejected while allocated")
+}
+
+func Test_inventoryCheckReferencesGranted(t *testing.T) {
+	assert := require.New(t)
+	scheme := runtime.NewScheme()
+	assert.NoError(seederv1alpha1.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	iv := &InventoryValidator{
+		ctx:    context.TODO(),
+		client: fakeClient,
+	}
+
+	iObj := &seederv1alpha1.Inventory{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "inventory-references",
+			Namespace: "team-a",
+		},
+		Spec: seederv1alpha1.InventorySpec{
+			BaseboardManagementSpec: v1alpha1.MachineSpec{
+				Connection: v1alpha1.Connection{
+					AuthSecretRef: corev1.SecretReference{
+						Name:      "bmc",
+						Namespace: "team-a",
+					},
+				},
+			},
+		},
+	}
+	assert.NoError(iv.checkReferencesGranted(nil, iObj), "expected secret in same namespace to be accepted")
+
+	updated := iObj.DeepCopy()
+	updated.Spec.BaseboardManagementSpec.Connection.AuthSecretRef.Namespace = "shared"
+	assert.Error(iv.checkReferencesGranted(iObj, updated), "expected secret in other namespace to be rejected without a grant")
+
+	assert.NoError(fakeClient.Create(context.TODO(), &seederv1alpha1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bmc",
+			Namespace: "shared",
+		},
+		Spec: seederv1alpha1.ReferenceGrantSpec{
+			From: []seederv1alpha1.ReferenceGrantFrom{
+				{
+					Kind:      seederv1alpha1.ReferenceKindInventory,
+					Namespace: "team-a",
+				},
+			},
+			To: []seederv1alpha1.ReferenceGrantTo{
+				{
+					Kind: seederv1alpha1.ReferenceKindSecret,
+					Name: "bmc",
+				},
+			},
+		},
+	}))
+	assert.NoError(iv.checkReferencesGranted(iObj, updated), "expected granted secret to be accepted")
+
+	updated.Spec.Acceptance = &seederv1alpha1.AcceptanceSpec{
+		BurnIn: &seederv1alpha1.BurnInSpec{
+			AddressPoolReference: seederv1alpha1.ObjectReference{Name: "burnin", Namespace: "shared"},
+		},
+	}
+	assert.Error(iv.checkReferencesGranted(iObj, updated), "expected burn in address pool in other namespace to be rejected without a grant")
+}
+
 func Test_verifyRemoteClusterObjects(t *testing.T) {
 	assert := require.New(t)
 	crdObj := &apiextensionsv1.CustomResourceDefinition{
@@ -37,6 +37,14 @@ func SetupWebhookServer(ctx context.Context, mgr manager.Manager, namespace stri
 	if err := webhookServer.RegisterValidators(NewInventoryTemplateValidator(ctx, mgr)); err != nil {
 		return err
 	}
+
+	if err := webhookServer.RegisterMutators(NewInventoryMutator()); err != nil {
+		return err
+	}
+
+	if err := webhookServer.RegisterMutators(NewClusterMutator(ctx, mgr)); err != nil {
+		return err
+	}
 	// since webhook and manager start run as two go routines, need to wait for caches to sync
 	// before starting the server
 	for {